@@ -21,8 +21,14 @@ var DefaultRoles = map[string]string{
 	atc.CreateBuild:                   MemberRole,
 	atc.ListBuilds:                    ViewerRole,
 	atc.BuildEvents:                   ViewerRole,
+	atc.GetBuildLog:                   ViewerRole,
+	atc.Firehose:                      ViewerRole,
+	atc.TeamFirehose:                  ViewerRole,
 	atc.BuildResources:                ViewerRole,
 	atc.AbortBuild:                    OperatorRole,
+	atc.HoldBuild:                     OperatorRole,
+	atc.ReleaseBuild:                  OperatorRole,
+	atc.ResumeBuild:                   OperatorRole,
 	atc.GetBuildPreparation:           ViewerRole,
 	atc.GetJob:                        ViewerRole,
 	atc.CreateJobBuild:                OperatorRole,
@@ -38,6 +44,7 @@ var DefaultRoles = map[string]string{
 	atc.GetVersionsDB:                 ViewerRole,
 	atc.JobBadge:                      ViewerRole,
 	atc.MainJobBadge:                  ViewerRole,
+	atc.GetJobStats:                   ViewerRole,
 	atc.ClearTaskCache:                OperatorRole,
 	atc.ListAllResources:              ViewerRole,
 	atc.ListResources:                 ViewerRole,
@@ -48,8 +55,10 @@ var DefaultRoles = map[string]string{
 	atc.CheckResource:                 OperatorRole,
 	atc.CheckResourceWebHook:          OperatorRole,
 	atc.CheckResourceType:             OperatorRole,
+	atc.ListResourceWebhookDeliveries: ViewerRole,
 	atc.ListResourceVersions:          ViewerRole,
 	atc.GetResourceVersion:            ViewerRole,
+	atc.SaveResourceVersions:          MemberRole,
 	atc.EnableResourceVersion:         OperatorRole,
 	atc.DisableResourceVersion:        OperatorRole,
 	atc.PinResourceVersion:            OperatorRole,
@@ -85,6 +94,9 @@ var DefaultRoles = map[string]string{
 	atc.ListContainers:                ViewerRole,
 	atc.GetContainer:                  ViewerRole,
 	atc.HijackContainer:               MemberRole,
+	atc.StreamOutContainer:            MemberRole,
+	atc.StreamInContainer:             MemberRole,
+	atc.PortForwardContainer:          MemberRole,
 	atc.ListDestroyingContainers:      ViewerRole,
 	atc.ReportWorkerContainers:        MemberRole,
 	atc.ListVolumes:                   ViewerRole,
@@ -96,6 +108,8 @@ var DefaultRoles = map[string]string{
 	atc.RenameTeam:                    OwnerRole,
 	atc.DestroyTeam:                   OwnerRole,
 	atc.ListTeamBuilds:                ViewerRole,
+	atc.SearchBuildLogs:               ViewerRole,
+	atc.ClearNamedCache:               OperatorRole,
 	atc.CreateArtifact:                MemberRole,
 	atc.GetArtifact:                   MemberRole,
 	atc.ListBuildArtifacts:            ViewerRole,
@@ -0,0 +1,79 @@
+// Package buildfilter reads and writes the query params by which the API
+// filters lists of builds.
+package buildfilter
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// Parse reads the filter for a list of builds from its query params.
+func Parse(query url.Values) (db.BuildFilter, error) {
+	filter := db.BuildFilter{
+		PipelineName: query.Get(atc.BuildsQueryPipeline),
+		JobName:      query.Get(atc.BuildsQueryJob),
+		CreatedBy:    query.Get(atc.BuildsQueryCreatedBy),
+	}
+
+	for _, status := range query[atc.BuildsQueryStatus] {
+		switch atc.BuildStatus(status) {
+		case atc.StatusPending,
+			atc.StatusStarted,
+			atc.StatusSucceeded,
+			atc.StatusFailed,
+			atc.StatusErrored,
+			atc.StatusAborted:
+			filter.Statuses = append(filter.Statuses, db.BuildStatus(status))
+		default:
+			return db.BuildFilter{}, fmt.Errorf("invalid build status: %s", status)
+		}
+	}
+
+	if manual := query.Get(atc.BuildsQueryManuallyTriggered); manual != "" {
+		manuallyTriggered, err := strconv.ParseBool(manual)
+		if err != nil {
+			return db.BuildFilter{}, fmt.Errorf("invalid value for %s: %s", atc.BuildsQueryManuallyTriggered, manual)
+		}
+
+		filter.ManuallyTriggered = &manuallyTriggered
+	}
+
+	return filter, nil
+}
+
+// Query encodes the filter as query params, e.g. for pagination
+// links. It is empty if the filter matches every build, and otherwise starts
+// with an '&' so that it can be appended to other params.
+func Query(filter db.BuildFilter) string {
+	query := url.Values{}
+
+	for _, status := range filter.Statuses {
+		query.Add(atc.BuildsQueryStatus, string(status))
+	}
+
+	if filter.PipelineName != "" {
+		query.Set(atc.BuildsQueryPipeline, filter.PipelineName)
+	}
+
+	if filter.JobName != "" {
+		query.Set(atc.BuildsQueryJob, filter.JobName)
+	}
+
+	if filter.ManuallyTriggered != nil {
+		query.Set(atc.BuildsQueryManuallyTriggered, strconv.FormatBool(*filter.ManuallyTriggered))
+	}
+
+	if filter.CreatedBy != "" {
+		query.Set(atc.BuildsQueryCreatedBy, filter.CreatedBy)
+	}
+
+	if len(query) == 0 {
+		return ""
+	}
+
+	return "&" + query.Encode()
+}
@@ -7,11 +7,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
 	. "github.com/concourse/concourse/atc/testhelpers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -104,6 +107,9 @@ var _ = Describe("Builds API", func() {
 						fakeBuild.StartTimeReturns(time.Unix(1, 0))
 						fakeBuild.EndTimeReturns(time.Unix(100, 0))
 						fakeBuild.ReapTimeReturns(time.Unix(200, 0))
+						fakeBuild.CreatedByReturns("some-user")
+
+						fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
 
 						dbTeam.CreateStartedBuildReturns(fakeBuild, nil)
 					})
@@ -121,7 +127,9 @@ var _ = Describe("Builds API", func() {
 
 					It("creates a started build", func() {
 						Expect(dbTeam.CreateStartedBuildCallCount()).To(Equal(1))
-						Expect(dbTeam.CreateStartedBuildArgsForCall(0)).To(Equal(plan))
+						actualPlan, createdBy := dbTeam.CreateStartedBuildArgsForCall(0)
+						Expect(actualPlan).To(Equal(plan))
+						Expect(createdBy).To(Equal("some-user"))
 					})
 
 					It("returns the created build", func() {
@@ -136,7 +144,8 @@ var _ = Describe("Builds API", func() {
 							"api_url": "/api/v1/builds/42",
 							"start_time": 1,
 							"end_time": 100,
-							"reap_time": 200
+							"reap_time": 200,
+							"created_by": "some-user"
 						}`))
 					})
 
@@ -236,6 +245,69 @@ var _ = Describe("Builds API", func() {
 				})
 			})
 
+			Context("when filters are passed", func() {
+				BeforeEach(func() {
+					queryParams = "?status=failed&status=errored&pipeline_name=some-pipeline&job_name=some-job&manually_triggered=false&created_by=some-user"
+				})
+
+				It("passes them through", func() {
+					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(1))
+
+					manuallyTriggered := false
+
+					_, page := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					Expect(page.Filter).To(Equal(db.BuildFilter{
+						Statuses:          []db.BuildStatus{db.BuildStatusFailed, db.BuildStatusErrored},
+						PipelineName:      "some-pipeline",
+						JobName:           "some-job",
+						ManuallyTriggered: &manuallyTriggered,
+						CreatedBy:         "some-user",
+					}))
+				})
+
+				Context("when next/previous pages are available", func() {
+					BeforeEach(func() {
+						filter := db.BuildFilter{
+							Statuses:     []db.BuildStatus{db.BuildStatusFailed},
+							PipelineName: "some-pipeline",
+						}
+
+						dbBuildFactory.VisibleBuildsReturns(returnedBuilds, db.Pagination{
+							Previous: &db.Page{Until: 4, Limit: 2, Filter: filter},
+							Next:     &db.Page{Since: 3, Limit: 2, Filter: filter},
+						}, nil)
+					})
+
+					It("keeps filtering in the Link headers", func() {
+						Expect(response.Header["Link"]).To(ConsistOf([]string{
+							fmt.Sprintf(`<%s/api/v1/builds?until=4&limit=2&pipeline_name=some-pipeline&status=failed>; rel="previous"`, externalURL),
+							fmt.Sprintf(`<%s/api/v1/builds?since=3&limit=2&pipeline_name=some-pipeline&status=failed>; rel="next"`, externalURL),
+						}))
+					})
+				})
+			})
+
+			Context("when an invalid status is passed", func() {
+				BeforeEach(func() {
+					queryParams = "?status=bogus"
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when an invalid trigger filter is passed", func() {
+				BeforeEach(func() {
+					queryParams = "?manually_triggered=maybe"
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
 			Context("when getting the builds succeeds", func() {
 				BeforeEach(func() {
 					dbBuildFactory.VisibleBuildsReturns(returnedBuilds, db.Pagination{}, nil)
@@ -416,6 +488,33 @@ var _ = Describe("Builds API", func() {
 					teamName, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
 					Expect(teamName).To(ConsistOf("some-team"))
 				})
+
+				Context("when some of the builds belong to other teams", func() {
+					BeforeEach(func() {
+						build1 := returnedBuilds[0].(*dbfakes.FakeBuild)
+						build1.CreatedByReturns("some-user")
+
+						build2 := returnedBuilds[1].(*dbfakes.FakeBuild)
+						build2.TeamNameReturns("some-other-team")
+						build2.CreatedByReturns("some-other-user")
+						build2.AbortedByReturns("some-other-user")
+
+						fakeAccess.IsAuthorizedStub = func(teamName string) bool {
+							return teamName == "some-team"
+						}
+					})
+
+					It("only shows who created or aborted the builds of the user's teams", func() {
+						var builds []atc.Build
+						err := json.NewDecoder(response.Body).Decode(&builds)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(builds).To(HaveLen(2))
+						Expect(builds[0].CreatedBy).To(Equal("some-user"))
+						Expect(builds[1].CreatedBy).To(BeEmpty())
+						Expect(builds[1].AbortedBy).To(BeEmpty())
+					})
+				})
 			})
 
 			Context("when next/previous pages are available", func() {
@@ -501,6 +600,8 @@ var _ = Describe("Builds API", func() {
 					build.StartTimeReturns(time.Unix(1, 0))
 					build.EndTimeReturns(time.Unix(100, 0))
 					build.ReapTimeReturns(time.Unix(200, 0))
+					build.CreatedByReturns("some-user")
+					build.AbortedByReturns("some-other-user")
 					dbBuildFactory.BuildReturns(build, true, nil)
 					build.PipelineReturns(fakePipeline, true, nil)
 					fakePipeline.PublicReturns(true)
@@ -542,6 +643,15 @@ var _ = Describe("Builds API", func() {
 						It("returns 200", func() {
 							Expect(response.StatusCode).To(Equal(http.StatusOK))
 						})
+
+						It("does not show who created or aborted the build", func() {
+							var build atc.Build
+							err := json.NewDecoder(response.Body).Decode(&build)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(build.CreatedBy).To(BeEmpty())
+							Expect(build.AbortedBy).To(BeEmpty())
+						})
 					})
 				})
 
@@ -558,6 +668,15 @@ var _ = Describe("Builds API", func() {
 						It("returns 200 OK", func() {
 							Expect(response.StatusCode).To(Equal(http.StatusOK))
 						})
+
+						It("does not show who created or aborted the build", func() {
+							var build atc.Build
+							err := json.NewDecoder(response.Body).Decode(&build)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(build.CreatedBy).To(BeEmpty())
+							Expect(build.AbortedBy).To(BeEmpty())
+						})
 					})
 
 					Context("when user is authorized", func() {
@@ -594,7 +713,9 @@ var _ = Describe("Builds API", func() {
 						"api_url": "/api/v1/builds/1",
 						"start_time": 1,
 						"end_time": 100,
-						"reap_time": 200
+						"reap_time": 200,
+						"created_by": "some-user",
+						"aborted_by": "some-other-user"
 					}`))
 						})
 					})
@@ -976,6 +1097,166 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/builds/:build_id/log", func() {
+		var (
+			fakeEventSource *dbfakes.FakeEventSource
+			query           string
+			response        *http.Response
+		)
+
+		envelope := func(ev atc.Event) event.Envelope {
+			payload, err := json.Marshal(ev)
+			Expect(err).NotTo(HaveOccurred())
+
+			data := json.RawMessage(payload)
+
+			return event.Envelope{
+				Data:    &data,
+				Event:   ev.EventType(),
+				Version: ev.Version(),
+			}
+		}
+
+		BeforeEach(func() {
+			query = ""
+
+			build.JobNameReturns("some-job")
+			build.TeamNameReturns("some-team")
+			build.PipelineReturns(fakePipeline, true, nil)
+			dbBuildFactory.BuildReturns(build, true, nil)
+
+			fakeAccess.IsAuthenticatedReturns(true)
+			fakeAccess.IsAuthorizedReturns(true)
+
+			plan := json.RawMessage(`{
+				"id": "1",
+				"do": [
+					{"id": "2", "get": {"name": "repo"}},
+					{"id": "3", "task": {"name": "unit"}}
+				]
+			}`)
+			build.HasPlanReturns(true)
+			build.PublicPlanReturns(&plan)
+
+			events := []atc.Event{
+				event.Status{Status: atc.StatusStarted, Time: 99},
+				event.Log{Time: 100, Origin: event.Origin{ID: "2"}, Payload: "fetching\n"},
+				event.InitializeTask{Time: 110, Origin: event.Origin{ID: "3"}},
+				event.StartTask{Time: 111, Origin: event.Origin{ID: "3"}, TaskConfig: event.TaskConfig{
+					Run: event.TaskRunConfig{Path: "go", Args: []string{"test"}},
+				}},
+				event.Log{Time: 112, Origin: event.Origin{ID: "3", Source: event.OriginSourceStdout}, Payload: "ok  \tpkg"},
+				event.Log{Time: 113, Origin: event.Origin{ID: "3", Source: event.OriginSourceStdout}, Payload: "\nFAIL\n"},
+				event.Error{Time: 114, Origin: event.Origin{ID: "3"}, Message: "boom"},
+				event.Status{Status: atc.StatusFailed, Time: 120},
+			}
+
+			fakeEventSource = new(dbfakes.FakeEventSource)
+			for i, ev := range events {
+				fakeEventSource.NextReturnsOnCall(i, envelope(ev), nil)
+			}
+			fakeEventSource.NextReturnsOnCall(len(events), event.Envelope{}, db.ErrEndOfBuildEventStream)
+
+			build.EventsReturns(fakeEventSource, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/128/log" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		readBody := func() string {
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			return string(body)
+		}
+
+		It("renders the log as plain text", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(response.Header.Get("Content-Type")).To(Equal("text/plain; charset=utf-8"))
+
+			Expect(readBody()).To(Equal("fetching\ninitializing\nrunning go test\nok  \tpkg\nFAIL\nboom\nfailed\n"))
+
+			Expect(build.EventsCallCount()).To(Equal(1))
+			Expect(build.EventsArgsForCall(0)).To(BeZero())
+			Expect(fakeEventSource.CloseCallCount()).To(Equal(1))
+		})
+
+		Context("when step headers are requested", func() {
+			BeforeEach(func() {
+				query = "?steps=true"
+			})
+
+			It("prints a header whenever the step changes", func() {
+				Expect(readBody()).To(Equal("=== get: repo ===\nfetching\n=== task: unit ===\ninitializing\nrunning go test\nok  \tpkg\nFAIL\nboom\nfailed\n"))
+			})
+		})
+
+		Context("when timestamps are requested", func() {
+			BeforeEach(func() {
+				query = "?timestamps=true"
+			})
+
+			It("prefixes every line with its time", func() {
+				lines := strings.Split(readBody(), "\n")
+				Expect(lines[0]).To(Equal("1970-01-01T00:01:40Z  fetching"))
+				Expect(lines[3]).To(Equal("1970-01-01T00:01:52Z  ok  \tpkg"))
+				Expect(lines[6]).To(Equal("1970-01-01T00:02:00Z  failed"))
+			})
+		})
+
+		Context("when JSON lines are requested", func() {
+			BeforeEach(func() {
+				query = "?format=jsonl"
+			})
+
+			It("renders one JSON object per line", func() {
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/x-ndjson"))
+
+				lines := strings.Split(strings.TrimSpace(readBody()), "\n")
+				Expect(lines).To(HaveLen(5))
+				Expect(lines[0]).To(MatchJSON(`{"type":"log","time":100,"origin":"2","step":"get: repo","message":"fetching"}`))
+				Expect(lines[1]).To(MatchJSON(`{"type":"log","time":112,"origin":"3","source":"stdout","step":"task: unit","message":"ok  \tpkg"}`))
+				Expect(lines[2]).To(MatchJSON(`{"type":"log","time":113,"origin":"3","source":"stdout","step":"task: unit","message":"FAIL"}`))
+				Expect(lines[3]).To(MatchJSON(`{"type":"error","time":114,"origin":"3","step":"task: unit","message":"boom"}`))
+				Expect(lines[4]).To(MatchJSON(`{"type":"status","time":120,"message":"failed"}`))
+			})
+		})
+
+		Context("when the format is unknown", func() {
+			BeforeEach(func() {
+				query = "?format=xml"
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(build.EventsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when getting the events fails", func() {
+			BeforeEach(func() {
+				build.EventsReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/builds/:build_id/abort", func() {
 		var (
 			response *http.Response
@@ -1059,12 +1340,298 @@ var _ = Describe("Builds API", func() {
 
 					Context("when aborting succeeds", func() {
 						BeforeEach(func() {
+							fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
+
 							build.MarkAsAbortedReturns(nil)
 						})
 
 						It("returns 204", func() {
 							Expect(response.StatusCode).To(Equal(http.StatusNoContent))
 						})
+
+						It("records the current user as having aborted the build", func() {
+							Expect(build.MarkAsAbortedCallCount()).To(Equal(1))
+							Expect(build.MarkAsAbortedArgsForCall(0)).To(Equal("some-user"))
+						})
+					})
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/builds/:build_id/hold", func() {
+		var (
+			response *http.Response
+		)
+
+		JustBeforeEach(func() {
+			var err error
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/hold", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the build can not be found", func() {
+				BeforeEach(func() {
+					dbBuildFactory.BuildReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the build is found", func() {
+				BeforeEach(func() {
+					build.TeamNameReturns("some-team")
+					dbBuildFactory.BuildReturns(build, true, nil)
+				})
+
+				Context("when not authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+
+					It("does not hold the build", func() {
+						Expect(build.SetHeldCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(true)
+					})
+
+					It("returns 204", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					})
+
+					It("holds the build", func() {
+						Expect(build.SetHeldCallCount()).To(Equal(1))
+						Expect(build.SetHeldArgsForCall(0)).To(Equal(true))
+					})
+
+					Context("when holding the build fails", func() {
+						BeforeEach(func() {
+							build.SetHeldReturns(errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/builds/:build_id/resume", func() {
+		var (
+			response *http.Response
+		)
+
+		JustBeforeEach(func() {
+			var err error
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/resume", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the build can not be found", func() {
+				BeforeEach(func() {
+					dbBuildFactory.BuildReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the build is found", func() {
+				BeforeEach(func() {
+					build.TeamNameReturns("some-team")
+					dbBuildFactory.BuildReturns(build, true, nil)
+				})
+
+				Context("when not authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+
+					It("does not resume the build", func() {
+						Expect(build.ResumeCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(true)
+					})
+
+					Context("when the build is paused at a breakpoint", func() {
+						BeforeEach(func() {
+							build.ResumeReturns(true, nil)
+						})
+
+						It("returns 204", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+						})
+
+						It("resumes the build", func() {
+							Expect(build.ResumeCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when the build is not paused at a breakpoint", func() {
+						BeforeEach(func() {
+							build.ResumeReturns(false, nil)
+						})
+
+						It("returns 409", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						})
+					})
+
+					Context("when resuming the build fails", func() {
+						BeforeEach(func() {
+							build.ResumeReturns(false, errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/builds/:build_id/release", func() {
+		var (
+			response *http.Response
+		)
+
+		JustBeforeEach(func() {
+			var err error
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/release", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the build can not be found", func() {
+				BeforeEach(func() {
+					dbBuildFactory.BuildReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the build is found", func() {
+				BeforeEach(func() {
+					build.TeamNameReturns("some-team")
+					dbBuildFactory.BuildReturns(build, true, nil)
+				})
+
+				Context("when not authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+
+					It("does not release the build", func() {
+						Expect(build.SetHeldCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(true)
+					})
+
+					It("returns 204", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					})
+
+					It("releases the build", func() {
+						Expect(build.SetHeldCallCount()).To(Equal(1))
+						Expect(build.SetHeldArgsForCall(0)).To(Equal(false))
+					})
+
+					Context("when releasing the build fails", func() {
+						BeforeEach(func() {
+							build.SetHeldReturns(errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
 					})
 				})
 			})
@@ -1484,4 +2051,166 @@ var _ = Describe("Builds API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/firehose", func() {
+		var (
+			fakeSource *dbfakes.FakeBuildLifecycleEventSource
+			request    *http.Request
+			response   *http.Response
+		)
+
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest("GET", server.URL+"/api/v1/firehose", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeSource = new(dbfakes.FakeBuildLifecycleEventSource)
+			fakeSource.NextReturnsOnCall(0, atc.BuildLifecycleEvent{
+				ID:           42,
+				Type:         atc.BuildStarted,
+				Status:       atc.StatusStarted,
+				Time:         100,
+				BuildID:      128,
+				BuildName:    "3",
+				TeamName:     "some-team",
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+			}, nil)
+			fakeSource.NextReturnsOnCall(1, atc.BuildLifecycleEvent{}, db.ErrBuildLifecycleEventStreamClosed)
+
+			dbBuildFactory.BuildLifecycleEventsReturns(fakeSource, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("streams the lifecycle events of all builds from now on", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("text/event-stream; charset=utf-8"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(string(body)).To(Equal("id: 42\nevent: event\n" +
+					`data: {"id":42,"type":"started","status":"started","time":100,"build_id":128,"build_name":"3","team_name":"some-team","pipeline_name":"some-pipeline","job_name":"some-job"}` +
+					"\n\n"))
+
+				Expect(dbBuildFactory.BuildLifecycleEventsCallCount()).To(Equal(1))
+				Expect(dbBuildFactory.BuildLifecycleEventsArgsForCall(0)).To(Equal(int64(-1)))
+			})
+
+			It("closes the event source", func() {
+				_, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(fakeSource.CloseCallCount).Should(Equal(1))
+			})
+
+			Context("when resuming with Last-Event-ID", func() {
+				BeforeEach(func() {
+					request.Header.Set("Last-Event-ID", "41")
+				})
+
+				It("streams the events after it", func() {
+					Expect(dbBuildFactory.BuildLifecycleEventsArgsForCall(0)).To(Equal(int64(41)))
+				})
+			})
+
+			Context("when resuming with the last_event_id query param", func() {
+				BeforeEach(func() {
+					request.URL.RawQuery = "last_event_id=10"
+				})
+
+				It("streams the events after it", func() {
+					Expect(dbBuildFactory.BuildLifecycleEventsArgsForCall(0)).To(Equal(int64(10)))
+				})
+			})
+
+			Context("when the last event id is invalid", func() {
+				BeforeEach(func() {
+					request.Header.Set("Last-Event-ID", "nope")
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when subscribing fails", func() {
+				BeforeEach(func() {
+					dbBuildFactory.BuildLifecycleEventsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/firehose", func() {
+		var (
+			fakeSource *dbfakes.FakeBuildLifecycleEventSource
+			response   *http.Response
+		)
+
+		BeforeEach(func() {
+			fakeSource = new(dbfakes.FakeBuildLifecycleEventSource)
+			fakeSource.NextReturns(atc.BuildLifecycleEvent{}, db.ErrBuildLifecycleEventStreamClosed)
+
+			dbTeam.BuildLifecycleEventsReturns(fakeSource, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/firehose?last_event_id=7")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("streams the team's build lifecycle events", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("some-team"))
+				Expect(dbTeam.BuildLifecycleEventsCallCount()).To(Equal(1))
+				Expect(dbTeam.BuildLifecycleEventsArgsForCall(0)).To(Equal(int64(7)))
+			})
+		})
+	})
 })
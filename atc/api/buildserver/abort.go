@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -13,7 +14,7 @@ func (s *Server) AbortBuild(build db.Build) http.Handler {
 			"build": build.ID(),
 		})
 
-		err := build.MarkAsAborted()
+		err := build.MarkAsAborted(accessor.GetAccessor(r).Claims().UserName)
 		if err != nil {
 			aLog.Error("failed-to-abort-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
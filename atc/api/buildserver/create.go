@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -22,7 +23,7 @@ func (s *Server) CreateBuild(team db.Team) http.Handler {
 			return
 		}
 
-		build, err := team.CreateStartedBuild(plan)
+		build, err := team.CreateStartedBuild(plan, accessor.GetAccessor(r).Claims().UserName)
 		if err != nil {
 			hLog.Error("failed-to-create-one-off-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -32,7 +33,7 @@ func (s *Server) CreateBuild(team db.Team) http.Handler {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusCreated)
 
-		err = json.NewEncoder(w).Encode(present.Build(build))
+		err = json.NewEncoder(w).Encode(present.Build(build, true))
 		if err != nil {
 			hLog.Error("failed-to-encode-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,114 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/vito/go-sse/sse"
+)
+
+func (s *Server) Firehose(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("firehose")
+
+	s.serveFirehose(logger, w, r, s.buildFactory.BuildLifecycleEvents)
+}
+
+func (s *Server) TeamFirehose(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("team-firehose", lager.Data{"team": team.Name()})
+
+		s.serveFirehose(logger, w, r, team.BuildLifecycleEvents)
+	})
+}
+
+func (s *Server) serveFirehose(
+	logger lager.Logger,
+	w http.ResponseWriter,
+	r *http.Request,
+	subscribe func(int64) (db.BuildLifecycleEventSource, error),
+) {
+	// the event source sends an empty Last-Event-ID when it first connects, so
+	// fall back to the query param to let clients resume a previous stream
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get(atc.FirehoseQueryLastEventID)
+	}
+
+	var after int64 = -1
+	if lastEventID != "" {
+		var err error
+		after, err = strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil || after < 0 {
+			logger.Info("failed-to-parse-last-event-id", lager.Data{"last-event-id": lastEventID})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	events, err := subscribe(after)
+	if err != nil {
+		logger.Error("failed-to-subscribe-to-build-lifecycle-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// closing the source unblocks Next, so close it once the client goes away
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+
+		db.Close(events)
+	}()
+
+	w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+	w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Add("X-Accel-Buffering", "no")
+	w.Header().Add(ProtocolVersionHeader, CurrentProtocolVersion)
+
+	w.WriteHeader(http.StatusOK)
+
+	writer := eventWriter{
+		responseWriter:  w,
+		responseFlusher: w.(http.Flusher),
+	}
+
+	writer.responseFlusher.Flush()
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err != db.ErrBuildLifecycleEventStreamClosed {
+				logger.Error("failed-to-get-next-build-lifecycle-event", err)
+			}
+
+			return
+		}
+
+		payload, err := json.Marshal(ev)
+		if err != nil {
+			logger.Error("failed-to-marshal-build-lifecycle-event", err)
+			return
+		}
+
+		err = sse.Event{
+			ID:   strconv.FormatInt(ev.ID, 10),
+			Name: "event",
+			Data: payload,
+		}.Write(writer.responseWriter)
+		if err != nil {
+			logger.Info("failed-to-write-event", lager.Data{"error": err.Error()})
+			return
+		}
+
+		writer.responseFlusher.Flush()
+	}
+}
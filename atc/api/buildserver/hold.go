@@ -0,0 +1,34 @@
+package buildserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) HoldBuild(build db.Build) http.Handler {
+	return s.setHeld(build, true)
+}
+
+func (s *Server) ReleaseBuild(build db.Build) http.Handler {
+	return s.setHeld(build, false)
+}
+
+func (s *Server) setHeld(build db.Build, held bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hLog := s.logger.Session("set-held", lager.Data{
+			"build": build.ID(),
+			"held":  held,
+		})
+
+		err := build.SetHeld(held)
+		if err != nil {
+			hLog.Error("failed-to-set-held", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -6,8 +6,10 @@ import (
 	"net/http"
 	"strconv"
 
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/buildfilter"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -42,7 +44,14 @@ func (s *Server) ListBuilds(w http.ResponseWriter, r *http.Request) {
 		limit = atc.PaginationAPIDefaultLimit
 	}
 
-	page := db.Page{Until: until, Since: since, Limit: limit, UseDate: useDate}
+	filter, err := buildfilter.Parse(r.URL.Query())
+	if err != nil {
+		logger.Info("invalid-build-filter", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	page := db.Page{Until: until, Since: since, Limit: limit, UseDate: useDate, Filter: filter}
 
 	var builds []db.Build
 	var pagination db.Pagination
@@ -74,7 +83,7 @@ func (s *Server) ListBuilds(w http.ResponseWriter, r *http.Request) {
 	atc := make([]atc.Build, len(builds))
 	for i := 0; i < len(builds); i++ {
 		build := builds[i]
-		atc[i] = present.Build(build)
+		atc[i] = present.Build(build, acc.IsAuthorized(build.TeamName()))
 	}
 
 	err = json.NewEncoder(w).Encode(atc)
@@ -86,24 +95,26 @@ func (s *Server) ListBuilds(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) addNextLink(w http.ResponseWriter, page db.Page) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		atc.PaginationQuerySince,
 		page.Since,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		buildfilter.Query(page.Filter),
 		atc.LinkRelNext,
 	))
 }
 
 func (s *Server) addPreviousLink(w http.ResponseWriter, page db.Page) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		atc.PaginationQueryUntil,
 		page.Until,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		buildfilter.Query(page.Filter),
 		atc.LinkRelPrevious,
 	))
 }
@@ -0,0 +1,373 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+func (s *Server) GetBuildLog(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("get-build-log", lager.Data{"build-id": build.ID()})
+
+		format := atc.BuildLogFormat(r.FormValue(atc.BuildLogQueryFormat))
+		if format == "" {
+			format = atc.BuildLogFormatText
+		}
+
+		steps := stepNames(logger, build)
+
+		var renderer logRenderer
+		switch format {
+		case atc.BuildLogFormatText:
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			renderer = newTextLogRenderer(w, steps, r.FormValue(atc.BuildLogQueryTimestamps) == "true", r.FormValue(atc.BuildLogQuerySteps) == "true")
+		case atc.BuildLogFormatJSONLines:
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			renderer = newJSONLogRenderer(w, steps)
+		default:
+			http.Error(w, fmt.Sprintf("unknown log format '%s'", format), http.StatusBadRequest)
+			return
+		}
+
+		events, err := build.Events(0)
+		if err != nil {
+			logger.Error("failed-to-get-build-events", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		defer db.Close(events)
+
+		w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		flusher, _ := w.(http.Flusher)
+
+		for {
+			envelope, err := events.Next()
+			if err != nil {
+				if err != db.ErrEndOfBuildEventStream {
+					logger.Error("failed-to-get-next-build-event", err)
+				}
+
+				break
+			}
+
+			ev, err := event.ParseEvent(envelope.Version, envelope.Event, *envelope.Data)
+			if err != nil {
+				logger.Info("failed-to-parse-build-event", lager.Data{"error": err.Error()})
+				continue
+			}
+
+			err = renderer.Render(ev)
+			if err != nil {
+				logger.Info("failed-to-write-build-log", lager.Data{"error": err.Error()})
+				return
+			}
+
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+
+		err = renderer.Close()
+		if err != nil {
+			logger.Info("failed-to-write-build-log", lager.Data{"error": err.Error()})
+		}
+	})
+}
+
+// stepNames maps the origins of a build's events to the names of the steps
+// they came from, e.g. "task: unit".
+func stepNames(logger lager.Logger, build db.Build) map[event.OriginID]string {
+	names := map[event.OriginID]string{}
+
+	if !build.HasPlan() || build.PublicPlan() == nil {
+		return names
+	}
+
+	var plan atc.Plan
+	err := json.Unmarshal(*build.PublicPlan(), &plan)
+	if err != nil {
+		logger.Info("failed-to-unmarshal-public-plan", lager.Data{"error": err.Error()})
+		return names
+	}
+
+	plan.Each(func(p *atc.Plan) {
+		id := event.OriginID(p.ID)
+
+		switch {
+		case p.Get != nil:
+			names[id] = "get: " + p.Get.Name
+		case p.Put != nil:
+			names[id] = "put: " + p.Put.Name
+		case p.Task != nil:
+			names[id] = "task: " + p.Task.Name
+		case p.SetPipeline != nil:
+			names[id] = "set_pipeline: " + p.SetPipeline.Name
+		case p.LoadVar != nil:
+			names[id] = "load_var: " + p.LoadVar.Name
+		}
+	})
+
+	return names
+}
+
+type logRenderer interface {
+	Render(atc.Event) error
+	Close() error
+}
+
+// textLogRenderer renders a build's log the way fly shows it, without any
+// colours.
+type textLogRenderer struct {
+	w     io.Writer
+	steps map[event.OriginID]string
+
+	showTimestamps bool
+	showSteps      bool
+
+	lastStep    event.OriginID
+	atLineStart bool
+}
+
+func newTextLogRenderer(w io.Writer, steps map[event.OriginID]string, showTimestamps bool, showSteps bool) *textLogRenderer {
+	return &textLogRenderer{
+		w:     w,
+		steps: steps,
+
+		showTimestamps: showTimestamps,
+		showSteps:      showSteps,
+
+		atLineStart: true,
+	}
+}
+
+func (renderer *textLogRenderer) Render(ev atc.Event) error {
+	switch e := ev.(type) {
+	case event.Log:
+		err := renderer.header(e.Origin.ID)
+		if err != nil {
+			return err
+		}
+
+		return renderer.write(e.Time, e.Payload)
+
+	case event.InitializeTask:
+		err := renderer.header(e.Origin.ID)
+		if err != nil {
+			return err
+		}
+
+		return renderer.writeLine(e.Time, "initializing")
+
+	case event.StartTask:
+		err := renderer.header(e.Origin.ID)
+		if err != nil {
+			return err
+		}
+
+		argv := strings.Join(append([]string{e.TaskConfig.Run.Path}, e.TaskConfig.Run.Args...), " ")
+		return renderer.writeLine(e.Time, "running "+argv)
+
+	case event.Error:
+		err := renderer.header(e.Origin.ID)
+		if err != nil {
+			return err
+		}
+
+		return renderer.writeLine(e.Time, e.Message)
+
+	case event.Status:
+		if e.Status == atc.StatusStarted || e.Status == atc.StatusPending {
+			return nil
+		}
+
+		return renderer.writeLine(e.Time, string(e.Status))
+	}
+
+	return nil
+}
+
+func (renderer *textLogRenderer) Close() error {
+	return nil
+}
+
+func (renderer *textLogRenderer) header(origin event.OriginID) error {
+	if !renderer.showSteps || origin == "" || origin == renderer.lastStep {
+		return nil
+	}
+
+	name, found := renderer.steps[origin]
+	if !found {
+		return nil
+	}
+
+	renderer.lastStep = origin
+
+	return renderer.writeLine(0, "=== "+name+" ===")
+}
+
+// writeLine writes a whole line, starting a new line first if the previous
+// output did not end with one.
+func (renderer *textLogRenderer) writeLine(timestamp int64, line string) error {
+	if !renderer.atLineStart {
+		err := renderer.write(0, "\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	return renderer.write(timestamp, line+"\n")
+}
+
+func (renderer *textLogRenderer) write(timestamp int64, payload string) error {
+	var b strings.Builder
+
+	for _, line := range strings.SplitAfter(payload, "\n") {
+		if line == "" {
+			continue
+		}
+
+		if renderer.showTimestamps && renderer.atLineStart {
+			if timestamp == 0 {
+				b.WriteString(strings.Repeat(" ", len(time.RFC3339)))
+			} else {
+				b.WriteString(time.Unix(timestamp, 0).UTC().Format(time.RFC3339))
+			}
+
+			b.WriteString("  ")
+		}
+
+		b.WriteString(line)
+
+		renderer.atLineStart = strings.HasSuffix(line, "\n")
+	}
+
+	_, err := io.WriteString(renderer.w, b.String())
+	return err
+}
+
+// jsonLogRenderer renders a build's log as JSON lines. Log output is split
+// into lines, holding on to incomplete lines of every origin until they are
+// completed or the build finishes.
+type jsonLogRenderer struct {
+	encoder *json.Encoder
+	steps   map[event.OriginID]string
+
+	pending  map[event.Origin]*atc.BuildLogLine
+	ordering []event.Origin
+}
+
+func newJSONLogRenderer(w io.Writer, steps map[event.OriginID]string) *jsonLogRenderer {
+	return &jsonLogRenderer{
+		encoder: json.NewEncoder(w),
+		steps:   steps,
+
+		pending: map[event.Origin]*atc.BuildLogLine{},
+	}
+}
+
+func (renderer *jsonLogRenderer) Render(ev atc.Event) error {
+	switch e := ev.(type) {
+	case event.Log:
+		lines := strings.SplitAfter(e.Payload, "\n")
+
+		for _, line := range lines {
+			if line == "" {
+				continue
+			}
+
+			pending, found := renderer.pending[e.Origin]
+			if !found {
+				pending = renderer.line(atc.BuildLogLineTypeLog, e.Time, e.Origin, "")
+				renderer.pending[e.Origin] = pending
+				renderer.ordering = append(renderer.ordering, e.Origin)
+			}
+
+			if !strings.HasSuffix(line, "\n") {
+				pending.Message += line
+				continue
+			}
+
+			pending.Message += strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+
+			err := renderer.flush(e.Origin)
+			if err != nil {
+				return err
+			}
+		}
+
+	case event.Error:
+		err := renderer.flushAll()
+		if err != nil {
+			return err
+		}
+
+		return renderer.encoder.Encode(renderer.line(atc.BuildLogLineTypeError, e.Time, e.Origin, e.Message))
+
+	case event.Status:
+		if e.Status == atc.StatusStarted || e.Status == atc.StatusPending {
+			return nil
+		}
+
+		err := renderer.flushAll()
+		if err != nil {
+			return err
+		}
+
+		return renderer.encoder.Encode(renderer.line(atc.BuildLogLineTypeStatus, e.Time, event.Origin{}, string(e.Status)))
+	}
+
+	return nil
+}
+
+func (renderer *jsonLogRenderer) Close() error {
+	return renderer.flushAll()
+}
+
+func (renderer *jsonLogRenderer) line(typ atc.BuildLogLineType, timestamp int64, origin event.Origin, message string) *atc.BuildLogLine {
+	return &atc.BuildLogLine{
+		Type:    typ,
+		Time:    timestamp,
+		Origin:  string(origin.ID),
+		Source:  string(origin.Source),
+		Step:    renderer.steps[origin.ID],
+		Message: message,
+	}
+}
+
+func (renderer *jsonLogRenderer) flush(origin event.Origin) error {
+	pending := renderer.pending[origin]
+	delete(renderer.pending, origin)
+
+	for i, o := range renderer.ordering {
+		if o == origin {
+			renderer.ordering = append(renderer.ordering[:i], renderer.ordering[i+1:]...)
+			break
+		}
+	}
+
+	return renderer.encoder.Encode(pending)
+}
+
+func (renderer *jsonLogRenderer) flushAll() error {
+	for len(renderer.ordering) > 0 {
+		err := renderer.flush(renderer.ordering[0])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package buildserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ResumeBuild(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hLog := s.logger.Session("resume", lager.Data{
+			"build": build.ID(),
+		})
+
+		resumed, err := build.Resume()
+		if err != nil {
+			hLog.Error("failed-to-resume", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !resumed {
+			hLog.Info("not-paused-at-breakpoint")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
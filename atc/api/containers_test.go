@@ -3,6 +3,8 @@ package api_test
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -167,6 +169,55 @@ var _ = Describe("Containers API", func() {
 					})
 				})
 
+				Context("when the containers of their builds are kept", func() {
+					BeforeEach(func() {
+						fakeContainer1.IDReturns(1)
+						fakeContainer2.IDReturns(2)
+						dbTeam.ContainersReturns([]db.Container{fakeContainer1, fakeContainer2}, nil)
+
+						fakeContainerRepository.FindContainerRetentionsReturns(map[int]db.ContainerRetention{
+							1: {Held: true},
+							2: {ExpiresAt: time.Now().Add(time.Hour + 30*time.Second)},
+						}, nil)
+					})
+
+					It("looks up the retentions of the containers", func() {
+						_, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeContainerRepository.FindContainerRetentionsCallCount()).To(Equal(1))
+						Expect(fakeContainerRepository.FindContainerRetentionsArgsForCall(0)).To(Equal([]int{1, 2}))
+					})
+
+					It("returns whether they are held or when they expire", func() {
+						response, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+
+						var containers []atc.Container
+						err = json.NewDecoder(response.Body).Decode(&containers)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(containers).To(HaveLen(2))
+						Expect(containers[0].Held).To(BeTrue())
+						Expect(containers[0].ExpiresIn).To(BeEmpty())
+						Expect(containers[1].Held).To(BeFalse())
+						Expect(containers[1].ExpiresIn).To(Equal("1h0m30s"))
+					})
+
+					Context("when looking up the retentions fails", func() {
+						BeforeEach(func() {
+							fakeContainerRepository.FindContainerRetentionsReturns(nil, errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							response, err := client.Do(req)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+
 				Context("when no containers are found", func() {
 					BeforeEach(func() {
 						dbTeam.ContainersReturns([]db.Container{}, nil)
@@ -991,6 +1042,572 @@ var _ = Describe("Containers API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/a-team/containers/:id/files", func() {
+		var (
+			path     string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			path = "/tmp/build/some-guid/some-output"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			req, err = http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/containers/some-handle/files", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.URL.RawQuery = url.Values{"path": {path}}.Encode()
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			var fakeContainer *workerfakes.FakeContainer
+
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				fakeContainer = new(workerfakes.FakeContainer)
+				fakeWorkerClient.FindContainerReturns(fakeContainer, true, nil)
+				dbTeam.IsContainerWithinTeamReturns(true, nil)
+			})
+
+			Context("when no path is given", func() {
+				BeforeEach(func() {
+					path = ""
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+
+				It("does not stream out of the container", func() {
+					Expect(fakeContainer.StreamOutCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the container could not be found on the worker client", func() {
+				BeforeEach(func() {
+					fakeWorkerClient.FindContainerReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the container is not within the team", func() {
+				BeforeEach(func() {
+					dbTeam.IsContainerWithinTeamReturns(false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+
+				It("does not stream out of the container", func() {
+					Expect(fakeContainer.StreamOutCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the container is a check container and the user is not admin", func() {
+				BeforeEach(func() {
+					dbTeam.IsCheckContainerReturns(true, nil)
+					fakeAccess.IsAdminReturns(false)
+				})
+
+				It("returns 403 Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+
+				It("does not stream out of the container", func() {
+					Expect(fakeContainer.StreamOutCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when streaming out succeeds", func() {
+				BeforeEach(func() {
+					fakeContainer.StreamOutReturns(ioutil.NopCloser(bytes.NewBufferString("some-tar-contents")), nil)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type application/x-tar", func() {
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/x-tar"))
+				})
+
+				It("streams out the given path of the team's container", func() {
+					_, teamID, handle := fakeWorkerClient.FindContainerArgsForCall(0)
+					Expect(teamID).To(Equal(734))
+					Expect(handle).To(Equal("some-handle"))
+
+					Expect(fakeContainer.StreamOutCallCount()).To(Equal(1))
+					Expect(fakeContainer.StreamOutArgsForCall(0)).To(Equal(garden.StreamOutSpec{
+						Path: "/tmp/build/some-guid/some-output",
+					}))
+				})
+
+				It("returns the tarball", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("some-tar-contents"))
+				})
+			})
+
+			Context("when streaming out fails", func() {
+				BeforeEach(func() {
+					fakeContainer.StreamOutReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/a-team/containers/:id/files", func() {
+		var (
+			path     string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			path = "/tmp/build/some-guid"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			req, err = http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/containers/some-handle/files", bytes.NewBufferString("some-tar-contents"))
+			Expect(err).NotTo(HaveOccurred())
+
+			req.URL.RawQuery = url.Values{"path": {path}}.Encode()
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			var (
+				fakeContainer *workerfakes.FakeContainer
+				streamedIn    string
+			)
+
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				fakeContainer = new(workerfakes.FakeContainer)
+				fakeWorkerClient.FindContainerReturns(fakeContainer, true, nil)
+				dbTeam.IsContainerWithinTeamReturns(true, nil)
+
+				streamedIn = ""
+				fakeContainer.StreamInStub = func(spec garden.StreamInSpec) error {
+					contents, err := ioutil.ReadAll(spec.TarStream)
+					streamedIn = string(contents)
+					return err
+				}
+			})
+
+			Context("when no path is given", func() {
+				BeforeEach(func() {
+					path = ""
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+
+				It("does not stream into the container", func() {
+					Expect(fakeContainer.StreamInCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the container is not within the team", func() {
+				BeforeEach(func() {
+					dbTeam.IsContainerWithinTeamReturns(false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+
+				It("does not stream into the container", func() {
+					Expect(fakeContainer.StreamInCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the container is a check container and the user is not admin", func() {
+				BeforeEach(func() {
+					dbTeam.IsCheckContainerReturns(true, nil)
+					fakeAccess.IsAdminReturns(false)
+				})
+
+				It("returns 403 Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+
+				It("does not stream into the container", func() {
+					Expect(fakeContainer.StreamInCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when streaming in succeeds", func() {
+				It("returns 204 No Content", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				})
+
+				It("streams the request body into the given path of the container", func() {
+					Expect(fakeContainer.StreamInCallCount()).To(Equal(1))
+					Expect(fakeContainer.StreamInArgsForCall(0).Path).To(Equal("/tmp/build/some-guid"))
+					Expect(streamedIn).To(Equal("some-tar-contents"))
+				})
+			})
+
+			Context("when streaming in fails", func() {
+				BeforeEach(func() {
+					fakeContainer.StreamInStub = nil
+					fakeContainer.StreamInReturns(errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/a-team/containers/:id/port-forward", func() {
+		var (
+			port string
+
+			conn     *websocket.Conn
+			response *http.Response
+
+			expectBadHandshake bool
+		)
+
+		BeforeEach(func() {
+			port = "8080"
+			expectBadHandshake = false
+		})
+
+		JustBeforeEach(func() {
+			wsURL, err := url.Parse(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+
+			wsURL.Scheme = "ws"
+			wsURL.Path = "/api/v1/teams/a-team/containers/some-handle/port-forward"
+			wsURL.RawQuery = url.Values{"port": {port}}.Encode()
+
+			dialer := websocket.Dialer{}
+			conn, response, err = dialer.Dial(wsURL.String(), nil)
+			if !expectBadHandshake {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		AfterEach(func() {
+			if !expectBadHandshake {
+				_ = conn.Close()
+			}
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				expectBadHandshake = true
+
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			var (
+				fakeContainer *workerfakes.FakeContainer
+				fakeProcess   *gfakes.FakeProcess
+				processExit   chan int
+				relayStdin    chan []byte
+				relayStdout   chan io.Writer
+			)
+
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				fakeContainer = new(workerfakes.FakeContainer)
+				fakeWorkerClient.FindContainerReturns(fakeContainer, true, nil)
+				dbTeam.IsContainerWithinTeamReturns(true, nil)
+
+				exit := make(chan int, 1)
+				processExit = exit
+
+				fakeProcess = new(gfakes.FakeProcess)
+				fakeProcess.WaitStub = func() (int, error) {
+					return <-exit, nil
+				}
+
+				stdin := make(chan []byte, 10)
+				relayStdin = stdin
+
+				stdout := make(chan io.Writer, 1)
+				relayStdout = stdout
+
+				fakeContainer.RunStub = func(_ context.Context, _ garden.ProcessSpec, pio garden.ProcessIO) (garden.Process, error) {
+					stdout <- pio.Stdout
+
+					go func() {
+						defer close(stdin)
+
+						buf := make([]byte, 1024)
+						for {
+							n, err := pio.Stdin.Read(buf)
+							if n > 0 {
+								chunk := make([]byte, n)
+								copy(chunk, buf[:n])
+								stdin <- chunk
+							}
+
+							if err != nil {
+								return
+							}
+						}
+					}()
+
+					_, err := pio.Stdout.Write([]byte("some-response"))
+					Expect(err).NotTo(HaveOccurred())
+
+					return fakeProcess, nil
+				}
+			})
+
+			AfterEach(func() {
+				select {
+				case processExit <- 0:
+				default:
+				}
+			})
+
+			Context("when the port is invalid", func() {
+				BeforeEach(func() {
+					expectBadHandshake = true
+					port = "not-a-port"
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+
+				It("does not run anything in the container", func() {
+					Expect(fakeContainer.RunCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the container is not within the team", func() {
+				BeforeEach(func() {
+					expectBadHandshake = true
+					dbTeam.IsContainerWithinTeamReturns(false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the container is a check container and the user is not admin", func() {
+				BeforeEach(func() {
+					expectBadHandshake = true
+					dbTeam.IsCheckContainerReturns(true, nil)
+					fakeAccess.IsAdminReturns(false)
+				})
+
+				It("returns 403 Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when the container is found", func() {
+				It("runs a relay to the port in the team's container", func() {
+					Eventually(fakeContainer.RunCallCount).Should(Equal(1))
+
+					_, teamID, handle := fakeWorkerClient.FindContainerArgsForCall(0)
+					Expect(teamID).To(Equal(734))
+					Expect(handle).To(Equal("some-handle"))
+
+					_, spec, _ := fakeContainer.RunArgsForCall(0)
+					Expect(spec.Path).To(Equal("sh"))
+					Expect(spec.Args[len(spec.Args)-1]).To(Equal("8080"))
+				})
+
+				It("runs the relay as the container's user", func() {
+					Eventually(fakeContainer.RunCallCount).Should(Equal(1))
+
+					_, spec, _ := fakeContainer.RunArgsForCall(0)
+					Expect(spec.User).To(BeEmpty())
+				})
+
+				It("keeps the container from being garbage collected", func() {
+					Eventually(fakeContainer.UpdateLastHijackCallCount).Should(Equal(1))
+				})
+
+				Context("when keeping the container from being garbage collected fails", func() {
+					BeforeEach(func() {
+						fakeContainer.UpdateLastHijackReturns(errors.New("oh no"))
+					})
+
+					It("terminates the relay", func() {
+						Eventually(fakeProcess.SignalCallCount).Should(Equal(1))
+						Expect(fakeProcess.SignalArgsForCall(0)).To(Equal(garden.SignalTerminate))
+					})
+
+					It("closes the connection with the error", func() {
+						var err error
+						for err == nil {
+							_, _, err = conn.ReadMessage()
+						}
+
+						closeErr, ok := err.(*websocket.CloseError)
+						Expect(ok).To(BeTrue())
+						Expect(closeErr.Code).To(Equal(websocket.CloseInternalServerErr))
+						Expect(closeErr.Text).To(Equal("oh no"))
+					})
+				})
+
+				It("sends the relay's output as binary messages", func() {
+					messageType, data, err := conn.ReadMessage()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(messageType).To(Equal(websocket.BinaryMessage))
+					Expect(string(data)).To(Equal("some-response"))
+				})
+
+				It("sends binary messages to the relay's input", func() {
+					err := conn.WriteMessage(websocket.BinaryMessage, []byte("some-request"))
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(relayStdin).Should(Receive(Equal([]byte("some-request"))))
+				})
+
+				Context("when the client closes the connection", func() {
+					JustBeforeEach(func() {
+						err := conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("closes the relay's input and terminates it", func() {
+						Eventually(relayStdin).Should(BeClosed())
+						Eventually(fakeProcess.SignalCallCount).Should(Equal(1))
+						Expect(fakeProcess.SignalArgsForCall(0)).To(Equal(garden.SignalTerminate))
+					})
+				})
+
+				Context("when the client has nothing more to send", func() {
+					JustBeforeEach(func() {
+						_, _, err := conn.ReadMessage()
+						Expect(err).NotTo(HaveOccurred())
+
+						err = conn.WriteMessage(websocket.TextMessage, []byte(atc.ContainerPortForwardEOF))
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("closes the relay's input without terminating it", func() {
+						Eventually(relayStdin).Should(BeClosed())
+						Consistently(fakeProcess.SignalCallCount).Should(BeZero())
+					})
+
+					It("still sends the relay's output until it exits", func() {
+						Eventually(relayStdin).Should(BeClosed())
+
+						var stdout io.Writer
+						Eventually(relayStdout).Should(Receive(&stdout))
+
+						_, err := stdout.Write([]byte("some-more-response"))
+						Expect(err).NotTo(HaveOccurred())
+
+						processExit <- 0
+
+						messageType, data, err := conn.ReadMessage()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(messageType).To(Equal(websocket.BinaryMessage))
+						Expect(string(data)).To(Equal("some-more-response"))
+
+						_, _, err = conn.ReadMessage()
+						Expect(websocket.IsCloseError(err, websocket.CloseNormalClosure)).To(BeTrue())
+					})
+				})
+
+				Context("when the relay exits successfully", func() {
+					BeforeEach(func() {
+						processExit <- 0
+					})
+
+					It("closes the websocket normally", func() {
+						_, _, err := conn.ReadMessage()
+						Expect(err).NotTo(HaveOccurred())
+
+						_, _, err = conn.ReadMessage()
+						Expect(websocket.IsCloseError(err, websocket.CloseNormalClosure)).To(BeTrue())
+					})
+				})
+
+				Context("when the relay fails", func() {
+					BeforeEach(func() {
+						processExit <- 1
+					})
+
+					It("closes the websocket with an error", func() {
+						_, _, err := conn.ReadMessage()
+						Expect(err).NotTo(HaveOccurred())
+
+						_, _, err = conn.ReadMessage()
+						Expect(websocket.IsCloseError(err, websocket.CloseInternalServerErr)).To(BeTrue())
+						Expect(err.(*websocket.CloseError).Text).To(Equal("relay exited with status 1"))
+					})
+				})
+
+				Context("when running the relay fails", func() {
+					BeforeEach(func() {
+						fakeContainer.RunStub = nil
+						fakeContainer.RunReturns(nil, errors.New("oh no"))
+					})
+
+					It("closes the websocket with the error", func() {
+						_, _, err := conn.ReadMessage()
+						Expect(websocket.IsCloseError(err, websocket.CloseInternalServerErr)).To(BeTrue())
+						Expect(err.(*websocket.CloseError).Text).To(Equal("oh no"))
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/containers/destroying", func() {
 		BeforeEach(func() {
 			var err error
@@ -0,0 +1,97 @@
+package containerserver
+
+import (
+	"io"
+	"net/http"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// StreamOutContainer responds with a tarball of the given path within the
+// container. It is subject to the same checks as hijacking the container.
+func (s *Server) StreamOutContainer(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handle := r.FormValue(":id")
+		path := r.FormValue(atc.ContainerFilesQueryPath)
+
+		hLog := s.logger.Session("stream-out", lager.Data{
+			"handle": handle,
+			"path":   path,
+		})
+
+		if path == "" {
+			hLog.Info("missing-path")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		container, ok := s.findHijackableContainer(hLog, w, r, team, handle)
+		if !ok {
+			return
+		}
+
+		hLog.Debug("found-container")
+
+		reader, err := container.StreamOut(garden.StreamOutSpec{
+			Path: path,
+		})
+		if err != nil {
+			hLog.Error("failed-to-stream-out", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		defer db.Close(reader)
+
+		w.Header().Set("Content-Type", "application/x-tar")
+		w.WriteHeader(http.StatusOK)
+
+		_, err = io.Copy(w, reader)
+		if err != nil {
+			hLog.Error("failed-to-write-response", err)
+		}
+	})
+}
+
+// StreamInContainer extracts the tarball in the request body into the given
+// path within the container. It is subject to the same checks as hijacking
+// the container.
+func (s *Server) StreamInContainer(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handle := r.FormValue(":id")
+		path := r.FormValue(atc.ContainerFilesQueryPath)
+
+		hLog := s.logger.Session("stream-in", lager.Data{
+			"handle": handle,
+			"path":   path,
+		})
+
+		if path == "" {
+			hLog.Info("missing-path")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		container, ok := s.findHijackableContainer(hLog, w, r, team, handle)
+		if !ok {
+			return
+		}
+
+		hLog.Debug("found-container")
+
+		err := container.StreamIn(garden.StreamInSpec{
+			Path:      path,
+			TarStream: r.Body,
+		})
+		if err != nil {
+			hLog.Error("failed-to-stream-in", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
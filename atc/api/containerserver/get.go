@@ -53,7 +53,14 @@ func (s *Server) GetContainer(team db.Team) http.Handler {
 
 		hLog.Debug("found-container")
 
-		presentedContainer := present.Container(container, time.Time{})
+		retentions, err := s.containerRepository.FindContainerRetentions([]int{container.ID()})
+		if err != nil {
+			hLog.Error("failed-to-find-container-retention", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presentedContainer := present.Container(container, time.Time{}, retentions[container.ID()])
 
 		w.Header().Set("Content-Type", "application/json")
 		err = json.NewEncoder(w).Encode(presentedContainer)
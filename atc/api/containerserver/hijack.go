@@ -89,45 +89,8 @@ func (s *Server) HijackContainer(team db.Team) http.Handler {
 			"handle": handle,
 		})
 
-		container, found, err := s.workerClient.FindContainer(hLog, team.ID(), handle)
-		if err != nil {
-			hLog.Error("failed-to-find-container", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-
-		if !found {
-			hLog.Info("container-not-found")
-			w.WriteHeader(http.StatusNotFound)
-			return
-		}
-
-		isCheckContainer, err := team.IsCheckContainer(handle)
-		if err != nil {
-			hLog.Error("failed-to-find-container", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-
-		if isCheckContainer {
-			acc := accessor.GetAccessor(r)
-			if !acc.IsAdmin() {
-				hLog.Error("user-not-authorized-to-hijack-check-container", err)
-				w.WriteHeader(http.StatusForbidden)
-				return
-			}
-		}
-
-		ok, err := team.IsContainerWithinTeam(handle, isCheckContainer)
-		if err != nil {
-			hLog.Error("failed-to-find-container-within-team", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-
+		container, ok := s.findHijackableContainer(hLog, w, r, team, handle)
 		if !ok {
-			hLog.Error("container-not-found-within-team", err)
-			w.WriteHeader(http.StatusNotFound)
 			return
 		}
 
@@ -175,6 +138,56 @@ func closeWithErr(log lager.Logger, conn *websocket.Conn, code int, reason strin
 	}
 }
 
+// findHijackableContainer finds the team's container with the given handle,
+// responding with an error if it does not exist or the user may not access
+// it. Only admins may access check containers, as they are shared between
+// teams.
+func (s *Server) findHijackableContainer(hLog lager.Logger, w http.ResponseWriter, r *http.Request, team db.Team, handle string) (worker.Container, bool) {
+	container, found, err := s.workerClient.FindContainer(hLog, team.ID(), handle)
+	if err != nil {
+		hLog.Error("failed-to-find-container", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !found {
+		hLog.Info("container-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	isCheckContainer, err := team.IsCheckContainer(handle)
+	if err != nil {
+		hLog.Error("failed-to-find-container", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if isCheckContainer {
+		acc := accessor.GetAccessor(r)
+		if !acc.IsAdmin() {
+			hLog.Error("user-not-authorized-to-hijack-check-container", err)
+			w.WriteHeader(http.StatusForbidden)
+			return nil, false
+		}
+	}
+
+	ok, err := team.IsContainerWithinTeam(handle, isCheckContainer)
+	if err != nil {
+		hLog.Error("failed-to-find-container-within-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !ok {
+		hLog.Error("container-not-found-within-team", err)
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	return container, true
+}
+
 func (s *Server) hijack(hLog lager.Logger, conn *websocket.Conn, request hijackRequest) {
 	hLog = hLog.Session("hijack", lager.Data{
 		"handle":  request.Container.Handle(),
@@ -236,28 +249,12 @@ func (s *Server) hijack(hLog lager.Logger, conn *websocket.Conn, request hijackR
 		return
 	}
 
-	err = request.Container.UpdateLastHijack()
+	err = s.markHijacked(hLog, request.Container, cleanup)
 	if err != nil {
 		hLog.Error("failed-to-update-container-hijack-time", err)
 		return
 	}
 
-	go func() {
-		for {
-			select {
-			case <-s.clock.After(s.interceptUpdateInterval):
-				err = request.Container.UpdateLastHijack()
-				if err != nil {
-					hLog.Error("failed-to-update-container-hijack-time", err)
-					return
-				}
-
-			case <-cleanup:
-				return
-			}
-		}
-	}()
-
 	hLog.Info("hijacked")
 
 	go func() {
@@ -336,6 +333,33 @@ func (s *Server) hijack(hLog lager.Logger, conn *websocket.Conn, request hijackR
 	}
 }
 
+// markHijacked marks the container as hijacked until done is closed, so that
+// it is not garbage collected while it is in use.
+func (s *Server) markHijacked(hLog lager.Logger, container worker.Container, done <-chan struct{}) error {
+	err := container.UpdateLastHijack()
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case <-s.clock.After(s.interceptUpdateInterval):
+				err := container.UpdateLastHijack()
+				if err != nil {
+					hLog.Error("failed-to-update-container-hijack-time", err)
+					return
+				}
+
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
 type stdoutWriter struct {
 	outputs chan<- atc.HijackOutput
 	done    chan struct{}
@@ -41,10 +41,22 @@ func (s *Server) ListContainers(team db.Team) http.Handler {
 
 		hLog.Debug("listed", lager.Data{"container-count": len(containers)})
 
+		containerIDs := make([]int, len(containers))
+		for i, container := range containers {
+			containerIDs[i] = container.ID()
+		}
+
+		retentions, err := s.containerRepository.FindContainerRetentions(containerIDs)
+		if err != nil {
+			hLog.Error("failed-to-find-container-retentions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 		presentedContainers := make([]atc.Container, len(containers))
 		for i := 0; i < len(containers); i++ {
 			container := containers[i]
-			presentedContainers[i] = present.Container(container, checkContainersExpiresAt[container.ID()])
+			presentedContainers[i] = present.Container(container, checkContainersExpiresAt[container.ID()], retentions[container.ID()])
 		}
 
 		err = json.NewEncoder(w).Encode(presentedContainers)
@@ -0,0 +1,239 @@
+package containerserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/gorilla/websocket"
+)
+
+// relayScript connects its stdin and stdout to the port given as its first
+// argument. Garden has no way of connecting to a port within the container
+// other than running a process in it, so the connection is made by whichever
+// of socat, bash or nc the container's image provides, as the container's
+// user.
+//
+// Once its stdin is closed, socat closes the connection for writing and keeps
+// relaying what the port sends back for up to an hour. bash cannot close the
+// connection for writing, but keeps relaying until the port closes it. nc is
+// the last resort, as some of its variants exit as soon as their stdin is
+// closed.
+const relayScript = `
+if command -v socat >/dev/null 2>&1; then
+	exec socat -t 3600 - TCP:127.0.0.1:"$1"
+elif command -v bash >/dev/null 2>&1; then
+	exec bash -c 'exec 3<>/dev/tcp/127.0.0.1/"$1" || exit 1; cat <&0 >&3 & cat <&3; kill $! 2>/dev/null || :' bash "$1"
+elif command -v nc >/dev/null 2>&1; then
+	exec nc 127.0.0.1 "$1"
+else
+	echo "the image has none of socat, bash or nc to forward the port with" >&2
+	exit 1
+fi
+`
+
+// maxCloseReasonLength is the most a close message's reason may hold, as
+// control frames are limited to 125 bytes including the 2 byte close code.
+const maxCloseReasonLength = 123
+
+// PortForwardContainer forwards a single connection over a websocket to a
+// port within the container. Data is sent either way as binary messages. The
+// client sends atc.ContainerPortForwardEOF as a text message once it has
+// nothing more to send, after which whatever the port sends back is still
+// forwarded until it closes the connection. The websocket is closed once the
+// connection within the container is closed, or the client goes away. It is
+// subject to the same checks as hijacking the container.
+func (s *Server) PortForwardContainer(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handle := r.FormValue(":id")
+		portParam := r.FormValue(atc.ContainerPortForwardQueryPort)
+
+		hLog := s.logger.Session("port-forward", lager.Data{
+			"handle": handle,
+			"port":   portParam,
+		})
+
+		port, err := strconv.ParseUint(portParam, 10, 16)
+		if err != nil || port == 0 {
+			hLog.Info("invalid-port")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		container, ok := s.findHijackableContainer(hLog, w, r, team, handle)
+		if !ok {
+			return
+		}
+
+		hLog.Debug("found-container")
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			hLog.Error("unable-to-upgrade-connection-for-websockets", err)
+			return
+		}
+
+		defer db.Close(conn)
+
+		s.portForward(hLog, conn, container, uint16(port))
+	})
+}
+
+func (s *Server) portForward(hLog lager.Logger, conn *websocket.Conn, container worker.Container, port uint16) {
+	stdinR, stdinW := io.Pipe()
+	defer db.Close(stdinW)
+
+	stderr := new(bytes.Buffer)
+
+	cleanup := make(chan struct{})
+	defer close(cleanup)
+
+	process, err := container.Run(context.Background(), garden.ProcessSpec{
+		Path: "sh",
+		Args: []string{"-c", relayScript, "sh", strconv.Itoa(int(port))},
+	}, garden.ProcessIO{
+		Stdin:  stdinR,
+		Stdout: &binaryWriter{conn: conn},
+		Stderr: stderr,
+	})
+	if err != nil {
+		hLog.Error("failed-to-run-relay", err)
+		closeWithErr(hLog, conn, websocket.CloseInternalServerErr, truncateCloseReason(err.Error()))
+		return
+	}
+
+	err = s.markHijacked(hLog, container, cleanup)
+	if err != nil {
+		hLog.Error("failed-to-update-container-hijack-time", err)
+
+		// closing its input is not enough, as socat would wait for up to its
+		// timeout for the port to close too
+		signalErr := process.Signal(garden.SignalTerminate)
+		if signalErr != nil {
+			hLog.Error("failed-to-terminate-relay", signalErr)
+		}
+
+		closeWithErr(hLog, conn, websocket.CloseInternalServerErr, truncateCloseReason(err.Error()))
+		return
+	}
+
+	hLog.Info("forwarding")
+
+	clientClosed := make(chan struct{})
+
+	go func() {
+		for {
+			messageType, reader, err := conn.NextReader()
+			if err != nil {
+				break
+			}
+
+			if messageType == websocket.TextMessage {
+				message, err := ioutil.ReadAll(reader)
+				if err != nil {
+					break
+				}
+
+				if string(message) == atc.ContainerPortForwardEOF {
+					// the client has nothing more to send, but still wants
+					// to hear back from the port
+					_ = stdinW.Close()
+				}
+
+				continue
+			}
+
+			if messageType != websocket.BinaryMessage {
+				continue
+			}
+
+			_, err = io.Copy(stdinW, reader)
+			if err != nil {
+				break
+			}
+		}
+
+		select {
+		case <-cleanup:
+			// the relay has already exited
+			return
+		default:
+		}
+
+		// the client closed the websocket or went away, so there is nowhere
+		// left to send whatever the relay still has to say
+		close(clientClosed)
+		_ = stdinW.Close()
+
+		err := process.Signal(garden.SignalTerminate)
+		if err != nil {
+			hLog.Error("failed-to-terminate-relay", err)
+		}
+	}()
+
+	status, err := process.Wait()
+
+	select {
+	case <-clientClosed:
+		hLog.Debug("client-closed")
+		return
+	default:
+	}
+
+	if err != nil {
+		hLog.Error("failed-to-wait-for-relay", err)
+		closeWithErr(hLog, conn, websocket.CloseInternalServerErr, truncateCloseReason(err.Error()))
+		return
+	}
+
+	if status != 0 {
+		hLog.Info("relay-exited", lager.Data{"status": status, "stderr": stderr.String()})
+
+		reason := strings.TrimSpace(stderr.String())
+		if reason == "" {
+			reason = fmt.Sprintf("relay exited with status %d", status)
+		}
+
+		closeWithErr(hLog, conn, websocket.CloseInternalServerErr, truncateCloseReason(reason))
+		return
+	}
+
+	closeWithErr(hLog, conn, websocket.CloseNormalClosure, "")
+}
+
+func truncateCloseReason(reason string) string {
+	if len(reason) <= maxCloseReasonLength {
+		return reason
+	}
+
+	truncated := reason[:maxCloseReasonLength]
+	for !utf8.ValidString(truncated) {
+		truncated = truncated[:len(truncated)-1]
+	}
+
+	return truncated
+}
+
+type binaryWriter struct {
+	conn *websocket.Conn
+}
+
+func (writer *binaryWriter) Write(b []byte) (int, error) {
+	err := writer.conn.WriteMessage(websocket.BinaryMessage, b)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
@@ -118,9 +118,15 @@ func NewHandler(
 		atc.GetBuild:            buildHandlerFactory.HandlerFor(buildServer.GetBuild),
 		atc.BuildResources:      buildHandlerFactory.HandlerFor(buildServer.BuildResources),
 		atc.AbortBuild:          buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
+		atc.HoldBuild:           buildHandlerFactory.HandlerFor(buildServer.HoldBuild),
+		atc.ReleaseBuild:        buildHandlerFactory.HandlerFor(buildServer.ReleaseBuild),
+		atc.ResumeBuild:         buildHandlerFactory.HandlerFor(buildServer.ResumeBuild),
 		atc.GetBuildPlan:        buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
 		atc.GetBuildPreparation: buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
+		atc.GetBuildLog:         buildHandlerFactory.HandlerFor(buildServer.GetBuildLog),
+		atc.Firehose:            http.HandlerFunc(buildServer.Firehose),
+		atc.TeamFirehose:        teamHandlerFactory.HandlerFor(buildServer.TeamFirehose),
 		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
 
 		atc.GetCheck: http.HandlerFunc(checkServer.GetCheck),
@@ -141,6 +147,7 @@ func NewHandler(
 			Routes: atc.Routes,
 			Route:  atc.JobBadge,
 		},
+		atc.GetJobStats: pipelineHandlerFactory.HandlerFor(jobServer.GetJobStats),
 
 		atc.ClearTaskCache: pipelineHandlerFactory.HandlerFor(jobServer.ClearTaskCache),
 
@@ -170,7 +177,10 @@ func NewHandler(
 		atc.CheckResourceWebHook:    pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceWebHook),
 		atc.CheckResourceType:       pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceType),
 
+		atc.ListResourceWebhookDeliveries: pipelineHandlerFactory.HandlerFor(resourceServer.ListWebhookDeliveries),
+
 		atc.ListResourceVersions:          pipelineHandlerFactory.HandlerFor(versionServer.ListResourceVersions),
+		atc.SaveResourceVersions:          pipelineHandlerFactory.HandlerFor(resourceServer.SaveResourceVersions),
 		atc.GetResourceVersion:            pipelineHandlerFactory.HandlerFor(versionServer.GetResourceVersion),
 		atc.EnableResourceVersion:         pipelineHandlerFactory.HandlerFor(versionServer.EnableResourceVersion),
 		atc.DisableResourceVersion:        pipelineHandlerFactory.HandlerFor(versionServer.DisableResourceVersion),
@@ -200,6 +210,9 @@ func NewHandler(
 		atc.ListContainers:           teamHandlerFactory.HandlerFor(containerServer.ListContainers),
 		atc.GetContainer:             teamHandlerFactory.HandlerFor(containerServer.GetContainer),
 		atc.HijackContainer:          teamHandlerFactory.HandlerFor(containerServer.HijackContainer),
+		atc.StreamOutContainer:       teamHandlerFactory.HandlerFor(containerServer.StreamOutContainer),
+		atc.StreamInContainer:        teamHandlerFactory.HandlerFor(containerServer.StreamInContainer),
+		atc.PortForwardContainer:     teamHandlerFactory.HandlerFor(containerServer.PortForwardContainer),
 		atc.ListDestroyingContainers: http.HandlerFunc(containerServer.ListDestroyingContainers),
 		atc.ReportWorkerContainers:   http.HandlerFunc(containerServer.ReportWorkerContainers),
 
@@ -214,6 +227,9 @@ func NewHandler(
 		atc.DestroyTeam:    http.HandlerFunc(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: http.HandlerFunc(teamServer.ListTeamBuilds),
 
+		atc.SearchBuildLogs: http.HandlerFunc(teamServer.SearchBuildLogs),
+		atc.ClearNamedCache: http.HandlerFunc(teamServer.ClearNamedCache),
+
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
 
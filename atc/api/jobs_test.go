@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/testhelpers"
@@ -911,6 +913,166 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/stats", func() {
+		var (
+			response *http.Response
+			query    string
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/stats" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated and not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			Context("and the pipeline is private", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("and the pipeline is public", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(true)
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the job is found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				Context("when getting the stats fails", func() {
+					BeforeEach(func() {
+						fakeJob.StatsReturns(db.JobStats{}, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when getting the stats succeeds", func() {
+					BeforeEach(func() {
+						fakeJob.StatsReturns(db.JobStats{
+							Builds:             10,
+							Succeeded:          6,
+							Failed:             2,
+							Errored:            1,
+							Aborted:            1,
+							DurationP50:        time.Minute,
+							DurationP90:        2 * time.Minute,
+							DurationP99:        5 * time.Minute,
+							Recoveries:         2,
+							MeanTimeToRecovery: time.Hour,
+							FlakyBuilds:        1,
+						}, nil)
+					})
+
+					It("looks at the last week of builds by default", func() {
+						Expect(fakeJob.StatsCallCount()).To(Equal(1))
+						Expect(fakeJob.StatsArgsForCall(0)).To(BeTemporally("~", time.Now().Add(-7*24*time.Hour), time.Minute))
+					})
+
+					It("returns 200 OK", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns Content-Type 'application/json'", func() {
+						expectedHeaderEntries := map[string]string{
+							"Content-Type": "application/json",
+						}
+						Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+					})
+
+					It("returns the stats", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`{
+							"window": 604800,
+							"builds": 10,
+							"succeeded": 6,
+							"failed": 2,
+							"errored": 1,
+							"aborted": 1,
+							"success_rate": 0.6,
+							"failure_rate": 0.2,
+							"error_rate": 0.1,
+							"durations": {
+								"p50": 60,
+								"p90": 120,
+								"p99": 300
+							},
+							"recoveries": 2,
+							"mean_time_to_recovery": 3600,
+							"flaky_builds": 1,
+							"flakiness": 0.5
+						}`))
+					})
+
+					Context("when a window is given", func() {
+						BeforeEach(func() {
+							query = "?window=24h"
+						})
+
+						It("looks at the builds within the window", func() {
+							Expect(fakeJob.StatsCallCount()).To(Equal(1))
+							Expect(fakeJob.StatsArgsForCall(0)).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+						})
+					})
+				})
+			})
+
+			Context("when the window is invalid", func() {
+				BeforeEach(func() {
+					query = "?window=forever"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs", func() {
 		var response *http.Response
 		var dashboardResponse atc.Dashboard
@@ -1181,6 +1343,10 @@ var _ = Describe("Jobs API", func() {
 		var response *http.Response
 		var queryParams string
 
+		BeforeEach(func() {
+			queryParams = ""
+		})
+
 		JustBeforeEach(func() {
 			var err error
 
@@ -1208,15 +1374,42 @@ var _ = Describe("Jobs API", func() {
 			Context("and the pipeline is public", func() {
 				BeforeEach(func() {
 					fakeBuild := new(dbfakes.FakeBuild)
+					fakeBuild.CreatedByReturns("some-user")
+					fakeBuild.AbortedByReturns("some-user")
 
 					fakePipeline.PublicReturns(true)
 					fakePipeline.JobReturns(fakeJob, true, nil)
 					fakeJob.BuildReturns(fakeBuild, true, nil)
+					fakeJob.BuildsReturns([]db.Build{fakeBuild}, db.Pagination{}, nil)
 				})
 
 				It("returns 200 OK", func() {
 					Expect(response.StatusCode).To(Equal(http.StatusOK))
 				})
+
+				It("does not show who created or aborted the builds", func() {
+					var builds []atc.Build
+					err := json.NewDecoder(response.Body).Decode(&builds)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(builds).To(HaveLen(1))
+					Expect(builds[0].CreatedBy).To(BeEmpty())
+					Expect(builds[0].AbortedBy).To(BeEmpty())
+				})
+
+				Context("when filtering by who created the builds", func() {
+					BeforeEach(func() {
+						queryParams = "?created_by=some-user"
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+
+					It("does not look up the builds", func() {
+						Expect(fakeJob.BuildsCallCount()).To(BeZero())
+					})
+				})
 			})
 		})
 
@@ -1480,6 +1673,70 @@ var _ = Describe("Jobs API", func() {
 						fakeJob.DisableManualTriggerReturns(false)
 					})
 
+					Context("when breakpoints are given", func() {
+						BeforeEach(func() {
+							var err error
+
+							request, err = http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds?break_at=some-task&break_before=some-get", nil)
+							Expect(err).NotTo(HaveOccurred())
+
+							fakeJob.ConfigReturns(atc.JobConfig{
+								Name: "some-job",
+								PlanSequence: []atc.PlanConfig{
+									{Get: "some-get"},
+									{Task: "some-task"},
+								},
+							}, nil)
+
+							fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
+
+							build := new(dbfakes.FakeBuild)
+							build.IDReturns(42)
+							fakeJob.CreateBuildWithBreakpointsReturns(build, nil)
+						})
+
+						It("triggers the build with the breakpoints", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(fakeJob.CreateBuildCallCount()).To(BeZero())
+							Expect(fakeJob.CreateBuildWithBreakpointsCallCount()).To(Equal(1))
+
+							createdBy, breakpoints := fakeJob.CreateBuildWithBreakpointsArgsForCall(0)
+							Expect(createdBy).To(Equal("some-user"))
+							Expect(breakpoints).To(Equal(map[string]atc.Breakpoint{
+								"some-task": atc.BreakpointAfter,
+								"some-get":  atc.BreakpointBefore,
+							}))
+						})
+
+						Context("when a step is given a breakpoint both before and after it", func() {
+							BeforeEach(func() {
+								var err error
+
+								request, err = http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds?break_at=some-task&break_before=some-task", nil)
+								Expect(err).NotTo(HaveOccurred())
+							})
+
+							It("returns 400 without triggering the build", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(fakeJob.CreateBuildWithBreakpointsCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when a breakpoint names a step the job does not have", func() {
+							BeforeEach(func() {
+								var err error
+
+								request, err = http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds?break_at=bogus", nil)
+								Expect(err).NotTo(HaveOccurred())
+							})
+
+							It("returns 400 without triggering the build", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(fakeJob.CreateBuildWithBreakpointsCallCount()).To(BeZero())
+							})
+						})
+					})
+
 					Context("when triggering the build fails", func() {
 						BeforeEach(func() {
 							fakeJob.CreateBuildReturns(nil, errors.New("nopers"))
@@ -1501,11 +1758,14 @@ var _ = Describe("Jobs API", func() {
 							build.StartTimeReturns(time.Unix(1, 0))
 							build.EndTimeReturns(time.Unix(100, 0))
 
+							fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
+
 							fakeJob.CreateBuildReturns(build, nil)
 						})
 
-						It("triggers the build", func() {
+						It("triggers the build as the current user", func() {
 							Expect(fakeJob.CreateBuildCallCount()).To(Equal(1))
+							Expect(fakeJob.CreateBuildArgsForCall(0)).To(Equal("some-user"))
 						})
 
 						Context("when finding the pipeline resources fails", func() {
@@ -1998,16 +2258,21 @@ var _ = Describe("Jobs API", func() {
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
 		var request *http.Request
 		var response *http.Response
+		var fromStep string
 
 		BeforeEach(func() {
+			fromStep = ""
+		})
+
+		JustBeforeEach(func() {
 			var err error
 
 			request, err = http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/some-build", nil)
 			Expect(err).NotTo(HaveOccurred())
-		})
 
-		JustBeforeEach(func() {
-			var err error
+			if fromStep != "" {
+				request.URL.RawQuery = url.Values{atc.RerunBuildQueryFromStep: {fromStep}}.Encode()
+			}
 
 			response, err = client.Do(request)
 			Expect(err).NotTo(HaveOccurred())
@@ -2121,6 +2386,8 @@ var _ = Describe("Jobs API", func() {
 								build.StartTimeReturns(time.Unix(1, 0))
 								build.EndTimeReturns(time.Unix(100, 0))
 
+								fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
+
 								fakeJob.RerunBuildReturns(build, nil)
 							})
 
@@ -2128,6 +2395,12 @@ var _ = Describe("Jobs API", func() {
 								Expect(response.StatusCode).To(Equal(http.StatusOK))
 							})
 
+							It("reruns the build as the current user", func() {
+								Expect(fakeJob.RerunBuildCallCount()).To(Equal(1))
+								_, createdBy := fakeJob.RerunBuildArgsForCall(0)
+								Expect(createdBy).To(Equal("some-user"))
+							})
+
 							It("returns Content-Type 'application/json'", func() {
 								expectedHeaderEntries := map[string]string{
 									"Content-Type": "application/json",
@@ -2152,6 +2425,101 @@ var _ = Describe("Jobs API", func() {
 						}`))
 							})
 						})
+
+						Context("when rerunning from a step", func() {
+							BeforeEach(func() {
+								fromStep = "some-task"
+
+								fakeJob.ConfigReturns(atc.JobConfig{
+									Name: "some-job",
+									PlanSequence: []atc.PlanConfig{
+										{Get: "some-input"},
+										{Task: "some-task"},
+										{Put: "some-output"},
+									},
+								}, nil)
+							})
+
+							Context("when creating the rerun build succeeds", func() {
+								BeforeEach(func() {
+									build := new(dbfakes.FakeBuild)
+									build.IDReturns(2)
+									build.NameReturns("1.1")
+									build.JobNameReturns("some-job")
+									build.PipelineNameReturns("a-pipeline")
+									build.TeamNameReturns("some-team")
+									build.StatusReturns(db.BuildStatusPending)
+									build.RerunFromStepReturns("some-task")
+
+									fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
+
+									fakeJob.RerunBuildFromStepReturns(build, nil)
+								})
+
+								It("returns 200 OK", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusOK))
+								})
+
+								It("reruns the build from the step as the current user", func() {
+									Expect(fakeJob.RerunBuildCallCount()).To(BeZero())
+									Expect(fakeJob.RerunBuildFromStepCallCount()).To(Equal(1))
+									buildToRerun, stepName, createdBy := fakeJob.RerunBuildFromStepArgsForCall(0)
+									Expect(buildToRerun).To(Equal(fakeBuild))
+									Expect(stepName).To(Equal("some-task"))
+									Expect(createdBy).To(Equal("some-user"))
+								})
+
+								It("returns the build", func() {
+									body, err := ioutil.ReadAll(response.Body)
+									Expect(err).NotTo(HaveOccurred())
+
+									Expect(body).To(MatchJSON(`{
+										"id": 2,
+										"name": "1.1",
+										"job_name": "some-job",
+										"status": "pending",
+										"api_url": "/api/v1/builds/2",
+										"pipeline_name": "a-pipeline",
+										"team_name": "some-team",
+										"rerun_from_step": "some-task"
+									}`))
+								})
+							})
+
+							Context("when creating the rerun build fails", func() {
+								BeforeEach(func() {
+									fakeJob.RerunBuildFromStepReturns(nil, errors.New("nopers"))
+								})
+
+								It("returns a 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+
+							Context("when the job has no such step", func() {
+								BeforeEach(func() {
+									fromStep = "bogus-step"
+								})
+
+								It("returns a 400", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								})
+
+								It("does not rerun the build", func() {
+									Expect(fakeJob.RerunBuildFromStepCallCount()).To(BeZero())
+								})
+							})
+
+							Context("when getting the job config fails", func() {
+								BeforeEach(func() {
+									fakeJob.ConfigReturns(atc.JobConfig{}, errors.New("nope"))
+								})
+
+								It("returns a 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+						})
 					})
 				})
 			})
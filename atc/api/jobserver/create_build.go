@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -34,7 +37,52 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		build, err := job.CreateBuild()
+		err = r.ParseForm()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		createdBy := accessor.GetAccessor(r).Claims().UserName
+
+		breakpoints := map[string]atc.Breakpoint{}
+		for _, step := range r.Form[atc.CreateBuildQueryBreakAt] {
+			breakpoints[step] = atc.BreakpointAfter
+		}
+
+		for _, step := range r.Form[atc.CreateBuildQueryBreakBefore] {
+			if _, found := breakpoints[step]; found {
+				logger.Info("conflicting-breakpoints", lager.Data{"step": step})
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			breakpoints[step] = atc.BreakpointBefore
+		}
+
+		var build db.Build
+		if len(breakpoints) > 0 {
+			var config atc.JobConfig
+			config, err = job.Config()
+			if err != nil {
+				logger.Error("failed-to-get-job-config", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			for step := range breakpoints {
+				if !hasNamedStep(config, step) {
+					logger.Info("unknown-step", lager.Data{"step": step})
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+			}
+
+			build, err = job.CreateBuildWithBreakpoints(createdBy, breakpoints)
+		} else {
+			build, err = job.CreateBuild(createdBy)
+		}
+
 		if err != nil {
 			logger.Error("failed-to-create-job-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -84,7 +132,7 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 			logger.Error("failed-to-notify-checker", err)
 		}
 
-		err = json.NewEncoder(w).Encode(present.Build(build))
+		err = json.NewEncoder(w).Encode(present.Build(build, true))
 		if err != nil {
 			logger.Error("failed-to-encode-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -59,6 +60,7 @@ func (s *Server) GetJob(pipeline db.Pipeline) http.Handler {
 			finished,
 			next,
 			nil,
+			accessor.GetAccessor(r).IsAuthorized(pipeline.TeamName()),
 		))
 		if err != nil {
 			logger.Error("failed-to-encode-job", err)
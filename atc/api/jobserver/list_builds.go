@@ -6,7 +6,10 @@ import (
 	"net/http"
 	"strconv"
 
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/buildfilter"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -41,6 +44,22 @@ func (s *Server) ListJobBuilds(pipeline db.Pipeline) http.Handler {
 			limit = atc.PaginationAPIDefaultLimit
 		}
 
+		filter, err := buildfilter.Parse(r.URL.Query())
+		if err != nil {
+			logger.Info("invalid-build-filter", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+
+		// who created a build is only shown to its team, so builds of public
+		// pipelines cannot be filtered by it either
+		if filter.CreatedBy != "" && !acc.IsAuthorized(pipeline.TeamName()) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
 		job, found, err := pipeline.Job(jobName)
 		if err != nil {
 			logger.Error("failed-to-get-job", err)
@@ -55,15 +74,17 @@ func (s *Server) ListJobBuilds(pipeline db.Pipeline) http.Handler {
 
 		if timestamps == "" {
 			builds, pagination, err = job.Builds(db.Page{
-				Since: since,
-				Until: until,
-				Limit: limit,
+				Since:  since,
+				Until:  until,
+				Limit:  limit,
+				Filter: filter,
 			})
 		} else {
 			builds, pagination, err = job.BuildsWithTime(db.Page{
-				Since: since,
-				Until: until,
-				Limit: limit,
+				Since:  since,
+				Until:  until,
+				Limit:  limit,
+				Filter: filter,
 			})
 		}
 		if err != nil {
@@ -82,9 +103,11 @@ func (s *Server) ListJobBuilds(pipeline db.Pipeline) http.Handler {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 
+		showUsers := acc.IsAuthorized(pipeline.TeamName())
+
 		jobBuilds := make([]atc.Build, len(builds))
 		for i := 0; i < len(builds); i++ {
-			jobBuilds[i] = present.Build(builds[i])
+			jobBuilds[i] = present.Build(builds[i], showUsers)
 		}
 
 		err = json.NewEncoder(w).Encode(jobBuilds)
@@ -97,7 +120,7 @@ func (s *Server) ListJobBuilds(pipeline db.Pipeline) http.Handler {
 
 func (s *Server) addNextLink(w http.ResponseWriter, teamName, pipelineName, jobName string, page db.Page) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/teams/%s/pipelines/%s/jobs/%s/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/teams/%s/pipelines/%s/jobs/%s/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		teamName,
 		pipelineName,
@@ -106,13 +129,14 @@ func (s *Server) addNextLink(w http.ResponseWriter, teamName, pipelineName, jobN
 		page.Since,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		buildfilter.Query(page.Filter),
 		atc.LinkRelNext,
 	))
 }
 
 func (s *Server) addPreviousLink(w http.ResponseWriter, teamName, pipelineName, jobName string, page db.Page) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/teams/%s/pipelines/%s/jobs/%s/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/teams/%s/pipelines/%s/jobs/%s/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		teamName,
 		pipelineName,
@@ -121,6 +145,7 @@ func (s *Server) addPreviousLink(w http.ResponseWriter, teamName, pipelineName,
 		page.Until,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		buildfilter.Query(page.Filter),
 		atc.LinkRelPrevious,
 	))
 }
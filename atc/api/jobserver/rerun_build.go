@@ -5,6 +5,9 @@ import (
 	"errors"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -47,17 +50,55 @@ func (s *Server) RerunJobBuild(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		build, err := job.RerunBuild(buildToRerun)
+		createdBy := accessor.GetAccessor(r).Claims().UserName
+
+		var build db.Build
+		if fromStep := r.FormValue(atc.RerunBuildQueryFromStep); fromStep != "" {
+			var config atc.JobConfig
+			config, err = job.Config()
+			if err != nil {
+				logger.Error("failed-to-get-job-config", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if !hasNamedStep(config, fromStep) {
+				logger.Info("unknown-step", lager.Data{"step": fromStep})
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			build, err = job.RerunBuildFromStep(buildToRerun, fromStep, createdBy)
+		} else {
+			build, err = job.RerunBuild(buildToRerun, createdBy)
+		}
+
 		if err != nil {
 			logger.Error("failed-to-retrigger-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
 
-		err = json.NewEncoder(w).Encode(present.Build(build))
+		err = json.NewEncoder(w).Encode(present.Build(build, true))
 		if err != nil {
 			logger.Error("failed-to-encode-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	})
 }
+
+// hasNamedStep is true if the job has a get, put or task step with the given
+// name, which are the only steps a build can be rerun from or break at.
+func hasNamedStep(config atc.JobConfig, name string) bool {
+	for _, plan := range config.Plans() {
+		if plan.Get == "" && plan.Put == "" && plan.Task == "" {
+			continue
+		}
+
+		if plan.Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
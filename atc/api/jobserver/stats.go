@@ -0,0 +1,58 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetJobStats(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-job-stats")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+
+		window := atc.JobStatsDefaultWindow
+		if urlWindow := r.FormValue(atc.JobStatsQueryWindow); urlWindow != "" {
+			var err error
+			window, err = time.ParseDuration(urlWindow)
+			if err != nil || window <= 0 {
+				logger.Info("invalid-window", lager.Data{"window": urlWindow})
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		stats, err := job.Stats(time.Now().Add(-window))
+		if err != nil {
+			logger.Error("failed-to-get-job-stats", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(present.JobStats(window, stats))
+		if err != nil {
+			logger.Error("failed-to-encode-job-stats", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/testhelpers"
@@ -1626,6 +1627,10 @@ var _ = Describe("Pipelines API", func() {
 		var response *http.Response
 		var queryParams string
 
+		BeforeEach(func() {
+			queryParams = ""
+		})
+
 		JustBeforeEach(func() {
 			var err error
 
@@ -1657,6 +1662,21 @@ var _ = Describe("Pipelines API", func() {
 				It("returns 200 OK", func() {
 					Expect(response.StatusCode).To(Equal(http.StatusOK))
 				})
+
+				Context("when filtering by who created the builds", func() {
+					BeforeEach(func() {
+						queryParams = "?created_by=some-user"
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+
+					It("does not look up the builds", func() {
+						Expect(fakePipeline.BuildsCallCount()).To(BeZero())
+						Expect(fakePipeline.BuildsWithTimeCallCount()).To(BeZero())
+					})
+				})
 			})
 		})
 
@@ -1884,6 +1904,8 @@ var _ = Describe("Pipelines API", func() {
 						fakeBuild.EndTimeReturns(time.Unix(100, 0))
 						fakeBuild.ReapTimeReturns(time.Unix(200, 0))
 
+						fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
+
 						dbPipeline.CreateStartedBuildReturns(fakeBuild, nil)
 					})
 
@@ -1900,7 +1922,9 @@ var _ = Describe("Pipelines API", func() {
 
 					It("creates a started build", func() {
 						Expect(dbPipeline.CreateStartedBuildCallCount()).To(Equal(1))
-						Expect(dbPipeline.CreateStartedBuildArgsForCall(0)).To(Equal(plan))
+						actualPlan, createdBy := dbPipeline.CreateStartedBuildArgsForCall(0)
+						Expect(actualPlan).To(Equal(plan))
+						Expect(createdBy).To(Equal("some-user"))
 					})
 
 					It("returns the created build", func() {
@@ -6,7 +6,10 @@ import (
 	"net/http"
 	"strconv"
 
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/buildfilter"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -40,7 +43,23 @@ func (s *Server) ListPipelineBuilds(pipeline db.Pipeline) http.Handler {
 			limit = atc.PaginationAPIDefaultLimit
 		}
 
-		page := db.Page{Until: until, Since: since, Limit: limit}
+		filter, err := buildfilter.Parse(r.URL.Query())
+		if err != nil {
+			logger.Info("invalid-build-filter", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+
+		// who created a build is only shown to its team, so builds of public
+		// pipelines cannot be filtered by it either
+		if filter.CreatedBy != "" && !acc.IsAuthorized(pipeline.TeamName()) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		page := db.Page{Until: until, Since: since, Limit: limit, Filter: filter}
 
 		if timestamps == "" {
 			builds, pagination, err = pipeline.Builds(page)
@@ -69,10 +88,12 @@ func (s *Server) ListPipelineBuilds(pipeline db.Pipeline) http.Handler {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 
+		showUsers := acc.IsAuthorized(pipeline.TeamName())
+
 		atc := make([]atc.Build, len(builds))
 		for i := 0; i < len(builds); i++ {
 			build := builds[i]
-			atc[i] = present.Build(build)
+			atc[i] = present.Build(build, showUsers)
 		}
 
 		err = json.NewEncoder(w).Encode(atc)
@@ -85,7 +106,7 @@ func (s *Server) ListPipelineBuilds(pipeline db.Pipeline) http.Handler {
 
 func (s *Server) addNextLink(w http.ResponseWriter, teamName, pipelineName string, page db.Page) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/teams/%s/pipelines/%s/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/teams/%s/pipelines/%s/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		teamName,
 		pipelineName,
@@ -93,13 +114,14 @@ func (s *Server) addNextLink(w http.ResponseWriter, teamName, pipelineName strin
 		page.Since,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		buildfilter.Query(page.Filter),
 		atc.LinkRelNext,
 	))
 }
 
 func (s *Server) addPreviousLink(w http.ResponseWriter, teamName, pipelineName string, page db.Page) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/teams/%s/pipelines/%s/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/teams/%s/pipelines/%s/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		teamName,
 		pipelineName,
@@ -107,6 +129,7 @@ func (s *Server) addPreviousLink(w http.ResponseWriter, teamName, pipelineName s
 		page.Until,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		buildfilter.Query(page.Filter),
 		atc.LinkRelPrevious,
 	))
 }
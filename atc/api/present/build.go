@@ -8,7 +8,7 @@ import (
 	"github.com/tedsuo/rata"
 )
 
-func Build(build db.Build) atc.Build {
+func Build(build db.Build, showUsers bool) atc.Build {
 
 	apiURL, err := atc.Routes.CreatePathForRoute(atc.GetBuild, rata.Params{
 		"build_id":  strconv.Itoa(build.ID()),
@@ -26,6 +26,16 @@ func Build(build db.Build) atc.Build {
 		TeamName:     build.TeamName(),
 		Status:       string(build.Status()),
 		APIURL:       apiURL,
+		Held:         build.IsHeld(),
+
+		PausedAtBreakpoint: build.IsPausedAtBreakpoint(),
+
+		RerunFromStep: build.RerunFromStep(),
+	}
+
+	if showUsers {
+		atcBuild.CreatedBy = build.CreatedBy()
+		atcBuild.AbortedBy = build.AbortedBy()
 	}
 
 	if build.RerunOf() != 0 {
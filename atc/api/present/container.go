@@ -7,7 +7,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 )
 
-func Container(container db.Container, expiresAt time.Time) atc.Container {
+func Container(container db.Container, expiresAt time.Time, retention db.ContainerRetention) atc.Container {
 	meta := container.Metadata()
 
 	atcContainer := atc.Container{
@@ -32,6 +32,12 @@ func Container(container db.Container, expiresAt time.Time) atc.Container {
 		User:             meta.User,
 	}
 
+	if retention.Held {
+		atcContainer.Held = true
+	} else if !retention.ExpiresAt.IsZero() {
+		expiresAt = retention.ExpiresAt
+	}
+
 	if !expiresAt.IsZero() {
 		atcContainer.ExpiresIn = expiresAt.Sub(time.Now()).Round(time.Second).String()
 	}
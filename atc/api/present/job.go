@@ -13,21 +13,22 @@ func Job(
 	finishedBuild db.Build,
 	nextBuild db.Build,
 	transitionBuild db.Build,
+	showUsers bool,
 ) atc.Job {
 	var presentedNextBuild, presentedFinishedBuild, presentedTransitionBuild *atc.Build
 
 	if nextBuild != nil {
-		presented := Build(nextBuild)
+		presented := Build(nextBuild, showUsers)
 		presentedNextBuild = &presented
 	}
 
 	if finishedBuild != nil {
-		presented := Build(finishedBuild)
+		presented := Build(finishedBuild, showUsers)
 		presentedFinishedBuild = &presented
 	}
 
 	if transitionBuild != nil {
-		presented := Build(transitionBuild)
+		presented := Build(transitionBuild, showUsers)
 		presentedTransitionBuild = &presented
 	}
 
@@ -0,0 +1,43 @@
+package present
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func JobStats(window time.Duration, stats db.JobStats) atc.JobStats {
+	presented := atc.JobStats{
+		Window: int64(window / time.Second),
+
+		Builds:    stats.Builds,
+		Succeeded: stats.Succeeded,
+		Failed:    stats.Failed,
+		Errored:   stats.Errored,
+		Aborted:   stats.Aborted,
+
+		Durations: atc.JobStatsDurations{
+			P50: int64(stats.DurationP50 / time.Second),
+			P90: int64(stats.DurationP90 / time.Second),
+			P99: int64(stats.DurationP99 / time.Second),
+		},
+
+		Recoveries:         stats.Recoveries,
+		MeanTimeToRecovery: int64(stats.MeanTimeToRecovery / time.Second),
+
+		FlakyBuilds: stats.FlakyBuilds,
+	}
+
+	if stats.Builds != 0 {
+		presented.SuccessRate = float64(stats.Succeeded) / float64(stats.Builds)
+		presented.FailureRate = float64(stats.Failed) / float64(stats.Builds)
+		presented.ErrorRate = float64(stats.Errored) / float64(stats.Builds)
+	}
+
+	if stats.Failed != 0 {
+		presented.Flakiness = float64(stats.FlakyBuilds) / float64(stats.Failed)
+	}
+
+	return presented
+}
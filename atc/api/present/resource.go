@@ -30,6 +30,7 @@ func Resource(resource db.Resource, showCheckError bool, teamName string) atc.Re
 		FailingToCheck:  failingToCheck,
 		CheckSetupError: checkErrString,
 		CheckError:      rcCheckErrString,
+		CheckFailures:   resource.CheckFailures(),
 		PinComment:      resource.PinComment(),
 	}
 
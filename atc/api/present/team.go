@@ -10,5 +10,8 @@ func Team(team db.Team) atc.Team {
 		ID:   team.ID(),
 		Name: team.Name(),
 		Auth: team.Auth(),
+
+		Network: team.Network(),
+		Caches:  team.Caches(),
 	}
 }
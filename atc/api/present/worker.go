@@ -40,6 +40,7 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		State:            string(workerInfo.State()),
 		Version:          version,
 		Ephemeral:        workerInfo.Ephemeral(),
+		Capabilities:     workerInfo.Capabilities(),
 	}
 
 	if !workerInfo.StartTime().IsZero() {
@@ -2,6 +2,9 @@ package api_test
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -201,6 +204,7 @@ var _ = Describe("Resources API", func() {
 				resource2.PipelineNameReturns("a-pipeline")
 				resource2.NameReturns("resource-2")
 				resource2.TypeReturns("type-2")
+				resource2.CheckFailuresReturns(2)
 
 				resource3 := new(dbfakes.FakeResource)
 				resource3.IDReturns(3)
@@ -264,7 +268,8 @@ var _ = Describe("Resources API", func() {
 						"pipeline_name": "a-pipeline",
 						"team_name": "a-team",
 						"type": "type-2",
-						"failing_to_check": true
+						"failing_to_check": true,
+						"check_failures": 2
 					},
 					{
 						"name": "resource-3",
@@ -313,7 +318,8 @@ var _ = Describe("Resources API", func() {
 							"team_name": "a-team",
 							"type": "type-2",
 							"failing_to_check": true,
-							"check_error": "sup"
+							"check_error": "sup",
+							"check_failures": 2
 						},
 						{
 							"name": "resource-3",
@@ -1382,12 +1388,16 @@ var _ = Describe("Resources API", func() {
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", func() {
 		var (
 			checkRequestBody atc.CheckRequestBody
+			reqPayload       []byte
+			reqHeaders       http.Header
 			response         *http.Response
 			fakeResource     *dbfakes.FakeResource
 		)
 
 		BeforeEach(func() {
 			checkRequestBody = atc.CheckRequestBody{}
+			reqPayload = nil
+			reqHeaders = http.Header{}
 
 			fakeResource = new(dbfakes.FakeResource)
 			fakeResource.NameReturns("resource-name")
@@ -1395,12 +1405,18 @@ var _ = Describe("Resources API", func() {
 		})
 
 		JustBeforeEach(func() {
-			reqPayload, err := json.Marshal(checkRequestBody)
-			Expect(err).NotTo(HaveOccurred())
+			var err error
+			if reqPayload == nil {
+				reqPayload, err = json.Marshal(checkRequestBody)
+				Expect(err).NotTo(HaveOccurred())
+			}
 
 			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/check/webhook?webhook_token=fake-token", bytes.NewBuffer(reqPayload))
 			Expect(err).NotTo(HaveOccurred())
 			request.Header.Set("Content-Type", "application/json")
+			for name, values := range reqHeaders {
+				request.Header[name] = values
+			}
 
 			response, err = client.Do(request)
 			Expect(err).NotTo(HaveOccurred())
@@ -1500,6 +1516,14 @@ var _ = Describe("Resources API", func() {
 							Expect(dbCheckFactory.NotifyCheckerCallCount()).To(Equal(1))
 						})
 
+						It("records an accepted delivery", func() {
+							Expect(fakeResource.RecordWebhookDeliveryCallCount()).To(Equal(1))
+							Expect(fakeResource.RecordWebhookDeliveryArgsForCall(0)).To(Equal(atc.WebhookDelivery{
+								Outcome: atc.WebhookDeliveryAccepted,
+								CheckID: 10,
+							}))
+						})
+
 						It("returns 201", func() {
 							Expect(response.StatusCode).To(Equal(http.StatusCreated))
 							Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
@@ -1543,6 +1567,468 @@ var _ = Describe("Resources API", func() {
 			It("returns 401", func() {
 				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
 			})
+
+			It("records a rejected delivery", func() {
+				Expect(fakeResource.RecordWebhookDeliveryCallCount()).To(Equal(1))
+				Expect(fakeResource.RecordWebhookDeliveryArgsForCall(0).Outcome).To(Equal(atc.WebhookDeliveryRejected))
+			})
+		})
+
+		Context("when the resource configures a signed webhook", func() {
+			var webhook *atc.WebhookConfig
+
+			sign := func(payload []byte) string {
+				mac := hmac.New(sha256.New, []byte("some-secret"))
+				mac.Write(payload)
+				return hex.EncodeToString(mac.Sum(nil))
+			}
+
+			BeforeEach(func() {
+				variables = vars.StaticVariables{
+					"webhook-secret": "some-secret",
+				}
+				fakePipeline.VariablesReturns(variables, nil)
+
+				reqPayload = []byte(`{"ref":"refs/heads/main","repository":{"name":"some-repo"}}`)
+
+				webhook = &atc.WebhookConfig{
+					Type:   atc.WebhookTypeGitHub,
+					Secret: "((webhook-secret))",
+				}
+				fakeResource.WebhookReturns(webhook)
+				fakePipeline.ResourceReturns(fakeResource, true, nil)
+
+				fakeCheck := new(dbfakes.FakeCheck)
+				fakeCheck.IDReturns(42)
+				dbCheckFactory.TryCreateCheckReturns(fakeCheck, true, nil)
+			})
+
+			Context("when the github signature is valid", func() {
+				BeforeEach(func() {
+					reqHeaders.Set("X-Hub-Signature-256", "sha256="+sign(reqPayload))
+				})
+
+				It("returns 201", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				})
+
+				It("creates a check", func() {
+					Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+				})
+
+				It("records an accepted delivery", func() {
+					Expect(fakeResource.RecordWebhookDeliveryCallCount()).To(Equal(1))
+					Expect(fakeResource.RecordWebhookDeliveryArgsForCall(0)).To(Equal(atc.WebhookDelivery{
+						Outcome: atc.WebhookDeliveryAccepted,
+						CheckID: 42,
+					}))
+				})
+
+				Context("when the payload matches the filter", func() {
+					BeforeEach(func() {
+						webhook.Filter = map[string]string{
+							"ref":             "refs/heads/main",
+							"repository.name": "some-repo",
+						}
+					})
+
+					It("returns 201", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					})
+				})
+
+				Context("when the payload does not match the filter", func() {
+					BeforeEach(func() {
+						webhook.Filter = map[string]string{"ref": "refs/heads/develop"}
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("does not create a check", func() {
+						Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+					})
+
+					It("records a filtered delivery", func() {
+						Expect(fakeResource.RecordWebhookDeliveryCallCount()).To(Equal(1))
+						Expect(fakeResource.RecordWebhookDeliveryArgsForCall(0).Outcome).To(Equal(atc.WebhookDeliveryFiltered))
+					})
+				})
+
+				Context("when a filter is configured and the payload is not JSON", func() {
+					BeforeEach(func() {
+						reqPayload = []byte("not-json")
+						reqHeaders.Set("X-Hub-Signature-256", "sha256="+sign(reqPayload))
+						webhook.Filter = map[string]string{"ref": "refs/heads/main"}
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+			})
+
+			Context("when the github signature is invalid", func() {
+				BeforeEach(func() {
+					reqHeaders.Set("X-Hub-Signature-256", "sha256="+sign([]byte("some-other-payload")))
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+
+				It("does not create a check", func() {
+					Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+				})
+
+				It("records a rejected delivery", func() {
+					Expect(fakeResource.RecordWebhookDeliveryCallCount()).To(Equal(1))
+					Expect(fakeResource.RecordWebhookDeliveryArgsForCall(0).Outcome).To(Equal(atc.WebhookDeliveryRejected))
+				})
+			})
+
+			Context("when the signature is missing", func() {
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("when the payload is too large", func() {
+				BeforeEach(func() {
+					reqPayload = bytes.Repeat([]byte("x"), 5*1024*1024+1)
+					reqHeaders.Set("X-Hub-Signature-256", "sha256="+sign(reqPayload))
+				})
+
+				It("returns 413", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusRequestEntityTooLarge))
+				})
+
+				It("does not create a check", func() {
+					Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the webhook is a gitlab webhook", func() {
+				BeforeEach(func() {
+					webhook.Type = atc.WebhookTypeGitLab
+				})
+
+				Context("when the token header matches the secret", func() {
+					BeforeEach(func() {
+						reqHeaders.Set("X-Gitlab-Token", "some-secret")
+					})
+
+					It("returns 201", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					})
+				})
+
+				Context("when the token header does not match the secret", func() {
+					BeforeEach(func() {
+						reqHeaders.Set("X-Gitlab-Token", "wrong-secret")
+					})
+
+					It("returns 401", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					})
+				})
+			})
+
+			Context("when the webhook is a generic hmac webhook", func() {
+				BeforeEach(func() {
+					webhook.Type = atc.WebhookTypeHMAC
+				})
+
+				Context("with the default header", func() {
+					BeforeEach(func() {
+						reqHeaders.Set("X-Signature", sign(reqPayload))
+					})
+
+					It("returns 201", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					})
+				})
+
+				Context("with a custom header", func() {
+					BeforeEach(func() {
+						webhook.Header = "X-Custom-Signature"
+						reqHeaders.Set("X-Custom-Signature", "sha256="+sign(reqPayload))
+					})
+
+					It("returns 201", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/webhook/deliveries", func() {
+		var (
+			response     *http.Response
+			fakeResource *dbfakes.FakeResource
+			query        string
+		)
+
+		BeforeEach(func() {
+			query = ""
+			fakeResource = new(dbfakes.FakeResource)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/webhook/deliveries"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the resource is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the resource is found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(fakeResource, true, nil)
+					fakeResource.WebhookDeliveriesReturns([]atc.WebhookDelivery{
+						{
+							ID:          2,
+							DeliveredAt: 1591025749,
+							Outcome:     atc.WebhookDeliveryAccepted,
+							CheckID:     42,
+						},
+						{
+							ID:          1,
+							DeliveredAt: 1591025700,
+							Outcome:     atc.WebhookDeliveryRejected,
+							Message:     "invalid webhook signature",
+						},
+					}, nil)
+				})
+
+				It("returns the deliveries", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{"id": 2, "delivered_at": 1591025749, "outcome": "accepted", "check_id": 42},
+						{"id": 1, "delivered_at": 1591025700, "outcome": "rejected", "message": "invalid webhook signature"}
+					]`))
+				})
+
+				It("uses the default limit", func() {
+					Expect(fakeResource.WebhookDeliveriesArgsForCall(0)).To(Equal(25))
+				})
+
+				Context("when a limit is given", func() {
+					BeforeEach(func() {
+						query = "?limit=5"
+					})
+
+					It("passes the limit along", func() {
+						Expect(fakeResource.WebhookDeliveriesArgsForCall(0)).To(Equal(5))
+					})
+				})
+
+				Context("when the limit is invalid", func() {
+					BeforeEach(func() {
+						query = "?limit=nope"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+			})
+
+			Context("when fetching the deliveries fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(fakeResource, true, nil)
+					fakeResource.WebhookDeliveriesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions", func() {
+		var (
+			requestBody  string
+			response     *http.Response
+			fakeResource *dbfakes.FakeResource
+			fakeScope    *dbfakes.FakeResourceConfigScope
+		)
+
+		BeforeEach(func() {
+			requestBody = `{
+				"versions": [
+					{"version": {"ref": "v1"}},
+					{"version": {"ref": "v2"}, "metadata": [{"name": "author", "value": "someone"}]}
+				]
+			}`
+
+			fakeResource = new(dbfakes.FakeResource)
+			fakeResource.NameReturns("resource-name")
+			fakeResource.SourceReturns(atc.Source{"uri": "((uri))"})
+
+			fakeScope = new(dbfakes.FakeResourceConfigScope)
+			fakeResource.SetResourceConfigReturns(fakeScope, nil)
+
+			fakePipeline.VariablesReturns(vars.StaticVariables{"uri": "some-uri"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/versions", bytes.NewBufferString(requestBody))
+			Expect(err).NotTo(HaveOccurred())
+			request.Header.Set("Content-Type", "application/json")
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the request body is malformed", func() {
+				BeforeEach(func() {
+					requestBody = "{"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when no versions are given", func() {
+				BeforeEach(func() {
+					requestBody = `{"versions": []}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when an empty version is given", func() {
+				BeforeEach(func() {
+					requestBody = `{"versions": [{"version": {}}]}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the resource is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the resource is found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(fakeResource, true, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("sets the resource config using the evaluated source", func() {
+					Expect(fakeResource.SetResourceConfigCallCount()).To(Equal(1))
+					source, _ := fakeResource.SetResourceConfigArgsForCall(0)
+					Expect(source).To(Equal(atc.Source{"uri": "some-uri"}))
+				})
+
+				It("saves the versions with their metadata into the resource config scope", func() {
+					Expect(fakeScope.SaveVersionsWithMetadataCallCount()).To(Equal(1))
+					_, versions := fakeScope.SaveVersionsWithMetadataArgsForCall(0)
+					Expect(versions).To(Equal([]atc.VersionWithMetadata{
+						{Version: atc.Version{"ref": "v1"}},
+						{
+							Version:  atc.Version{"ref": "v2"},
+							Metadata: []atc.MetadataField{{Name: "author", Value: "someone"}},
+						},
+					}))
+				})
+
+				Context("when setting the resource config fails", func() {
+					BeforeEach(func() {
+						fakeResource.SetResourceConfigReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when saving the versions fails", func() {
+					BeforeEach(func() {
+						fakeScope.SaveVersionsWithMetadataReturns(errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when the source cannot be evaluated", func() {
+					BeforeEach(func() {
+						fakePipeline.VariablesReturns(vars.StaticVariables{}, nil)
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+
+					It("does not save any versions", func() {
+						Expect(fakeScope.SaveVersionsWithMetadataCallCount()).To(BeZero())
+					})
+				})
+			})
 		})
 	})
 })
@@ -3,30 +3,32 @@ package resourceserver
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/tedsuo/rata"
 )
 
-// CheckResourceWebHook defines a handler for process a check resource request via an access token.
+// maxWebhookPayloadSize bounds how much of a webhook request body is read
+// before its signature is verified, as the endpoint is unauthenticated.
+const maxWebhookPayloadSize = 5 * 1024 * 1024
+
+// CheckResourceWebHook defines a handler for process a check resource request
+// via an access token or, when the resource configures a webhook, a signed
+// payload.
 func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 	logger := s.logger.Session("check-resource-webhook")
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resourceName := rata.Param(r, "resource_name")
-		webhookToken := r.URL.Query().Get("webhook_token")
-
-		if webhookToken == "" {
-			logger.Info("no-webhook-token", lager.Data{"error": "missing webhook_token"})
-			w.WriteHeader(http.StatusBadRequest)
-			return
-		}
 
 		dbResource, found, err := dbPipeline.Resource(resourceName)
 		if err != nil {
@@ -47,11 +49,73 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
-		token, err := creds.NewString(variables, dbResource.WebhookToken()).Evaluate()
-		if token != webhookToken {
-			logger.Info("invalid-token", lager.Data{"error": fmt.Sprintf("invalid token for webhook %s", webhookToken)})
-			w.WriteHeader(http.StatusUnauthorized)
-			return
+
+		if webhook := dbResource.Webhook(); webhook != nil {
+			payload, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayloadSize))
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					logger.Info("payload-too-large", lager.Data{"limit": tooLarge.Limit})
+					w.WriteHeader(http.StatusRequestEntityTooLarge)
+					return
+				}
+
+				logger.Error("failed-to-read-payload", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			secret, err := creds.NewString(variables, webhook.Secret).Evaluate()
+			if err != nil {
+				logger.Error("failed-to-evaluate-webhook-secret", err)
+				s.recordWebhookDelivery(logger, dbResource, atc.WebhookDeliveryErrored, err.Error(), 0)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			err = verifyWebhook(*webhook, secret, r.Header, payload)
+			if err != nil {
+				logger.Info("invalid-signature", lager.Data{"error": err.Error()})
+				s.recordWebhookDelivery(logger, dbResource, atc.WebhookDeliveryRejected, err.Error(), 0)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			matched, err := matchesWebhookFilter(webhook.Filter, payload)
+			if err != nil {
+				logger.Info("malformed-payload", lager.Data{"error": err.Error()})
+				s.recordWebhookDelivery(logger, dbResource, atc.WebhookDeliveryRejected, "malformed payload: "+err.Error(), 0)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			if !matched {
+				logger.Debug("payload-filtered")
+				s.recordWebhookDelivery(logger, dbResource, atc.WebhookDeliveryFiltered, "", 0)
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+		} else {
+			webhookToken := r.URL.Query().Get("webhook_token")
+			if webhookToken == "" {
+				logger.Info("no-webhook-token", lager.Data{"error": "missing webhook_token"})
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			token, err := creds.NewString(variables, dbResource.WebhookToken()).Evaluate()
+			if err != nil {
+				logger.Error("failed-to-evaluate-webhook-token", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if token != webhookToken {
+				logger.Info("invalid-token", lager.Data{"error": fmt.Sprintf("invalid token for webhook %s", webhookToken)})
+				s.recordWebhookDelivery(logger, dbResource, atc.WebhookDeliveryRejected, "invalid webhook token", 0)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
 		}
 
 		dbResourceTypes, err := dbPipeline.ResourceTypes()
@@ -70,6 +134,7 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 		)
 		if err != nil {
 			s.logger.Error("failed-to-create-check", err)
+			s.recordWebhookDelivery(logger, dbResource, atc.WebhookDeliveryErrored, err.Error(), 0)
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
 			return
@@ -77,10 +142,13 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 
 		if !created {
 			s.logger.Info("check-not-created")
+			s.recordWebhookDelivery(logger, dbResource, atc.WebhookDeliveryErrored, "check not created", 0)
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
 
+		s.recordWebhookDelivery(logger, dbResource, atc.WebhookDeliveryAccepted, "", check.ID())
+
 		err = s.checkFactory.NotifyChecker()
 		if err != nil {
 			s.logger.Error("failed-to-notify-checker", err)
@@ -97,3 +165,14 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 		}
 	})
 }
+
+func (s *Server) recordWebhookDelivery(logger lager.Logger, dbResource db.Resource, outcome atc.WebhookDeliveryOutcome, message string, checkID int) {
+	err := dbResource.RecordWebhookDelivery(atc.WebhookDelivery{
+		Outcome: outcome,
+		Message: message,
+		CheckID: checkID,
+	})
+	if err != nil {
+		logger.Error("failed-to-record-webhook-delivery", err)
+	}
+}
@@ -0,0 +1,58 @@
+package resourceserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+const defaultWebhookDeliveriesLimit = 25
+
+func (s *Server) ListWebhookDeliveries(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-webhook-deliveries")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceName := r.FormValue(":resource_name")
+
+		limit := defaultWebhookDeliveriesLimit
+		if limitStr := r.FormValue("limit"); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit <= 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+
+		dbResource, found, err := pipeline.Resource(resourceName)
+		if err != nil {
+			logger.Error("failed-to-get-resource", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		deliveries, err := dbResource.WebhookDeliveries(limit)
+		if err != nil {
+			logger.Error("failed-to-get-webhook-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(deliveries)
+		if err != nil {
+			logger.Error("failed-to-encode-webhook-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
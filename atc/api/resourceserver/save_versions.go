@@ -0,0 +1,106 @@
+package resourceserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+// SaveResourceVersions saves versions which are known by some external system
+// into the resource's config scope, as if they had been found by a check.
+func (s *Server) SaveResourceVersions(dbPipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("save-resource-versions")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceName := rata.Param(r, "resource_name")
+
+		var reqBody atc.SaveVersionsRequestBody
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if len(reqBody.Versions) == 0 {
+			logger.Info("no-versions-given")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		for _, version := range reqBody.Versions {
+			if len(version.Version) == 0 {
+				logger.Info("empty-version-given")
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+
+		dbResource, found, err := dbPipeline.Resource(resourceName)
+		if err != nil {
+			logger.Error("failed-to-get-resource", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		dbResourceTypes, err := dbPipeline.ResourceTypes()
+		if err != nil {
+			logger.Error("failed-to-get-resource-types", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		variables, err := dbPipeline.Variables(logger, s.secretManager, s.varSourcePool)
+		if err != nil {
+			logger.Error("failed-to-create-var-sources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		source, err := creds.NewSource(variables, dbResource.Source()).Evaluate()
+		if err != nil {
+			logger.Error("failed-to-evaluate-source", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		versionedResourceTypes, err := creds.NewVersionedResourceTypes(
+			variables,
+			dbResourceTypes.Filter(dbResource).Deserialize(),
+		).Evaluate()
+		if err != nil {
+			logger.Error("failed-to-evaluate-resource-types", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		resourceConfigScope, err := dbResource.SetResourceConfig(source, versionedResourceTypes)
+		if err != nil {
+			logger.Error("failed-to-set-resource-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		err = resourceConfigScope.SaveVersionsWithMetadata(db.NewSpanContext(r.Context()), reqBody.Versions)
+		if err != nil {
+			logger.Error("failed-to-save-versions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,90 @@
+package resourceserver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var ErrMissingWebhookSignature = errors.New("missing webhook signature")
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+func verifyWebhook(config atc.WebhookConfig, secret string, header http.Header, payload []byte) error {
+	signature := header.Get(config.SignatureHeader())
+	if signature == "" {
+		return ErrMissingWebhookSignature
+	}
+
+	switch config.Type {
+	case atc.WebhookTypeGitLab:
+		if subtle.ConstantTimeCompare([]byte(signature), []byte(secret)) != 1 {
+			return ErrInvalidWebhookSignature
+		}
+
+		return nil
+
+	case atc.WebhookTypeGitHub, atc.WebhookTypeHMAC:
+		expected, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+		if err != nil {
+			return ErrInvalidWebhookSignature
+		}
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload)
+
+		if !hmac.Equal(mac.Sum(nil), expected) {
+			return ErrInvalidWebhookSignature
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("unknown webhook type '%s'", config.Type)
+}
+
+// matchesWebhookFilter returns whether every path in the filter resolves to
+// the expected value in the JSON payload.
+func matchesWebhookFilter(filter map[string]string, payload []byte) (bool, error) {
+	if len(filter) == 0 {
+		return true, nil
+	}
+
+	var body interface{}
+	err := json.Unmarshal(payload, &body)
+	if err != nil {
+		return false, err
+	}
+
+	for path, expected := range filter {
+		value, found := lookupPayloadPath(body, strings.Split(path, "."))
+		if !found || fmt.Sprint(value) != expected {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func lookupPayloadPath(value interface{}, path []string) (interface{}, bool) {
+	for _, segment := range path {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		value, ok = object[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
@@ -336,6 +336,43 @@ var _ = Describe("Teams API", func() {
 						Expect(updatedProviderAuth).To(Equal(atcTeam.Auth))
 					})
 
+					Context("when the team declares caches", func() {
+						BeforeEach(func() {
+							atcTeam.Caches = atc.NamedCacheConfigs{{Name: "go-mod", Size: "10GB"}}
+						})
+
+						It("updates the caches", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(fakeTeam.UpdateCachesCallCount()).To(Equal(1))
+							Expect(fakeTeam.UpdateCachesArgsForCall(0)).To(Equal(atcTeam.Caches))
+						})
+
+						Context("when a pipeline of the team already declares one of them", func() {
+							BeforeEach(func() {
+								fakeTeam.UpdateCachesReturns(db.NamedCacheDeclaredError{Name: "go-mod"})
+							})
+
+							It("returns 409 Conflict with the error", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusConflict))
+
+								body, err := ioutil.ReadAll(response.Body)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(string(body)).To(ContainSubstring("cache 'go-mod' is already declared"))
+							})
+						})
+
+						Context("when the size of a cache cannot be parsed", func() {
+							BeforeEach(func() {
+								atcTeam.Caches = atc.NamedCacheConfigs{{Name: "go-mod", Size: "bogus"}}
+							})
+
+							It("returns 400 Bad Request without updating the team", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(fakeTeam.UpdateCachesCallCount()).To(Equal(0))
+							})
+						})
+					})
+
 					Context("when updating provider auth fails", func() {
 						BeforeEach(func() {
 							fakeTeam.UpdateProviderAuthReturns(errors.New("stop trying to make fetch happen"))
@@ -387,6 +424,26 @@ var _ = Describe("Teams API", func() {
 
 				authorizedTeamTests()
 
+				Context("when the team exists and its network is locked", func() {
+					BeforeEach(func() {
+						fakeTeam.NetworkReturns(&atc.TeamNetworkConfig{
+							Default: atc.NetworkConfig{Mode: atc.NetworkModeNone},
+							Locked:  true,
+						})
+						dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+						atcTeam.Network = &atc.TeamNetworkConfig{
+							Default: atc.NetworkConfig{Mode: atc.NetworkModeDefault},
+						}
+					})
+
+					It("updates the network", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateNetworkCallCount()).To(Equal(1))
+						Expect(fakeTeam.UpdateNetworkArgsForCall(0)).To(Equal(atcTeam.Network))
+					})
+				})
+
 				Context("when the team is not found", func() {
 					BeforeEach(func() {
 						dbTeamFactory.FindTeamReturns(nil, false, nil)
@@ -432,6 +489,85 @@ var _ = Describe("Teams API", func() {
 
 				authorizedTeamTests()
 
+				Context("when the team exists", func() {
+					BeforeEach(func() {
+						dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					})
+
+					It("leaves the network as it is when none is given", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateNetworkCallCount()).To(Equal(0))
+					})
+
+					Context("when a default network is given", func() {
+						BeforeEach(func() {
+							atcTeam.Network = &atc.TeamNetworkConfig{
+								Default: atc.NetworkConfig{Mode: atc.NetworkModeNone},
+							}
+						})
+
+						It("updates the network", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(fakeTeam.UpdateNetworkCallCount()).To(Equal(1))
+							Expect(fakeTeam.UpdateNetworkArgsForCall(0)).To(Equal(atcTeam.Network))
+						})
+
+						Context("when updating the network fails", func() {
+							BeforeEach(func() {
+								fakeTeam.UpdateNetworkReturns(errors.New("nope"))
+							})
+
+							It("returns 500 Internal Server error", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+					})
+
+					Context("when trying to lock the network", func() {
+						BeforeEach(func() {
+							atcTeam.Network = &atc.TeamNetworkConfig{
+								Default: atc.NetworkConfig{Mode: atc.NetworkModeNone},
+								Locked:  true,
+							}
+						})
+
+						It("returns 403 Forbidden", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+							Expect(fakeTeam.UpdateNetworkCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the network is locked", func() {
+						BeforeEach(func() {
+							fakeTeam.NetworkReturns(&atc.TeamNetworkConfig{
+								Default: atc.NetworkConfig{Mode: atc.NetworkModeNone},
+								Locked:  true,
+							})
+						})
+
+						Context("when trying to change it", func() {
+							BeforeEach(func() {
+								atcTeam.Network = &atc.TeamNetworkConfig{
+									Default: atc.NetworkConfig{Mode: atc.NetworkModeDefault},
+								}
+							})
+
+							It("returns 403 Forbidden without updating anything", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+								Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+								Expect(fakeTeam.UpdateNetworkCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when not changing it", func() {
+							It("updates provider auth", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+								Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(1))
+							})
+						})
+					})
+				})
+
 				Context("when the team is not found", func() {
 					BeforeEach(func() {
 						dbTeamFactory.FindTeamReturns(nil, false, nil)
@@ -670,6 +806,7 @@ var _ = Describe("Teams API", func() {
 
 			BeforeEach(func() {
 				teamName = "some-team"
+				queryParams = ""
 			})
 
 			JustBeforeEach(func() {
@@ -727,6 +864,66 @@ var _ = Describe("Teams API", func() {
 					})
 				})
 
+				Context("when filters are passed", func() {
+					BeforeEach(func() {
+						queryParams = "?status=failed&pipeline_name=some-pipeline&manually_triggered=true"
+					})
+
+					It("passes them through", func() {
+						Expect(fakeTeam.BuildsCallCount()).To(Equal(1))
+
+						manuallyTriggered := true
+
+						page := fakeTeam.BuildsArgsForCall(0)
+						Expect(page.Filter).To(Equal(db.BuildFilter{
+							Statuses:          []db.BuildStatus{db.BuildStatusFailed},
+							PipelineName:      "some-pipeline",
+							ManuallyTriggered: &manuallyTriggered,
+						}))
+					})
+				})
+
+				Context("when filtering by who created the builds", func() {
+					BeforeEach(func() {
+						queryParams = "?created_by=some-user"
+					})
+
+					Context("when authorized for the team", func() {
+						BeforeEach(func() {
+							fakeAccess.IsAuthorizedReturns(true)
+						})
+
+						It("passes the filter through", func() {
+							Expect(fakeTeam.BuildsCallCount()).To(Equal(1))
+							Expect(fakeTeam.BuildsArgsForCall(0).Filter).To(Equal(db.BuildFilter{
+								CreatedBy: "some-user",
+							}))
+						})
+					})
+
+					Context("when not authorized for the team", func() {
+						BeforeEach(func() {
+							fakeAccess.IsAuthorizedReturns(false)
+						})
+
+						It("returns 403", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+							Expect(fakeTeam.BuildsCallCount()).To(Equal(0))
+						})
+					})
+				})
+
+				Context("when an invalid status is passed", func() {
+					BeforeEach(func() {
+						queryParams = "?status=bogus"
+					})
+
+					It("returns 400 Bad Request", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeTeam.BuildsCallCount()).To(Equal(0))
+					})
+				})
+
 				Context("when getting the builds succeeds", func() {
 					var returnedBuilds []db.Build
 
@@ -826,4 +1023,219 @@ var _ = Describe("Teams API", func() {
 				})
 			})
 		})
+
+		Describe("GET /api/v1/teams/:team_name/build-logs", func() {
+			var (
+				response    *http.Response
+				queryParams string
+			)
+
+			BeforeEach(func() {
+				queryParams = "?q=connection+refused"
+			})
+
+			JustBeforeEach(func() {
+				var err error
+
+				response, err = client.Get(server.URL + "/api/v1/teams/some-team/build-logs" + queryParams)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeTeam.SearchBuildLogsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+					fakeTeam.SearchBuildLogsReturns([]atc.BuildLogMatch{
+						{
+							BuildID:      42,
+							BuildName:    "3",
+							TeamName:     "some-team",
+							PipelineName: "some-pipeline",
+							JobName:      "some-job",
+							StartTime:    1000,
+							Lines:        []string{"dial tcp: connection refused"},
+						},
+					}, nil)
+				})
+
+				It("returns the matches", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`[
+						{
+							"build_id": 42,
+							"build_name": "3",
+							"team_name": "some-team",
+							"pipeline_name": "some-pipeline",
+							"job_name": "some-job",
+							"start_time": 1000,
+							"lines": ["dial tcp: connection refused"]
+						}
+					]`))
+				})
+
+				It("searches with the default limit", func() {
+					Expect(fakeTeam.SearchBuildLogsCallCount()).To(Equal(1))
+					Expect(fakeTeam.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+						Query: "connection refused",
+						Limit: atc.SearchBuildLogsDefaultLimit,
+					}))
+				})
+
+				Context("when filters are given", func() {
+					BeforeEach(func() {
+						queryParams = "?q=refused&pipeline_name=some-pipeline&job_name=some-job&since=100&until=200&limit=5"
+					})
+
+					It("passes them along", func() {
+						Expect(fakeTeam.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+							Query:        "refused",
+							PipelineName: "some-pipeline",
+							JobName:      "some-job",
+							Since:        time.Unix(100, 0),
+							Until:        time.Unix(200, 0),
+							Limit:        5,
+						}))
+					})
+				})
+
+				Context("when there are no matches", func() {
+					BeforeEach(func() {
+						fakeTeam.SearchBuildLogsReturns(nil, nil)
+					})
+
+					It("returns an empty list", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(body).To(MatchJSON(`[]`))
+					})
+				})
+
+				Context("when the query is missing", func() {
+					BeforeEach(func() {
+						queryParams = ""
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+
+				Context("when a timestamp is malformed", func() {
+					BeforeEach(func() {
+						queryParams = "?q=refused&since=yesterday"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+
+				Context("when the team does not exist", func() {
+					BeforeEach(func() {
+						dbTeamFactory.FindTeamReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when searching fails", func() {
+					BeforeEach(func() {
+						fakeTeam.SearchBuildLogsReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+
+		Describe("DELETE /api/v1/teams/:team_name/caches/:cache_name", func() {
+			var response *http.Response
+
+			JustBeforeEach(func() {
+				request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/caches/some-cache", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err = client.Do(request)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeTeam.ClearNamedCacheCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+					fakeTeam.ClearNamedCacheReturns(2, nil)
+				})
+
+				It("clears the team's cache", func() {
+					Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("some-team"))
+					Expect(fakeTeam.ClearNamedCacheCallCount()).To(Equal(1))
+					Expect(fakeTeam.ClearNamedCacheArgsForCall(0)).To(Equal("some-cache"))
+				})
+
+				It("returns the number of caches removed", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{"caches_removed": 2}`))
+				})
+
+				Context("when the team does not exist", func() {
+					BeforeEach(func() {
+						dbTeamFactory.FindTeamReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when clearing fails", func() {
+					BeforeEach(func() {
+						fakeTeam.ClearNamedCacheReturns(0, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
 	})
@@ -0,0 +1,43 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+)
+
+func (s *Server) ClearNamedCache(w http.ResponseWriter, r *http.Request) {
+	cacheName := r.FormValue(":cache_name")
+
+	logger := s.logger.Session("clear-named-cache", lager.Data{"cache": cacheName})
+
+	team, found, err := s.teamFactory.FindTeam(r.FormValue(":team_name"))
+	if err != nil {
+		logger.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	removed, err := team.ClearNamedCache(cacheName)
+	if err != nil {
+		logger.Error("failed-to-clear-named-cache", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(atc.ClearTaskCacheResponse{CachesRemoved: removed})
+	if err != nil {
+		logger.Error("failed-to-encode-response", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
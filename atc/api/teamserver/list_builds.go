@@ -6,7 +6,10 @@ import (
 	"net/http"
 	"strconv"
 
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/buildfilter"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -38,7 +41,23 @@ func (s *Server) ListTeamBuilds(w http.ResponseWriter, r *http.Request) {
 		limit = atc.PaginationAPIDefaultLimit
 	}
 
-	page := db.Page{Until: until, Since: since, Limit: limit}
+	filter, err := buildfilter.Parse(r.URL.Query())
+	if err != nil {
+		logger.Info("invalid-build-filter", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	acc := accessor.GetAccessor(r)
+
+	// who created a build is only shown to its team, so other teams cannot
+	// filter by it either
+	if filter.CreatedBy != "" && !acc.IsAuthorized(teamName) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	page := db.Page{Until: until, Since: since, Limit: limit, Filter: filter}
 
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
@@ -73,10 +92,12 @@ func (s *Server) ListTeamBuilds(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 
+	showUsers := acc.IsAuthorized(teamName)
+
 	atc := make([]atc.Build, len(builds))
 	for i := 0; i < len(builds); i++ {
 		build := builds[i]
-		atc[i] = present.Build(build)
+		atc[i] = present.Build(build, showUsers)
 	}
 
 	err = json.NewEncoder(w).Encode(atc)
@@ -88,26 +109,28 @@ func (s *Server) ListTeamBuilds(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) addNextLink(w http.ResponseWriter, teamName string, page db.Page) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/teams/%s/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/teams/%s/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		teamName,
 		atc.PaginationQuerySince,
 		page.Since,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		buildfilter.Query(page.Filter),
 		atc.LinkRelNext,
 	))
 }
 
 func (s *Server) addPreviousLink(w http.ResponseWriter, teamName string, page db.Page) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/teams/%s/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/teams/%s/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		teamName,
 		atc.PaginationQueryUntil,
 		page.Until,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		buildfilter.Query(page.Filter),
 		atc.LinkRelPrevious,
 	))
 }
@@ -0,0 +1,88 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SearchBuildLogs(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("search-build-logs")
+
+	search := db.BuildLogSearch{
+		Query:        r.FormValue(atc.SearchBuildLogsQueryText),
+		PipelineName: r.FormValue(atc.SearchBuildLogsQueryPipeline),
+		JobName:      r.FormValue(atc.SearchBuildLogsQueryJob),
+		Limit:        atc.SearchBuildLogsDefaultLimit,
+	}
+
+	if search.Query == "" {
+		http.Error(w, "missing search query", http.StatusBadRequest)
+		return
+	}
+
+	if since := r.FormValue(atc.SearchBuildLogsQuerySince); since != "" {
+		timestamp, err := strconv.ParseInt(since, 10, 64)
+		if err != nil {
+			http.Error(w, "malformed since timestamp", http.StatusBadRequest)
+			return
+		}
+
+		search.Since = time.Unix(timestamp, 0)
+	}
+
+	if until := r.FormValue(atc.SearchBuildLogsQueryUntil); until != "" {
+		timestamp, err := strconv.ParseInt(until, 10, 64)
+		if err != nil {
+			http.Error(w, "malformed until timestamp", http.StatusBadRequest)
+			return
+		}
+
+		search.Until = time.Unix(timestamp, 0)
+	}
+
+	if limit := r.FormValue(atc.SearchBuildLogsQueryLimit); limit != "" {
+		var err error
+		search.Limit, err = strconv.Atoi(limit)
+		if err != nil || search.Limit <= 0 {
+			http.Error(w, "malformed limit", http.StatusBadRequest)
+			return
+		}
+	}
+
+	team, found, err := s.teamFactory.FindTeam(r.FormValue(":team_name"))
+	if err != nil {
+		logger.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	matches, err := team.SearchBuildLogs(search)
+	if err != nil {
+		logger.Error("failed-to-search-build-logs", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if matches == nil {
+		matches = []atc.BuildLogMatch{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(matches)
+	if err != nil {
+		logger.Error("failed-to-encode-build-log-matches", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
 )
 
 func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
@@ -49,6 +50,12 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if found {
+		if atcTeam.Network != nil && !acc.IsAdmin() && !canChangeNetwork(team.Network(), atcTeam.Network) {
+			hLog.Debug("network-locked")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
 		hLog.Debug("updating-credentials")
 		err = team.UpdateProviderAuth(atcTeam.Auth)
 		if err != nil {
@@ -57,6 +64,30 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if atcTeam.Network != nil {
+			hLog.Debug("updating-network")
+			err = team.UpdateNetwork(atcTeam.Network)
+			if err != nil {
+				hLog.Error("failed-to-update-team-network", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
+		hLog.Debug("updating-caches")
+		err = team.UpdateCaches(atcTeam.Caches)
+		if err != nil {
+			hLog.Error("failed-to-update-team-caches", err, lager.Data{"teamName": teamName})
+
+			if _, ok := err.(db.NamedCacheDeclaredError); ok {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			}
+
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 	} else if acc.IsAdmin() {
@@ -89,3 +120,14 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 	}
 
 }
+
+// canChangeNetwork returns whether a team member who is not an admin may
+// change the team's network config: only admins may lock it, or change it
+// once it is locked.
+func canChangeNetwork(current *atc.TeamNetworkConfig, desired *atc.TeamNetworkConfig) bool {
+	if current != nil && current.Locked {
+		return current.Locked == desired.Locked && current.Default.Equal(desired.Default)
+	}
+
+	return !desired.Locked
+}
@@ -515,6 +515,64 @@ var _ = Describe("Volumes API", func() {
 					Expect(workerName).To(Equal("some-worker-name"))
 					Expect(handles).To(Equal([]string{"handle1", "handle2"}))
 				})
+
+				It("does not update the disk usage", func() {
+					_, err = client.Do(req)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeVolumeRepository.UpdateVolumesDiskUsageCallCount()).To(BeZero())
+				})
+			})
+
+			Context("querying with worker name and disk usage", func() {
+				var diskUsed string
+
+				BeforeEach(func() {
+					diskUsed = "42"
+				})
+
+				JustBeforeEach(func() {
+					req.URL.RawQuery = url.Values{
+						"worker_name":   []string{"some-worker-name"},
+						"disk_used":     []string{diskUsed},
+						"disk_capacity": []string{"100"},
+					}.Encode()
+				})
+
+				It("updates the worker's disk usage", func() {
+					response, err = client.Do(req)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					Expect(fakeVolumeRepository.UpdateVolumesDiskUsageCallCount()).To(Equal(1))
+					workerName, usage := fakeVolumeRepository.UpdateVolumesDiskUsageArgsForCall(0)
+					Expect(workerName).To(Equal("some-worker-name"))
+					Expect(usage).To(Equal(atc.VolumesDiskUsage{Used: 42, Capacity: 100}))
+				})
+
+				Context("when the disk usage is malformed", func() {
+					BeforeEach(func() {
+						diskUsed = "lots"
+					})
+
+					It("returns 400", func() {
+						response, err = client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeDestroyer.DestroyVolumesCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when updating the disk usage fails", func() {
+					BeforeEach(func() {
+						fakeVolumeRepository.UpdateVolumesDiskUsageReturns(errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						response, err = client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
 			})
 		})
 	})
@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/metric"
 
 	"code.cloudfoundry.org/lager"
@@ -23,6 +25,27 @@ func (s *Server) ReportWorkerVolumes(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// the disk usage is optional, as workers which cannot measure it (or
+	// were registered through an older TSA) do not report it
+	var usage *atc.VolumesDiskUsage
+	if r.URL.Query().Get("disk_capacity") != "" {
+		used, err := strconv.ParseUint(r.URL.Query().Get("disk_used"), 10, 64)
+		if err != nil {
+			logger.Error("malformed-disk-used", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		capacity, err := strconv.ParseUint(r.URL.Query().Get("disk_capacity"), 10, 64)
+		if err != nil {
+			logger.Error("malformed-disk-capacity", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		usage = &atc.VolumesDiskUsage{Used: used, Capacity: capacity}
+	}
+
 	defer r.Body.Close()
 
 	data, err := ioutil.ReadAll(r.Body)
@@ -76,5 +99,20 @@ func (s *Server) ReportWorkerVolumes(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if usage != nil {
+		err = s.repository.UpdateVolumesDiskUsage(workerName, *usage)
+		if err != nil {
+			logger.Error("failed-to-update-volumes-disk-usage", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		metric.WorkerVolumesDiskUsage{
+			WorkerName: workerName,
+			Used:       usage.Used,
+			Capacity:   usage.Capacity,
+		}.Emit(logger)
+	}
+
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -39,9 +39,11 @@ import (
 	"github.com/concourse/concourse/atc/db/migration"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/engine/builder"
+	"github.com/concourse/concourse/atc/eventstore"
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/lidar"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/prefetch"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/scheduler/algorithm"
@@ -141,17 +143,27 @@ type RunCommand struct {
 
 	InterceptIdleTimeout time.Duration `long:"intercept-idle-timeout" default:"0m" description:"Length of time for a intercepted session to be idle before terminating."`
 
+	BreakpointTimeout time.Duration `long:"breakpoint-timeout" default:"1h" description:"Length of time for a build to stay paused at a breakpoint before it is resumed on its own. 0 means no limit."`
+
 	EnableGlobalResources bool `long:"enable-global-resources" description:"Enable equivalent resources across pipelines and teams to share a single version history."`
 
 	ComponentRunnerInterval time.Duration `long:"component-runner-interval" default:"10s" description:"Interval on which runners are kicked off for builds, locks, scans, and checks"`
 
 	LidarScannerInterval time.Duration `long:"lidar-scanner-interval" default:"10s" description:"Interval on which the resource scanner will run to see if new checks need to be scheduled"`
 	LidarCheckerInterval time.Duration `long:"lidar-checker-interval" default:"10s" description:"Interval on which the resource checker runs any scheduled checks"`
+	LidarScannerShards   int           `long:"lidar-scanner-shards" default:"1" description:"Number of shards to split resource scanning into. Each shard is locked separately, so shards can be scanned by different web nodes in parallel."`
+
+	PrefetchInterval time.Duration `long:"prefetch-interval" default:"1m" description:"Interval on which the latest versions of task images configured with 'prefetch: true' are checked for and fetched onto workers. Resources configured with 'prefetch: true' are also fetched as soon as new versions are found."`
+	PrefetchWorkers  int           `long:"prefetch-workers" default:"1" description:"Number of workers to fetch the latest version of a resource or task image configured with 'prefetch: true' onto. 0 disables prefetching."`
 
-	GlobalResourceCheckTimeout          time.Duration `long:"global-resource-check-timeout" default:"1h" description:"Time limit on checking for new versions of resources."`
-	ResourceCheckingInterval            time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
-	ResourceWithWebhookCheckingInterval time.Duration `long:"resource-with-webhook-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources that has webhook defined."`
-	MaxChecksPerSecond                  int           `long:"max-checks-per-second" description:"Maximum number of checks that can run in one second. If not specified, this will be calculated as (# of resources)/(resource checking interval). -1 value will remove this maximum limit of checks per second."`
+	GlobalResourceCheckTimeout          time.Duration      `long:"global-resource-check-timeout" default:"1h" description:"Time limit on checking for new versions of resources."`
+	ResourceCheckingInterval            time.Duration      `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
+	ResourceWithWebhookCheckingInterval time.Duration      `long:"resource-with-webhook-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources that has webhook defined."`
+	ResourceCheckingMaxBackoff          time.Duration      `long:"resource-checking-max-backoff" default:"1h" description:"Maximum interval to back off to when checks for a resource keep failing. The checking interval doubles with every consecutive failure. 0 disables backing off."`
+	MaxChecksPerSecond                  int                `long:"max-checks-per-second" description:"Maximum number of checks that can run in one second. If not specified, this will be calculated as (# of resources)/(resource checking interval). -1 value will remove this maximum limit of checks per second."`
+	ResourceTypeMaxChecksPerSecond      map[string]float64 `long:"resource-type-max-checks-per-second" value-name:"TYPE:RATE" description:"Maximum number of checks of the given resource type that can run in one second, on top of --max-checks-per-second. Can be specified multiple times."`
+	HostMaxChecksPerSecond              map[string]float64 `long:"host-max-checks-per-second" value-name:"HOST:RATE" description:"Maximum number of checks that can run in one second for resources whose source uri or url points at the given host, on top of --max-checks-per-second. Can be specified multiple times."`
+	CheckRateLimitBurst                 int                `long:"check-rate-limit-burst" default:"1" description:"Number of checks that may run at once before the limits of --resource-type-max-checks-per-second and --host-max-checks-per-second take effect."`
 
 	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" description:"Method by which a worker is selected during container placement."`
 	MaxActiveTasksPerWorker           int           `long:"max-active-tasks-per-worker" default:"0" description:"Maximum allowed number of active build tasks per worker. Has effect only when used with limit-active-tasks placement strategy. 0 means no limit."`
@@ -189,10 +201,21 @@ type RunCommand struct {
 		HijackGracePeriod      time.Duration `long:"hijack-grace-period" default:"5m" description:"Period after which hijacked containers will be garbage collected"`
 		FailedGracePeriod      time.Duration `long:"failed-grace-period" default:"120h" description:"Period after which failed containers will be garbage collected"`
 		CheckRecyclePeriod     time.Duration `long:"check-recycle-period" default:"1m" description:"Period after which to reap checks that are completed."`
+
+		BuildLifecycleEventRecyclePeriod time.Duration `long:"build-lifecycle-event-recycle-period" default:"24h" description:"Period after which to reap build lifecycle events. Firehose clients can only resume streams within this period."`
+
+		WorkerDiskUsageThreshold     int `long:"worker-disk-usage-threshold" default:"90" description:"Percentage of the disk holding a worker's volumes above which its least recently used caches are evicted. 0 disables eviction."`
+		WorkerCacheEvictionBatchSize int `long:"worker-cache-eviction-batch-size" default:"10" description:"Maximum number of caches to evict from a worker on each garbage collection run."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
 
+	BuildEventStore struct {
+		Backend string              `long:"backend" default:"postgres" choice:"postgres" choice:"filesystem" choice:"s3" description:"Where to keep the events of completed builds. Events of running builds are always kept in Postgres, and are moved to the filesystem or S3 backends once the build completes."`
+		Dir     string              `long:"dir" description:"Directory to keep the compressed events of completed builds in, when using the filesystem backend. Must be shared by all web nodes."`
+		S3      eventstore.S3Config `namespace:"s3"`
+	} `group:"Build Event Storage" namespace:"build-event-store"`
+
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
 
 	DefaultBuildLogsToRetain uint64 `long:"default-build-logs-to-retain" description:"Default build logs to retain, 0 means all"`
@@ -203,8 +226,10 @@ type RunCommand struct {
 
 	JobSchedulingMaxInFlight uint64 `long:"job-scheduling-max-in-flight" default:"32" description:"Maximum number of jobs to be scheduling at the same time"`
 
-	DefaultCpuLimit    *int    `long:"default-task-cpu-limit" description:"Default max number of cpu shares per task, 0 means unlimited"`
-	DefaultMemoryLimit *string `long:"default-task-memory-limit" description:"Default maximum memory per task, 0 means unlimited"`
+	DefaultCpuLimit       *int    `long:"default-task-cpu-limit" description:"Default max number of cpu shares per task, 0 means unlimited"`
+	DefaultMemoryLimit    *string `long:"default-task-memory-limit" description:"Default maximum memory per task, 0 means unlimited"`
+	DefaultPidsLimit      *int    `long:"default-task-pids-limit" description:"Default maximum number of processes and threads per task, 0 means unlimited"`
+	DefaultOpenFilesLimit *int    `long:"default-task-open-files-limit" description:"Default maximum number of files each task may have open at once, 0 means the worker's default"`
 
 	Auditor struct {
 		EnableBuildAuditLog     bool `long:"enable-build-auditing" description:"Enable auditing for all api requests connected to builds."`
@@ -587,6 +612,9 @@ func (cmd *RunCommand) constructMembers(
 				Interval:  cmd.ComponentRunnerInterval,
 				Component: dbComponent,
 				Bus:       bus,
+
+				Notification: c.Notification,
+
 				Schedulable: &component.Coordinator{
 					Locker:    lockFactory,
 					Component: dbComponent,
@@ -957,21 +985,37 @@ func (cmd *RunCommand) backendComponents(
 		cmd.ResourceWithWebhookCheckingInterval = cmd.ResourceCheckingInterval
 	}
 
-	components := []RunnableComponent{
-		{
+	var components []RunnableComponent
+
+	// every shard of the scanner is its own component, and thus has its own
+	// lock, so that shards can be scanned by different ATCs at the same time.
+	// the first shard keeps the name of the unsharded scanner.
+	for i := 0; i < cmd.LidarScannerShards; i++ {
+		name := atc.ComponentLidarScanner
+		if i > 0 {
+			name = fmt.Sprintf("%s-%d", atc.ComponentLidarScanner, i)
+		}
+
+		components = append(components, RunnableComponent{
 			Component: atc.Component{
-				Name:     atc.ComponentLidarScanner,
+				Name:     name,
 				Interval: cmd.LidarScannerInterval,
 			},
+			Notification: atc.ComponentLidarScanner,
 			Runnable: lidar.NewScanner(
-				logger.Session(atc.ComponentLidarScanner),
+				logger.Session(name),
 				dbCheckFactory,
 				secretManager,
 				cmd.GlobalResourceCheckTimeout,
 				cmd.ResourceCheckingInterval,
 				cmd.ResourceWithWebhookCheckingInterval,
+				cmd.ResourceCheckingMaxBackoff,
+				lidar.Shard{Index: i, Count: cmd.LidarScannerShards},
 			),
-		},
+		})
+	}
+
+	components = append(components, []RunnableComponent{
 		{
 			Component: atc.Component{
 				Name:     atc.ComponentLidarChecker,
@@ -986,6 +1030,11 @@ func (cmd *RunCommand) backendComponents(
 					ResourceCheckingInterval: cmd.ResourceCheckingInterval,
 					CheckableCounter:         dbCheckableCounter,
 				},
+				lidar.CheckRateLimits{
+					ResourceTypes: cmd.ResourceTypeMaxChecksPerSecond,
+					Hosts:         cmd.HostMaxChecksPerSecond,
+					Burst:         cmd.CheckRateLimitBurst,
+				},
 			),
 		},
 		{
@@ -1007,6 +1056,24 @@ func (cmd *RunCommand) backendComponents(
 				cmd.JobSchedulingMaxInFlight,
 			),
 		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentPrefetcher,
+				Interval: cmd.PrefetchInterval,
+			},
+			Runnable: prefetch.NewPrefetcher(
+				logger.Session(atc.ComponentPrefetcher),
+				dbCheckFactory,
+				dbJobFactory,
+				dbResourceConfigFactory,
+				dbResourceCacheFactory,
+				resourceFactory,
+				secretManager,
+				cmd.varSourcePool,
+				workerClient,
+				cmd.PrefetchWorkers,
+			),
+		},
 		{
 			Component: atc.Component{
 				Name:     atc.ComponentBuildTracker,
@@ -1031,7 +1098,7 @@ func (cmd *RunCommand) backendComponents(
 				syslogDrainConfigured,
 			),
 		},
-	}
+	}...)
 
 	if syslogDrainConfigured {
 		components = append(components, RunnableComponent{
@@ -1062,6 +1129,7 @@ func (cmd *RunCommand) gcComponents(
 	dbContainerRepository := db.NewContainerRepository(gcConn)
 	dbArtifactLifecycle := db.NewArtifactLifecycle(gcConn)
 	dbCheckLifecycle := db.NewCheckLifecycle(gcConn)
+	dbWorkerCacheLifecycle := db.NewWorkerCacheLifecycle(gcConn)
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(gcConn)
 	dbBuildFactory := db.NewBuildFactory(gcConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod)
 	dbResourceConfigFactory := db.NewResourceConfigFactory(gcConn, lockFactory)
@@ -1069,7 +1137,7 @@ func (cmd *RunCommand) gcComponents(
 	dbVolumeRepository := db.NewVolumeRepository(gcConn)
 
 	collectors := map[string]component.Runnable{
-		atc.ComponentCollectorBuilds:            gc.NewBuildCollector(dbBuildFactory),
+		atc.ComponentCollectorBuilds:            gc.NewBuildCollector(dbBuildFactory, cmd.GC.BuildLifecycleEventRecyclePeriod),
 		atc.ComponentCollectorWorkers:           gc.NewWorkerCollector(dbWorkerLifecycle),
 		atc.ComponentCollectorResourceConfigs:   gc.NewResourceConfigCollector(dbResourceConfigFactory),
 		atc.ComponentCollectorResourceCaches:    gc.NewResourceCacheCollector(dbResourceCacheLifecycle),
@@ -1079,6 +1147,11 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorVolumes:           gc.NewVolumeCollector(dbVolumeRepository, cmd.GC.MissingGracePeriod),
 		atc.ComponentCollectorContainers:        gc.NewContainerCollector(dbContainerRepository, cmd.GC.MissingGracePeriod, cmd.GC.HijackGracePeriod),
 		atc.ComponentCollectorCheckSessions:     gc.NewResourceConfigCheckSessionCollector(resourceConfigCheckSessionLifecycle),
+		atc.ComponentCollectorWorkerCaches:      gc.NewWorkerCacheCollector(dbWorkerCacheLifecycle, cmd.GC.WorkerDiskUsageThreshold, cmd.GC.WorkerCacheEvictionBatchSize),
+	}
+
+	if cmd.BuildEventStore.Backend != "postgres" {
+		collectors[atc.ComponentCollectorBuildEvents] = gc.NewBuildEventCollector(db.NewBuildEventLifecycle(gcConn, lockFactory), 100)
 	}
 
 	var components []RunnableComponent
@@ -1318,8 +1391,10 @@ func (cmd *RunCommand) tlsConfig(logger lager.Logger, dbConn db.Conn) (*tls.Conf
 
 func (cmd *RunCommand) parseDefaultLimits() (atc.ContainerLimits, error) {
 	return atc.ParseContainerLimits(map[string]interface{}{
-		"cpu":    cmd.DefaultCpuLimit,
-		"memory": cmd.DefaultMemoryLimit,
+		"cpu":        cmd.DefaultCpuLimit,
+		"memory":     cmd.DefaultMemoryLimit,
+		"pids":       cmd.DefaultPidsLimit,
+		"open_files": cmd.DefaultOpenFilesLimit,
 	})
 }
 
@@ -1396,6 +1471,30 @@ func (cmd *RunCommand) validate() error {
 		)
 	}
 
+	switch cmd.BuildEventStore.Backend {
+	case "filesystem":
+		if cmd.BuildEventStore.Dir == "" {
+			errs = multierror.Append(
+				errs,
+				errors.New("must specify --build-event-store-dir to use the filesystem build event store"),
+			)
+		}
+	case "s3":
+		if cmd.BuildEventStore.S3.Bucket == "" {
+			errs = multierror.Append(
+				errs,
+				errors.New("must specify --build-event-store-s3-bucket to use the s3 build event store"),
+			)
+		}
+	}
+
+	if cmd.LidarScannerShards < 1 {
+		errs = multierror.Append(
+			errs,
+			errors.New("--lidar-scanner-shards must be at least 1"),
+		)
+	}
+
 	if err := cmd.validateCustomRoles(); err != nil {
 		errs = multierror.Append(errs, err)
 	}
@@ -1436,6 +1535,13 @@ func (cmd *RunCommand) constructDBConn(
 		return nil, fmt.Errorf("failed to migrate database: %s", err)
 	}
 
+	eventStore, err := cmd.constructEventStore()
+	if err != nil {
+		return nil, err
+	}
+
+	dbConn = db.WithEventStore(dbConn, eventStore)
+
 	// Instrument with Metrics
 	dbConn = metric.CountQueries(dbConn)
 	metric.Databases = append(metric.Databases, dbConn)
@@ -1452,6 +1558,22 @@ func (cmd *RunCommand) constructDBConn(
 	return dbConn, nil
 }
 
+func (cmd *RunCommand) constructEventStore() (db.EventStore, error) {
+	switch cmd.BuildEventStore.Backend {
+	case "filesystem":
+		return db.NewOffloadingEventStore(eventstore.NewFilesystem(cmd.BuildEventStore.Dir)), nil
+	case "s3":
+		s3, err := eventstore.NewS3(cmd.BuildEventStore.S3)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure s3 build event store: %w", err)
+		}
+
+		return db.NewOffloadingEventStore(s3), nil
+	default:
+		return db.NewPostgresEventStore(), nil
+	}
+}
+
 type Closer interface {
 	Close() error
 }
@@ -1547,6 +1669,7 @@ func (cmd *RunCommand) constructEngine(
 		secretManager,
 		cmd.varSourcePool,
 		cmd.EnableRedactSecrets,
+		cmd.BreakpointTimeout,
 	)
 
 	return engine.NewEngine(stepBuilder)
@@ -1860,4 +1983,8 @@ func (runner drainRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) e
 type RunnableComponent struct {
 	atc.Component
 	component.Runnable
+
+	// Notification overrides the notification the component listens on to be
+	// run immediately, which defaults to its name.
+	Notification string
 }
@@ -61,8 +61,14 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RerunJobBuild,
 		atc.ListBuilds,
 		atc.BuildEvents,
+		atc.GetBuildLog,
+		atc.Firehose,
+		atc.TeamFirehose,
 		atc.BuildResources,
 		atc.AbortBuild,
+		atc.HoldBuild,
+		atc.ReleaseBuild,
+		atc.ResumeBuild,
 		atc.GetBuildPreparation,
 		atc.ListBuildsWithVersionAsInput,
 		atc.ListBuildsWithVersionAsOutput,
@@ -73,6 +79,9 @@ func (a *auditor) ValidateAction(action string) bool {
 	case atc.ListContainers,
 		atc.GetContainer,
 		atc.HijackContainer,
+		atc.StreamOutContainer,
+		atc.StreamInContainer,
+		atc.PortForwardContainer,
 		atc.ListDestroyingContainers,
 		atc.ReportWorkerContainers:
 		return a.EnableContainerAuditLog
@@ -87,7 +96,8 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.UnpauseJob,
 		atc.ScheduleJob,
 		atc.JobBadge,
-		atc.MainJobBadge:
+		atc.MainJobBadge,
+		atc.GetJobStats:
 		return a.EnableJobAuditLog
 	case atc.ListAllPipelines,
 		atc.ListPipelines,
@@ -113,7 +123,9 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.CheckResource,
 		atc.CheckResourceWebHook,
 		atc.CheckResourceType,
+		atc.ListResourceWebhookDeliveries,
 		atc.ListResourceVersions,
+		atc.SaveResourceVersions,
 		atc.GetResourceVersion,
 		atc.EnableResourceVersion,
 		atc.DisableResourceVersion,
@@ -143,6 +155,8 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
+		atc.SearchBuildLogs,
+		atc.ClearNamedCache,
 		atc.GetTeam:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
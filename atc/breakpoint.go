@@ -0,0 +1,46 @@
+package atc
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Breakpoint says whether the build pauses before or after a step.
+type Breakpoint string
+
+const (
+	BreakpointBefore Breakpoint = "before"
+	BreakpointAfter  Breakpoint = "after"
+)
+
+// UnmarshalJSON accepts 'before' or 'after', as well as 'true', which means
+// 'after', and 'false', which means no breakpoint.
+func (b *Breakpoint) UnmarshalJSON(payload []byte) error {
+	var data interface{}
+	err := json.Unmarshal(payload, &data)
+	if err != nil {
+		return err
+	}
+
+	switch actual := data.(type) {
+	case bool:
+		if actual {
+			*b = BreakpointAfter
+		} else {
+			*b = ""
+		}
+	case string:
+		switch Breakpoint(actual) {
+		case BreakpointBefore, BreakpointAfter, "":
+			*b = Breakpoint(actual)
+		default:
+			return fmt.Errorf("invalid breakpoint '%s': must be 'before' or 'after'", actual)
+		}
+	case nil:
+		*b = ""
+	default:
+		return fmt.Errorf("invalid breakpoint: must be 'before', 'after' or a boolean")
+	}
+
+	return nil
+}
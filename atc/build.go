@@ -11,6 +11,18 @@ const (
 	StatusAborted   BuildStatus = "aborted"
 )
 
+// RerunBuildQueryFromStep names the step a build is rerun from. The steps
+// before it which succeeded are reused rather than run again.
+const RerunBuildQueryFromStep = "from_step"
+
+// CreateBuildQueryBreakAt names a step the build pauses after, as if it were
+// configured with 'breakpoint: after'. It may be given more than once.
+const CreateBuildQueryBreakAt = "break_at"
+
+// CreateBuildQueryBreakBefore names a step the build pauses before, as if it
+// were configured with 'breakpoint: before'. It may be given more than once.
+const CreateBuildQueryBreakBefore = "break_before"
+
 type Build struct {
 	ID           int           `json:"id"`
 	TeamName     string        `json:"team_name"`
@@ -24,6 +36,13 @@ type Build struct {
 	ReapTime     int64         `json:"reap_time,omitempty"`
 	RerunNumber  int           `json:"rerun_number,omitempty"`
 	RerunOf      *RerunOfBuild `json:"rerun_of,omitempty"`
+	CreatedBy    string        `json:"created_by,omitempty"`
+	AbortedBy    string        `json:"aborted_by,omitempty"`
+	Held         bool          `json:"held,omitempty"`
+
+	PausedAtBreakpoint bool `json:"paused_at_breakpoint,omitempty"`
+
+	RerunFromStep string `json:"rerun_from_step,omitempty"`
 }
 
 type RerunOfBuild struct {
@@ -0,0 +1,11 @@
+package atc
+
+// Query params for narrowing down build lists. Status may be given multiple
+// times to match builds with any of the statuses.
+const (
+	BuildsQueryStatus            = "status"
+	BuildsQueryPipeline          = "pipeline_name"
+	BuildsQueryJob               = "job_name"
+	BuildsQueryManuallyTriggered = "manually_triggered"
+	BuildsQueryCreatedBy         = "created_by"
+)
@@ -0,0 +1,28 @@
+package atc
+
+const FirehoseQueryLastEventID = "last_event_id"
+
+type BuildLifecycleEventType string
+
+const (
+	BuildCreated  BuildLifecycleEventType = "created"
+	BuildStarted  BuildLifecycleEventType = "started"
+	BuildFinished BuildLifecycleEventType = "finished"
+
+	// the build's status changed without it starting or finishing
+	BuildStatusChanged BuildLifecycleEventType = "status"
+)
+
+// BuildLifecycleEvent is emitted by the firehose whenever a build is created
+// or its status changes. Status is the build's status as of the event.
+type BuildLifecycleEvent struct {
+	ID           int64                   `json:"id"`
+	Type         BuildLifecycleEventType `json:"type"`
+	Status       BuildStatus             `json:"status"`
+	Time         int64                   `json:"time"`
+	BuildID      int                     `json:"build_id"`
+	BuildName    string                  `json:"build_name"`
+	TeamName     string                  `json:"team_name"`
+	PipelineName string                  `json:"pipeline_name,omitempty"`
+	JobName      string                  `json:"job_name,omitempty"`
+}
@@ -0,0 +1,38 @@
+package atc
+
+const (
+	BuildLogQueryFormat     = "format"
+	BuildLogQueryTimestamps = "timestamps"
+	BuildLogQuerySteps      = "steps"
+)
+
+type BuildLogFormat string
+
+const (
+	// BuildLogFormatText renders a build's log as plain text, the way it is
+	// shown by fly.
+	BuildLogFormatText BuildLogFormat = "text"
+
+	// BuildLogFormatJSONLines renders a build's log as one BuildLogLine per
+	// line.
+	BuildLogFormatJSONLines BuildLogFormat = "jsonl"
+)
+
+type BuildLogLineType string
+
+const (
+	BuildLogLineTypeLog    BuildLogLineType = "log"
+	BuildLogLineTypeError  BuildLogLineType = "error"
+	BuildLogLineTypeStatus BuildLogLineType = "status"
+)
+
+// BuildLogLine is a line of a build's log along with where it came from. For
+// lines of type "status", the message is the build's final status.
+type BuildLogLine struct {
+	Type    BuildLogLineType `json:"type"`
+	Time    int64            `json:"time,omitempty"`
+	Origin  string           `json:"origin,omitempty"`
+	Source  string           `json:"source,omitempty"`
+	Step    string           `json:"step,omitempty"`
+	Message string           `json:"message"`
+}
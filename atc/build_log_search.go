@@ -0,0 +1,24 @@
+package atc
+
+const (
+	SearchBuildLogsQueryText     = "q"
+	SearchBuildLogsQueryPipeline = "pipeline_name"
+	SearchBuildLogsQueryJob      = "job_name"
+	SearchBuildLogsQuerySince    = "since"
+	SearchBuildLogsQueryUntil    = "until"
+	SearchBuildLogsQueryLimit    = "limit"
+
+	SearchBuildLogsDefaultLimit = 100
+)
+
+// BuildLogMatch is a build whose logs matched a search, along with the
+// matching lines in the order they were logged.
+type BuildLogMatch struct {
+	BuildID      int      `json:"build_id"`
+	BuildName    string   `json:"build_name"`
+	TeamName     string   `json:"team_name"`
+	PipelineName string   `json:"pipeline_name,omitempty"`
+	JobName      string   `json:"job_name,omitempty"`
+	StartTime    int64    `json:"start_time,omitempty"`
+	Lines        []string `json:"lines"`
+}
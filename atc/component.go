@@ -8,9 +8,11 @@ const (
 	ComponentLidarScanner               = "scanner"
 	ComponentLidarChecker               = "checker"
 	ComponentBuildReaper                = "reaper"
+	ComponentPrefetcher                 = "prefetcher"
 	ComponentSyslogDrainer              = "drainer"
 	ComponentCollectorArtifacts         = "collector_artifacts"
 	ComponentCollectorBuilds            = "collector_builds"
+	ComponentCollectorBuildEvents       = "collector_build_events"
 	ComponentCollectorCheckSessions     = "collector_check_sessions"
 	ComponentCollectorChecks            = "collector_checks"
 	ComponentCollectorContainers        = "collector_containers"
@@ -18,6 +20,7 @@ const (
 	ComponentCollectorResourceCaches    = "collector_resource_caches"
 	ComponentCollectorResourceConfigs   = "collector_resource_configs"
 	ComponentCollectorVolumes           = "collector_volumes"
+	ComponentCollectorWorkerCaches      = "collector_worker_caches"
 	ComponentCollectorWorkers           = "collector_workers"
 )
 
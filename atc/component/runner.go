@@ -33,6 +33,10 @@ type Runner struct {
 	Component Component
 	Bus       NotificationsBus
 
+	// Notification is the name of the notification that causes the workload
+	// to run immediately. It defaults to the name of the component.
+	Notification string
+
 	Schedulable Schedulable
 }
 
@@ -40,12 +44,17 @@ func (scheduler *Runner) Run(signals <-chan os.Signal, ready chan<- struct{}) er
 	scheduler.Logger.Debug("start")
 	defer scheduler.Logger.Debug("done")
 
-	notifier, err := scheduler.Bus.Listen(scheduler.Component.Name())
+	notification := scheduler.Notification
+	if notification == "" {
+		notification = scheduler.Component.Name()
+	}
+
+	notifier, err := scheduler.Bus.Listen(notification)
 	if err != nil {
 		return err
 	}
 
-	defer scheduler.Bus.Unlisten(scheduler.Component.Name(), notifier)
+	defer scheduler.Bus.Unlisten(notification, notifier)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	go func() {
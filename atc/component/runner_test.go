@@ -144,6 +144,57 @@ func (s *RunnerSuite) TestEndToEnd() {
 	})
 }
 
+func (s *RunnerSuite) TestNotification() {
+	interval := 30 * time.Second
+	componentName := "some-component-1"
+	notificationName := "some-component"
+
+	mockComponent := new(cmocks.Component)
+	mockComponent.On("Name").Return(componentName)
+	mockComponent.On("Interval").Return(interval)
+
+	mockBus := new(cmocks.NotificationsBus)
+
+	ranImmediately := make(chan context.Context)
+
+	mockSchedulable := schedulable{
+		runPeriodically: func(ctx context.Context) {},
+		runImmediately: func(ctx context.Context) {
+			ranImmediately <- ctx
+		},
+	}
+
+	scheduler := &component.Runner{
+		Logger:       lagertest.NewTestLogger("test"),
+		Interval:     interval,
+		Component:    mockComponent,
+		Bus:          mockBus,
+		Notification: notificationName,
+		Schedulable:  mockSchedulable,
+	}
+
+	notifications := make(chan bool, 1)
+	mockBus.On("Listen", notificationName).Return(notifications, nil)
+	mockBus.On("Unlisten", notificationName, notifications).Return(nil)
+
+	process := ifrit.Background(scheduler)
+	select {
+	case <-process.Ready():
+	case err := <-process.Wait():
+		s.Failf("process exited early", "error: %s", err)
+	}
+
+	mockBus.AssertCalled(s.T(), "Listen", notificationName)
+
+	notifications <- true
+	<-ranImmediately
+
+	process.Signal(os.Interrupt)
+	s.NoError(<-process.Wait())
+
+	mockBus.AssertCalled(s.T(), "Unlisten", notificationName, notifications)
+}
+
 type schedulable struct {
 	runPeriodically func(context.Context)
 	runImmediately  func(context.Context)
@@ -19,11 +19,12 @@ const DefaultTeamName = "main"
 type Tags []string
 
 type Config struct {
-	Groups        GroupConfigs     `json:"groups,omitempty"`
-	VarSources    VarSourceConfigs `json:"var_sources,omitempty"`
-	Resources     ResourceConfigs  `json:"resources,omitempty"`
-	ResourceTypes ResourceTypes    `json:"resource_types,omitempty"`
-	Jobs          JobConfigs       `json:"jobs,omitempty"`
+	Groups        GroupConfigs      `json:"groups,omitempty"`
+	VarSources    VarSourceConfigs  `json:"var_sources,omitempty"`
+	Resources     ResourceConfigs   `json:"resources,omitempty"`
+	ResourceTypes ResourceTypes     `json:"resource_types,omitempty"`
+	Jobs          JobConfigs        `json:"jobs,omitempty"`
+	Caches        NamedCacheConfigs `json:"caches,omitempty"`
 }
 
 func UnmarshalConfig(payload []byte, config interface{}) error {
@@ -34,6 +35,7 @@ func UnmarshalConfig(payload []byte, config interface{}) error {
 		Resources     interface{} `json:"resources,omitempty"`
 		ResourceTypes interface{} `json:"resource_types,omitempty"`
 		Jobs          interface{} `json:"jobs,omitempty"`
+		Caches        interface{} `json:"caches,omitempty"`
 	}
 
 	var stripped skeletonConfig
@@ -89,6 +91,68 @@ func (c VarSourceConfigs) Lookup(name string) (VarSourceConfig, bool) {
 	return VarSourceConfig{}, false
 }
 
+// NamedCacheConfig declares a cache which any task in the team can mount by
+// its name, regardless of the job or pipeline it belongs to. Caches are
+// declared either by a pipeline or by the team itself.
+type NamedCacheConfig struct {
+	Name string `json:"name"`
+
+	// Cap on the size of each worker's copy of the cache, e.g. 10GB. The least
+	// recently used files are removed from a copy which has grown beyond it.
+	Size string `json:"size,omitempty"`
+}
+
+// SizeLimit returns the cap on the size of the cache in bytes, or 0 if it
+// has none.
+func (c NamedCacheConfig) SizeLimit() (uint64, error) {
+	if c.Size == "" {
+		return 0, nil
+	}
+
+	limit, err := parseSizeLimit("cache size", c.Size)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse cache size '%s'", c.Size)
+	}
+
+	return limit, nil
+}
+
+type NamedCacheConfigs []NamedCacheConfig
+
+// Validate checks that the caches have distinct names and sizes which can be
+// parsed.
+func (c NamedCacheConfigs) Validate() error {
+	names := map[string]bool{}
+	for _, cache := range c {
+		if cache.Name == "" {
+			return errors.New("cache has no name")
+		}
+
+		if names[cache.Name] {
+			return fmt.Errorf("cache '%s' is declared more than once", cache.Name)
+		}
+
+		names[cache.Name] = true
+
+		_, err := cache.SizeLimit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c NamedCacheConfigs) Lookup(name string) (NamedCacheConfig, bool) {
+	for _, cache := range c {
+		if cache.Name == name {
+			return cache, true
+		}
+	}
+
+	return NamedCacheConfig{}, false
+}
+
 type pendingVarSource struct {
 	vs   VarSourceConfig
 	deps []string
@@ -173,16 +237,21 @@ func (c VarSourceConfigs) OrderByDependency() (VarSourceConfigs, error) {
 }
 
 type ResourceConfig struct {
-	Name         string  `json:"name"`
-	Public       bool    `json:"public,omitempty"`
-	WebhookToken string  `json:"webhook_token,omitempty"`
-	Type         string  `json:"type"`
-	Source       Source  `json:"source"`
-	CheckEvery   string  `json:"check_every,omitempty"`
-	CheckTimeout string  `json:"check_timeout,omitempty"`
-	Tags         Tags    `json:"tags,omitempty"`
-	Version      Version `json:"version,omitempty"`
-	Icon         string  `json:"icon,omitempty"`
+	Name         string         `json:"name"`
+	Public       bool           `json:"public,omitempty"`
+	WebhookToken string         `json:"webhook_token,omitempty"`
+	Webhook      *WebhookConfig `json:"webhook,omitempty"`
+	Type         string         `json:"type"`
+	Source       Source         `json:"source"`
+	CheckEvery   string         `json:"check_every,omitempty"`
+	CheckTimeout string         `json:"check_timeout,omitempty"`
+	Tags         Tags           `json:"tags,omitempty"`
+	Version      Version        `json:"version,omitempty"`
+	Icon         string         `json:"icon,omitempty"`
+
+	// when set, the latest version of the resource is fetched onto workers
+	// ahead of the builds which need it
+	Prefetch bool `json:"prefetch,omitempty"`
 }
 
 type ResourceType struct {
@@ -450,6 +519,9 @@ type PlanConfig struct {
 	// used to specify an image artifact from a previous build to be used as the image for a subsequent task container
 	ImageArtifactName string `json:"image,omitempty"`
 
+	// used by Task to restrict the network access of its container
+	Network *NetworkConfig `json:"network,omitempty"`
+
 	// used by Put to specify params for the subsequent Get
 	GetParams Params `json:"get_params,omitempty"`
 
@@ -477,12 +549,29 @@ type PlanConfig struct {
 	// used on any step to interrupt the step after a given duration
 	Timeout string `json:"timeout,omitempty"`
 
+	// used on any step to pause the build before or after the step runs,
+	// until it is resumed, e.g. to hijack its container
+	Breakpoint Breakpoint `json:"breakpoint,omitempty"`
+
+	// used on any step to limit the size of its log, e.g. 10MB
+	LogLimit string `json:"log_limit,omitempty"`
+
+	// what to do once the log limit is exceeded: truncate (default) or fail
+	OnLogLimit LogLimitAction `json:"on_log_limit,omitempty"`
+
+	// used on any step to interrupt the step once it has not printed anything
+	// for a given duration
+	IdleTimeout string `json:"idle_timeout,omitempty"`
+
 	// not present in yaml
 	DependentGet string `json:"-" json:"-"`
 
 	// repeat the step up to N times, until it works
 	Attempts int `json:"attempts,omitempty"`
 
+	// used with attempts to wait in between them or to only retry errors
+	Retry *RetryConfig `json:"retry,omitempty"`
+
 	Version *VersionConfig `json:"version,omitempty"`
 
 	// name of 'load_var' step
@@ -41,6 +41,45 @@ var _ = Describe("Config", func() {
 		})
 	})
 
+	Describe("Breakpoint", func() {
+		var (
+			planConfig PlanConfig
+			err        error
+		)
+
+		unmarshal := func(payload string) {
+			planConfig = PlanConfig{}
+			err = json.Unmarshal([]byte(payload), &planConfig)
+		}
+
+		It("pauses after the step when it is true", func() {
+			unmarshal(`{"task":"some-task","breakpoint":true}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(planConfig.Breakpoint).To(Equal(BreakpointAfter))
+		})
+
+		It("does not pause when it is false", func() {
+			unmarshal(`{"task":"some-task","breakpoint":false}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(planConfig.Breakpoint).To(BeEmpty())
+		})
+
+		It("pauses before or after the step when it says so", func() {
+			unmarshal(`{"task":"some-task","breakpoint":"before"}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(planConfig.Breakpoint).To(Equal(BreakpointBefore))
+
+			unmarshal(`{"task":"some-task","breakpoint":"after"}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(planConfig.Breakpoint).To(Equal(BreakpointAfter))
+		})
+
+		It("errors when it is anything else", func() {
+			unmarshal(`{"task":"some-task","breakpoint":"during"}`)
+			Expect(err).To(MatchError("invalid breakpoint 'during': must be 'before' or 'after'"))
+		})
+	})
+
 	Describe("VarSourceConfigs.OrderByDependency", func() {
 		var (
 			varSources VarSourceConfigs
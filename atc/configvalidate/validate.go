@@ -46,6 +46,11 @@ func Validate(c Config) ([]ConfigWarning, []string) {
 		errorMessages = append(errorMessages, formatErr("variable sources", varSourcesErr))
 	}
 
+	cachesErr := validateCaches(c)
+	if cachesErr != nil {
+		errorMessages = append(errorMessages, formatErr("caches", cachesErr))
+	}
+
 	jobWarnings, jobsErr := validateJobs(c)
 	if jobsErr != nil {
 		errorMessages = append(errorMessages, formatErr("jobs", jobsErr))
@@ -140,6 +145,10 @@ func validateResources(c Config) error {
 		if resource.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		if resource.Webhook != nil {
+			errorMessages = append(errorMessages, validateWebhook(identifier, *resource.Webhook)...)
+		}
 	}
 
 	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
@@ -147,6 +156,29 @@ func validateResources(c Config) error {
 	return compositeErr(errorMessages)
 }
 
+func validateWebhook(identifier string, webhook WebhookConfig) []string {
+	var errorMessages []string
+
+	switch webhook.Type {
+	case WebhookTypeGitHub, WebhookTypeGitLab, WebhookTypeHMAC:
+	case "":
+		errorMessages = append(errorMessages, identifier+".webhook has no type")
+	default:
+		errorMessages = append(errorMessages,
+			fmt.Sprintf("%s.webhook has unknown type '%s'", identifier, webhook.Type))
+	}
+
+	if webhook.Secret == "" {
+		errorMessages = append(errorMessages, identifier+".webhook has no secret")
+	}
+
+	if webhook.Header != "" && webhook.Type != WebhookTypeHMAC {
+		errorMessages = append(errorMessages, identifier+".webhook.header is only valid for hmac webhooks")
+	}
+
+	return errorMessages
+}
+
 func validateResourceTypes(c Config) error {
 	var errorMessages []string
 
@@ -181,6 +213,40 @@ func validateResourceTypes(c Config) error {
 	return compositeErr(errorMessages)
 }
 
+func validateCaches(c Config) error {
+	var errorMessages []string
+
+	names := map[string]int{}
+
+	for i, cache := range c.Caches {
+		var identifier string
+		if cache.Name == "" {
+			identifier = fmt.Sprintf("caches[%d]", i)
+		} else {
+			identifier = fmt.Sprintf("caches.%s", cache.Name)
+		}
+
+		if other, exists := names[cache.Name]; exists {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"caches[%d] and caches[%d] have the same name ('%s')",
+					other, i, cache.Name))
+		} else if cache.Name != "" {
+			names[cache.Name] = i
+		}
+
+		if cache.Name == "" {
+			errorMessages = append(errorMessages, identifier+" has no name")
+		}
+
+		if _, err := cache.SizeLimit(); err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s %s", identifier, err))
+		}
+	}
+
+	return compositeErr(errorMessages)
+}
+
 func validateResourcesUnused(c Config) []string {
 	usedResources := usedResources(c)
 
@@ -276,6 +342,23 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 			}
 		}
 
+		if job.KeepFailedContainers != "" {
+			duration, err := time.ParseDuration(job.KeepFailedContainers)
+			if err != nil {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has invalid keep_failed_containers: %s", err),
+				)
+			} else if duration < 0 {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has negative keep_failed_containers: %s", job.KeepFailedContainers),
+				)
+			}
+		}
+
+		errorMessages = append(errorMessages, validateOutputLimits(identifier, job.LogLimit, job.OnLogLimit, job.IdleTimeout)...)
+
 		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", job.Plan())
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
@@ -421,7 +504,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		identifier = fmt.Sprintf("%s.get.%s", identifier, plan.Get)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"privileged", "config", "file"},
+			[]string{"privileged", "config", "file", "network"},
 			plan, identifier)...,
 		)
 
@@ -496,7 +579,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		identifier = fmt.Sprintf("%s.put.%s", identifier, plan.Put)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"passed", "trigger", "privileged", "config", "file"},
+			[]string{"passed", "trigger", "privileged", "config", "file", "network"},
 			plan, identifier)...,
 		)
 
@@ -552,6 +635,12 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 			}
 		}
 
+		if plan.Network != nil {
+			if err := plan.Network.Validate(); err != nil {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s.network %s", identifier, err))
+			}
+		}
+
 		errorMessages = append(errorMessages, validateInapplicableFields(
 			[]string{"resource", "passed", "trigger"},
 			plan, identifier)...,
@@ -621,11 +710,41 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		}
 	}
 
+	errorMessages = append(errorMessages, validateOutputLimits(identifier, plan.LogLimit, plan.OnLogLimit, plan.IdleTimeout)...)
+
 	if plan.Attempts < 0 {
 		subIdentifier := fmt.Sprintf("%s.attempts", identifier)
 		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
 	}
 
+	if plan.Retry != nil {
+		subIdentifier := fmt.Sprintf("%s.retry", identifier)
+
+		if plan.Attempts == 0 {
+			errorMessages = append(errorMessages, subIdentifier+" has no effect without attempts")
+		}
+
+		if plan.Retry.Backoff != "" {
+			_, err := time.ParseDuration(plan.Retry.Backoff)
+			if err != nil {
+				errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(".backoff refers to a duration that could not be parsed ('%s')", plan.Retry.Backoff))
+			}
+		}
+
+		if plan.Retry.MaxBackoff != "" {
+			_, err := time.ParseDuration(plan.Retry.MaxBackoff)
+			if err != nil {
+				errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(".max_backoff refers to a duration that could not be parsed ('%s')", plan.Retry.MaxBackoff))
+			}
+		}
+
+		switch plan.Retry.When {
+		case "", RetryWhenFailed, RetryWhenErrored:
+		default:
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(".when must be either '%s' or '%s' ('%s')", RetryWhenFailed, RetryWhenErrored, plan.Retry.When))
+		}
+	}
+
 	return warnings, errorMessages
 }
 
@@ -659,6 +778,10 @@ func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, id
 			if plan.File != "" {
 				foundInapplicableFields = append(foundInapplicableFields, field)
 			}
+		case "network":
+			if plan.Network != nil {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
 		}
 	}
 
@@ -722,3 +845,32 @@ func validateVarSources(c Config) error {
 
 	return nil
 }
+
+func validateOutputLimits(identifier string, logLimit string, onLogLimit LogLimitAction, idleTimeout string) []string {
+	var errorMessages []string
+
+	if logLimit != "" {
+		_, err := ParseLogLimit(logLimit)
+		if err != nil {
+			subIdentifier := fmt.Sprintf("%s.log_limit", identifier)
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" refers to a size that could not be parsed ('%s')", logLimit))
+		}
+	}
+
+	switch onLogLimit {
+	case "", LogLimitTruncate, LogLimitFail:
+	default:
+		subIdentifier := fmt.Sprintf("%s.on_log_limit", identifier)
+		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" must be either '%s' or '%s' ('%s')", LogLimitTruncate, LogLimitFail, onLogLimit))
+	}
+
+	if idleTimeout != "" {
+		_, err := time.ParseDuration(idleTimeout)
+		if err != nil {
+			subIdentifier := fmt.Sprintf("%s.idle_timeout", identifier)
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" refers to a duration that could not be parsed ('%s')", idleTimeout))
+		}
+	}
+
+	return errorMessages
+}
@@ -364,6 +364,64 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a resource has a webhook with an unknown type", func() {
+			BeforeEach(func() {
+				config.Resources[0].Webhook = &WebhookConfig{
+					Type:   "bitbucket",
+					Secret: "some-secret",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook has unknown type 'bitbucket'"))
+			})
+		})
+
+		Context("when a resource has a webhook without a secret", func() {
+			BeforeEach(func() {
+				config.Resources[0].Webhook = &WebhookConfig{
+					Type: WebhookTypeGitHub,
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook has no secret"))
+			})
+		})
+
+		Context("when a non-hmac webhook configures a header", func() {
+			BeforeEach(func() {
+				config.Resources[0].Webhook = &WebhookConfig{
+					Type:   WebhookTypeGitLab,
+					Secret: "some-secret",
+					Header: "X-Custom",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook.header is only valid for hmac webhooks"))
+			})
+		})
+
+		Context("when a resource has a valid hmac webhook", func() {
+			BeforeEach(func() {
+				config.Resources[0].Webhook = &WebhookConfig{
+					Type:   WebhookTypeHMAC,
+					Secret: "((webhook-secret))",
+					Header: "X-Custom-Signature",
+					Filter: map[string]string{"ref": "refs/heads/main"},
+				}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
 		Context("when two resources have the same name", func() {
 			BeforeEach(func() {
 				config.Resources = append(config.Resources, config.Resources...)
@@ -623,6 +681,44 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 
+	Describe("invalid caches", func() {
+		Context("when a cache has no name", func() {
+			BeforeEach(func() {
+				config.Caches = NamedCacheConfigs{{Size: "1GB"}}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid caches:"))
+				Expect(errorMessages[0]).To(ContainSubstring("caches[0] has no name"))
+			})
+		})
+
+		Context("when a cache has an invalid size", func() {
+			BeforeEach(func() {
+				config.Caches = NamedCacheConfigs{{Name: "go-mod", Size: "lots"}}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid caches:"))
+				Expect(errorMessages[0]).To(ContainSubstring("caches.go-mod could not parse cache size 'lots'"))
+			})
+		})
+
+		Context("when two caches have the same name", func() {
+			BeforeEach(func() {
+				config.Caches = NamedCacheConfigs{{Name: "go-mod"}, {Name: "go-mod", Size: "1GB"}}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid caches:"))
+				Expect(errorMessages[0]).To(ContainSubstring("caches[0] and caches[1] have the same name ('go-mod')"))
+			})
+		})
+	})
+
 	Describe("validating a job", func() {
 		var job JobConfig
 
@@ -646,6 +742,42 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has an invalid keep_failed_containers", func() {
+			BeforeEach(func() {
+				job.KeepFailedContainers = "forever"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has invalid keep_failed_containers"))
+			})
+		})
+
+		Context("when a job has a negative keep_failed_containers", func() {
+			BeforeEach(func() {
+				job.KeepFailedContainers = "-1h"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has negative keep_failed_containers: -1h"))
+			})
+		})
+
+		Context("when a job has a valid keep_failed_containers", func() {
+			BeforeEach(func() {
+				job.KeepFailedContainers = "24h"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
 		Context("when a job has a negative build_logs_to_retain", func() {
 			BeforeEach(func() {
 				job.BuildLogsToRetain = -1
@@ -864,6 +996,43 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a task plan has an invalid network", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, PlanConfig{
+						Task: "lol",
+						File: "task.yml",
+						Network: &NetworkConfig{
+							Mode:  NetworkModeAllowlist,
+							Allow: []string{"10.0.0.0/8", "not a host"},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.lol.network network allowlist entry 'not a host' is not a subnet, an IP address or a host name"))
+				})
+			})
+
+			Context("when a get plan restricts the network", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, PlanConfig{
+						Get:     "some-resource",
+						Network: &NetworkConfig{Mode: NetworkModeNone},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource has invalid fields specified (network)"))
+				})
+			})
+
 			Context("when a task plan has neither a config or a path set", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, PlanConfig{
@@ -925,6 +1094,26 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a task config sets a disk limit", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, PlanConfig{
+						Task: "some-task",
+						TaskConfig: &TaskConfig{
+							Platform: "linux",
+							Run:      TaskRunConfig{Path: "some-path"},
+							Limits:   &ContainerLimits{Unsupported: []string{"disk"}},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error, as it cannot be enforced", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task container_limits.disk is not supported"))
+				})
+			})
+
 			Context("when a put plan has invalid fields specified", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, PlanConfig{
@@ -1431,6 +1620,75 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a plan has an invalid log limit in a step", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, PlanConfig{
+						Get:        "some-resource",
+						LogLimit:   "lots",
+						OnLogLimit: "explode",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.log_limit refers to a size that could not be parsed ('lots')"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.on_log_limit must be either 'truncate' or 'fail' ('explode')"))
+				})
+			})
+
+			Context("when a plan has an invalid idle timeout in a step", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, PlanConfig{
+						Get:         "some-resource",
+						IdleTimeout: "nope",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.idle_timeout refers to a duration that could not be parsed ('nope')"))
+				})
+			})
+
+			Context("when a job has a valid log limit and idle timeout", func() {
+				BeforeEach(func() {
+					job.LogLimit = "10MB"
+					job.OnLogLimit = LogLimitFail
+					job.IdleTimeout = "30m"
+					job.PlanSequence = append(job.PlanSequence, PlanConfig{
+						Get: "some-resource",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a job has an invalid log limit and idle timeout", func() {
+				BeforeEach(func() {
+					job.LogLimit = "lots"
+					job.IdleTimeout = "nope"
+					job.PlanSequence = append(job.PlanSequence, PlanConfig{
+						Get: "some-resource",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.log_limit refers to a size that could not be parsed ('lots')"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.idle_timeout refers to a duration that could not be parsed ('nope')"))
+				})
+			})
+
 			Context("when a plan has an invalid step within a try", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, PlanConfig{
@@ -1465,6 +1723,65 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a retry policy is configured", func() {
+				var retry *RetryConfig
+
+				BeforeEach(func() {
+					retry = &RetryConfig{
+						Backoff:    "10s",
+						MaxBackoff: "1m",
+						When:       RetryWhenErrored,
+					}
+
+					job.PlanSequence = append(job.PlanSequence, PlanConfig{
+						Put:      "some-resource",
+						Attempts: 3,
+						Retry:    retry,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(BeEmpty())
+				})
+
+				Context("without attempts", func() {
+					BeforeEach(func() {
+						config.Jobs[len(config.Jobs)-1].PlanSequence[0].Attempts = 0
+					})
+
+					It("does return an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.retry has no effect without attempts"))
+					})
+				})
+
+				Context("with durations that cannot be parsed", func() {
+					BeforeEach(func() {
+						retry.Backoff = "bogus"
+						retry.MaxBackoff = "also-bogus"
+					})
+
+					It("does return an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.retry.backoff refers to a duration that could not be parsed ('bogus')"))
+						Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.retry.max_backoff refers to a duration that could not be parsed ('also-bogus')"))
+					})
+				})
+
+				Context("with an unknown condition", func() {
+					BeforeEach(func() {
+						retry.When = "bogus"
+					})
+
+					It("does return an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.retry.when must be either 'failed' or 'errored' ('bogus')"))
+					})
+				})
+			})
+
 			Context("when a put plan has a custom name but refers to a resource that does not exist", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, PlanConfig{
@@ -1,5 +1,17 @@
 package atc
 
+// ContainerFilesQueryPath names the path within a container which files are
+// streamed out of or into.
+const ContainerFilesQueryPath = "path"
+
+// ContainerPortForwardQueryPort names the port within a container which a
+// connection is forwarded to.
+const ContainerPortForwardQueryPort = "port"
+
+// ContainerPortForwardEOF is sent as a text message by the client of a port
+// forwarding websocket once it has nothing more to send.
+const ContainerPortForwardEOF = "eof"
+
 type Container struct {
 	ID         string `json:"id"`
 	WorkerName string `json:"worker_name"`
@@ -26,6 +38,7 @@ type Container struct {
 	WorkingDirectory string `json:"working_directory,omitempty"`
 
 	ExpiresIn string `json:"expires_in,omitempty"`
+	Held      bool   `json:"held,omitempty"`
 }
 
 const (
@@ -3,8 +3,10 @@ package atc
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -26,6 +28,9 @@ func (c *ContainerLimits) UnmarshalJSON(limit []byte) error {
 
 	c.CPU = climits.CPU
 	c.Memory = climits.Memory
+	c.Pids = climits.Pids
+	c.OpenFiles = climits.OpenFiles
+	c.Unsupported = climits.Unsupported
 
 	return nil
 }
@@ -85,19 +90,70 @@ func ParseContainerLimits(data interface{}) (ContainerLimits, error) {
 			helper := uint64(uVal)
 			c.CPU = &helper
 
+		} else if key == "pids" {
+			c.Pids, err = parseCountLimit(key, val)
+			if err != nil {
+				return ContainerLimits{}, err
+			}
+
+		} else if key == "open_files" {
+			c.OpenFiles, err = parseCountLimit(key, val)
+			if err != nil {
+				return ContainerLimits{}, err
+			}
+
+		} else {
+			// not an error here, so that configs saved before the limit was
+			// known can still be loaded; validation rejects them instead
+			c.Unsupported = append(c.Unsupported, key)
 		}
 	}
 
+	sort.Strings(c.Unsupported)
+
 	return c, nil
 }
 
+// parseCountLimit parses a limit on the number of something, e.g. processes.
+func parseCountLimit(name string, val interface{}) (*uint64, error) {
+	var count int
+
+	switch v := val.(type) {
+	case float64:
+		if v != math.Trunc(v) {
+			return nil, fmt.Errorf("%s limit must be an integer", name)
+		}
+		count = int(v)
+	case int:
+		count = v
+	case *int:
+		if v == nil {
+			return nil, nil
+		}
+		count = *v
+	default:
+		return nil, fmt.Errorf("%s limit must be an integer", name)
+	}
+
+	if count < 0 {
+		return nil, fmt.Errorf("%s limit must not be negative", name)
+	}
+
+	limit := uint64(count)
+	return &limit, nil
+}
+
 func parseMemoryLimit(limit string) (uint64, error) {
+	return parseSizeLimit("memory", limit)
+}
+
+func parseSizeLimit(name string, limit string) (uint64, error) {
 	limit = strings.ToUpper(limit)
 	var sizeRegex *regexp.Regexp = regexp.MustCompile(MemoryRegex)
 	matches := sizeRegex.FindStringSubmatch(limit)
 
 	if len(matches) > 3 || len(matches) < 1 {
-		return 0, errors.New("could not parse container memory limit")
+		return 0, fmt.Errorf("could not parse container %s limit", name)
 	}
 
 	value, err := strconv.ParseUint(matches[1], 10, 64)
@@ -46,4 +46,55 @@ var _ = Describe("ContainerLimits", func() {
 			Expect(containerLimits).To(Equal(expected))
 		})
 	})
+
+	Context("when unmarshaling pids and open_files limits", func() {
+		It("produces the correct ContainerLimits without error", func() {
+			var containerLimits ContainerLimits
+			bs := []byte(`{ pids: 1000, open_files: 4096 }`)
+			err := yaml.Unmarshal(bs, &containerLimits)
+			Expect(err).NotTo(HaveOccurred())
+
+			pids := uint64(1000)
+			openFiles := uint64(4096)
+			expected := ContainerLimits{
+				Pids:      &pids,
+				OpenFiles: &openFiles,
+			}
+
+			Expect(containerLimits).To(Equal(expected))
+		})
+
+		It("errors when the pids limit is not an integer", func() {
+			var containerLimits ContainerLimits
+			err := yaml.Unmarshal([]byte(`{ pids: many }`), &containerLimits)
+			Expect(err).To(MatchError(ContainSubstring("pids limit must be an integer")))
+		})
+
+		It("errors when the open_files limit is negative", func() {
+			var containerLimits ContainerLimits
+			err := json.Unmarshal([]byte(`{ "open_files": -1 }`), &containerLimits)
+			Expect(err).To(MatchError(ContainSubstring("open_files limit must not be negative")))
+		})
+
+		It("records limits which cannot be enforced so that they can be rejected", func() {
+			var containerLimits ContainerLimits
+			err := yaml.Unmarshal([]byte(`{ memroy: 1GB, disk: 10GB }`), &containerLimits)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(containerLimits.Unsupported).To(Equal([]string{"disk", "memroy"}))
+		})
+	})
+
+	Context("when parsing the cluster-wide defaults", func() {
+		It("treats unset limits as unlimited", func() {
+			var memory *string
+			var pids *int
+
+			limits, err := ParseContainerLimits(map[string]interface{}{
+				"memory": memory,
+				"pids":   pids,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limits).To(Equal(ContainerLimits{}))
+		})
+	})
 })
@@ -81,7 +81,14 @@ var buildsQuery = psql.Select(`
 		b.rerun_of,
 		r.name,
 		b.rerun_number,
-		b.span_context
+		b.span_context,
+		b.created_by,
+		b.aborted_by,
+		b.rerun_from_build_id,
+		b.rerun_from_step,
+		b.held,
+		b.breakpoints,
+		b.paused_at_breakpoint
 	`).
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
@@ -117,6 +124,8 @@ type Build interface {
 	EndTime() time.Time
 	ReapTime() time.Time
 	IsManuallyTriggered() bool
+	CreatedBy() string
+	AbortedBy() string
 	IsScheduled() bool
 	IsRunning() bool
 	IsCompleted() bool
@@ -124,6 +133,10 @@ type Build interface {
 	RerunOf() int
 	RerunOfName() string
 	RerunNumber() int
+	RerunFromStep() string
+	IsHeld() bool
+	Breakpoints() map[string]atc.Breakpoint
+	IsPausedAtBreakpoint() bool
 
 	Reload() (bool, error)
 
@@ -138,6 +151,7 @@ type Build interface {
 	Finish(BuildStatus) error
 
 	SetInterceptible(bool) error
+	SetHeld(bool) error
 
 	Events(uint) (EventSource, error)
 	SaveEvent(event atc.Event) error
@@ -145,6 +159,9 @@ type Build interface {
 	Artifacts() ([]WorkerArtifact, error)
 	Artifact(artifactID int) (WorkerArtifact, error)
 
+	SaveStep(BuildStep) error
+	ReusableSteps() ([]BuildStep, error)
+
 	SaveOutput(string, atc.Source, atc.VersionedResourceTypes, atc.Version, ResourceConfigMetadataFields, string, string) error
 	AdoptInputsAndPipes() ([]BuildInput, bool, error)
 	AdoptRerunInputsAndPipes() ([]BuildInput, bool, error)
@@ -153,10 +170,14 @@ type Build interface {
 	SaveImageResourceVersion(UsedResourceCache) error
 
 	Delete() (bool, error)
-	MarkAsAborted() error
+	MarkAsAborted(abortedBy string) error
 	IsAborted() bool
 	AbortNotifier() (Notifier, error)
 
+	PauseAtBreakpoint() error
+	Resume() (bool, error)
+	ResumeNotifier() (Notifier, error)
+
 	IsDrained() bool
 	SetDrained(bool) error
 
@@ -179,11 +200,16 @@ type build struct {
 	jobName string
 
 	isManuallyTriggered bool
+	createdBy           string
+	abortedBy           string
 
 	rerunOf     int
 	rerunOfName string
 	rerunNumber int
 
+	rerunFromBuildID int
+	rerunFromStep    string
+
 	schema      string
 	privatePlan atc.Plan
 	publicPlan  *json.RawMessage
@@ -196,6 +222,10 @@ type build struct {
 	drained   bool
 	aborted   bool
 	completed bool
+	held      bool
+
+	breakpoints        map[string]atc.Breakpoint
+	pausedAtBreakpoint bool
 
 	spanContext SpanContext
 }
@@ -224,6 +254,8 @@ func (b *build) JobName() string              { return b.jobName }
 func (b *build) TeamID() int                  { return b.teamID }
 func (b *build) TeamName() string             { return b.teamName }
 func (b *build) IsManuallyTriggered() bool    { return b.isManuallyTriggered }
+func (b *build) CreatedBy() string            { return b.createdBy }
+func (b *build) AbortedBy() string            { return b.abortedBy }
 func (b *build) Schema() string               { return b.schema }
 func (b *build) PrivatePlan() atc.Plan        { return b.privatePlan }
 func (b *build) PublicPlan() *json.RawMessage { return b.publicPlan }
@@ -231,19 +263,26 @@ func (b *build) HasPlan() bool                { return string(*b.publicPlan) !=
 func (b *build) IsNewerThanLastCheckOf(input Resource) bool {
 	return b.createTime.After(input.LastCheckEndTime())
 }
-func (b *build) StartTime() time.Time { return b.startTime }
-func (b *build) EndTime() time.Time   { return b.endTime }
-func (b *build) ReapTime() time.Time  { return b.reapTime }
-func (b *build) Status() BuildStatus  { return b.status }
-func (b *build) IsScheduled() bool    { return b.scheduled }
-func (b *build) IsDrained() bool      { return b.drained }
-func (b *build) IsRunning() bool      { return !b.completed }
-func (b *build) IsAborted() bool      { return b.aborted }
-func (b *build) IsCompleted() bool    { return b.completed }
-func (b *build) InputsReady() bool    { return b.inputsReady }
-func (b *build) RerunOf() int         { return b.rerunOf }
-func (b *build) RerunOfName() string  { return b.rerunOfName }
-func (b *build) RerunNumber() int     { return b.rerunNumber }
+func (b *build) StartTime() time.Time       { return b.startTime }
+func (b *build) EndTime() time.Time         { return b.endTime }
+func (b *build) ReapTime() time.Time        { return b.reapTime }
+func (b *build) Status() BuildStatus        { return b.status }
+func (b *build) IsScheduled() bool          { return b.scheduled }
+func (b *build) IsDrained() bool            { return b.drained }
+func (b *build) IsRunning() bool            { return !b.completed }
+func (b *build) IsAborted() bool            { return b.aborted }
+func (b *build) IsCompleted() bool          { return b.completed }
+func (b *build) InputsReady() bool          { return b.inputsReady }
+func (b *build) RerunOf() int               { return b.rerunOf }
+func (b *build) RerunOfName() string        { return b.rerunOfName }
+func (b *build) RerunNumber() int           { return b.rerunNumber }
+func (b *build) RerunFromStep() string      { return b.rerunFromStep }
+func (b *build) IsHeld() bool               { return b.held }
+func (b *build) IsPausedAtBreakpoint() bool { return b.pausedAtBreakpoint }
+
+func (b *build) Breakpoints() map[string]atc.Breakpoint {
+	return b.breakpoints
+}
 
 func (b *build) Reload() (bool, error) {
 	row := buildsQuery.Where(sq.Eq{"b.id": b.id}).
@@ -303,6 +342,34 @@ func (b *build) SetInterceptible(i bool) error {
 	return nil
 }
 
+// SetHeld holds or releases the build. The containers of a held build are kept
+// after it finishes until it is released, e.g. to hijack them for debugging.
+func (b *build) SetHeld(held bool) error {
+	rows, err := psql.Update("builds").
+		Set("held", held).
+		Where(sq.Eq{
+			"id": b.id,
+		}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrBuildDisappeared
+	}
+
+	b.held = held
+
+	return nil
+}
+
 func (b *build) ResourcesChecked() (bool, error) {
 	var notChecked bool
 	err := b.conn.QueryRow(`
@@ -409,6 +476,7 @@ func (b *build) Finish(status BuildStatus) error {
 		Set("status", status).
 		Set("end_time", sq.Expr("now()")).
 		Set("completed", true).
+		Set("paused_at_breakpoint", false).
 		Set("private_plan", nil).
 		Set("nonce", nil).
 		Where(sq.Eq{"id": b.id}).
@@ -574,12 +642,7 @@ func (b *build) Finish(status BuildStatus) error {
 		return err
 	}
 
-	err = b.conn.Bus().Notify(buildEventsChannel(b.id))
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return b.conn.Bus().Notify(buildEventsChannel(b.id))
 }
 
 func (b *build) SetDrained(drained bool) error {
@@ -623,10 +686,12 @@ func (b *build) Delete() (bool, error) {
 // AbortNotifier send notification in case if tracking ATC misses the first
 // notification on abort channel.
 // Setting status as aborted will also make Start() return false in case where
-// build was aborted before it was started.
-func (b *build) MarkAsAborted() error {
+// build was aborted before it was started. The user who aborted the build is
+// recorded, unless the build was already aborted.
+func (b *build) MarkAsAborted(abortedBy string) error {
 	_, err := psql.Update("builds").
 		Set("aborted", true).
+		Set("aborted_by", sq.Expr("CASE WHEN aborted THEN aborted_by ELSE ? END", nullableString(abortedBy))).
 		Where(sq.Eq{"id": b.id}).
 		RunWith(b.conn).
 		Exec()
@@ -654,6 +719,68 @@ func (b *build) AbortNotifier() (Notifier, error) {
 	})
 }
 
+// PauseAtBreakpoint marks the build as paused at a breakpoint, until it is
+// resumed.
+func (b *build) PauseAtBreakpoint() error {
+	_, err := psql.Update("builds").
+		Set("paused_at_breakpoint", true).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	b.pausedAtBreakpoint = true
+
+	return nil
+}
+
+// Resume continues the build if it is paused at a breakpoint, notifying the
+// ATC running it. It returns false if the build was not paused.
+func (b *build) Resume() (bool, error) {
+	result, err := psql.Update("builds").
+		Set("paused_at_breakpoint", false).
+		Where(sq.Eq{
+			"id":                   b.id,
+			"paused_at_breakpoint": true,
+		}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if affected == 0 {
+		return false, nil
+	}
+
+	b.pausedAtBreakpoint = false
+
+	return true, b.conn.Bus().Notify(buildResumeChannel(b.id))
+}
+
+// ResumeNotifier returns a Notifier that can be watched for when the build
+// is no longer paused at a breakpoint.
+func (b *build) ResumeNotifier() (Notifier, error) {
+	return newConditionNotifier(b.conn.Bus(), buildResumeChannel(b.id), func() (bool, error) {
+		var resumed bool
+		err := psql.Select("paused_at_breakpoint = false").
+			From("builds").
+			Where(sq.Eq{"id": b.id}).
+			RunWith(b.conn).
+			QueryRow().
+			Scan(&resumed)
+
+		return resumed, err
+	})
+}
+
 func (b *build) SaveImageResourceVersion(rc UsedResourceCache) error {
 	_, err := psql.Insert("build_image_resource_caches").
 		Columns("resource_cache_id", "build_id").
@@ -849,25 +976,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 }
 
 func (b *build) Events(from uint) (EventSource, error) {
-	notifier, err := newConditionNotifier(b.conn.Bus(), buildEventsChannel(b.id), func() (bool, error) {
-		return true, nil
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	table := fmt.Sprintf("team_build_events_%d", b.teamID)
-	if b.pipelineID != 0 {
-		table = fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
-	}
-
-	return newBuildEventSource(
-		b.id,
-		table,
-		b.conn,
-		notifier,
-		from,
-	), nil
+	return b.conn.EventStore().Events(b.conn, b, from)
 }
 
 func (b *build) SaveEvent(event atc.Event) error {
@@ -1492,10 +1601,11 @@ func buildEventSeq(buildid int) string {
 
 func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy) error {
 	var (
-		jobID, pipelineID, rerunOf, rerunNumber                             sql.NullInt64
+		jobID, pipelineID, rerunOf, rerunNumber, rerunFromBuildID           sql.NullInt64
 		schema, privatePlan, jobName, pipelineName, publicPlan, rerunOfName sql.NullString
 		createTime, startTime, endTime, reapTime                            pq.NullTime
-		nonce, spanContext                                                  sql.NullString
+		nonce, spanContext, createdBy, abortedBy, rerunFromStep             sql.NullString
+		breakpoints                                                         sql.NullString
 		drained, aborted, completed                                         bool
 		status                                                              string
 	)
@@ -1528,6 +1638,13 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&rerunOfName,
 		&rerunNumber,
 		&spanContext,
+		&createdBy,
+		&abortedBy,
+		&rerunFromBuildID,
+		&rerunFromStep,
+		&b.held,
+		&breakpoints,
+		&b.pausedAtBreakpoint,
 	)
 	if err != nil {
 		return err
@@ -1549,6 +1666,10 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.rerunOf = int(rerunOf.Int64)
 	b.rerunOfName = rerunOfName.String
 	b.rerunNumber = int(rerunNumber.Int64)
+	b.createdBy = createdBy.String
+	b.abortedBy = abortedBy.String
+	b.rerunFromBuildID = int(rerunFromBuildID.Int64)
+	b.rerunFromStep = rerunFromStep.String
 
 	var (
 		noncense      *string
@@ -1586,25 +1707,28 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		}
 	}
 
+	if breakpoints.Valid {
+		err = json.Unmarshal([]byte(breakpoints.String), &b.breakpoints)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (b *build) saveEvent(tx Tx, event atc.Event) error {
-	payload, err := json.Marshal(event)
-	if err != nil {
-		return err
-	}
+	return b.conn.EventStore().Save(tx, b, event)
+}
 
-	table := fmt.Sprintf("team_build_events_%d", b.teamID)
-	if b.pipelineID != 0 {
-		table = fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
+// nullableString stores empty strings as NULL, e.g. for builds which were not
+// created by a user.
+func nullableString(s string) interface{} {
+	if s == "" {
+		return nil
 	}
-	_, err = psql.Insert(table).
-		Columns("event_id", "build_id", "type", "version", "payload").
-		Values(sq.Expr("nextval('"+buildEventSeq(b.id)+"')"), b.id, string(event.EventType()), string(event.Version()), payload).
-		RunWith(tx).
-		Exec()
-	return err
+
+	return s
 }
 
 func createBuild(tx Tx, build *build, vals map[string]interface{}) error {
@@ -1652,6 +1776,10 @@ func buildAbortChannel(buildID int) string {
 	return fmt.Sprintf("build_abort_%d", buildID)
 }
 
+func buildResumeChannel(buildID int) string {
+	return fmt.Sprintf("build_resume_%d", buildID)
+}
+
 func latestCompletedNonRerunBuild(tx Tx, jobID int) (int, error) {
 	var latestNonRerunId int
 	err := latestCompletedBuildQuery.
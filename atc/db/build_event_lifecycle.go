@@ -0,0 +1,58 @@
+package db
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/db/lock"
+)
+
+//go:generate counterfeiter . BuildEventLifecycle
+
+// BuildEventLifecycle finalizes the events of completed builds in the
+// EventStore, and cleans up after those of deleted builds, in the background
+// so that neither holds up the builds themselves.
+type BuildEventLifecycle interface {
+	// UnfinalizedBuilds returns up to limit completed builds whose events
+	// have not been finalized yet, oldest first. Builds whose events have
+	// been reaped are left alone.
+	UnfinalizedBuilds(limit int) ([]Build, error)
+
+	// FinalizeBuildEvents finalizes the events of a completed build.
+	FinalizeBuildEvents(build Build) error
+
+	// CollectDeletedBuildEvents removes what is left of the events of
+	// deleted builds.
+	CollectDeletedBuildEvents() error
+}
+
+type buildEventLifecycle struct {
+	conn        Conn
+	lockFactory lock.LockFactory
+}
+
+func NewBuildEventLifecycle(conn Conn, lockFactory lock.LockFactory) BuildEventLifecycle {
+	return &buildEventLifecycle{
+		conn:        conn,
+		lockFactory: lockFactory,
+	}
+}
+
+func (lifecycle *buildEventLifecycle) UnfinalizedBuilds(limit int) ([]Build, error) {
+	query := buildsQuery.
+		Where(sq.Eq{
+			"b.completed":        true,
+			"b.events_offloaded": false,
+			"b.reap_time":        nil,
+		}).
+		OrderBy("b.id ASC").
+		Limit(uint64(limit))
+
+	return getBuilds(query, lifecycle.conn, lifecycle.lockFactory)
+}
+
+func (lifecycle *buildEventLifecycle) FinalizeBuildEvents(build Build) error {
+	return lifecycle.conn.EventStore().Finalize(lifecycle.conn, build)
+}
+
+func (lifecycle *buildEventLifecycle) CollectDeletedBuildEvents() error {
+	return lifecycle.conn.EventStore().Collect(lifecycle.conn)
+}
@@ -19,8 +19,10 @@ type BuildFactory interface {
 	PublicBuilds(Page) ([]Build, Pagination, error)
 	GetAllStartedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
+	BuildLifecycleEvents(after int64) (BuildLifecycleEventSource, error)
 	// TODO: move to BuildLifecycle, new interface (see WorkerLifecycle)
 	MarkNonInterceptibleBuilds() error
+	RemoveExpiredBuildLifecycleEvents(time.Duration) error
 }
 
 type buildFactory struct {
@@ -57,12 +59,20 @@ func (f *buildFactory) Build(buildID int) (Build, bool, error) {
 	return build, true, nil
 }
 
+// VisibleBuilds returns the builds of the given teams and of public
+// pipelines. Who created a build is only shown to its team, so only the
+// builds of the given teams are returned when filtering by it.
 func (f *buildFactory) VisibleBuilds(teamNames []string, page Page) ([]Build, Pagination, error) {
-	newBuildsQuery := buildsQuery.
-		Where(sq.Or{
-			sq.Eq{"p.public": true},
-			sq.Eq{"t.name": teamNames},
-		})
+	var visible sq.Sqlizer = sq.Or{
+		sq.Eq{"p.public": true},
+		sq.Eq{"t.name": teamNames},
+	}
+
+	if page.Filter.CreatedBy != "" {
+		visible = sq.Eq{"t.name": teamNames}
+	}
+
+	newBuildsQuery := buildsQuery.Where(visible)
 
 	if page.UseDate {
 		return getBuildsWithDates(newBuildsQuery, minMaxIdQuery, page, f.conn,
@@ -81,7 +91,13 @@ func (f *buildFactory) AllBuilds(page Page) ([]Build, Pagination, error) {
 		page, f.conn, f.lockFactory)
 }
 
+// PublicBuilds returns the builds of public pipelines. As who created a build
+// is not public, filtering by it matches no builds.
 func (f *buildFactory) PublicBuilds(page Page) ([]Build, Pagination, error) {
+	if page.Filter.CreatedBy != "" {
+		return []Build{}, Pagination{}, nil
+	}
+
 	return getBuildsWithPagination(
 		buildsQuery.Where(sq.Eq{"p.public": true}), minMaxIdQuery,
 		page, f.conn, f.lockFactory)
@@ -104,6 +120,14 @@ func (f *buildFactory) MarkNonInterceptibleBuilds() error {
 	return err
 }
 
+func (f *buildFactory) RemoveExpiredBuildLifecycleEvents(recyclePeriod time.Duration) error {
+	_, err := psql.Delete("build_lifecycle_events").
+		Where(sq.Expr(fmt.Sprintf("now() - time > '%d seconds'::interval", int(recyclePeriod.Seconds())))).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
 func (f *buildFactory) constructBuildFilter() sq.Or {
 	buildFilter := sq.Or{
 		sq.Expr("NOT EXISTS (SELECT 1 FROM jobs j WHERE j.latest_completed_build_id = b.id)"),
@@ -116,6 +140,10 @@ func (f *buildFactory) constructBuildFilter() sq.Or {
 	return buildFilter
 }
 
+func (f *buildFactory) BuildLifecycleEvents(after int64) (BuildLifecycleEventSource, error) {
+	return newBuildLifecycleEventSource(f.conn, 0, after)
+}
+
 func (f *buildFactory) GetDrainableBuilds() ([]Build, error) {
 	query := buildsQuery.Where(sq.Eq{
 		"b.completed": true,
@@ -157,7 +185,11 @@ func getBuilds(buildsQuery sq.SelectBuilder, conn Conn, lockFactory lock.LockFac
 }
 
 func getBuildsWithDates(buildsQuery, minMaxIdQuery sq.SelectBuilder, page Page, conn Conn, lockFactory lock.LockFactory) ([]Build, Pagination, error) {
-	var newPage = Page{Limit: page.Limit}
+	var newPage = Page{Limit: page.Limit, Filter: page.Filter}
+
+	// the boundaries have to be builds matching the filter, otherwise they may
+	// fall outside of the range of matching builds
+	boundaryQuery := page.Filter.apply(buildsQuery)
 
 	tx, err := conn.Begin()
 	if err != nil {
@@ -167,7 +199,7 @@ func getBuildsWithDates(buildsQuery, minMaxIdQuery sq.SelectBuilder, page Page,
 	defer Rollback(tx)
 
 	if page.Since != 0 {
-		sinceRow, err := buildsQuery.
+		sinceRow, err := boundaryQuery.
 			Where(sq.Expr("b.start_time >= to_timestamp(" + strconv.Itoa(page.Since) + ")")).
 			OrderBy("COALESCE(b.rerun_of, b.id) ASC, b.id ASC").
 			Limit(1).
@@ -208,7 +240,7 @@ func getBuildsWithDates(buildsQuery, minMaxIdQuery sq.SelectBuilder, page Page,
 	}
 
 	if page.Until != 0 {
-		untilRow, err := buildsQuery.
+		untilRow, err := boundaryQuery.
 			Where(sq.Expr("b.start_time <= to_timestamp(" + strconv.Itoa(page.Until) + ")")).
 			OrderBy("COALESCE(b.rerun_of, b.id) DESC, b.id DESC").
 			Limit(1).
@@ -267,7 +299,8 @@ func getBuildsWithPagination(buildsQuery, minMaxIdQuery sq.SelectBuilder, page P
 
 	defer Rollback(tx)
 
-	buildsQuery = buildsQuery.Limit(uint64(page.Limit))
+	buildsQuery = page.Filter.apply(buildsQuery).Limit(uint64(page.Limit))
+	minMaxIdQuery = page.Filter.apply(minMaxIdQuery)
 
 	if page.Since == 0 && page.Until == 0 { // none
 		buildsQuery = buildsQuery.
@@ -342,15 +375,17 @@ func getBuildsWithPagination(buildsQuery, minMaxIdQuery sq.SelectBuilder, page P
 	var pagination Pagination
 	if first.ID() < maxID {
 		pagination.Previous = &Page{
-			Until: first.ID(),
-			Limit: page.Limit,
+			Until:  first.ID(),
+			Limit:  page.Limit,
+			Filter: page.Filter,
 		}
 	}
 
 	if last.ID() > minID {
 		pagination.Next = &Page{
-			Since: last.ID(),
-			Limit: page.Limit,
+			Since:  last.ID(),
+			Limit:  page.Limit,
+			Filter: page.Filter,
 		}
 	}
 
@@ -105,10 +105,10 @@ var _ = Describe("BuildFactory", func() {
 		Context("pipeline builds", func() {
 
 			It("[#139963615] marks builds that aren't the latest as non-interceptible, ", func() {
-				build1, err := defaultJob.CreateBuild()
+				build1, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
-				build2, err := defaultJob.CreateBuild()
+				build2, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				err = build1.Finish(db.BuildStatusErrored)
@@ -129,10 +129,10 @@ var _ = Describe("BuildFactory", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				pb1, err := j.CreateBuild()
+				pb1, err := j.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
-				pb2, err := j.CreateBuild()
+				pb2, err := j.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				err = pb1.Finish(db.BuildStatusErrored)
@@ -164,7 +164,7 @@ var _ = Describe("BuildFactory", func() {
 
 			DescribeTable("completed builds",
 				func(status db.BuildStatus, matcher types.GomegaMatcher) {
-					b, err := defaultJob.CreateBuild()
+					b, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 
 					var i bool
@@ -185,7 +185,7 @@ var _ = Describe("BuildFactory", func() {
 			)
 
 			It("does not mark non-completed builds", func() {
-				b, err := defaultJob.CreateBuild()
+				b, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				var i bool
@@ -212,7 +212,7 @@ var _ = Describe("BuildFactory", func() {
 		Context("GC failed builds", func() {
 			It("marks failed builds non-interceptible after failed-grace-period", func() {
 				buildFactory = db.NewBuildFactory(dbConn, lockFactory, 0, 2*time.Second) // 1 second could create a flaky test
-				build, err := defaultJob.CreateBuild()
+				build, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				err = build.Finish(db.BuildStatusFailed)
@@ -258,7 +258,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build2, err = privateJob.CreateBuild()
+			build2, err = privateJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), false)
@@ -270,7 +270,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build3, err = publicJob.CreateBuild()
+			build3, err = publicJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
@@ -279,7 +279,7 @@ var _ = Describe("BuildFactory", func() {
 			build4, err = otherTeam.CreateOneOffBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			build5, err = privateJob.RerunBuild(build2)
+			build5, err = privateJob.RerunBuild(build2, defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -296,6 +296,27 @@ var _ = Describe("BuildFactory", func() {
 			Expect(buildIDs).To(Equal([]int{build3.ID(), build5.ID(), build2.ID(), build1.ID()}))
 			Expect(builds).NotTo(ContainElement(build4))
 		})
+
+		Context("when filtering by who created the builds", func() {
+			page := db.Page{Limit: 10, Filter: db.BuildFilter{CreatedBy: defaultBuildCreatedBy}}
+
+			It("filters the builds of the given teams", func() {
+				builds, _, err := buildFactory.VisibleBuilds([]string{"some-team"}, page)
+				Expect(err).NotTo(HaveOccurred())
+
+				buildIDs := []int{}
+				for _, build := range builds {
+					buildIDs = append(buildIDs, build.ID())
+				}
+				Expect(buildIDs).To(Equal([]int{build3.ID(), build5.ID(), build2.ID()}))
+			})
+
+			It("does not filter the builds of other teams' public pipelines", func() {
+				builds, _, err := buildFactory.VisibleBuilds([]string{"some-other-team"}, page)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(BeEmpty())
+			})
+		})
 	})
 
 	Describe("AllBuilds", func() {
@@ -317,7 +338,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build2, err = privateJob.CreateBuild()
+			build2, err = privateJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), false)
@@ -329,7 +350,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build3, err = publicJob.CreateBuild()
+			build3, err = publicJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
@@ -346,6 +367,54 @@ var _ = Describe("BuildFactory", func() {
 			Expect(builds).To(HaveLen(4))
 			Expect(builds).To(ConsistOf(build1, build2, build3, build4))
 		})
+
+		Context("with a filter", func() {
+			BeforeEach(func() {
+				err = build2.Finish(db.BuildStatusFailed)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build3.Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("filters by status", func() {
+				builds, _, err := buildFactory.AllBuilds(db.Page{
+					Limit:  10,
+					Filter: db.BuildFilter{Statuses: []db.BuildStatus{db.BuildStatusFailed, db.BuildStatusSucceeded}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(ConsistOf(build2, build3))
+			})
+
+			It("filters by pipeline and job", func() {
+				builds, _, err := buildFactory.AllBuilds(db.Page{
+					Limit:  10,
+					Filter: db.BuildFilter{PipelineName: "public-pipeline", JobName: "some-job"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(ConsistOf(build3))
+			})
+
+			It("filters by how the build was triggered", func() {
+				manual := true
+				builds, _, err := buildFactory.AllBuilds(db.Page{
+					Limit:  10,
+					Filter: db.BuildFilter{ManuallyTriggered: &manual},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(ConsistOf(build2, build3))
+			})
+
+			It("does not paginate past the matching builds", func() {
+				_, pagination, err := buildFactory.AllBuilds(db.Page{
+					Limit:  1,
+					Filter: db.BuildFilter{Statuses: []db.BuildStatus{db.BuildStatusFailed}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pagination.Next).To(BeNil())
+				Expect(pagination.Previous).To(BeNil())
+			})
+		})
 	})
 
 	Describe("PublicBuilds", func() {
@@ -363,7 +432,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			_, err = privateJob.CreateBuild()
+			_, err = privateJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), false)
@@ -375,7 +444,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			publicBuild, err = publicJob.CreateBuild()
+			publicBuild, err = publicJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -386,6 +455,15 @@ var _ = Describe("BuildFactory", func() {
 			Expect(builds).To(HaveLen(1))
 			Expect(builds).To(ConsistOf(publicBuild))
 		})
+
+		It("does not filter them by who created them", func() {
+			builds, _, err := buildFactory.PublicBuilds(db.Page{
+				Limit:  10,
+				Filter: db.BuildFilter{CreatedBy: defaultBuildCreatedBy},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(BeEmpty())
+		})
 	})
 
 	Describe("GetDrainableBuilds", func() {
@@ -411,10 +489,10 @@ var _ = Describe("BuildFactory", func() {
 			build2DB, err = team.CreateOneOffBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			build3DB, err = job.CreateBuild()
+			build3DB, err = job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
-			build4DB, err = job.CreateBuild()
+			build4DB, err = job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			started, err := build2DB.Start(atc.Plan{})
@@ -442,6 +520,160 @@ var _ = Describe("BuildFactory", func() {
 		})
 	})
 
+	Describe("BuildLifecycleEvents", func() {
+		var (
+			otherTeam db.Team
+			source    db.BuildLifecycleEventSource
+		)
+
+		BeforeEach(func() {
+			var err error
+			otherTeam, err = teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(source.Close()).To(Succeed())
+		})
+
+		It("streams the lifecycle of builds in every team", func() {
+			var err error
+			source, err = buildFactory.BuildLifecycleEvents(-1)
+			Expect(err).ToNot(HaveOccurred())
+
+			build, err := team.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			otherBuild, err := otherTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			started, err := build.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			err = build.Finish(db.BuildStatusSucceeded)
+			Expect(err).ToNot(HaveOccurred())
+
+			var events []atc.BuildLifecycleEvent
+			for i := 0; i < 4; i++ {
+				ev, err := source.Next()
+				Expect(err).ToNot(HaveOccurred())
+
+				events = append(events, ev)
+			}
+
+			Expect(events[0].BuildID).To(Equal(build.ID()))
+			Expect(events[0].TeamName).To(Equal("some-team"))
+			Expect(events[0].Type).To(Equal(atc.BuildCreated))
+			Expect(events[0].Status).To(Equal(atc.StatusPending))
+
+			Expect(events[1].BuildID).To(Equal(otherBuild.ID()))
+			Expect(events[1].TeamName).To(Equal("some-other-team"))
+			Expect(events[1].Type).To(Equal(atc.BuildCreated))
+
+			Expect(events[2].BuildID).To(Equal(build.ID()))
+			Expect(events[2].Type).To(Equal(atc.BuildStarted))
+			Expect(events[2].Status).To(Equal(atc.StatusStarted))
+
+			Expect(events[3].BuildID).To(Equal(build.ID()))
+			Expect(events[3].Type).To(Equal(atc.BuildFinished))
+			Expect(events[3].Status).To(Equal(atc.StatusSucceeded))
+
+			Expect(events[3].ID).To(BeNumerically(">", events[2].ID))
+		})
+
+		It("resumes after the given event", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusAborted)
+			Expect(err).ToNot(HaveOccurred())
+
+			source, err = buildFactory.BuildLifecycleEvents(0)
+			Expect(err).ToNot(HaveOccurred())
+
+			created, err := source.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created.Type).To(Equal(atc.BuildCreated))
+
+			Expect(source.Close()).To(Succeed())
+
+			source, err = buildFactory.BuildLifecycleEvents(created.ID)
+			Expect(err).ToNot(HaveOccurred())
+
+			finished, err := source.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(finished.BuildID).To(Equal(build.ID()))
+			Expect(finished.Type).To(Equal(atc.BuildFinished))
+			Expect(finished.Status).To(Equal(atc.StatusAborted))
+		})
+
+		It("does not skip events which are committed after events recorded later", func() {
+			var err error
+			source, err = buildFactory.BuildLifecycleEvents(-1)
+			Expect(err).ToNot(HaveOccurred())
+
+			tx, err := dbConn.Begin()
+			Expect(err).ToNot(HaveOccurred())
+
+			var slowBuildID int
+			err = tx.QueryRow(`
+				INSERT INTO builds (name, team_id, status, needs_v6_migration)
+				VALUES ('slow', $1, 'pending', false)
+				RETURNING id
+			`, team.ID()).Scan(&slowBuildID)
+			Expect(err).ToNot(HaveOccurred())
+
+			fastBuild, err := team.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			events := make(chan atc.BuildLifecycleEvent, 2)
+			go func() {
+				defer GinkgoRecover()
+
+				for i := 0; i < 2; i++ {
+					ev, err := source.Next()
+					Expect(err).ToNot(HaveOccurred())
+
+					events <- ev
+				}
+			}()
+
+			Consistently(events).ShouldNot(Receive())
+
+			Expect(tx.Commit()).To(Succeed())
+
+			var slow, fast atc.BuildLifecycleEvent
+			Eventually(events).Should(Receive(&slow))
+			Eventually(events).Should(Receive(&fast))
+
+			Expect(slow.BuildID).To(Equal(slowBuildID))
+			Expect(fast.BuildID).To(Equal(fastBuild.ID()))
+			Expect(fast.ID).To(BeNumerically(">", slow.ID))
+		})
+	})
+
+	Describe("RemoveExpiredBuildLifecycleEvents", func() {
+		It("removes events older than the recycle period", func() {
+			_, err := team.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dbConn.Exec(`UPDATE build_lifecycle_events SET time = now() - interval '2 days'`)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = team.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = buildFactory.RemoveExpiredBuildLifecycleEvents(24 * time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			var count int
+			err = dbConn.QueryRow(`SELECT COUNT(*) FROM build_lifecycle_events`).Scan(&count)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+	})
+
 	Describe("GetAllStartedBuilds", func() {
 		var build1DB db.Build
 		var build2DB db.Build
@@ -463,7 +695,7 @@ var _ = Describe("BuildFactory", func() {
 			build1DB, err = team.CreateOneOffBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			build2DB, err = job.CreateBuild()
+			build2DB, err = job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			_, err = team.CreateOneOffBuild()
@@ -512,7 +744,7 @@ var _ = Describe("BuildFactory", func() {
 			build1DB, err = team.CreateOneOffBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			build2DB, err = job.CreateBuild()
+			build2DB, err = job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			_, err = team.CreateOneOffBuild()
@@ -0,0 +1,57 @@
+package db
+
+import (
+	sq "github.com/Masterminds/squirrel"
+)
+
+// BuildFilter narrows down a list of builds. Zero values match every build.
+type BuildFilter struct {
+	Statuses     []BuildStatus
+	PipelineName string
+	JobName      string
+
+	// ManuallyTriggered only matches builds which were (or were not) triggered
+	// by a user when set.
+	ManuallyTriggered *bool
+
+	CreatedBy string
+}
+
+// apply only refers to columns of the builds table (aliased as b), so that it
+// can narrow down both the builds query and the min/max id query used for
+// pagination. Pipelines and jobs are looked up by name within the build's
+// team, as their names are only unique within a team.
+func (filter BuildFilter) apply(query sq.SelectBuilder) sq.SelectBuilder {
+	if len(filter.Statuses) != 0 {
+		query = query.Where(sq.Eq{"b.status": filter.Statuses})
+	}
+
+	if filter.PipelineName != "" {
+		query = query.Where(sq.Expr(`b.pipeline_id IN (
+			SELECT p.id
+			FROM pipelines p
+			WHERE p.name = ?
+			AND p.team_id = b.team_id
+		)`, filter.PipelineName))
+	}
+
+	if filter.JobName != "" {
+		query = query.Where(sq.Expr(`b.job_id IN (
+			SELECT j.id
+			FROM jobs j
+			JOIN pipelines p ON p.id = j.pipeline_id
+			WHERE j.name = ?
+			AND p.team_id = b.team_id
+		)`, filter.JobName))
+	}
+
+	if filter.ManuallyTriggered != nil {
+		query = query.Where(sq.Eq{"b.manually_triggered": *filter.ManuallyTriggered})
+	}
+
+	if filter.CreatedBy != "" {
+		query = query.Where(sq.Eq{"b.created_by": filter.CreatedBy})
+	}
+
+	return query
+}
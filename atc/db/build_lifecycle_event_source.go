@@ -0,0 +1,277 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+var ErrBuildLifecycleEventStreamClosed = errors.New("build lifecycle event stream closed")
+
+// buildLifecycleEventsChannel is notified by the trigger on the builds table
+// which records build lifecycle events.
+const buildLifecycleEventsChannel = "build_lifecycle_events"
+
+//go:generate counterfeiter . BuildLifecycleEventSource
+
+type BuildLifecycleEventSource interface {
+	Next() (atc.BuildLifecycleEvent, error)
+	Close() error
+}
+
+// pendingEventsRetryInterval is how often events which are held back behind
+// an older transaction are checked for, in case that transaction finishes
+// without recording any events itself.
+const pendingEventsRetryInterval = time.Second
+
+// buildLifecycleEventCursor is the position of an event in the stream.
+//
+// Event IDs are allocated when an event is recorded, not when its transaction
+// commits, so a transaction may commit an event with a lower ID after one with
+// a higher ID has been read. Events are instead streamed in the order of the
+// transactions which recorded them, and only once every transaction older than
+// theirs has finished, so that an event can never show up behind the cursor.
+type buildLifecycleEventCursor struct {
+	txid int64
+	id   int64
+}
+
+// newBuildLifecycleEventSource streams the lifecycle events of the team's
+// builds, or of all builds if teamID is 0, which come after the given event
+// ID. If the ID is negative only events from now on are streamed.
+func newBuildLifecycleEventSource(conn Conn, teamID int, after int64) (*buildLifecycleEventSource, error) {
+	notifier, err := newConditionNotifier(conn.Bus(), buildLifecycleEventsChannel, func() (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// only look up where to start once we're listening, so that no events are
+	// missed in between
+	cursor, err := buildLifecycleEventCursorAfter(conn, after)
+	if err != nil {
+		notifier.Close()
+		return nil, err
+	}
+
+	wg := new(sync.WaitGroup)
+
+	source := &buildLifecycleEventSource{
+		teamID: teamID,
+
+		conn:     conn,
+		notifier: notifier,
+
+		events: make(chan atc.BuildLifecycleEvent, 100),
+		stop:   make(chan struct{}),
+		wg:     wg,
+	}
+
+	wg.Add(1)
+	go source.collectEvents(cursor)
+
+	return source, nil
+}
+
+// buildLifecycleEventCursorAfter determines the position of the event with the
+// given ID in the stream.
+//
+// If the ID is negative, the stream starts with the events of the transactions
+// which have not finished yet. If the event no longer exists, e.g. because it
+// expired, the stream starts after the last transaction which recorded an
+// event up to that ID.
+func buildLifecycleEventCursorAfter(conn Conn, after int64) (buildLifecycleEventCursor, error) {
+	if after < 0 {
+		var txid int64
+		err := psql.Select("txid_snapshot_xmin(txid_current_snapshot())").
+			RunWith(conn).
+			QueryRow().
+			Scan(&txid)
+		if err != nil {
+			return buildLifecycleEventCursor{}, err
+		}
+
+		return buildLifecycleEventCursor{txid: txid}, nil
+	}
+
+	var txid sql.NullInt64
+	err := psql.Select("txid").
+		From("build_lifecycle_events").
+		Where(sq.Eq{"id": after}).
+		RunWith(conn).
+		QueryRow().
+		Scan(&txid)
+	if err == sql.ErrNoRows {
+		err = psql.Select("MAX(txid)").
+			From("build_lifecycle_events").
+			Where(sq.LtOrEq{"id": after}).
+			RunWith(conn).
+			QueryRow().
+			Scan(&txid)
+		if err != nil {
+			return buildLifecycleEventCursor{}, err
+		}
+
+		return buildLifecycleEventCursor{txid: txid.Int64, id: math.MaxInt64}, nil
+	}
+	if err != nil {
+		return buildLifecycleEventCursor{}, err
+	}
+
+	return buildLifecycleEventCursor{txid: txid.Int64, id: after}, nil
+}
+
+type buildLifecycleEventSource struct {
+	teamID int
+
+	conn     Conn
+	notifier Notifier
+
+	events chan atc.BuildLifecycleEvent
+	stop   chan struct{}
+	err    error
+	wg     *sync.WaitGroup
+}
+
+func (source *buildLifecycleEventSource) Next() (atc.BuildLifecycleEvent, error) {
+	e, ok := <-source.events
+	if !ok {
+		return atc.BuildLifecycleEvent{}, source.err
+	}
+
+	return e, nil
+}
+
+func (source *buildLifecycleEventSource) Close() error {
+	select {
+	case <-source.stop:
+		return nil
+	default:
+		close(source.stop)
+	}
+
+	source.wg.Wait()
+
+	return source.notifier.Close()
+}
+
+func (source *buildLifecycleEventSource) collectEvents(cursor buildLifecycleEventCursor) {
+	defer source.wg.Done()
+
+	var batchSize = uint64(cap(source.events))
+
+	for {
+		select {
+		case <-source.stop:
+			source.err = ErrBuildLifecycleEventStreamClosed
+			close(source.events)
+			return
+		default:
+		}
+
+		// events of transactions which started after the oldest one still
+		// running are held back until it finishes
+		query := psql.Select("e.id", "e.txid", "e.txid < txid_snapshot_xmin(txid_current_snapshot())", "e.type", "e.status", "e.time", "b.id", "b.name", "t.name", "p.name", "j.name").
+			From("build_lifecycle_events e").
+			Join("builds b ON b.id = e.build_id").
+			Join("teams t ON t.id = e.team_id").
+			LeftJoin("pipelines p ON p.id = b.pipeline_id").
+			LeftJoin("jobs j ON j.id = b.job_id").
+			Where(sq.Expr("(e.txid, e.id) > (?, ?)", cursor.txid, cursor.id)).
+			OrderBy("e.txid ASC", "e.id ASC").
+			Limit(batchSize)
+
+		if source.teamID != 0 {
+			query = query.Where(sq.Eq{"e.team_id": source.teamID})
+		}
+
+		rows, err := query.RunWith(source.conn).Query()
+		if err != nil {
+			source.err = err
+			close(source.events)
+			return
+		}
+
+		var rowsReturned uint64
+		var pending bool
+
+		for rows.Next() {
+			var (
+				ev           atc.BuildLifecycleEvent
+				txid         int64
+				settled      bool
+				eventTime    time.Time
+				pipelineName sql.NullString
+				jobName      sql.NullString
+			)
+
+			err := rows.Scan(&ev.ID, &txid, &settled, &ev.Type, &ev.Status, &eventTime, &ev.BuildID, &ev.BuildName, &ev.TeamName, &pipelineName, &jobName)
+			if err != nil {
+				_ = rows.Close()
+
+				source.err = err
+				close(source.events)
+				return
+			}
+
+			if !settled {
+				// neither this event nor any after it can be sent yet
+				pending = true
+				_ = rows.Close()
+				break
+			}
+
+			rowsReturned++
+
+			ev.Time = eventTime.Unix()
+			ev.PipelineName = pipelineName.String
+			ev.JobName = jobName.String
+
+			cursor = buildLifecycleEventCursor{txid: txid, id: ev.ID}
+
+			select {
+			case source.events <- ev:
+			case <-source.stop:
+				_ = rows.Close()
+
+				source.err = ErrBuildLifecycleEventStreamClosed
+				close(source.events)
+				return
+			}
+		}
+
+		err = rows.Err()
+		if err != nil {
+			source.err = err
+			close(source.events)
+			return
+		}
+
+		if rowsReturned == batchSize {
+			// still more events
+			continue
+		}
+
+		// the transaction holding back pending events may not notify when it
+		// finishes, so check for them again in a bit
+		var retry <-chan time.Time
+		if pending {
+			retry = time.After(pendingEventsRetryInterval)
+		}
+
+		select {
+		case <-source.notifier.Notify():
+		case <-retry:
+		case <-source.stop:
+			source.err = ErrBuildLifecycleEventStreamClosed
+			close(source.events)
+			return
+		}
+	}
+}
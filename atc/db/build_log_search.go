@@ -0,0 +1,400 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/lib/pq"
+)
+
+// BuildLogSearch describes a full-text search through the logs of a team's
+// builds. Only Query is required.
+type BuildLogSearch struct {
+	Query string
+
+	PipelineName string
+	JobName      string
+
+	Since time.Time
+	Until time.Time
+
+	// Limit is the maximum number of matching lines to return.
+	Limit int
+}
+
+// logSearchVector is the expression matched against when searching build
+// logs. It, along with the condition on the event type, must be kept in sync
+// with the log search indexes created on the build events tables.
+const logSearchVector = `to_tsvector('simple', e.payload::json->>'payload')`
+
+func (t *team) SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	if strings.TrimSpace(search.Query) == "" {
+		return nil, nil
+	}
+
+	matches, err := t.searchBuildEvents(search)
+	if err != nil {
+		return nil, err
+	}
+
+	offloaded, err := t.offloadedSearchCandidates(search)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(offloaded) == 0 {
+		return matches, nil
+	}
+
+	// merge the matches from the events still in Postgres with those from the
+	// offloaded events, newest build first, up to the limit on the lines
+	var merged []atc.BuildLogMatch
+	var lines int
+	for len(matches) > 0 || len(offloaded) > 0 {
+		var match atc.BuildLogMatch
+		if len(offloaded) == 0 || (len(matches) > 0 && matches[0].BuildID > offloaded[0].ID()) {
+			match = matches[0]
+			matches = matches[1:]
+		} else {
+			match, err = t.searchOffloadedBuild(offloaded[0], search.Query)
+			if err != nil {
+				return nil, err
+			}
+
+			offloaded = offloaded[1:]
+		}
+
+		if len(match.Lines) == 0 {
+			continue
+		}
+
+		if search.Limit > 0 && lines+len(match.Lines) > search.Limit {
+			match.Lines = match.Lines[:search.Limit-lines]
+		}
+
+		merged = append(merged, match)
+		lines += len(match.Lines)
+
+		if search.Limit > 0 && lines == search.Limit {
+			break
+		}
+	}
+
+	return merged, nil
+}
+
+// searchBuildEvents searches the logs of the builds whose events are still in
+// Postgres.
+func (t *team) searchBuildEvents(search BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	tables, err := t.buildEventsTables(search)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tables) == 0 {
+		return nil, nil
+	}
+
+	var matched sq.SelectBuilder
+	for i, table := range tables {
+		subquery := sq.Select("e.build_id", "e.event_id", "line").
+			From(table+" e").
+			JoinClause(`CROSS JOIN LATERAL regexp_split_to_table(e.payload::json->>'payload', E'\n') AS line`).
+			Where("e.type = 'log'").
+			Where(logSearchVector+" @@ plainto_tsquery('simple', ?)", search.Query).
+			Where("to_tsvector('simple', line) @@ plainto_tsquery('simple', ?)", search.Query)
+
+		if i == 0 {
+			matched = subquery
+			continue
+		}
+
+		subquerySQL, args, err := subquery.ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		matched = matched.Suffix("UNION ALL "+subquerySQL, args...)
+	}
+
+	query := psql.Select("b.id", "b.name", "p.name", "j.name", "b.start_time", "m.line").
+		FromSelect(matched, "m").
+		Join("builds b ON b.id = m.build_id").
+		LeftJoin("pipelines p ON p.id = b.pipeline_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		Where(sq.Eq{"b.team_id": t.id, "b.events_offloaded": false}).
+		OrderBy("b.id DESC", "m.event_id ASC")
+
+	if search.JobName != "" {
+		query = query.Where(sq.Eq{"j.name": search.JobName})
+	}
+
+	if !search.Since.IsZero() {
+		query = query.Where(sq.GtOrEq{"b.start_time": search.Since})
+	}
+
+	if !search.Until.IsZero() {
+		query = query.Where(sq.LtOrEq{"b.start_time": search.Until})
+	}
+
+	if search.Limit > 0 {
+		query = query.Limit(uint64(search.Limit))
+	}
+
+	rows, err := query.RunWith(t.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var matches []atc.BuildLogMatch
+	for rows.Next() {
+		var (
+			buildID      int
+			buildName    string
+			pipelineName sql.NullString
+			jobName      sql.NullString
+			startTime    pq.NullTime
+			line         string
+		)
+
+		err = rows.Scan(&buildID, &buildName, &pipelineName, &jobName, &startTime, &line)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(matches) == 0 || matches[len(matches)-1].BuildID != buildID {
+			match := atc.BuildLogMatch{
+				BuildID:      buildID,
+				BuildName:    buildName,
+				TeamName:     t.name,
+				PipelineName: pipelineName.String,
+				JobName:      jobName.String,
+			}
+
+			if startTime.Valid {
+				match.StartTime = startTime.Time.Unix()
+			}
+
+			matches = append(matches, match)
+		}
+
+		last := &matches[len(matches)-1]
+		last.Lines = append(last.Lines, strings.TrimRight(line, "\r"))
+	}
+
+	return matches, rows.Err()
+}
+
+// offloadedSearchCandidates returns the builds in the scope of the search
+// whose events have been offloaded and whose logs contain the searched words,
+// newest first, along with those whose logs were too large to index.
+func (t *team) offloadedSearchCandidates(search BuildLogSearch) ([]Build, error) {
+	query := buildsQuery.
+		Join("build_log_search_index i ON i.build_id = b.id").
+		Where(sq.Eq{"b.team_id": t.id, "b.events_offloaded": true}).
+		Where(sq.Or{
+			sq.Expr("i.log_vector @@ plainto_tsquery('simple', ?)", search.Query),
+			sq.Eq{"i.log_vector": nil},
+		}).
+		OrderBy("b.id DESC")
+
+	if search.PipelineName != "" {
+		query = query.Where(sq.Eq{"p.name": search.PipelineName})
+	}
+
+	if search.JobName != "" {
+		query = query.Where(sq.Eq{"j.name": search.JobName})
+	}
+
+	if !search.Since.IsZero() {
+		query = query.Where(sq.GtOrEq{"b.start_time": search.Since})
+	}
+
+	if !search.Until.IsZero() {
+		query = query.Where(sq.LtOrEq{"b.start_time": search.Until})
+	}
+
+	return getBuilds(query, t.conn, t.lockFactory)
+}
+
+// searchOffloadedBuild reads the offloaded logs of the build back from the
+// event store and returns the lines which match the query, matched the same
+// way as the lines of the events in Postgres.
+func (t *team) searchOffloadedBuild(build Build, query string) (atc.BuildLogMatch, error) {
+	match := atc.BuildLogMatch{
+		BuildID:      build.ID(),
+		BuildName:    build.Name(),
+		TeamName:     t.name,
+		PipelineName: build.PipelineName(),
+		JobName:      build.JobName(),
+	}
+
+	if !build.StartTime().IsZero() {
+		match.StartTime = build.StartTime().Unix()
+	}
+
+	events, err := build.Events(0)
+	if err != nil {
+		return atc.BuildLogMatch{}, err
+	}
+
+	defer Close(events)
+
+	var lines []string
+	for {
+		envelope, err := events.Next()
+		if err == ErrEndOfBuildEventStream {
+			break
+		}
+
+		if err != nil {
+			return atc.BuildLogMatch{}, err
+		}
+
+		if envelope.Event != event.EventTypeLog || envelope.Data == nil {
+			continue
+		}
+
+		var log event.Log
+		err = json.Unmarshal(*envelope.Data, &log)
+		if err != nil {
+			return atc.BuildLogMatch{}, err
+		}
+
+		lines = append(lines, strings.Split(log.Payload, "\n")...)
+	}
+
+	if len(lines) == 0 {
+		return match, nil
+	}
+
+	rows, err := t.conn.Query(`
+		SELECT line
+		FROM unnest($1::text[]) WITH ORDINALITY AS l (line, n)
+		WHERE to_tsvector('simple', line) @@ plainto_tsquery('simple', $2)
+		ORDER BY n
+	`, pq.Array(lines), query)
+	if err != nil {
+		return atc.BuildLogMatch{}, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var line string
+		err = rows.Scan(&line)
+		if err != nil {
+			return atc.BuildLogMatch{}, err
+		}
+
+		match.Lines = append(match.Lines, strings.TrimRight(line, "\r"))
+	}
+
+	return match, rows.Err()
+}
+
+// buildEventsTables returns the build events tables which may contain events
+// of the builds matching the search, i.e. those of the pipelines which have
+// such builds whose events have not been offloaded, rather than those of all
+// of the team's pipelines.
+func (t *team) buildEventsTables(search BuildLogSearch) ([]string, error) {
+	builds := t.searchedBuilds(search).
+		Where("b.pipeline_id = p.id")
+
+	if search.JobName != "" {
+		builds = builds.
+			Join("jobs j ON j.id = b.job_id").
+			Where(sq.Eq{"j.name": search.JobName})
+	}
+
+	buildsSQL, buildsArgs, err := builds.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	query := psql.Select("p.id").
+		From("pipelines p").
+		Where(sq.Eq{"p.team_id": t.id}).
+		Where("EXISTS ("+buildsSQL+")", buildsArgs...).
+		OrderBy("p.id")
+
+	if search.PipelineName != "" {
+		query = query.Where(sq.Eq{"p.name": search.PipelineName})
+	}
+
+	rows, err := query.RunWith(t.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var tables []string
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		tables = append(tables, fmt.Sprintf("pipeline_build_events_%d", id))
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	// one-off builds do not belong to a pipeline or job
+	if search.PipelineName == "" && search.JobName == "" {
+		oneOffSQL, oneOffArgs, err := t.searchedBuilds(search).
+			Where(sq.Eq{"b.team_id": t.id, "b.pipeline_id": nil}).
+			ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		var exists bool
+		err = psql.Select().
+			Column(sq.Expr("EXISTS ("+oneOffSQL+")", oneOffArgs...)).
+			RunWith(t.conn).
+			QueryRow().
+			Scan(&exists)
+		if err != nil {
+			return nil, err
+		}
+
+		if exists {
+			tables = append(tables, fmt.Sprintf("team_build_events_%d", t.id))
+		}
+	}
+
+	return tables, nil
+}
+
+// searchedBuilds selects the builds in the time range of the search whose
+// events are still in the database. Builds whose events have been offloaded
+// are found through the build log search index instead.
+func (t *team) searchedBuilds(search BuildLogSearch) sq.SelectBuilder {
+	builds := sq.Select("1").
+		From("builds b").
+		Where(sq.Eq{"b.events_offloaded": false})
+
+	if !search.Since.IsZero() {
+		builds = builds.Where(sq.GtOrEq{"b.start_time": search.Since})
+	}
+
+	if !search.Until.IsZero() {
+		builds = builds.Where(sq.LtOrEq{"b.start_time": search.Until})
+	}
+
+	return builds
+}
@@ -0,0 +1,161 @@
+package db
+
+import (
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+const (
+	BuildStepTypeGet  = "get"
+	BuildStepTypePut  = "put"
+	BuildStepTypeTask = "task"
+)
+
+// BuildStep is a get, put or task step which succeeded in a build, along with
+// what it produced, so that a rerun of the build from a later step can reuse
+// it instead of running it again.
+type BuildStep struct {
+	// Path identifies the step within the plan of its job's builds, as the
+	// names of steps need not be unique.
+	Path string
+
+	Type string
+	Name string
+
+	// Artifacts maps the names of the artifacts the step registered to the
+	// handles of their volumes.
+	Artifacts map[string]string
+
+	// Version and Metadata are the result of a put step.
+	Version  atc.Version
+	Metadata []atc.MetadataField
+}
+
+// SaveStep records a step which succeeded. As the attempts of a retried step
+// share its path, a later attempt replaces the one saved earlier.
+func (b *build) SaveStep(step BuildStep) error {
+	artifacts, err := json.Marshal(step.Artifacts)
+	if err != nil {
+		return err
+	}
+
+	var version, metadata interface{}
+	if step.Version != nil {
+		payload, err := json.Marshal(step.Version)
+		if err != nil {
+			return err
+		}
+
+		version = payload
+	}
+
+	if step.Metadata != nil {
+		payload, err := json.Marshal(step.Metadata)
+		if err != nil {
+			return err
+		}
+
+		metadata = payload
+	}
+
+	_, err = psql.Insert("build_steps").
+		Columns("build_id", "path", "type", "name", "artifacts", "version", "metadata").
+		Values(b.id, step.Path, step.Type, step.Name, artifacts, version, metadata).
+		Suffix(`
+			ON CONFLICT (build_id, path) DO UPDATE SET
+				artifacts = EXCLUDED.artifacts,
+				version = EXCLUDED.version,
+				metadata = EXCLUDED.metadata
+		`).
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+// ReusableSteps returns the steps which succeeded in the build this build is
+// rerunning from a step, as long as the volumes of all of their artifacts
+// still exist.
+func (b *build) ReusableSteps() ([]BuildStep, error) {
+	if b.rerunFromBuildID == 0 {
+		return nil, nil
+	}
+
+	rows, err := psql.Select("s.path", "s.type", "s.name", "s.artifacts", "s.version", "s.metadata").
+		From("build_steps s").
+		Where(sq.Eq{"s.build_id": b.rerunFromBuildID}).
+		Where(`NOT EXISTS (
+			SELECT 1
+			FROM jsonb_each_text(s.artifacts) a
+			WHERE NOT EXISTS (
+				SELECT 1
+				FROM volumes v
+				WHERE v.handle = a.value
+				AND v.state = 'created'
+			)
+		)`).
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var steps []BuildStep
+	for rows.Next() {
+		var (
+			step                         BuildStep
+			artifacts, version, metadata []byte
+		)
+
+		err := rows.Scan(&step.Path, &step.Type, &step.Name, &artifacts, &version, &metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(artifacts, &step.Artifacts)
+		if err != nil {
+			return nil, err
+		}
+
+		if version != nil {
+			err = json.Unmarshal(version, &step.Version)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if metadata != nil {
+			err = json.Unmarshal(metadata, &step.Metadata)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		steps = append(steps, step)
+	}
+
+	return steps, rows.Err()
+}
+
+// useReusableResourceCaches makes the build use the resource caches whose
+// volumes are artifacts of the steps of the build it is rerunning from a
+// step, so that they are kept until the build has finished reusing them.
+//
+// The volumes of the other artifacts belong to the containers of the builds
+// which ran their steps, which are kept for as long as they are reusable by a
+// running build (see reusedBuildContainers).
+func useReusableResourceCaches(tx Tx, buildID int, rerunFromBuildID int) error {
+	_, err := tx.Exec(`
+		INSERT INTO resource_cache_uses (build_id, resource_cache_id)
+		SELECT DISTINCT $1::integer, wrc.resource_cache_id
+		FROM build_steps s
+		CROSS JOIN LATERAL jsonb_each_text(s.artifacts) a
+		JOIN volumes v ON v.handle = a.value
+		JOIN worker_resource_caches wrc ON wrc.id = v.worker_resource_cache_id
+		WHERE s.build_id = $2
+	`, buildID, rerunFromBuildID)
+	return err
+}
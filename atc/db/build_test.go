@@ -125,7 +125,7 @@ var _ = Describe("Build", func() {
 
 		Context("build has been aborted", func() {
 			BeforeEach(func() {
-				err = build.MarkAsAborted()
+				err = build.MarkAsAborted("some-user")
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -276,7 +276,7 @@ var _ = Describe("Build", func() {
 			})
 			Expect(err).ToNot(HaveOccurred())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			err = job.SaveNextInputMapping(db.InputMapping{
@@ -393,13 +393,13 @@ var _ = Describe("Build", func() {
 
 			Context("when there is a pending build that is not a rerun", func() {
 				BeforeEach(func() {
-					pdBuild, err = job.CreateBuild()
+					pdBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 				})
 
 				Context("when rerunning the latest completed build", func() {
 					BeforeEach(func() {
-						rrBuild, err = job.RerunBuild(build)
+						rrBuild, err = job.RerunBuild(build, defaultBuildCreatedBy)
 						Expect(err).NotTo(HaveOccurred())
 					})
 
@@ -424,7 +424,7 @@ var _ = Describe("Build", func() {
 
 					Context("when there is another pending build that is not a rerun and the first pending build finishes", func() {
 						BeforeEach(func() {
-							pdBuild2, err = job.CreateBuild()
+							pdBuild2, err = job.CreateBuild(defaultBuildCreatedBy)
 							Expect(err).NotTo(HaveOccurred())
 
 							err = pdBuild.Finish(db.BuildStatusSucceeded)
@@ -443,7 +443,7 @@ var _ = Describe("Build", func() {
 
 				Context("when rerunning the pending build and the pending build finished", func() {
 					BeforeEach(func() {
-						rrBuild, err = job.RerunBuild(pdBuild)
+						rrBuild, err = job.RerunBuild(pdBuild, defaultBuildCreatedBy)
 						Expect(err).NotTo(HaveOccurred())
 
 						err = pdBuild.Finish(db.BuildStatusSucceeded)
@@ -465,7 +465,7 @@ var _ = Describe("Build", func() {
 							err = rrBuild.Finish(db.BuildStatusSucceeded)
 							Expect(err).NotTo(HaveOccurred())
 
-							rrBuild2, err = job.RerunBuild(rrBuild)
+							rrBuild2, err = job.RerunBuild(rrBuild, defaultBuildCreatedBy)
 							Expect(err).NotTo(HaveOccurred())
 						})
 
@@ -484,7 +484,7 @@ var _ = Describe("Build", func() {
 						err = pdBuild.Finish(db.BuildStatusErrored)
 						Expect(err).NotTo(HaveOccurred())
 
-						rrBuild, err = job.RerunBuild(build)
+						rrBuild, err = job.RerunBuild(build, defaultBuildCreatedBy)
 						Expect(err).NotTo(HaveOccurred())
 
 						err = rrBuild.Finish(db.BuildStatusSucceeded)
@@ -538,7 +538,7 @@ var _ = Describe("Build", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				newBuild, err := job.CreateBuild()
+				newBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				requestedSchedule := downstreamJob.ScheduleRequestedTime()
@@ -557,7 +557,7 @@ var _ = Describe("Build", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				newBuild, err := job.CreateBuild()
+				newBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				requestedSchedule := noRequestJob.ScheduleRequestedTime()
@@ -574,6 +574,118 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("ReusableSteps", func() {
+		var (
+			build       db.Build
+			rerun       db.Build
+			artifactVol db.CreatedVolume
+		)
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			creatingVolume, err := volumeRepository.CreateVolume(defaultTeam.ID(), defaultWorker.Name(), db.VolumeTypeArtifact)
+			Expect(err).NotTo(HaveOccurred())
+
+			artifactVol, err = creatingVolume.Created()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveStep(db.BuildStep{
+				Path:      "do.1/task:some-task",
+				Type:      db.BuildStepTypeTask,
+				Name:      "some-task",
+				Artifacts: map[string]string{"some-output": artifactVol.Handle()},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveStep(db.BuildStep{
+				Path:      "do.2/put:some-put",
+				Type:      db.BuildStepTypePut,
+				Name:      "some-put",
+				Artifacts: map[string]string{},
+				Version:   atc.Version{"some": "version"},
+				Metadata:  []atc.MetadataField{{Name: "some", Value: "metadata"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveStep(db.BuildStep{
+				Path:      "do.0/get:some-get",
+				Type:      db.BuildStepTypeGet,
+				Name:      "some-get",
+				Artifacts: map[string]string{"some-get": "some-missing-handle"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			rerun, err = defaultJob.RerunBuildFromStep(build, "some-other-task", defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the steps of the build being rerun whose artifacts still exist", func() {
+			steps, err := rerun.ReusableSteps()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(steps).To(ConsistOf(
+				db.BuildStep{
+					Path:      "do.1/task:some-task",
+					Type:      db.BuildStepTypeTask,
+					Name:      "some-task",
+					Artifacts: map[string]string{"some-output": artifactVol.Handle()},
+				},
+				db.BuildStep{
+					Path:      "do.2/put:some-put",
+					Type:      db.BuildStepTypePut,
+					Name:      "some-put",
+					Artifacts: map[string]string{},
+					Version:   atc.Version{"some": "version"},
+					Metadata:  []atc.MetadataField{{Name: "some", Value: "metadata"}},
+				},
+			))
+		})
+
+		It("keeps steps with the same name at different paths apart", func() {
+			err := build.SaveStep(db.BuildStep{
+				Path:      "do.3/put:some-put",
+				Type:      db.BuildStepTypePut,
+				Name:      "some-put",
+				Artifacts: map[string]string{},
+				Version:   atc.Version{"some": "other-version"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			steps, err := rerun.ReusableSteps()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(steps).To(HaveLen(3))
+		})
+
+		It("replaces a step saved again at the same path", func() {
+			err := build.SaveStep(db.BuildStep{
+				Path:      "do.2/put:some-put",
+				Type:      db.BuildStepTypePut,
+				Name:      "some-put",
+				Artifacts: map[string]string{},
+				Version:   atc.Version{"some": "other-version"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			steps, err := rerun.ReusableSteps()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(steps).To(ContainElement(db.BuildStep{
+				Path:      "do.2/put:some-put",
+				Type:      db.BuildStepTypePut,
+				Name:      "some-put",
+				Artifacts: map[string]string{},
+				Version:   atc.Version{"some": "other-version"},
+			}))
+		})
+
+		It("returns nothing for a build which is not rerun from a step", func() {
+			steps, err := build.ReusableSteps()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(steps).To(BeEmpty())
+		})
+	})
+
 	Describe("Abort", func() {
 		var build db.Build
 		BeforeEach(func() {
@@ -581,7 +693,7 @@ var _ = Describe("Build", func() {
 			build, err = team.CreateOneOffBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			err = build.MarkAsAborted()
+			err = build.MarkAsAborted("some-user")
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -591,6 +703,89 @@ var _ = Describe("Build", func() {
 			Expect(found).To(BeTrue())
 			Expect(build.IsAborted()).To(BeTrue())
 		})
+
+		It("records who aborted the build", func() {
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.AbortedBy()).To(Equal("some-user"))
+		})
+
+		Context("when the build is aborted again", func() {
+			BeforeEach(func() {
+				err := build.MarkAsAborted("some-other-user")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("keeps whoever aborted it first", func() {
+				found, err := build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.AbortedBy()).To(Equal("some-user"))
+			})
+		})
+	})
+
+	Describe("Breakpoints", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is not paused at a breakpoint", func() {
+			It("is not resumed", func() {
+				resumed, err := build.Resume()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resumed).To(BeFalse())
+			})
+		})
+
+		Context("when the build is paused at a breakpoint", func() {
+			BeforeEach(func() {
+				err := build.PauseAtBreakpoint()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("is marked as paused", func() {
+				found, err := build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.IsPausedAtBreakpoint()).To(BeTrue())
+			})
+
+			It("notifies once it is resumed", func() {
+				notifier, err := build.ResumeNotifier()
+				Expect(err).NotTo(HaveOccurred())
+
+				defer notifier.Close()
+
+				Consistently(notifier.Notify()).ShouldNot(Receive())
+
+				resumed, err := build.Resume()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resumed).To(BeTrue())
+
+				Eventually(notifier.Notify()).Should(Receive())
+
+				found, err := build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.IsPausedAtBreakpoint()).To(BeFalse())
+			})
+
+			It("is no longer paused once finished", func() {
+				err := build.Finish(db.BuildStatusAborted)
+				Expect(err).NotTo(HaveOccurred())
+
+				found, err := build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.IsPausedAtBreakpoint()).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("Events", func() {
@@ -773,7 +968,7 @@ var _ = Describe("Build", func() {
 
 		Context("when the version does not exist", func() {
 			It("can save a build's output", func() {
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build.SaveOutput("some-type", atc.Source{"some": "explicit-source"}, atc.VersionedResourceTypes{}, atc.Version{"some": "version"}, []db.ResourceConfigMetadataField{
@@ -802,7 +997,7 @@ var _ = Describe("Build", func() {
 			It("requests schedule on all jobs using the resource config", func() {
 				atc.EnableGlobalResources = true
 
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 
 				pipelineConfig := atc.Config{
@@ -879,7 +1074,7 @@ var _ = Describe("Build", func() {
 			})
 
 			It("does not increment the check order", func() {
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build.SaveOutput("some-type", atc.Source{"some": "explicit-source"}, atc.VersionedResourceTypes{}, atc.Version{"some": "version"}, []db.ResourceConfigMetadataField{
@@ -902,7 +1097,7 @@ var _ = Describe("Build", func() {
 			})
 
 			It("does not request schedule on all jobs using the resource config", func() {
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 
 				pipelineConfig := atc.Config{
@@ -992,7 +1187,7 @@ var _ = Describe("Build", func() {
 						})
 
 						It("saves the output", func() {
-							build, err := job.CreateBuild()
+							build, err := job.CreateBuild(defaultBuildCreatedBy)
 							Expect(err).ToNot(HaveOccurred())
 
 							err = build.SaveOutput(
@@ -1092,7 +1287,7 @@ var _ = Describe("Build", func() {
 		})
 
 		It("returns build inputs and outputs", func() {
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			// save a normal 'get'
@@ -1149,7 +1344,7 @@ var _ = Describe("Build", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				// save a normal 'get'
@@ -1208,7 +1403,7 @@ var _ = Describe("Build", func() {
 
 				BeforeEach(func() {
 					var err error
-					newBuild, err = job.CreateBuild()
+					newBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 
 					// save a normal 'get'
@@ -1284,7 +1479,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1398,7 +1593,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				expectedBuildPrep.BuildID = build.ID()
@@ -1554,7 +1749,7 @@ var _ = Describe("Build", func() {
 							Expect(err).ToNot(HaveOccurred())
 							Expect(found).To(BeTrue())
 
-							newBuild, err := job.CreateBuild()
+							newBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 							Expect(err).NotTo(HaveOccurred())
 
 							err = job.SaveNextInputMapping(nil, true)
@@ -1655,7 +1850,7 @@ var _ = Describe("Build", func() {
 							Expect(err).ToNot(HaveOccurred())
 							Expect(found).To(BeTrue())
 
-							newBuild, err := job.CreateBuild()
+							newBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 							Expect(err).NotTo(HaveOccurred())
 
 							scheduled, err := job.ScheduleBuild(build)
@@ -1919,17 +2114,17 @@ var _ = Describe("Build", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 
 			otherJob, found, err = pipeline.Job("some-other-job")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			otherBuild, err = otherJob.CreateBuild()
+			otherBuild, err = otherJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 
-			otherBuild2, err = otherJob.CreateBuild()
+			otherBuild2, err = otherJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -2182,17 +2377,17 @@ var _ = Describe("Build", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			otherBuild, err = otherJob.CreateBuild()
+			otherBuild, err = otherJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 
-			retriggerBuild, err = job.RerunBuild(build)
+			retriggerBuild, err = job.RerunBuild(build, defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -2475,7 +2670,7 @@ var _ = Describe("Build", func() {
 			resourceConfigScope2, err = resource2.SetResourceConfig(atc.Source{"some": "other-source"}, atc.VersionedResourceTypes{})
 			Expect(err).ToNot(HaveOccurred())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -234,9 +234,13 @@ func (c *check) finish(status CheckStatus, checkError error) error {
 		})
 
 	if checkError != nil {
-		builder = builder.Set("check_error", checkError.Error())
+		builder = builder.
+			Set("check_error", checkError.Error()).
+			Set("check_failures", sq.Expr("check_failures + 1"))
 	} else {
-		builder = builder.Set("check_error", nil)
+		builder = builder.
+			Set("check_error", nil).
+			Set("check_failures", 0)
 	}
 
 	_, err = builder.
@@ -30,6 +30,7 @@ type Checkable interface {
 	CheckEvery() string
 	CheckTimeout() string
 	LastCheckEndTime() time.Time
+	CheckFailures() int
 	CurrentPinnedVersion() atc.Version
 
 	HasWebhook() bool
@@ -306,6 +307,19 @@ func (c *checkFactory) CreateCheck(
 		return nil, false, err
 	}
 
+	if manuallyTriggered {
+		// a manual check is a request to try again right away, so forget about
+		// any previous failures the scanner has been backing off from
+		_, err = psql.Update("resource_config_scopes").
+			Set("check_failures", 0).
+			Where(sq.Eq{"id": resourceConfigScopeID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, false, err
@@ -313,13 +313,17 @@ var _ = Describe("CheckFactory", func() {
 	})
 
 	Describe("CreateCheck", func() {
-		var created bool
+		var created, manuallyTriggered bool
 		var check db.Check
 
+		BeforeEach(func() {
+			manuallyTriggered = false
+		})
+
 		JustBeforeEach(func() {
 			check, created, err = checkFactory.CreateCheck(
 				resourceConfigScope.ID(),
-				false,
+				manuallyTriggered,
 				atc.Plan{Check: &atc.CheckPlan{Name: "some-name", Type: "some-type"}},
 				metadata,
 				map[string]string{"fake": "span"},
@@ -362,6 +366,31 @@ var _ = Describe("CheckFactory", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
+
+		Context("when previous checks have failed", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec("UPDATE resource_config_scopes SET check_failures = 3 WHERE id = $1", resourceConfigScope.ID())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("keeps the check failures", func() {
+				defaultResource.Reload()
+
+				Expect(defaultResource.CheckFailures()).To(Equal(3))
+			})
+
+			Context("when the check is manually triggered", func() {
+				BeforeEach(func() {
+					manuallyTriggered = true
+				})
+
+				It("resets the check failures", func() {
+					defaultResource.Reload()
+
+					Expect(defaultResource.CheckFailures()).To(BeZero())
+				})
+			})
+		})
 	})
 
 	Describe("StartedChecks", func() {
@@ -111,6 +111,19 @@ var _ = Describe("Check", func() {
 
 			Expect(defaultResource.CheckError()).To(BeNil())
 		})
+
+		Context("when previous checks have failed", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec("UPDATE resource_config_scopes SET check_failures = 3 WHERE id = $1", resourceConfigScope.ID())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("resets the check failures", func() {
+				defaultResource.Reload()
+
+				Expect(defaultResource.CheckFailures()).To(BeZero())
+			})
+		})
 	})
 
 	Describe("FinishWithError", func() {
@@ -142,6 +155,19 @@ var _ = Describe("Check", func() {
 			Expect(defaultResource.LastCheckEndTime()).To(BeTemporally("~", time.Now(), time.Second))
 			Expect(defaultResource.CheckError()).To(Equal(errors.New("nope")))
 		})
+
+		It("increments the check failures", func() {
+			defaultResource.Reload()
+
+			Expect(defaultResource.CheckFailures()).To(Equal(1))
+
+			err = check.FinishWithError(errors.New("nope again"))
+			Expect(err).NotTo(HaveOccurred())
+
+			defaultResource.Reload()
+
+			Expect(defaultResource.CheckFailures()).To(Equal(2))
+		})
 	})
 
 	Describe("AllCheckables", func() {
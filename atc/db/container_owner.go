@@ -120,6 +120,74 @@ func (c buildStepContainerOwner) sqlMap() map[string]interface{} {
 	}
 }
 
+// NewPrefetchContainerOwner references a resource cache which is being
+// prefetched onto a worker, with an expiry. Once the cache has been
+// initialized on the container's worker, or disappears, or the expiry is
+// reached, the container can be removed.
+func NewPrefetchContainerOwner(
+	resourceCache UsedResourceCache,
+	teamID int,
+	expiry time.Duration,
+) ContainerOwner {
+	return prefetchContainerOwner{
+		ResourceCache: resourceCache,
+		TeamID:        teamID,
+		Expiry:        expiry,
+	}
+}
+
+type prefetchContainerOwner struct {
+	ResourceCache UsedResourceCache
+	TeamID        int
+	Expiry        time.Duration
+}
+
+func (c prefetchContainerOwner) Find(conn Conn) (sq.Eq, bool, error) {
+	var ids []int
+	rows, err := psql.Select("id").
+		From("containers").
+		Where(sq.And{
+			sq.Eq{
+				"prefetch_resource_cache_id": c.ResourceCache.ID(),
+				"team_id":                    c.TeamID,
+			},
+			sq.Expr("prefetch_expires_at > NOW()"),
+		}).
+		RunWith(conn).
+		Query()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, false, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		return nil, false, nil
+	}
+
+	return sq.Eq{
+		"id": ids,
+	}, true, nil
+}
+
+func (c prefetchContainerOwner) Create(Tx, string) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"prefetch_resource_cache_id": c.ResourceCache.ID(),
+		"team_id":                    c.TeamID,
+		"prefetch_expires_at":        sq.Expr(fmt.Sprintf("NOW() + '%d seconds'::interval", int(c.Expiry.Seconds()))),
+	}, nil
+}
+
 // NewResourceConfigCheckSessionContainerOwner references a resource config and
 // worker base resource type, with an expiry. When the resource config or
 // worker base resource type disappear, or the expiry is reached, the container
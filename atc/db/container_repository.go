@@ -19,8 +19,43 @@ type ContainerRepository interface {
 	UpdateContainersMissingSince(workerName string, handles []string) error
 	RemoveMissingContainers(time.Duration) (int, error)
 	DestroyUnknownContainers(workerName string, reportedHandles []string) (int, error)
+	FindContainerRetentions(containerIDs []int) (map[int]ContainerRetention, error)
 }
 
+// ContainerRetention is why the containers of a build are kept after it
+// finishes rather than being garbage collected.
+type ContainerRetention struct {
+	// Held is true if the build is held until it is released.
+	Held bool
+
+	// ExpiresAt is when the containers of a failed build stop being kept, as
+	// configured by the job's keep_failed_containers.
+	ExpiresAt time.Time
+}
+
+// keptBuildContainers matches builds, joined as b with their job joined as kj,
+// whose containers are kept after they finish.
+const keptBuildContainers = `(
+	b.held
+	OR (
+		b.status IN ('failed', 'errored')
+		AND COALESCE(b.end_time + kj.keep_failed_containers > now(), false)
+	)
+)`
+
+// reusedBuildContainers matches containers, joined as c, whose volumes are
+// artifacts of steps which a running build, rerun from a later step, may still
+// reuse.
+const reusedBuildContainers = `EXISTS (
+	SELECT 1
+	FROM builds rb
+	JOIN build_steps s ON s.build_id = rb.rerun_from_build_id
+	CROSS JOIN LATERAL jsonb_each_text(s.artifacts) a
+	JOIN volumes v ON v.handle = a.value
+	WHERE NOT rb.completed
+	AND v.container_id = c.id
+)`
+
 type containerRepository struct {
 	conn Conn
 }
@@ -221,6 +256,7 @@ func (repository *containerRepository) RemoveDestroyingContainers(workerName str
 func (repository *containerRepository) FindOrphanedContainers() ([]CreatingContainer, []CreatedContainer, []DestroyingContainer, error) {
 	query, args, err := selectContainers("c").
 		LeftJoin("builds b ON b.id = c.build_id").
+		LeftJoin("jobs kj ON kj.id = b.job_id").
 		LeftJoin("containers icc ON icc.id = c.image_check_container_id").
 		LeftJoin("containers igc ON igc.id = c.image_get_container_id").
 		Where(sq.Or{
@@ -229,10 +265,13 @@ func (repository *containerRepository) FindOrphanedContainers() ([]CreatingConta
 				"c.image_check_container_id":         nil,
 				"c.image_get_container_id":           nil,
 				"c.resource_config_check_session_id": nil,
+				"c.prefetch_resource_cache_id":       nil,
 			},
 			sq.And{
 				sq.NotEq{"c.build_id": nil},
 				sq.Eq{"b.interceptible": false},
+				sq.Expr("NOT " + keptBuildContainers),
+				sq.Expr("NOT " + reusedBuildContainers),
 			},
 			sq.And{
 				sq.NotEq{"c.image_check_container_id": nil},
@@ -242,6 +281,20 @@ func (repository *containerRepository) FindOrphanedContainers() ([]CreatingConta
 				sq.NotEq{"c.image_get_container_id": nil},
 				sq.NotEq{"igc.state": atc.ContainerStateCreating},
 			},
+			sq.And{
+				sq.NotEq{"c.prefetch_resource_cache_id": nil},
+				sq.Or{
+					// the prefetch either succeeded, or failed or was
+					// interrupted and is left to be retried in a new container
+					sq.Expr("c.prefetch_expires_at < NOW()"),
+					sq.Expr(`EXISTS (
+						SELECT 1 FROM worker_resource_caches wrc
+						JOIN volumes v ON v.worker_resource_cache_id = wrc.id
+						WHERE wrc.resource_cache_id = c.prefetch_resource_cache_id
+						AND v.worker_name = c.worker_name
+					)`),
+				},
+			},
 		}).
 		ToSql()
 	if err != nil {
@@ -431,3 +484,42 @@ func (repository *containerRepository) DestroyUnknownContainers(workerName strin
 
 	return len(unknownHandles), nil
 }
+
+func (repository *containerRepository) FindContainerRetentions(containerIDs []int) (map[int]ContainerRetention, error) {
+	rows, err := psql.Select("c.id", "b.held", "b.end_time + kj.keep_failed_containers").
+		From("containers c").
+		Join("builds b ON b.id = c.build_id").
+		LeftJoin("jobs kj ON kj.id = b.job_id").
+		Where(sq.Eq{"c.id": containerIDs}).
+		Where(sq.Expr(keptBuildContainers)).
+		RunWith(repository.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	retentions := map[int]ContainerRetention{}
+	for rows.Next() {
+		var (
+			id        int
+			held      bool
+			expiresAt pq.NullTime
+		)
+
+		err = rows.Scan(&id, &held, &expiresAt)
+		if err != nil {
+			return nil, err
+		}
+
+		retention := ContainerRetention{Held: held}
+		if !held {
+			retention.ExpiresAt = expiresAt.Time
+		}
+
+		retentions[id] = retention
+	}
+
+	return retentions, rows.Err()
+}
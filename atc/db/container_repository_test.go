@@ -13,6 +13,57 @@ import (
 )
 
 var _ = Describe("ContainerRepository", func() {
+	Describe("FindContainerRetentions", func() {
+		var (
+			heldBuild, failedBuild, succeededBuild             db.Build
+			heldContainer, failedContainer, succeededContainer db.CreatingContainer
+		)
+
+		BeforeEach(func() {
+			_, err := dbConn.Exec(`UPDATE jobs SET keep_failed_containers = '1 hour' WHERE id = $1`, defaultJob.ID())
+			Expect(err).NotTo(HaveOccurred())
+
+			heldBuild, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(heldBuild.SetHeld(true)).To(Succeed())
+
+			failedBuild, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(failedBuild.Finish(db.BuildStatusFailed)).To(Succeed())
+
+			succeededBuild, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(succeededBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+			heldContainer, err = defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(heldBuild.ID(), "some-plan", defaultTeam.ID()), fullMetadata)
+			Expect(err).NotTo(HaveOccurred())
+
+			failedContainer, err = defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(failedBuild.ID(), "some-plan", defaultTeam.ID()), fullMetadata)
+			Expect(err).NotTo(HaveOccurred())
+
+			succeededContainer, err = defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(succeededBuild.ID(), "some-plan", defaultTeam.ID()), fullMetadata)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns why the containers of finished builds are kept", func() {
+			retentions, err := containerRepository.FindContainerRetentions([]int{
+				heldContainer.ID(),
+				failedContainer.ID(),
+				succeededContainer.ID(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(retentions).To(HaveLen(2))
+			Expect(retentions[heldContainer.ID()]).To(Equal(db.ContainerRetention{Held: true}))
+
+			found, err := failedBuild.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(retentions[failedContainer.ID()].Held).To(BeFalse())
+			Expect(retentions[failedContainer.ID()].ExpiresAt).To(BeTemporally("~", failedBuild.EndTime().Add(time.Hour), time.Second))
+		})
+	})
+
 	Describe("FindOrphanedContainers", func() {
 		Describe("check containers", func() {
 			var (
@@ -206,7 +257,7 @@ var _ = Describe("ContainerRepository", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				creatingContainer, err = defaultWorker.CreateContainer(
@@ -287,6 +338,89 @@ var _ = Describe("ContainerRepository", func() {
 				})
 			})
 
+			Context("when the build is non-interceptible but held", func() {
+				BeforeEach(func() {
+					err := build.SetInterceptible(false)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = build.SetHeld(true)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("does not find container for deletion", func() {
+					creatingContainers, createdContainers, destroyingContainers, err := containerRepository.FindOrphanedContainers()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(creatingContainers).To(BeEmpty())
+					Expect(createdContainers).To(BeEmpty())
+					Expect(destroyingContainers).To(BeEmpty())
+				})
+
+				Context("when the build is released", func() {
+					BeforeEach(func() {
+						err := build.SetHeld(false)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("finds container for deletion", func() {
+						creatingContainers, _, _, err := containerRepository.FindOrphanedContainers()
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(creatingContainers).To(HaveLen(1))
+					})
+				})
+			})
+
+			Context("when the build failed and is non-interceptible", func() {
+				BeforeEach(func() {
+					err := build.Finish(db.BuildStatusFailed)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = build.SetInterceptible(false)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				Context("when its job keeps failed containers for longer than it has been finished", func() {
+					BeforeEach(func() {
+						_, err := dbConn.Exec(`UPDATE jobs SET keep_failed_containers = '1 hour' WHERE id = $1`, defaultJob.ID())
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("does not find container for deletion", func() {
+						creatingContainers, _, _, err := containerRepository.FindOrphanedContainers()
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(creatingContainers).To(BeEmpty())
+					})
+				})
+
+				Context("when its job kept failed containers for less than it has been finished", func() {
+					BeforeEach(func() {
+						_, err := dbConn.Exec(`UPDATE builds SET end_time = now() - '2 hours'::interval WHERE id = $1`, build.ID())
+						Expect(err).NotTo(HaveOccurred())
+
+						_, err = dbConn.Exec(`UPDATE jobs SET keep_failed_containers = '1 hour' WHERE id = $1`, defaultJob.ID())
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("finds container for deletion", func() {
+						creatingContainers, _, _, err := containerRepository.FindOrphanedContainers()
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(creatingContainers).To(HaveLen(1))
+					})
+				})
+
+				Context("when its job does not keep failed containers", func() {
+					It("finds container for deletion", func() {
+						creatingContainers, _, _, err := containerRepository.FindOrphanedContainers()
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(creatingContainers).To(HaveLen(1))
+					})
+				})
+			})
+
 			Context("when build is deleted", func() {
 				BeforeEach(func() {
 					err := defaultPipeline.Destroy()
@@ -305,6 +439,68 @@ var _ = Describe("ContainerRepository", func() {
 			})
 		})
 
+		Describe("containers of builds whose steps are reused by a rerun", func() {
+			var (
+				build             db.Build
+				rerun             db.Build
+				creatingContainer db.CreatingContainer
+			)
+
+			BeforeEach(func() {
+				var err error
+				build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+				Expect(err).NotTo(HaveOccurred())
+
+				creatingContainer, err = defaultWorker.CreateContainer(
+					db.NewBuildStepContainerOwner(build.ID(), "simple-plan", defaultTeam.ID()),
+					fullMetadata,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), creatingContainer, "some-output")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.SaveStep(db.BuildStep{
+					Path:      "do.0/task:some-task",
+					Type:      db.BuildStepTypeTask,
+					Name:      "some-task",
+					Artifacts: map[string]string{"some-output": creatingVolume.Handle()},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(build.Finish(db.BuildStatusFailed)).To(Succeed())
+				Expect(build.SetInterceptible(false)).To(Succeed())
+
+				rerun, err = defaultJob.RerunBuildFromStep(build, "some-other-task", defaultBuildCreatedBy)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("while the rerun is running", func() {
+				It("does not find the container for deletion", func() {
+					creatingContainers, createdContainers, destroyingContainers, err := containerRepository.FindOrphanedContainers()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(creatingContainers).To(BeEmpty())
+					Expect(createdContainers).To(BeEmpty())
+					Expect(destroyingContainers).To(BeEmpty())
+				})
+			})
+
+			Context("once the rerun has finished", func() {
+				BeforeEach(func() {
+					Expect(rerun.Finish(db.BuildStatusSucceeded)).To(Succeed())
+				})
+
+				It("finds the container for deletion", func() {
+					creatingContainers, _, _, err := containerRepository.FindOrphanedContainers()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(creatingContainers).To(HaveLen(1))
+					Expect(creatingContainers[0].Handle()).To(Equal(creatingContainer.Handle()))
+				})
+			})
+		})
+
 		Describe("containers for checking images for creating containers", func() {
 			var (
 				creatingTaskContainer db.CreatingContainer
@@ -314,7 +510,7 @@ var _ = Describe("ContainerRepository", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				creatingTaskContainer, err = defaultWorker.CreateContainer(
@@ -382,7 +578,7 @@ var _ = Describe("ContainerRepository", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				creatingTaskContainer, err = defaultWorker.CreateContainer(
@@ -440,6 +636,59 @@ var _ = Describe("ContainerRepository", func() {
 				})
 			})
 		})
+
+		Describe("containers prefetching resource caches", func() {
+			var creatingContainer db.CreatingContainer
+
+			BeforeEach(func() {
+				resourceCache, err := resourceCacheFactory.FindOrCreateResourceCache(
+					db.ForResource(defaultResource.ID()),
+					"some-base-resource-type",
+					atc.Version{"some": "version"},
+					atc.Source{"some": "source"},
+					nil,
+					atc.VersionedResourceTypes{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				creatingContainer, err = defaultWorker.CreateContainer(
+					db.NewPrefetchContainerOwner(resourceCache, defaultTeam.ID(), time.Hour),
+					fullMetadata,
+				)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("while the prefetch is in progress", func() {
+				It("does not find the container for deletion", func() {
+					creatingContainers, createdContainers, destroyingContainers, err := containerRepository.FindOrphanedContainers()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(creatingContainers).To(BeEmpty())
+					Expect(createdContainers).To(BeEmpty())
+					Expect(destroyingContainers).To(BeEmpty())
+				})
+			})
+
+			Context("when the prefetch has expired without initializing the cache", func() {
+				BeforeEach(func() {
+					_, err := psql.Update("containers").
+						Set("prefetch_expires_at", sq.Expr("NOW() - '1 second'::INTERVAL")).
+						Where(sq.Eq{"handle": creatingContainer.Handle()}).
+						RunWith(dbConn).Exec()
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("finds the container for deletion", func() {
+					creatingContainers, createdContainers, destroyingContainers, err := containerRepository.FindOrphanedContainers()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(creatingContainers).To(HaveLen(1))
+					Expect(creatingContainers[0].Handle()).To(Equal(creatingContainer.Handle()))
+					Expect(createdContainers).To(BeEmpty())
+					Expect(destroyingContainers).To(BeEmpty())
+				})
+			})
+		})
 	})
 
 	Describe("DestroyFailedContainers", func() {
@@ -83,6 +83,8 @@ var (
 	}
 
 	psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	defaultBuildCreatedBy = "some-user"
 )
 
 var _ = BeforeSuite(func() {
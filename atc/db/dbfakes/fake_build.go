@@ -26,6 +26,16 @@ type FakeBuild struct {
 		result1 db.Notifier
 		result2 error
 	}
+	AbortedByStub        func() string
+	abortedByMutex       sync.RWMutex
+	abortedByArgsForCall []struct {
+	}
+	abortedByReturns struct {
+		result1 string
+	}
+	abortedByReturnsOnCall map[int]struct {
+		result1 string
+	}
 	AcquireTrackingLockStub        func(lager.Logger, time.Duration) (lock.Lock, bool, error)
 	acquireTrackingLockMutex       sync.RWMutex
 	acquireTrackingLockArgsForCall []struct {
@@ -95,6 +105,26 @@ type FakeBuild struct {
 		result1 []db.WorkerArtifact
 		result2 error
 	}
+	BreakpointsStub        func() map[string]atc.Breakpoint
+	breakpointsMutex       sync.RWMutex
+	breakpointsArgsForCall []struct {
+	}
+	breakpointsReturns struct {
+		result1 map[string]atc.Breakpoint
+	}
+	breakpointsReturnsOnCall map[int]struct {
+		result1 map[string]atc.Breakpoint
+	}
+	CreatedByStub        func() string
+	createdByMutex       sync.RWMutex
+	createdByArgsForCall []struct {
+	}
+	createdByReturns struct {
+		result1 string
+	}
+	createdByReturnsOnCall map[int]struct {
+		result1 string
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -213,6 +243,16 @@ type FakeBuild struct {
 	isDrainedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsHeldStub        func() bool
+	isHeldMutex       sync.RWMutex
+	isHeldArgsForCall []struct {
+	}
+	isHeldReturns struct {
+		result1 bool
+	}
+	isHeldReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsManuallyTriggeredStub        func() bool
 	isManuallyTriggeredMutex       sync.RWMutex
 	isManuallyTriggeredArgsForCall []struct {
@@ -234,6 +274,16 @@ type FakeBuild struct {
 	isNewerThanLastCheckOfReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsPausedAtBreakpointStub        func() bool
+	isPausedAtBreakpointMutex       sync.RWMutex
+	isPausedAtBreakpointArgsForCall []struct {
+	}
+	isPausedAtBreakpointReturns struct {
+		result1 bool
+	}
+	isPausedAtBreakpointReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsRunningStub        func() bool
 	isRunningMutex       sync.RWMutex
 	isRunningArgsForCall []struct {
@@ -274,9 +324,10 @@ type FakeBuild struct {
 	jobNameReturnsOnCall map[int]struct {
 		result1 string
 	}
-	MarkAsAbortedStub        func() error
+	MarkAsAbortedStub        func(string) error
 	markAsAbortedMutex       sync.RWMutex
 	markAsAbortedArgsForCall []struct {
+		arg1 string
 	}
 	markAsAbortedReturns struct {
 		result1 error
@@ -294,6 +345,16 @@ type FakeBuild struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PauseAtBreakpointStub        func() error
+	pauseAtBreakpointMutex       sync.RWMutex
+	pauseAtBreakpointArgsForCall []struct {
+	}
+	pauseAtBreakpointReturns struct {
+		result1 error
+	}
+	pauseAtBreakpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	PipelineStub        func() (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -384,6 +445,16 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	RerunFromStepStub        func() string
+	rerunFromStepMutex       sync.RWMutex
+	rerunFromStepArgsForCall []struct {
+	}
+	rerunFromStepReturns struct {
+		result1 string
+	}
+	rerunFromStepReturnsOnCall map[int]struct {
+		result1 string
+	}
 	RerunNumberStub        func() int
 	rerunNumberMutex       sync.RWMutex
 	rerunNumberArgsForCall []struct {
@@ -440,6 +511,42 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	ResumeStub        func() (bool, error)
+	resumeMutex       sync.RWMutex
+	resumeArgsForCall []struct {
+	}
+	resumeReturns struct {
+		result1 bool
+		result2 error
+	}
+	resumeReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ResumeNotifierStub        func() (db.Notifier, error)
+	resumeNotifierMutex       sync.RWMutex
+	resumeNotifierArgsForCall []struct {
+	}
+	resumeNotifierReturns struct {
+		result1 db.Notifier
+		result2 error
+	}
+	resumeNotifierReturnsOnCall map[int]struct {
+		result1 db.Notifier
+		result2 error
+	}
+	ReusableStepsStub        func() ([]db.BuildStep, error)
+	reusableStepsMutex       sync.RWMutex
+	reusableStepsArgsForCall []struct {
+	}
+	reusableStepsReturns struct {
+		result1 []db.BuildStep
+		result2 error
+	}
+	reusableStepsReturnsOnCall map[int]struct {
+		result1 []db.BuildStep
+		result2 error
+	}
 	SaveEventStub        func(atc.Event) error
 	saveEventMutex       sync.RWMutex
 	saveEventArgsForCall []struct {
@@ -479,6 +586,17 @@ type FakeBuild struct {
 	saveOutputReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveStepStub        func(db.BuildStep) error
+	saveStepMutex       sync.RWMutex
+	saveStepArgsForCall []struct {
+		arg1 db.BuildStep
+	}
+	saveStepReturns struct {
+		result1 error
+	}
+	saveStepReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SchemaStub        func() string
 	schemaMutex       sync.RWMutex
 	schemaArgsForCall []struct {
@@ -500,6 +618,17 @@ type FakeBuild struct {
 	setDrainedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetHeldStub        func(bool) error
+	setHeldMutex       sync.RWMutex
+	setHeldArgsForCall []struct {
+		arg1 bool
+	}
+	setHeldReturns struct {
+		result1 error
+	}
+	setHeldReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetInterceptibleStub        func(bool) error
 	setInterceptibleMutex       sync.RWMutex
 	setInterceptibleArgsForCall []struct {
@@ -633,6 +762,58 @@ func (fake *FakeBuild) AbortNotifierReturnsOnCall(i int, result1 db.Notifier, re
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) AbortedBy() string {
+	fake.abortedByMutex.Lock()
+	ret, specificReturn := fake.abortedByReturnsOnCall[len(fake.abortedByArgsForCall)]
+	fake.abortedByArgsForCall = append(fake.abortedByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AbortedBy", []interface{}{})
+	fake.abortedByMutex.Unlock()
+	if fake.AbortedByStub != nil {
+		return fake.AbortedByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.abortedByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) AbortedByCallCount() int {
+	fake.abortedByMutex.RLock()
+	defer fake.abortedByMutex.RUnlock()
+	return len(fake.abortedByArgsForCall)
+}
+
+func (fake *FakeBuild) AbortedByCalls(stub func() string) {
+	fake.abortedByMutex.Lock()
+	defer fake.abortedByMutex.Unlock()
+	fake.AbortedByStub = stub
+}
+
+func (fake *FakeBuild) AbortedByReturns(result1 string) {
+	fake.abortedByMutex.Lock()
+	defer fake.abortedByMutex.Unlock()
+	fake.AbortedByStub = nil
+	fake.abortedByReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) AbortedByReturnsOnCall(i int, result1 string) {
+	fake.abortedByMutex.Lock()
+	defer fake.abortedByMutex.Unlock()
+	fake.AbortedByStub = nil
+	if fake.abortedByReturnsOnCall == nil {
+		fake.abortedByReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.abortedByReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) AcquireTrackingLock(arg1 lager.Logger, arg2 time.Duration) (lock.Lock, bool, error) {
 	fake.acquireTrackingLockMutex.Lock()
 	ret, specificReturn := fake.acquireTrackingLockReturnsOnCall[len(fake.acquireTrackingLockArgsForCall)]
@@ -934,6 +1115,110 @@ func (fake *FakeBuild) ArtifactsReturnsOnCall(i int, result1 []db.WorkerArtifact
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) Breakpoints() map[string]atc.Breakpoint {
+	fake.breakpointsMutex.Lock()
+	ret, specificReturn := fake.breakpointsReturnsOnCall[len(fake.breakpointsArgsForCall)]
+	fake.breakpointsArgsForCall = append(fake.breakpointsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Breakpoints", []interface{}{})
+	fake.breakpointsMutex.Unlock()
+	if fake.BreakpointsStub != nil {
+		return fake.BreakpointsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.breakpointsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) BreakpointsCallCount() int {
+	fake.breakpointsMutex.RLock()
+	defer fake.breakpointsMutex.RUnlock()
+	return len(fake.breakpointsArgsForCall)
+}
+
+func (fake *FakeBuild) BreakpointsCalls(stub func() map[string]atc.Breakpoint) {
+	fake.breakpointsMutex.Lock()
+	defer fake.breakpointsMutex.Unlock()
+	fake.BreakpointsStub = stub
+}
+
+func (fake *FakeBuild) BreakpointsReturns(result1 map[string]atc.Breakpoint) {
+	fake.breakpointsMutex.Lock()
+	defer fake.breakpointsMutex.Unlock()
+	fake.BreakpointsStub = nil
+	fake.breakpointsReturns = struct {
+		result1 map[string]atc.Breakpoint
+	}{result1}
+}
+
+func (fake *FakeBuild) BreakpointsReturnsOnCall(i int, result1 map[string]atc.Breakpoint) {
+	fake.breakpointsMutex.Lock()
+	defer fake.breakpointsMutex.Unlock()
+	fake.BreakpointsStub = nil
+	if fake.breakpointsReturnsOnCall == nil {
+		fake.breakpointsReturnsOnCall = make(map[int]struct {
+			result1 map[string]atc.Breakpoint
+		})
+	}
+	fake.breakpointsReturnsOnCall[i] = struct {
+		result1 map[string]atc.Breakpoint
+	}{result1}
+}
+
+func (fake *FakeBuild) CreatedBy() string {
+	fake.createdByMutex.Lock()
+	ret, specificReturn := fake.createdByReturnsOnCall[len(fake.createdByArgsForCall)]
+	fake.createdByArgsForCall = append(fake.createdByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreatedBy", []interface{}{})
+	fake.createdByMutex.Unlock()
+	if fake.CreatedByStub != nil {
+		return fake.CreatedByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createdByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CreatedByCallCount() int {
+	fake.createdByMutex.RLock()
+	defer fake.createdByMutex.RUnlock()
+	return len(fake.createdByArgsForCall)
+}
+
+func (fake *FakeBuild) CreatedByCalls(stub func() string) {
+	fake.createdByMutex.Lock()
+	defer fake.createdByMutex.Unlock()
+	fake.CreatedByStub = stub
+}
+
+func (fake *FakeBuild) CreatedByReturns(result1 string) {
+	fake.createdByMutex.Lock()
+	defer fake.createdByMutex.Unlock()
+	fake.CreatedByStub = nil
+	fake.createdByReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) CreatedByReturnsOnCall(i int, result1 string) {
+	fake.createdByMutex.Lock()
+	defer fake.createdByMutex.Unlock()
+	fake.CreatedByStub = nil
+	if fake.createdByReturnsOnCall == nil {
+		fake.createdByReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.createdByReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1531,6 +1816,58 @@ func (fake *FakeBuild) IsDrainedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeBuild) IsHeld() bool {
+	fake.isHeldMutex.Lock()
+	ret, specificReturn := fake.isHeldReturnsOnCall[len(fake.isHeldArgsForCall)]
+	fake.isHeldArgsForCall = append(fake.isHeldArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IsHeld", []interface{}{})
+	fake.isHeldMutex.Unlock()
+	if fake.IsHeldStub != nil {
+		return fake.IsHeldStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isHeldReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) IsHeldCallCount() int {
+	fake.isHeldMutex.RLock()
+	defer fake.isHeldMutex.RUnlock()
+	return len(fake.isHeldArgsForCall)
+}
+
+func (fake *FakeBuild) IsHeldCalls(stub func() bool) {
+	fake.isHeldMutex.Lock()
+	defer fake.isHeldMutex.Unlock()
+	fake.IsHeldStub = stub
+}
+
+func (fake *FakeBuild) IsHeldReturns(result1 bool) {
+	fake.isHeldMutex.Lock()
+	defer fake.isHeldMutex.Unlock()
+	fake.IsHeldStub = nil
+	fake.isHeldReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) IsHeldReturnsOnCall(i int, result1 bool) {
+	fake.isHeldMutex.Lock()
+	defer fake.isHeldMutex.Unlock()
+	fake.IsHeldStub = nil
+	if fake.isHeldReturnsOnCall == nil {
+		fake.isHeldReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isHeldReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeBuild) IsManuallyTriggered() bool {
 	fake.isManuallyTriggeredMutex.Lock()
 	ret, specificReturn := fake.isManuallyTriggeredReturnsOnCall[len(fake.isManuallyTriggeredArgsForCall)]
@@ -1643,6 +1980,58 @@ func (fake *FakeBuild) IsNewerThanLastCheckOfReturnsOnCall(i int, result1 bool)
 	}{result1}
 }
 
+func (fake *FakeBuild) IsPausedAtBreakpoint() bool {
+	fake.isPausedAtBreakpointMutex.Lock()
+	ret, specificReturn := fake.isPausedAtBreakpointReturnsOnCall[len(fake.isPausedAtBreakpointArgsForCall)]
+	fake.isPausedAtBreakpointArgsForCall = append(fake.isPausedAtBreakpointArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IsPausedAtBreakpoint", []interface{}{})
+	fake.isPausedAtBreakpointMutex.Unlock()
+	if fake.IsPausedAtBreakpointStub != nil {
+		return fake.IsPausedAtBreakpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isPausedAtBreakpointReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) IsPausedAtBreakpointCallCount() int {
+	fake.isPausedAtBreakpointMutex.RLock()
+	defer fake.isPausedAtBreakpointMutex.RUnlock()
+	return len(fake.isPausedAtBreakpointArgsForCall)
+}
+
+func (fake *FakeBuild) IsPausedAtBreakpointCalls(stub func() bool) {
+	fake.isPausedAtBreakpointMutex.Lock()
+	defer fake.isPausedAtBreakpointMutex.Unlock()
+	fake.IsPausedAtBreakpointStub = stub
+}
+
+func (fake *FakeBuild) IsPausedAtBreakpointReturns(result1 bool) {
+	fake.isPausedAtBreakpointMutex.Lock()
+	defer fake.isPausedAtBreakpointMutex.Unlock()
+	fake.IsPausedAtBreakpointStub = nil
+	fake.isPausedAtBreakpointReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) IsPausedAtBreakpointReturnsOnCall(i int, result1 bool) {
+	fake.isPausedAtBreakpointMutex.Lock()
+	defer fake.isPausedAtBreakpointMutex.Unlock()
+	fake.IsPausedAtBreakpointStub = nil
+	if fake.isPausedAtBreakpointReturnsOnCall == nil {
+		fake.isPausedAtBreakpointReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isPausedAtBreakpointReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeBuild) IsRunning() bool {
 	fake.isRunningMutex.Lock()
 	ret, specificReturn := fake.isRunningReturnsOnCall[len(fake.isRunningArgsForCall)]
@@ -1851,15 +2240,16 @@ func (fake *FakeBuild) JobNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeBuild) MarkAsAborted() error {
+func (fake *FakeBuild) MarkAsAborted(arg1 string) error {
 	fake.markAsAbortedMutex.Lock()
 	ret, specificReturn := fake.markAsAbortedReturnsOnCall[len(fake.markAsAbortedArgsForCall)]
 	fake.markAsAbortedArgsForCall = append(fake.markAsAbortedArgsForCall, struct {
-	}{})
-	fake.recordInvocation("MarkAsAborted", []interface{}{})
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("MarkAsAborted", []interface{}{arg1})
 	fake.markAsAbortedMutex.Unlock()
 	if fake.MarkAsAbortedStub != nil {
-		return fake.MarkAsAbortedStub()
+		return fake.MarkAsAbortedStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1874,12 +2264,19 @@ func (fake *FakeBuild) MarkAsAbortedCallCount() int {
 	return len(fake.markAsAbortedArgsForCall)
 }
 
-func (fake *FakeBuild) MarkAsAbortedCalls(stub func() error) {
+func (fake *FakeBuild) MarkAsAbortedCalls(stub func(string) error) {
 	fake.markAsAbortedMutex.Lock()
 	defer fake.markAsAbortedMutex.Unlock()
 	fake.MarkAsAbortedStub = stub
 }
 
+func (fake *FakeBuild) MarkAsAbortedArgsForCall(i int) string {
+	fake.markAsAbortedMutex.RLock()
+	defer fake.markAsAbortedMutex.RUnlock()
+	argsForCall := fake.markAsAbortedArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeBuild) MarkAsAbortedReturns(result1 error) {
 	fake.markAsAbortedMutex.Lock()
 	defer fake.markAsAbortedMutex.Unlock()
@@ -1955,6 +2352,58 @@ func (fake *FakeBuild) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) PauseAtBreakpoint() error {
+	fake.pauseAtBreakpointMutex.Lock()
+	ret, specificReturn := fake.pauseAtBreakpointReturnsOnCall[len(fake.pauseAtBreakpointArgsForCall)]
+	fake.pauseAtBreakpointArgsForCall = append(fake.pauseAtBreakpointArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PauseAtBreakpoint", []interface{}{})
+	fake.pauseAtBreakpointMutex.Unlock()
+	if fake.PauseAtBreakpointStub != nil {
+		return fake.PauseAtBreakpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pauseAtBreakpointReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PauseAtBreakpointCallCount() int {
+	fake.pauseAtBreakpointMutex.RLock()
+	defer fake.pauseAtBreakpointMutex.RUnlock()
+	return len(fake.pauseAtBreakpointArgsForCall)
+}
+
+func (fake *FakeBuild) PauseAtBreakpointCalls(stub func() error) {
+	fake.pauseAtBreakpointMutex.Lock()
+	defer fake.pauseAtBreakpointMutex.Unlock()
+	fake.PauseAtBreakpointStub = stub
+}
+
+func (fake *FakeBuild) PauseAtBreakpointReturns(result1 error) {
+	fake.pauseAtBreakpointMutex.Lock()
+	defer fake.pauseAtBreakpointMutex.Unlock()
+	fake.PauseAtBreakpointStub = nil
+	fake.pauseAtBreakpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) PauseAtBreakpointReturnsOnCall(i int, result1 error) {
+	fake.pauseAtBreakpointMutex.Lock()
+	defer fake.pauseAtBreakpointMutex.Unlock()
+	fake.PauseAtBreakpointStub = nil
+	if fake.pauseAtBreakpointReturnsOnCall == nil {
+		fake.pauseAtBreakpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pauseAtBreakpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Pipeline() (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -2386,6 +2835,58 @@ func (fake *FakeBuild) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) RerunFromStep() string {
+	fake.rerunFromStepMutex.Lock()
+	ret, specificReturn := fake.rerunFromStepReturnsOnCall[len(fake.rerunFromStepArgsForCall)]
+	fake.rerunFromStepArgsForCall = append(fake.rerunFromStepArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunFromStep", []interface{}{})
+	fake.rerunFromStepMutex.Unlock()
+	if fake.RerunFromStepStub != nil {
+		return fake.RerunFromStepStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rerunFromStepReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RerunFromStepCallCount() int {
+	fake.rerunFromStepMutex.RLock()
+	defer fake.rerunFromStepMutex.RUnlock()
+	return len(fake.rerunFromStepArgsForCall)
+}
+
+func (fake *FakeBuild) RerunFromStepCalls(stub func() string) {
+	fake.rerunFromStepMutex.Lock()
+	defer fake.rerunFromStepMutex.Unlock()
+	fake.RerunFromStepStub = stub
+}
+
+func (fake *FakeBuild) RerunFromStepReturns(result1 string) {
+	fake.rerunFromStepMutex.Lock()
+	defer fake.rerunFromStepMutex.Unlock()
+	fake.RerunFromStepStub = nil
+	fake.rerunFromStepReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunFromStepReturnsOnCall(i int, result1 string) {
+	fake.rerunFromStepMutex.Lock()
+	defer fake.rerunFromStepMutex.Unlock()
+	fake.RerunFromStepStub = nil
+	if fake.rerunFromStepReturnsOnCall == nil {
+		fake.rerunFromStepReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.rerunFromStepReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) RerunNumber() int {
 	fake.rerunNumberMutex.Lock()
 	ret, specificReturn := fake.rerunNumberReturnsOnCall[len(fake.rerunNumberArgsForCall)]
@@ -2655,6 +3156,171 @@ func (fake *FakeBuild) ResourcesCheckedReturnsOnCall(i int, result1 bool, result
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) Resume() (bool, error) {
+	fake.resumeMutex.Lock()
+	ret, specificReturn := fake.resumeReturnsOnCall[len(fake.resumeArgsForCall)]
+	fake.resumeArgsForCall = append(fake.resumeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Resume", []interface{}{})
+	fake.resumeMutex.Unlock()
+	if fake.ResumeStub != nil {
+		return fake.ResumeStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.resumeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ResumeCallCount() int {
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
+	return len(fake.resumeArgsForCall)
+}
+
+func (fake *FakeBuild) ResumeCalls(stub func() (bool, error)) {
+	fake.resumeMutex.Lock()
+	defer fake.resumeMutex.Unlock()
+	fake.ResumeStub = stub
+}
+
+func (fake *FakeBuild) ResumeReturns(result1 bool, result2 error) {
+	fake.resumeMutex.Lock()
+	defer fake.resumeMutex.Unlock()
+	fake.ResumeStub = nil
+	fake.resumeReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ResumeReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.resumeMutex.Lock()
+	defer fake.resumeMutex.Unlock()
+	fake.ResumeStub = nil
+	if fake.resumeReturnsOnCall == nil {
+		fake.resumeReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.resumeReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ResumeNotifier() (db.Notifier, error) {
+	fake.resumeNotifierMutex.Lock()
+	ret, specificReturn := fake.resumeNotifierReturnsOnCall[len(fake.resumeNotifierArgsForCall)]
+	fake.resumeNotifierArgsForCall = append(fake.resumeNotifierArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResumeNotifier", []interface{}{})
+	fake.resumeNotifierMutex.Unlock()
+	if fake.ResumeNotifierStub != nil {
+		return fake.ResumeNotifierStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.resumeNotifierReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ResumeNotifierCallCount() int {
+	fake.resumeNotifierMutex.RLock()
+	defer fake.resumeNotifierMutex.RUnlock()
+	return len(fake.resumeNotifierArgsForCall)
+}
+
+func (fake *FakeBuild) ResumeNotifierCalls(stub func() (db.Notifier, error)) {
+	fake.resumeNotifierMutex.Lock()
+	defer fake.resumeNotifierMutex.Unlock()
+	fake.ResumeNotifierStub = stub
+}
+
+func (fake *FakeBuild) ResumeNotifierReturns(result1 db.Notifier, result2 error) {
+	fake.resumeNotifierMutex.Lock()
+	defer fake.resumeNotifierMutex.Unlock()
+	fake.ResumeNotifierStub = nil
+	fake.resumeNotifierReturns = struct {
+		result1 db.Notifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ResumeNotifierReturnsOnCall(i int, result1 db.Notifier, result2 error) {
+	fake.resumeNotifierMutex.Lock()
+	defer fake.resumeNotifierMutex.Unlock()
+	fake.ResumeNotifierStub = nil
+	if fake.resumeNotifierReturnsOnCall == nil {
+		fake.resumeNotifierReturnsOnCall = make(map[int]struct {
+			result1 db.Notifier
+			result2 error
+		})
+	}
+	fake.resumeNotifierReturnsOnCall[i] = struct {
+		result1 db.Notifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ReusableSteps() ([]db.BuildStep, error) {
+	fake.reusableStepsMutex.Lock()
+	ret, specificReturn := fake.reusableStepsReturnsOnCall[len(fake.reusableStepsArgsForCall)]
+	fake.reusableStepsArgsForCall = append(fake.reusableStepsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ReusableSteps", []interface{}{})
+	fake.reusableStepsMutex.Unlock()
+	if fake.ReusableStepsStub != nil {
+		return fake.ReusableStepsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.reusableStepsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ReusableStepsCallCount() int {
+	fake.reusableStepsMutex.RLock()
+	defer fake.reusableStepsMutex.RUnlock()
+	return len(fake.reusableStepsArgsForCall)
+}
+
+func (fake *FakeBuild) ReusableStepsCalls(stub func() ([]db.BuildStep, error)) {
+	fake.reusableStepsMutex.Lock()
+	defer fake.reusableStepsMutex.Unlock()
+	fake.ReusableStepsStub = stub
+}
+
+func (fake *FakeBuild) ReusableStepsReturns(result1 []db.BuildStep, result2 error) {
+	fake.reusableStepsMutex.Lock()
+	defer fake.reusableStepsMutex.Unlock()
+	fake.ReusableStepsStub = nil
+	fake.reusableStepsReturns = struct {
+		result1 []db.BuildStep
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ReusableStepsReturnsOnCall(i int, result1 []db.BuildStep, result2 error) {
+	fake.reusableStepsMutex.Lock()
+	defer fake.reusableStepsMutex.Unlock()
+	fake.ReusableStepsStub = nil
+	if fake.reusableStepsReturnsOnCall == nil {
+		fake.reusableStepsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildStep
+			result2 error
+		})
+	}
+	fake.reusableStepsReturnsOnCall[i] = struct {
+		result1 []db.BuildStep
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) SaveEvent(arg1 atc.Event) error {
 	fake.saveEventMutex.Lock()
 	ret, specificReturn := fake.saveEventReturnsOnCall[len(fake.saveEventArgsForCall)]
@@ -2841,6 +3507,66 @@ func (fake *FakeBuild) SaveOutputReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SaveStep(arg1 db.BuildStep) error {
+	fake.saveStepMutex.Lock()
+	ret, specificReturn := fake.saveStepReturnsOnCall[len(fake.saveStepArgsForCall)]
+	fake.saveStepArgsForCall = append(fake.saveStepArgsForCall, struct {
+		arg1 db.BuildStep
+	}{arg1})
+	fake.recordInvocation("SaveStep", []interface{}{arg1})
+	fake.saveStepMutex.Unlock()
+	if fake.SaveStepStub != nil {
+		return fake.SaveStepStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveStepReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveStepCallCount() int {
+	fake.saveStepMutex.RLock()
+	defer fake.saveStepMutex.RUnlock()
+	return len(fake.saveStepArgsForCall)
+}
+
+func (fake *FakeBuild) SaveStepCalls(stub func(db.BuildStep) error) {
+	fake.saveStepMutex.Lock()
+	defer fake.saveStepMutex.Unlock()
+	fake.SaveStepStub = stub
+}
+
+func (fake *FakeBuild) SaveStepArgsForCall(i int) db.BuildStep {
+	fake.saveStepMutex.RLock()
+	defer fake.saveStepMutex.RUnlock()
+	argsForCall := fake.saveStepArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SaveStepReturns(result1 error) {
+	fake.saveStepMutex.Lock()
+	defer fake.saveStepMutex.Unlock()
+	fake.SaveStepStub = nil
+	fake.saveStepReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveStepReturnsOnCall(i int, result1 error) {
+	fake.saveStepMutex.Lock()
+	defer fake.saveStepMutex.Unlock()
+	fake.SaveStepStub = nil
+	if fake.saveStepReturnsOnCall == nil {
+		fake.saveStepReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveStepReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Schema() string {
 	fake.schemaMutex.Lock()
 	ret, specificReturn := fake.schemaReturnsOnCall[len(fake.schemaArgsForCall)]
@@ -2953,6 +3679,66 @@ func (fake *FakeBuild) SetDrainedReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SetHeld(arg1 bool) error {
+	fake.setHeldMutex.Lock()
+	ret, specificReturn := fake.setHeldReturnsOnCall[len(fake.setHeldArgsForCall)]
+	fake.setHeldArgsForCall = append(fake.setHeldArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	fake.recordInvocation("SetHeld", []interface{}{arg1})
+	fake.setHeldMutex.Unlock()
+	if fake.SetHeldStub != nil {
+		return fake.SetHeldStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setHeldReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetHeldCallCount() int {
+	fake.setHeldMutex.RLock()
+	defer fake.setHeldMutex.RUnlock()
+	return len(fake.setHeldArgsForCall)
+}
+
+func (fake *FakeBuild) SetHeldCalls(stub func(bool) error) {
+	fake.setHeldMutex.Lock()
+	defer fake.setHeldMutex.Unlock()
+	fake.SetHeldStub = stub
+}
+
+func (fake *FakeBuild) SetHeldArgsForCall(i int) bool {
+	fake.setHeldMutex.RLock()
+	defer fake.setHeldMutex.RUnlock()
+	argsForCall := fake.setHeldArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SetHeldReturns(result1 error) {
+	fake.setHeldMutex.Lock()
+	defer fake.setHeldMutex.Unlock()
+	fake.SetHeldStub = nil
+	fake.setHeldReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetHeldReturnsOnCall(i int, result1 error) {
+	fake.setHeldMutex.Lock()
+	defer fake.setHeldMutex.Unlock()
+	fake.SetHeldStub = nil
+	if fake.setHeldReturnsOnCall == nil {
+		fake.setHeldReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setHeldReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SetInterceptible(arg1 bool) error {
 	fake.setInterceptibleMutex.Lock()
 	ret, specificReturn := fake.setInterceptibleReturnsOnCall[len(fake.setInterceptibleArgsForCall)]
@@ -3341,6 +4127,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.abortNotifierMutex.RLock()
 	defer fake.abortNotifierMutex.RUnlock()
+	fake.abortedByMutex.RLock()
+	defer fake.abortedByMutex.RUnlock()
 	fake.acquireTrackingLockMutex.RLock()
 	defer fake.acquireTrackingLockMutex.RUnlock()
 	fake.adoptInputsAndPipesMutex.RLock()
@@ -3351,6 +4139,10 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
+	fake.breakpointsMutex.RLock()
+	defer fake.breakpointsMutex.RUnlock()
+	fake.createdByMutex.RLock()
+	defer fake.createdByMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
@@ -3373,10 +4165,14 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.isCompletedMutex.RUnlock()
 	fake.isDrainedMutex.RLock()
 	defer fake.isDrainedMutex.RUnlock()
+	fake.isHeldMutex.RLock()
+	defer fake.isHeldMutex.RUnlock()
 	fake.isManuallyTriggeredMutex.RLock()
 	defer fake.isManuallyTriggeredMutex.RUnlock()
 	fake.isNewerThanLastCheckOfMutex.RLock()
 	defer fake.isNewerThanLastCheckOfMutex.RUnlock()
+	fake.isPausedAtBreakpointMutex.RLock()
+	defer fake.isPausedAtBreakpointMutex.RUnlock()
 	fake.isRunningMutex.RLock()
 	defer fake.isRunningMutex.RUnlock()
 	fake.isScheduledMutex.RLock()
@@ -3389,6 +4185,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.markAsAbortedMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.pauseAtBreakpointMutex.RLock()
+	defer fake.pauseAtBreakpointMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
@@ -3405,6 +4203,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.reapTimeMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.rerunFromStepMutex.RLock()
+	defer fake.rerunFromStepMutex.RUnlock()
 	fake.rerunNumberMutex.RLock()
 	defer fake.rerunNumberMutex.RUnlock()
 	fake.rerunOfMutex.RLock()
@@ -3415,16 +4215,26 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.resourcesMutex.RUnlock()
 	fake.resourcesCheckedMutex.RLock()
 	defer fake.resourcesCheckedMutex.RUnlock()
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
+	fake.resumeNotifierMutex.RLock()
+	defer fake.resumeNotifierMutex.RUnlock()
+	fake.reusableStepsMutex.RLock()
+	defer fake.reusableStepsMutex.RUnlock()
 	fake.saveEventMutex.RLock()
 	defer fake.saveEventMutex.RUnlock()
 	fake.saveImageResourceVersionMutex.RLock()
 	defer fake.saveImageResourceVersionMutex.RUnlock()
 	fake.saveOutputMutex.RLock()
 	defer fake.saveOutputMutex.RUnlock()
+	fake.saveStepMutex.RLock()
+	defer fake.saveStepMutex.RUnlock()
 	fake.schemaMutex.RLock()
 	defer fake.schemaMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
 	defer fake.setDrainedMutex.RUnlock()
+	fake.setHeldMutex.RLock()
+	defer fake.setHeldMutex.RUnlock()
 	fake.setInterceptibleMutex.RLock()
 	defer fake.setInterceptibleMutex.RUnlock()
 	fake.spanContextMutex.RLock()
@@ -0,0 +1,252 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeBuildEventLifecycle struct {
+	CollectDeletedBuildEventsStub        func() error
+	collectDeletedBuildEventsMutex       sync.RWMutex
+	collectDeletedBuildEventsArgsForCall []struct {
+	}
+	collectDeletedBuildEventsReturns struct {
+		result1 error
+	}
+	collectDeletedBuildEventsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FinalizeBuildEventsStub        func(db.Build) error
+	finalizeBuildEventsMutex       sync.RWMutex
+	finalizeBuildEventsArgsForCall []struct {
+		arg1 db.Build
+	}
+	finalizeBuildEventsReturns struct {
+		result1 error
+	}
+	finalizeBuildEventsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UnfinalizedBuildsStub        func(int) ([]db.Build, error)
+	unfinalizedBuildsMutex       sync.RWMutex
+	unfinalizedBuildsArgsForCall []struct {
+		arg1 int
+	}
+	unfinalizedBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	unfinalizedBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBuildEventLifecycle) CollectDeletedBuildEvents() error {
+	fake.collectDeletedBuildEventsMutex.Lock()
+	ret, specificReturn := fake.collectDeletedBuildEventsReturnsOnCall[len(fake.collectDeletedBuildEventsArgsForCall)]
+	fake.collectDeletedBuildEventsArgsForCall = append(fake.collectDeletedBuildEventsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CollectDeletedBuildEvents", []interface{}{})
+	fake.collectDeletedBuildEventsMutex.Unlock()
+	if fake.CollectDeletedBuildEventsStub != nil {
+		return fake.CollectDeletedBuildEventsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.collectDeletedBuildEventsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildEventLifecycle) CollectDeletedBuildEventsCallCount() int {
+	fake.collectDeletedBuildEventsMutex.RLock()
+	defer fake.collectDeletedBuildEventsMutex.RUnlock()
+	return len(fake.collectDeletedBuildEventsArgsForCall)
+}
+
+func (fake *FakeBuildEventLifecycle) CollectDeletedBuildEventsCalls(stub func() error) {
+	fake.collectDeletedBuildEventsMutex.Lock()
+	defer fake.collectDeletedBuildEventsMutex.Unlock()
+	fake.CollectDeletedBuildEventsStub = stub
+}
+
+func (fake *FakeBuildEventLifecycle) CollectDeletedBuildEventsReturns(result1 error) {
+	fake.collectDeletedBuildEventsMutex.Lock()
+	defer fake.collectDeletedBuildEventsMutex.Unlock()
+	fake.CollectDeletedBuildEventsStub = nil
+	fake.collectDeletedBuildEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildEventLifecycle) CollectDeletedBuildEventsReturnsOnCall(i int, result1 error) {
+	fake.collectDeletedBuildEventsMutex.Lock()
+	defer fake.collectDeletedBuildEventsMutex.Unlock()
+	fake.CollectDeletedBuildEventsStub = nil
+	if fake.collectDeletedBuildEventsReturnsOnCall == nil {
+		fake.collectDeletedBuildEventsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.collectDeletedBuildEventsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildEventLifecycle) FinalizeBuildEvents(arg1 db.Build) error {
+	fake.finalizeBuildEventsMutex.Lock()
+	ret, specificReturn := fake.finalizeBuildEventsReturnsOnCall[len(fake.finalizeBuildEventsArgsForCall)]
+	fake.finalizeBuildEventsArgsForCall = append(fake.finalizeBuildEventsArgsForCall, struct {
+		arg1 db.Build
+	}{arg1})
+	fake.recordInvocation("FinalizeBuildEvents", []interface{}{arg1})
+	fake.finalizeBuildEventsMutex.Unlock()
+	if fake.FinalizeBuildEventsStub != nil {
+		return fake.FinalizeBuildEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.finalizeBuildEventsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildEventLifecycle) FinalizeBuildEventsCallCount() int {
+	fake.finalizeBuildEventsMutex.RLock()
+	defer fake.finalizeBuildEventsMutex.RUnlock()
+	return len(fake.finalizeBuildEventsArgsForCall)
+}
+
+func (fake *FakeBuildEventLifecycle) FinalizeBuildEventsCalls(stub func(db.Build) error) {
+	fake.finalizeBuildEventsMutex.Lock()
+	defer fake.finalizeBuildEventsMutex.Unlock()
+	fake.FinalizeBuildEventsStub = stub
+}
+
+func (fake *FakeBuildEventLifecycle) FinalizeBuildEventsArgsForCall(i int) db.Build {
+	fake.finalizeBuildEventsMutex.RLock()
+	defer fake.finalizeBuildEventsMutex.RUnlock()
+	argsForCall := fake.finalizeBuildEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildEventLifecycle) FinalizeBuildEventsReturns(result1 error) {
+	fake.finalizeBuildEventsMutex.Lock()
+	defer fake.finalizeBuildEventsMutex.Unlock()
+	fake.FinalizeBuildEventsStub = nil
+	fake.finalizeBuildEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildEventLifecycle) FinalizeBuildEventsReturnsOnCall(i int, result1 error) {
+	fake.finalizeBuildEventsMutex.Lock()
+	defer fake.finalizeBuildEventsMutex.Unlock()
+	fake.FinalizeBuildEventsStub = nil
+	if fake.finalizeBuildEventsReturnsOnCall == nil {
+		fake.finalizeBuildEventsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.finalizeBuildEventsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildEventLifecycle) UnfinalizedBuilds(arg1 int) ([]db.Build, error) {
+	fake.unfinalizedBuildsMutex.Lock()
+	ret, specificReturn := fake.unfinalizedBuildsReturnsOnCall[len(fake.unfinalizedBuildsArgsForCall)]
+	fake.unfinalizedBuildsArgsForCall = append(fake.unfinalizedBuildsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("UnfinalizedBuilds", []interface{}{arg1})
+	fake.unfinalizedBuildsMutex.Unlock()
+	if fake.UnfinalizedBuildsStub != nil {
+		return fake.UnfinalizedBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unfinalizedBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildEventLifecycle) UnfinalizedBuildsCallCount() int {
+	fake.unfinalizedBuildsMutex.RLock()
+	defer fake.unfinalizedBuildsMutex.RUnlock()
+	return len(fake.unfinalizedBuildsArgsForCall)
+}
+
+func (fake *FakeBuildEventLifecycle) UnfinalizedBuildsCalls(stub func(int) ([]db.Build, error)) {
+	fake.unfinalizedBuildsMutex.Lock()
+	defer fake.unfinalizedBuildsMutex.Unlock()
+	fake.UnfinalizedBuildsStub = stub
+}
+
+func (fake *FakeBuildEventLifecycle) UnfinalizedBuildsArgsForCall(i int) int {
+	fake.unfinalizedBuildsMutex.RLock()
+	defer fake.unfinalizedBuildsMutex.RUnlock()
+	argsForCall := fake.unfinalizedBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildEventLifecycle) UnfinalizedBuildsReturns(result1 []db.Build, result2 error) {
+	fake.unfinalizedBuildsMutex.Lock()
+	defer fake.unfinalizedBuildsMutex.Unlock()
+	fake.UnfinalizedBuildsStub = nil
+	fake.unfinalizedBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildEventLifecycle) UnfinalizedBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.unfinalizedBuildsMutex.Lock()
+	defer fake.unfinalizedBuildsMutex.Unlock()
+	fake.UnfinalizedBuildsStub = nil
+	if fake.unfinalizedBuildsReturnsOnCall == nil {
+		fake.unfinalizedBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.unfinalizedBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildEventLifecycle) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.collectDeletedBuildEventsMutex.RLock()
+	defer fake.collectDeletedBuildEventsMutex.RUnlock()
+	fake.finalizeBuildEventsMutex.RLock()
+	defer fake.finalizeBuildEventsMutex.RUnlock()
+	fake.unfinalizedBuildsMutex.RLock()
+	defer fake.unfinalizedBuildsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBuildEventLifecycle) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.BuildEventLifecycle = new(FakeBuildEventLifecycle)
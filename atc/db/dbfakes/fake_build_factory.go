@@ -3,6 +3,7 @@ package dbfakes
 
 import (
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc/db"
 )
@@ -38,6 +39,19 @@ type FakeBuildFactory struct {
 		result2 bool
 		result3 error
 	}
+	BuildLifecycleEventsStub        func(int64) (db.BuildLifecycleEventSource, error)
+	buildLifecycleEventsMutex       sync.RWMutex
+	buildLifecycleEventsArgsForCall []struct {
+		arg1 int64
+	}
+	buildLifecycleEventsReturns struct {
+		result1 db.BuildLifecycleEventSource
+		result2 error
+	}
+	buildLifecycleEventsReturnsOnCall map[int]struct {
+		result1 db.BuildLifecycleEventSource
+		result2 error
+	}
 	GetAllStartedBuildsStub        func() ([]db.Build, error)
 	getAllStartedBuildsMutex       sync.RWMutex
 	getAllStartedBuildsArgsForCall []struct {
@@ -87,6 +101,17 @@ type FakeBuildFactory struct {
 		result2 db.Pagination
 		result3 error
 	}
+	RemoveExpiredBuildLifecycleEventsStub        func(time.Duration) error
+	removeExpiredBuildLifecycleEventsMutex       sync.RWMutex
+	removeExpiredBuildLifecycleEventsArgsForCall []struct {
+		arg1 time.Duration
+	}
+	removeExpiredBuildLifecycleEventsReturns struct {
+		result1 error
+	}
+	removeExpiredBuildLifecycleEventsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	VisibleBuildsStub        func([]string, db.Page) ([]db.Build, db.Pagination, error)
 	visibleBuildsMutex       sync.RWMutex
 	visibleBuildsArgsForCall []struct {
@@ -239,6 +264,69 @@ func (fake *FakeBuildFactory) BuildReturnsOnCall(i int, result1 db.Build, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuildFactory) BuildLifecycleEvents(arg1 int64) (db.BuildLifecycleEventSource, error) {
+	fake.buildLifecycleEventsMutex.Lock()
+	ret, specificReturn := fake.buildLifecycleEventsReturnsOnCall[len(fake.buildLifecycleEventsArgsForCall)]
+	fake.buildLifecycleEventsArgsForCall = append(fake.buildLifecycleEventsArgsForCall, struct {
+		arg1 int64
+	}{arg1})
+	fake.recordInvocation("BuildLifecycleEvents", []interface{}{arg1})
+	fake.buildLifecycleEventsMutex.Unlock()
+	if fake.BuildLifecycleEventsStub != nil {
+		return fake.BuildLifecycleEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.buildLifecycleEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) BuildLifecycleEventsCallCount() int {
+	fake.buildLifecycleEventsMutex.RLock()
+	defer fake.buildLifecycleEventsMutex.RUnlock()
+	return len(fake.buildLifecycleEventsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) BuildLifecycleEventsCalls(stub func(int64) (db.BuildLifecycleEventSource, error)) {
+	fake.buildLifecycleEventsMutex.Lock()
+	defer fake.buildLifecycleEventsMutex.Unlock()
+	fake.BuildLifecycleEventsStub = stub
+}
+
+func (fake *FakeBuildFactory) BuildLifecycleEventsArgsForCall(i int) int64 {
+	fake.buildLifecycleEventsMutex.RLock()
+	defer fake.buildLifecycleEventsMutex.RUnlock()
+	argsForCall := fake.buildLifecycleEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildFactory) BuildLifecycleEventsReturns(result1 db.BuildLifecycleEventSource, result2 error) {
+	fake.buildLifecycleEventsMutex.Lock()
+	defer fake.buildLifecycleEventsMutex.Unlock()
+	fake.BuildLifecycleEventsStub = nil
+	fake.buildLifecycleEventsReturns = struct {
+		result1 db.BuildLifecycleEventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) BuildLifecycleEventsReturnsOnCall(i int, result1 db.BuildLifecycleEventSource, result2 error) {
+	fake.buildLifecycleEventsMutex.Lock()
+	defer fake.buildLifecycleEventsMutex.Unlock()
+	fake.BuildLifecycleEventsStub = nil
+	if fake.buildLifecycleEventsReturnsOnCall == nil {
+		fake.buildLifecycleEventsReturnsOnCall = make(map[int]struct {
+			result1 db.BuildLifecycleEventSource
+			result2 error
+		})
+	}
+	fake.buildLifecycleEventsReturnsOnCall[i] = struct {
+		result1 db.BuildLifecycleEventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) GetAllStartedBuilds() ([]db.Build, error) {
 	fake.getAllStartedBuildsMutex.Lock()
 	ret, specificReturn := fake.getAllStartedBuildsReturnsOnCall[len(fake.getAllStartedBuildsArgsForCall)]
@@ -467,6 +555,66 @@ func (fake *FakeBuildFactory) PublicBuildsReturnsOnCall(i int, result1 []db.Buil
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuildFactory) RemoveExpiredBuildLifecycleEvents(arg1 time.Duration) error {
+	fake.removeExpiredBuildLifecycleEventsMutex.Lock()
+	ret, specificReturn := fake.removeExpiredBuildLifecycleEventsReturnsOnCall[len(fake.removeExpiredBuildLifecycleEventsArgsForCall)]
+	fake.removeExpiredBuildLifecycleEventsArgsForCall = append(fake.removeExpiredBuildLifecycleEventsArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("RemoveExpiredBuildLifecycleEvents", []interface{}{arg1})
+	fake.removeExpiredBuildLifecycleEventsMutex.Unlock()
+	if fake.RemoveExpiredBuildLifecycleEventsStub != nil {
+		return fake.RemoveExpiredBuildLifecycleEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.removeExpiredBuildLifecycleEventsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildFactory) RemoveExpiredBuildLifecycleEventsCallCount() int {
+	fake.removeExpiredBuildLifecycleEventsMutex.RLock()
+	defer fake.removeExpiredBuildLifecycleEventsMutex.RUnlock()
+	return len(fake.removeExpiredBuildLifecycleEventsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) RemoveExpiredBuildLifecycleEventsCalls(stub func(time.Duration) error) {
+	fake.removeExpiredBuildLifecycleEventsMutex.Lock()
+	defer fake.removeExpiredBuildLifecycleEventsMutex.Unlock()
+	fake.RemoveExpiredBuildLifecycleEventsStub = stub
+}
+
+func (fake *FakeBuildFactory) RemoveExpiredBuildLifecycleEventsArgsForCall(i int) time.Duration {
+	fake.removeExpiredBuildLifecycleEventsMutex.RLock()
+	defer fake.removeExpiredBuildLifecycleEventsMutex.RUnlock()
+	argsForCall := fake.removeExpiredBuildLifecycleEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildFactory) RemoveExpiredBuildLifecycleEventsReturns(result1 error) {
+	fake.removeExpiredBuildLifecycleEventsMutex.Lock()
+	defer fake.removeExpiredBuildLifecycleEventsMutex.Unlock()
+	fake.RemoveExpiredBuildLifecycleEventsStub = nil
+	fake.removeExpiredBuildLifecycleEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildFactory) RemoveExpiredBuildLifecycleEventsReturnsOnCall(i int, result1 error) {
+	fake.removeExpiredBuildLifecycleEventsMutex.Lock()
+	defer fake.removeExpiredBuildLifecycleEventsMutex.Unlock()
+	fake.RemoveExpiredBuildLifecycleEventsStub = nil
+	if fake.removeExpiredBuildLifecycleEventsReturnsOnCall == nil {
+		fake.removeExpiredBuildLifecycleEventsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeExpiredBuildLifecycleEventsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuildFactory) VisibleBuilds(arg1 []string, arg2 db.Page) ([]db.Build, db.Pagination, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -546,6 +694,8 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.allBuildsMutex.RUnlock()
 	fake.buildMutex.RLock()
 	defer fake.buildMutex.RUnlock()
+	fake.buildLifecycleEventsMutex.RLock()
+	defer fake.buildLifecycleEventsMutex.RUnlock()
 	fake.getAllStartedBuildsMutex.RLock()
 	defer fake.getAllStartedBuildsMutex.RUnlock()
 	fake.getDrainableBuildsMutex.RLock()
@@ -554,6 +704,8 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.markNonInterceptibleBuildsMutex.RUnlock()
 	fake.publicBuildsMutex.RLock()
 	defer fake.publicBuildsMutex.RUnlock()
+	fake.removeExpiredBuildLifecycleEventsMutex.RLock()
+	defer fake.removeExpiredBuildLifecycleEventsMutex.RUnlock()
 	fake.visibleBuildsMutex.RLock()
 	defer fake.visibleBuildsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
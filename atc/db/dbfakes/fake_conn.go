@@ -79,6 +79,16 @@ type FakeConn struct {
 	encryptionStrategyReturnsOnCall map[int]struct {
 		result1 encryption.Strategy
 	}
+	EventStoreStub        func() db.EventStore
+	eventStoreMutex       sync.RWMutex
+	eventStoreArgsForCall []struct {
+	}
+	eventStoreReturns struct {
+		result1 db.EventStore
+	}
+	eventStoreReturnsOnCall map[int]struct {
+		result1 db.EventStore
+	}
 	ExecStub        func(string, ...interface{}) (sql.Result, error)
 	execMutex       sync.RWMutex
 	execArgsForCall []struct {
@@ -560,6 +570,58 @@ func (fake *FakeConn) EncryptionStrategyReturnsOnCall(i int, result1 encryption.
 	}{result1}
 }
 
+func (fake *FakeConn) EventStore() db.EventStore {
+	fake.eventStoreMutex.Lock()
+	ret, specificReturn := fake.eventStoreReturnsOnCall[len(fake.eventStoreArgsForCall)]
+	fake.eventStoreArgsForCall = append(fake.eventStoreArgsForCall, struct {
+	}{})
+	fake.recordInvocation("EventStore", []interface{}{})
+	fake.eventStoreMutex.Unlock()
+	if fake.EventStoreStub != nil {
+		return fake.EventStoreStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.eventStoreReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConn) EventStoreCallCount() int {
+	fake.eventStoreMutex.RLock()
+	defer fake.eventStoreMutex.RUnlock()
+	return len(fake.eventStoreArgsForCall)
+}
+
+func (fake *FakeConn) EventStoreCalls(stub func() db.EventStore) {
+	fake.eventStoreMutex.Lock()
+	defer fake.eventStoreMutex.Unlock()
+	fake.EventStoreStub = stub
+}
+
+func (fake *FakeConn) EventStoreReturns(result1 db.EventStore) {
+	fake.eventStoreMutex.Lock()
+	defer fake.eventStoreMutex.Unlock()
+	fake.EventStoreStub = nil
+	fake.eventStoreReturns = struct {
+		result1 db.EventStore
+	}{result1}
+}
+
+func (fake *FakeConn) EventStoreReturnsOnCall(i int, result1 db.EventStore) {
+	fake.eventStoreMutex.Lock()
+	defer fake.eventStoreMutex.Unlock()
+	fake.EventStoreStub = nil
+	if fake.eventStoreReturnsOnCall == nil {
+		fake.eventStoreReturnsOnCall = make(map[int]struct {
+			result1 db.EventStore
+		})
+	}
+	fake.eventStoreReturnsOnCall[i] = struct {
+		result1 db.EventStore
+	}{result1}
+}
+
 func (fake *FakeConn) Exec(arg1 string, arg2 ...interface{}) (sql.Result, error) {
 	fake.execMutex.Lock()
 	ret, specificReturn := fake.execReturnsOnCall[len(fake.execArgsForCall)]
@@ -1301,6 +1363,8 @@ func (fake *FakeConn) Invocations() map[string][][]interface{} {
 	defer fake.driverMutex.RUnlock()
 	fake.encryptionStrategyMutex.RLock()
 	defer fake.encryptionStrategyMutex.RUnlock()
+	fake.eventStoreMutex.RLock()
+	defer fake.eventStoreMutex.RUnlock()
 	fake.execMutex.RLock()
 	defer fake.execMutex.RUnlock()
 	fake.execContextMutex.RLock()
@@ -35,6 +35,19 @@ type FakeContainerRepository struct {
 		result1 int
 		result2 error
 	}
+	FindContainerRetentionsStub        func([]int) (map[int]db.ContainerRetention, error)
+	findContainerRetentionsMutex       sync.RWMutex
+	findContainerRetentionsArgsForCall []struct {
+		arg1 []int
+	}
+	findContainerRetentionsReturns struct {
+		result1 map[int]db.ContainerRetention
+		result2 error
+	}
+	findContainerRetentionsReturnsOnCall map[int]struct {
+		result1 map[int]db.ContainerRetention
+		result2 error
+	}
 	FindDestroyingContainersStub        func(string) ([]string, error)
 	findDestroyingContainersMutex       sync.RWMutex
 	findDestroyingContainersArgsForCall []struct {
@@ -231,6 +244,74 @@ func (fake *FakeContainerRepository) DestroyUnknownContainersReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeContainerRepository) FindContainerRetentions(arg1 []int) (map[int]db.ContainerRetention, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.findContainerRetentionsMutex.Lock()
+	ret, specificReturn := fake.findContainerRetentionsReturnsOnCall[len(fake.findContainerRetentionsArgsForCall)]
+	fake.findContainerRetentionsArgsForCall = append(fake.findContainerRetentionsArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("FindContainerRetentions", []interface{}{arg1Copy})
+	fake.findContainerRetentionsMutex.Unlock()
+	if fake.FindContainerRetentionsStub != nil {
+		return fake.FindContainerRetentionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.findContainerRetentionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContainerRepository) FindContainerRetentionsCallCount() int {
+	fake.findContainerRetentionsMutex.RLock()
+	defer fake.findContainerRetentionsMutex.RUnlock()
+	return len(fake.findContainerRetentionsArgsForCall)
+}
+
+func (fake *FakeContainerRepository) FindContainerRetentionsCalls(stub func([]int) (map[int]db.ContainerRetention, error)) {
+	fake.findContainerRetentionsMutex.Lock()
+	defer fake.findContainerRetentionsMutex.Unlock()
+	fake.FindContainerRetentionsStub = stub
+}
+
+func (fake *FakeContainerRepository) FindContainerRetentionsArgsForCall(i int) []int {
+	fake.findContainerRetentionsMutex.RLock()
+	defer fake.findContainerRetentionsMutex.RUnlock()
+	argsForCall := fake.findContainerRetentionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeContainerRepository) FindContainerRetentionsReturns(result1 map[int]db.ContainerRetention, result2 error) {
+	fake.findContainerRetentionsMutex.Lock()
+	defer fake.findContainerRetentionsMutex.Unlock()
+	fake.FindContainerRetentionsStub = nil
+	fake.findContainerRetentionsReturns = struct {
+		result1 map[int]db.ContainerRetention
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerRepository) FindContainerRetentionsReturnsOnCall(i int, result1 map[int]db.ContainerRetention, result2 error) {
+	fake.findContainerRetentionsMutex.Lock()
+	defer fake.findContainerRetentionsMutex.Unlock()
+	fake.FindContainerRetentionsStub = nil
+	if fake.findContainerRetentionsReturnsOnCall == nil {
+		fake.findContainerRetentionsReturnsOnCall = make(map[int]struct {
+			result1 map[int]db.ContainerRetention
+			result2 error
+		})
+	}
+	fake.findContainerRetentionsReturnsOnCall[i] = struct {
+		result1 map[int]db.ContainerRetention
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeContainerRepository) FindDestroyingContainers(arg1 string) ([]string, error) {
 	fake.findDestroyingContainersMutex.Lock()
 	ret, specificReturn := fake.findDestroyingContainersReturnsOnCall[len(fake.findDestroyingContainersArgsForCall)]
@@ -560,6 +641,8 @@ func (fake *FakeContainerRepository) Invocations() map[string][][]interface{} {
 	defer fake.destroyFailedContainersMutex.RUnlock()
 	fake.destroyUnknownContainersMutex.RLock()
 	defer fake.destroyUnknownContainersMutex.RUnlock()
+	fake.findContainerRetentionsMutex.RLock()
+	defer fake.findContainerRetentionsMutex.RUnlock()
 	fake.findDestroyingContainersMutex.RLock()
 	defer fake.findDestroyingContainersMutex.RUnlock()
 	fake.findOrphanedContainersMutex.RLock()
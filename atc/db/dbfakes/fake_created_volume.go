@@ -90,6 +90,20 @@ type FakeCreatedVolume struct {
 		result1 db.WorkerArtifact
 		result2 error
 	}
+	InitializeNamedCacheStub        func(int, string) (uint64, error)
+	initializeNamedCacheMutex       sync.RWMutex
+	initializeNamedCacheArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	initializeNamedCacheReturns struct {
+		result1 uint64
+		result2 error
+	}
+	initializeNamedCacheReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
 	InitializeResourceCacheStub        func(db.UsedResourceCache) error
 	initializeResourceCacheMutex       sync.RWMutex
 	initializeResourceCacheArgsForCall []struct {
@@ -600,6 +614,70 @@ func (fake *FakeCreatedVolume) InitializeArtifactReturnsOnCall(i int, result1 db
 	}{result1, result2}
 }
 
+func (fake *FakeCreatedVolume) InitializeNamedCache(arg1 int, arg2 string) (uint64, error) {
+	fake.initializeNamedCacheMutex.Lock()
+	ret, specificReturn := fake.initializeNamedCacheReturnsOnCall[len(fake.initializeNamedCacheArgsForCall)]
+	fake.initializeNamedCacheArgsForCall = append(fake.initializeNamedCacheArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("InitializeNamedCache", []interface{}{arg1, arg2})
+	fake.initializeNamedCacheMutex.Unlock()
+	if fake.InitializeNamedCacheStub != nil {
+		return fake.InitializeNamedCacheStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.initializeNamedCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreatedVolume) InitializeNamedCacheCallCount() int {
+	fake.initializeNamedCacheMutex.RLock()
+	defer fake.initializeNamedCacheMutex.RUnlock()
+	return len(fake.initializeNamedCacheArgsForCall)
+}
+
+func (fake *FakeCreatedVolume) InitializeNamedCacheCalls(stub func(int, string) (uint64, error)) {
+	fake.initializeNamedCacheMutex.Lock()
+	defer fake.initializeNamedCacheMutex.Unlock()
+	fake.InitializeNamedCacheStub = stub
+}
+
+func (fake *FakeCreatedVolume) InitializeNamedCacheArgsForCall(i int) (int, string) {
+	fake.initializeNamedCacheMutex.RLock()
+	defer fake.initializeNamedCacheMutex.RUnlock()
+	argsForCall := fake.initializeNamedCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCreatedVolume) InitializeNamedCacheReturns(result1 uint64, result2 error) {
+	fake.initializeNamedCacheMutex.Lock()
+	defer fake.initializeNamedCacheMutex.Unlock()
+	fake.InitializeNamedCacheStub = nil
+	fake.initializeNamedCacheReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreatedVolume) InitializeNamedCacheReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.initializeNamedCacheMutex.Lock()
+	defer fake.initializeNamedCacheMutex.Unlock()
+	fake.InitializeNamedCacheStub = nil
+	if fake.initializeNamedCacheReturnsOnCall == nil {
+		fake.initializeNamedCacheReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.initializeNamedCacheReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCreatedVolume) InitializeResourceCache(arg1 db.UsedResourceCache) error {
 	fake.initializeResourceCacheMutex.Lock()
 	ret, specificReturn := fake.initializeResourceCacheReturnsOnCall[len(fake.initializeResourceCacheArgsForCall)]
@@ -1167,6 +1245,8 @@ func (fake *FakeCreatedVolume) Invocations() map[string][][]interface{} {
 	defer fake.handleMutex.RUnlock()
 	fake.initializeArtifactMutex.RLock()
 	defer fake.initializeArtifactMutex.RUnlock()
+	fake.initializeNamedCacheMutex.RLock()
+	defer fake.initializeNamedCacheMutex.RUnlock()
 	fake.initializeResourceCacheMutex.RLock()
 	defer fake.initializeResourceCacheMutex.RUnlock()
 	fake.initializeTaskCacheMutex.RLock()
@@ -111,9 +111,10 @@ type FakeJob struct {
 		result1 atc.JobConfig
 		result2 error
 	}
-	CreateBuildStub        func() (db.Build, error)
+	CreateBuildStub        func(string) (db.Build, error)
 	createBuildMutex       sync.RWMutex
 	createBuildArgsForCall []struct {
+		arg1 string
 	}
 	createBuildReturns struct {
 		result1 db.Build
@@ -123,6 +124,20 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	CreateBuildWithBreakpointsStub        func(string, map[string]atc.Breakpoint) (db.Build, error)
+	createBuildWithBreakpointsMutex       sync.RWMutex
+	createBuildWithBreakpointsArgsForCall []struct {
+		arg1 string
+		arg2 map[string]atc.Breakpoint
+	}
+	createBuildWithBreakpointsReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	createBuildWithBreakpointsReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
 	DisableManualTriggerStub        func() bool
 	disableManualTriggerMutex       sync.RWMutex
 	disableManualTriggerArgsForCall []struct {
@@ -334,6 +349,17 @@ type FakeJob struct {
 	publicReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	ReleasePrefetchedImagesStub        func([]db.UsedResourceCache) error
+	releasePrefetchedImagesMutex       sync.RWMutex
+	releasePrefetchedImagesArgsForCall []struct {
+		arg1 []db.UsedResourceCache
+	}
+	releasePrefetchedImagesReturns struct {
+		result1 error
+	}
+	releasePrefetchedImagesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -356,10 +382,11 @@ type FakeJob struct {
 	requestScheduleReturnsOnCall map[int]struct {
 		result1 error
 	}
-	RerunBuildStub        func(db.Build) (db.Build, error)
+	RerunBuildStub        func(db.Build, string) (db.Build, error)
 	rerunBuildMutex       sync.RWMutex
 	rerunBuildArgsForCall []struct {
 		arg1 db.Build
+		arg2 string
 	}
 	rerunBuildReturns struct {
 		result1 db.Build
@@ -369,6 +396,21 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	RerunBuildFromStepStub        func(db.Build, string, string) (db.Build, error)
+	rerunBuildFromStepMutex       sync.RWMutex
+	rerunBuildFromStepArgsForCall []struct {
+		arg1 db.Build
+		arg2 string
+		arg3 string
+	}
+	rerunBuildFromStepReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	rerunBuildFromStepReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
 	SaveNextInputMappingStub        func(db.InputMapping, bool) error
 	saveNextInputMappingMutex       sync.RWMutex
 	saveNextInputMappingArgsForCall []struct {
@@ -415,6 +457,19 @@ type FakeJob struct {
 	setHasNewInputsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	StatsStub        func(time.Time) (db.JobStats, error)
+	statsMutex       sync.RWMutex
+	statsArgsForCall []struct {
+		arg1 time.Time
+	}
+	statsReturns struct {
+		result1 db.JobStats
+		result2 error
+	}
+	statsReturnsOnCall map[int]struct {
+		result1 db.JobStats
+		result2 error
+	}
 	TagsStub        func() []string
 	tagsMutex       sync.RWMutex
 	tagsArgsForCall []struct {
@@ -919,15 +974,16 @@ func (fake *FakeJob) ConfigReturnsOnCall(i int, result1 atc.JobConfig, result2 e
 	}{result1, result2}
 }
 
-func (fake *FakeJob) CreateBuild() (db.Build, error) {
+func (fake *FakeJob) CreateBuild(arg1 string) (db.Build, error) {
 	fake.createBuildMutex.Lock()
 	ret, specificReturn := fake.createBuildReturnsOnCall[len(fake.createBuildArgsForCall)]
 	fake.createBuildArgsForCall = append(fake.createBuildArgsForCall, struct {
-	}{})
-	fake.recordInvocation("CreateBuild", []interface{}{})
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CreateBuild", []interface{}{arg1})
 	fake.createBuildMutex.Unlock()
 	if fake.CreateBuildStub != nil {
-		return fake.CreateBuildStub()
+		return fake.CreateBuildStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -942,12 +998,19 @@ func (fake *FakeJob) CreateBuildCallCount() int {
 	return len(fake.createBuildArgsForCall)
 }
 
-func (fake *FakeJob) CreateBuildCalls(stub func() (db.Build, error)) {
+func (fake *FakeJob) CreateBuildCalls(stub func(string) (db.Build, error)) {
 	fake.createBuildMutex.Lock()
 	defer fake.createBuildMutex.Unlock()
 	fake.CreateBuildStub = stub
 }
 
+func (fake *FakeJob) CreateBuildArgsForCall(i int) string {
+	fake.createBuildMutex.RLock()
+	defer fake.createBuildMutex.RUnlock()
+	argsForCall := fake.createBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeJob) CreateBuildReturns(result1 db.Build, result2 error) {
 	fake.createBuildMutex.Lock()
 	defer fake.createBuildMutex.Unlock()
@@ -974,6 +1037,70 @@ func (fake *FakeJob) CreateBuildReturnsOnCall(i int, result1 db.Build, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeJob) CreateBuildWithBreakpoints(arg1 string, arg2 map[string]atc.Breakpoint) (db.Build, error) {
+	fake.createBuildWithBreakpointsMutex.Lock()
+	ret, specificReturn := fake.createBuildWithBreakpointsReturnsOnCall[len(fake.createBuildWithBreakpointsArgsForCall)]
+	fake.createBuildWithBreakpointsArgsForCall = append(fake.createBuildWithBreakpointsArgsForCall, struct {
+		arg1 string
+		arg2 map[string]atc.Breakpoint
+	}{arg1, arg2})
+	fake.recordInvocation("CreateBuildWithBreakpoints", []interface{}{arg1, arg2})
+	fake.createBuildWithBreakpointsMutex.Unlock()
+	if fake.CreateBuildWithBreakpointsStub != nil {
+		return fake.CreateBuildWithBreakpointsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createBuildWithBreakpointsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) CreateBuildWithBreakpointsCallCount() int {
+	fake.createBuildWithBreakpointsMutex.RLock()
+	defer fake.createBuildWithBreakpointsMutex.RUnlock()
+	return len(fake.createBuildWithBreakpointsArgsForCall)
+}
+
+func (fake *FakeJob) CreateBuildWithBreakpointsCalls(stub func(string, map[string]atc.Breakpoint) (db.Build, error)) {
+	fake.createBuildWithBreakpointsMutex.Lock()
+	defer fake.createBuildWithBreakpointsMutex.Unlock()
+	fake.CreateBuildWithBreakpointsStub = stub
+}
+
+func (fake *FakeJob) CreateBuildWithBreakpointsArgsForCall(i int) (string, map[string]atc.Breakpoint) {
+	fake.createBuildWithBreakpointsMutex.RLock()
+	defer fake.createBuildWithBreakpointsMutex.RUnlock()
+	argsForCall := fake.createBuildWithBreakpointsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJob) CreateBuildWithBreakpointsReturns(result1 db.Build, result2 error) {
+	fake.createBuildWithBreakpointsMutex.Lock()
+	defer fake.createBuildWithBreakpointsMutex.Unlock()
+	fake.CreateBuildWithBreakpointsStub = nil
+	fake.createBuildWithBreakpointsReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) CreateBuildWithBreakpointsReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.createBuildWithBreakpointsMutex.Lock()
+	defer fake.createBuildWithBreakpointsMutex.Unlock()
+	fake.CreateBuildWithBreakpointsStub = nil
+	if fake.createBuildWithBreakpointsReturnsOnCall == nil {
+		fake.createBuildWithBreakpointsReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.createBuildWithBreakpointsReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) DisableManualTrigger() bool {
 	fake.disableManualTriggerMutex.Lock()
 	ret, specificReturn := fake.disableManualTriggerReturnsOnCall[len(fake.disableManualTriggerArgsForCall)]
@@ -2000,6 +2127,71 @@ func (fake *FakeJob) PublicReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeJob) ReleasePrefetchedImages(arg1 []db.UsedResourceCache) error {
+	var arg1Copy []db.UsedResourceCache
+	if arg1 != nil {
+		arg1Copy = make([]db.UsedResourceCache, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.releasePrefetchedImagesMutex.Lock()
+	ret, specificReturn := fake.releasePrefetchedImagesReturnsOnCall[len(fake.releasePrefetchedImagesArgsForCall)]
+	fake.releasePrefetchedImagesArgsForCall = append(fake.releasePrefetchedImagesArgsForCall, struct {
+		arg1 []db.UsedResourceCache
+	}{arg1Copy})
+	fake.recordInvocation("ReleasePrefetchedImages", []interface{}{arg1Copy})
+	fake.releasePrefetchedImagesMutex.Unlock()
+	if fake.ReleasePrefetchedImagesStub != nil {
+		return fake.ReleasePrefetchedImagesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.releasePrefetchedImagesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) ReleasePrefetchedImagesCallCount() int {
+	fake.releasePrefetchedImagesMutex.RLock()
+	defer fake.releasePrefetchedImagesMutex.RUnlock()
+	return len(fake.releasePrefetchedImagesArgsForCall)
+}
+
+func (fake *FakeJob) ReleasePrefetchedImagesCalls(stub func([]db.UsedResourceCache) error) {
+	fake.releasePrefetchedImagesMutex.Lock()
+	defer fake.releasePrefetchedImagesMutex.Unlock()
+	fake.ReleasePrefetchedImagesStub = stub
+}
+
+func (fake *FakeJob) ReleasePrefetchedImagesArgsForCall(i int) []db.UsedResourceCache {
+	fake.releasePrefetchedImagesMutex.RLock()
+	defer fake.releasePrefetchedImagesMutex.RUnlock()
+	argsForCall := fake.releasePrefetchedImagesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) ReleasePrefetchedImagesReturns(result1 error) {
+	fake.releasePrefetchedImagesMutex.Lock()
+	defer fake.releasePrefetchedImagesMutex.Unlock()
+	fake.ReleasePrefetchedImagesStub = nil
+	fake.releasePrefetchedImagesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) ReleasePrefetchedImagesReturnsOnCall(i int, result1 error) {
+	fake.releasePrefetchedImagesMutex.Lock()
+	defer fake.releasePrefetchedImagesMutex.Unlock()
+	fake.ReleasePrefetchedImagesStub = nil
+	if fake.releasePrefetchedImagesReturnsOnCall == nil {
+		fake.releasePrefetchedImagesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releasePrefetchedImagesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeJob) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -2107,16 +2299,17 @@ func (fake *FakeJob) RequestScheduleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeJob) RerunBuild(arg1 db.Build) (db.Build, error) {
+func (fake *FakeJob) RerunBuild(arg1 db.Build, arg2 string) (db.Build, error) {
 	fake.rerunBuildMutex.Lock()
 	ret, specificReturn := fake.rerunBuildReturnsOnCall[len(fake.rerunBuildArgsForCall)]
 	fake.rerunBuildArgsForCall = append(fake.rerunBuildArgsForCall, struct {
 		arg1 db.Build
-	}{arg1})
-	fake.recordInvocation("RerunBuild", []interface{}{arg1})
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RerunBuild", []interface{}{arg1, arg2})
 	fake.rerunBuildMutex.Unlock()
 	if fake.RerunBuildStub != nil {
-		return fake.RerunBuildStub(arg1)
+		return fake.RerunBuildStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2131,17 +2324,17 @@ func (fake *FakeJob) RerunBuildCallCount() int {
 	return len(fake.rerunBuildArgsForCall)
 }
 
-func (fake *FakeJob) RerunBuildCalls(stub func(db.Build) (db.Build, error)) {
+func (fake *FakeJob) RerunBuildCalls(stub func(db.Build, string) (db.Build, error)) {
 	fake.rerunBuildMutex.Lock()
 	defer fake.rerunBuildMutex.Unlock()
 	fake.RerunBuildStub = stub
 }
 
-func (fake *FakeJob) RerunBuildArgsForCall(i int) db.Build {
+func (fake *FakeJob) RerunBuildArgsForCall(i int) (db.Build, string) {
 	fake.rerunBuildMutex.RLock()
 	defer fake.rerunBuildMutex.RUnlock()
 	argsForCall := fake.rerunBuildArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeJob) RerunBuildReturns(result1 db.Build, result2 error) {
@@ -2170,6 +2363,71 @@ func (fake *FakeJob) RerunBuildReturnsOnCall(i int, result1 db.Build, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeJob) RerunBuildFromStep(arg1 db.Build, arg2 string, arg3 string) (db.Build, error) {
+	fake.rerunBuildFromStepMutex.Lock()
+	ret, specificReturn := fake.rerunBuildFromStepReturnsOnCall[len(fake.rerunBuildFromStepArgsForCall)]
+	fake.rerunBuildFromStepArgsForCall = append(fake.rerunBuildFromStepArgsForCall, struct {
+		arg1 db.Build
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RerunBuildFromStep", []interface{}{arg1, arg2, arg3})
+	fake.rerunBuildFromStepMutex.Unlock()
+	if fake.RerunBuildFromStepStub != nil {
+		return fake.RerunBuildFromStepStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.rerunBuildFromStepReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) RerunBuildFromStepCallCount() int {
+	fake.rerunBuildFromStepMutex.RLock()
+	defer fake.rerunBuildFromStepMutex.RUnlock()
+	return len(fake.rerunBuildFromStepArgsForCall)
+}
+
+func (fake *FakeJob) RerunBuildFromStepCalls(stub func(db.Build, string, string) (db.Build, error)) {
+	fake.rerunBuildFromStepMutex.Lock()
+	defer fake.rerunBuildFromStepMutex.Unlock()
+	fake.RerunBuildFromStepStub = stub
+}
+
+func (fake *FakeJob) RerunBuildFromStepArgsForCall(i int) (db.Build, string, string) {
+	fake.rerunBuildFromStepMutex.RLock()
+	defer fake.rerunBuildFromStepMutex.RUnlock()
+	argsForCall := fake.rerunBuildFromStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeJob) RerunBuildFromStepReturns(result1 db.Build, result2 error) {
+	fake.rerunBuildFromStepMutex.Lock()
+	defer fake.rerunBuildFromStepMutex.Unlock()
+	fake.RerunBuildFromStepStub = nil
+	fake.rerunBuildFromStepReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) RerunBuildFromStepReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.rerunBuildFromStepMutex.Lock()
+	defer fake.rerunBuildFromStepMutex.Unlock()
+	fake.RerunBuildFromStepStub = nil
+	if fake.rerunBuildFromStepReturnsOnCall == nil {
+		fake.rerunBuildFromStepReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.rerunBuildFromStepReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) SaveNextInputMapping(arg1 db.InputMapping, arg2 bool) error {
 	fake.saveNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveNextInputMappingReturnsOnCall[len(fake.saveNextInputMappingArgsForCall)]
@@ -2406,6 +2664,69 @@ func (fake *FakeJob) SetHasNewInputsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) Stats(arg1 time.Time) (db.JobStats, error) {
+	fake.statsMutex.Lock()
+	ret, specificReturn := fake.statsReturnsOnCall[len(fake.statsArgsForCall)]
+	fake.statsArgsForCall = append(fake.statsArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("Stats", []interface{}{arg1})
+	fake.statsMutex.Unlock()
+	if fake.StatsStub != nil {
+		return fake.StatsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.statsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) StatsCallCount() int {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return len(fake.statsArgsForCall)
+}
+
+func (fake *FakeJob) StatsCalls(stub func(time.Time) (db.JobStats, error)) {
+	fake.statsMutex.Lock()
+	defer fake.statsMutex.Unlock()
+	fake.StatsStub = stub
+}
+
+func (fake *FakeJob) StatsArgsForCall(i int) time.Time {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	argsForCall := fake.statsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) StatsReturns(result1 db.JobStats, result2 error) {
+	fake.statsMutex.Lock()
+	defer fake.statsMutex.Unlock()
+	fake.StatsStub = nil
+	fake.statsReturns = struct {
+		result1 db.JobStats
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) StatsReturnsOnCall(i int, result1 db.JobStats, result2 error) {
+	fake.statsMutex.Lock()
+	defer fake.statsMutex.Unlock()
+	fake.StatsStub = nil
+	if fake.statsReturnsOnCall == nil {
+		fake.statsReturnsOnCall = make(map[int]struct {
+			result1 db.JobStats
+			result2 error
+		})
+	}
+	fake.statsReturnsOnCall[i] = struct {
+		result1 db.JobStats
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) Tags() []string {
 	fake.tagsMutex.Lock()
 	ret, specificReturn := fake.tagsReturnsOnCall[len(fake.tagsArgsForCall)]
@@ -2753,6 +3074,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.configMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.createBuildWithBreakpointsMutex.RLock()
+	defer fake.createBuildWithBreakpointsMutex.RUnlock()
 	fake.disableManualTriggerMutex.RLock()
 	defer fake.disableManualTriggerMutex.RUnlock()
 	fake.ensurePendingBuildExistsMutex.RLock()
@@ -2791,12 +3114,16 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.publicMutex.RLock()
 	defer fake.publicMutex.RUnlock()
+	fake.releasePrefetchedImagesMutex.RLock()
+	defer fake.releasePrefetchedImagesMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
 	fake.requestScheduleMutex.RLock()
 	defer fake.requestScheduleMutex.RUnlock()
 	fake.rerunBuildMutex.RLock()
 	defer fake.rerunBuildMutex.RUnlock()
+	fake.rerunBuildFromStepMutex.RLock()
+	defer fake.rerunBuildFromStepMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	fake.scheduleBuildMutex.RLock()
@@ -2805,6 +3132,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.scheduleRequestedTimeMutex.RUnlock()
 	fake.setHasNewInputsMutex.RLock()
 	defer fake.setHasNewInputsMutex.RUnlock()
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
 	fake.tagsMutex.RLock()
 	defer fake.tagsMutex.RUnlock()
 	fake.teamIDMutex.RLock()
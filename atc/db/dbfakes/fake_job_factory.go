@@ -21,6 +21,18 @@ type FakeJobFactory struct {
 		result1 atc.Dashboard
 		result2 error
 	}
+	JobsPrefetchingImagesStub        func() (db.Jobs, error)
+	jobsPrefetchingImagesMutex       sync.RWMutex
+	jobsPrefetchingImagesArgsForCall []struct {
+	}
+	jobsPrefetchingImagesReturns struct {
+		result1 db.Jobs
+		result2 error
+	}
+	jobsPrefetchingImagesReturnsOnCall map[int]struct {
+		result1 db.Jobs
+		result2 error
+	}
 	JobsToScheduleStub        func() (db.SchedulerJobs, error)
 	jobsToScheduleMutex       sync.RWMutex
 	jobsToScheduleArgsForCall []struct {
@@ -105,6 +117,61 @@ func (fake *FakeJobFactory) AllActiveJobsReturnsOnCall(i int, result1 atc.Dashbo
 	}{result1, result2}
 }
 
+func (fake *FakeJobFactory) JobsPrefetchingImages() (db.Jobs, error) {
+	fake.jobsPrefetchingImagesMutex.Lock()
+	ret, specificReturn := fake.jobsPrefetchingImagesReturnsOnCall[len(fake.jobsPrefetchingImagesArgsForCall)]
+	fake.jobsPrefetchingImagesArgsForCall = append(fake.jobsPrefetchingImagesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("JobsPrefetchingImages", []interface{}{})
+	fake.jobsPrefetchingImagesMutex.Unlock()
+	if fake.JobsPrefetchingImagesStub != nil {
+		return fake.JobsPrefetchingImagesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.jobsPrefetchingImagesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobFactory) JobsPrefetchingImagesCallCount() int {
+	fake.jobsPrefetchingImagesMutex.RLock()
+	defer fake.jobsPrefetchingImagesMutex.RUnlock()
+	return len(fake.jobsPrefetchingImagesArgsForCall)
+}
+
+func (fake *FakeJobFactory) JobsPrefetchingImagesCalls(stub func() (db.Jobs, error)) {
+	fake.jobsPrefetchingImagesMutex.Lock()
+	defer fake.jobsPrefetchingImagesMutex.Unlock()
+	fake.JobsPrefetchingImagesStub = stub
+}
+
+func (fake *FakeJobFactory) JobsPrefetchingImagesReturns(result1 db.Jobs, result2 error) {
+	fake.jobsPrefetchingImagesMutex.Lock()
+	defer fake.jobsPrefetchingImagesMutex.Unlock()
+	fake.JobsPrefetchingImagesStub = nil
+	fake.jobsPrefetchingImagesReturns = struct {
+		result1 db.Jobs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobFactory) JobsPrefetchingImagesReturnsOnCall(i int, result1 db.Jobs, result2 error) {
+	fake.jobsPrefetchingImagesMutex.Lock()
+	defer fake.jobsPrefetchingImagesMutex.Unlock()
+	fake.JobsPrefetchingImagesStub = nil
+	if fake.jobsPrefetchingImagesReturnsOnCall == nil {
+		fake.jobsPrefetchingImagesReturnsOnCall = make(map[int]struct {
+			result1 db.Jobs
+			result2 error
+		})
+	}
+	fake.jobsPrefetchingImagesReturnsOnCall[i] = struct {
+		result1 db.Jobs
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJobFactory) JobsToSchedule() (db.SchedulerJobs, error) {
 	fake.jobsToScheduleMutex.Lock()
 	ret, specificReturn := fake.jobsToScheduleReturnsOnCall[len(fake.jobsToScheduleArgsForCall)]
@@ -233,6 +300,8 @@ func (fake *FakeJobFactory) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.allActiveJobsMutex.RLock()
 	defer fake.allActiveJobsMutex.RUnlock()
+	fake.jobsPrefetchingImagesMutex.RLock()
+	defer fake.jobsPrefetchingImagesMutex.RUnlock()
 	fake.jobsToScheduleMutex.RLock()
 	defer fake.jobsToScheduleMutex.RUnlock()
 	fake.visibleJobsMutex.RLock()
@@ -40,6 +40,16 @@ type FakeResource struct {
 	checkEveryReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CheckFailuresStub        func() int
+	checkFailuresMutex       sync.RWMutex
+	checkFailuresArgsForCall []struct {
+	}
+	checkFailuresReturns struct {
+		result1 int
+	}
+	checkFailuresReturnsOnCall map[int]struct {
+		result1 int
+	}
 	CheckSetupErrorStub        func() error
 	checkSetupErrorMutex       sync.RWMutex
 	checkSetupErrorArgsForCall []struct {
@@ -229,6 +239,16 @@ type FakeResource struct {
 	pipelineNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PrefetchStub        func() bool
+	prefetchMutex       sync.RWMutex
+	prefetchArgsForCall []struct {
+	}
+	prefetchReturns struct {
+		result1 bool
+	}
+	prefetchReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	PublicStub        func() bool
 	publicMutex       sync.RWMutex
 	publicArgsForCall []struct {
@@ -239,6 +259,28 @@ type FakeResource struct {
 	publicReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	RecordWebhookDeliveryStub        func(atc.WebhookDelivery) error
+	recordWebhookDeliveryMutex       sync.RWMutex
+	recordWebhookDeliveryArgsForCall []struct {
+		arg1 atc.WebhookDelivery
+	}
+	recordWebhookDeliveryReturns struct {
+		result1 error
+	}
+	recordWebhookDeliveryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReleasePrefetchedCachesStub        func(db.UsedResourceCache) error
+	releasePrefetchedCachesMutex       sync.RWMutex
+	releasePrefetchedCachesArgsForCall []struct {
+		arg1 db.UsedResourceCache
+	}
+	releasePrefetchedCachesReturns struct {
+		result1 error
+	}
+	releasePrefetchedCachesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -430,6 +472,29 @@ type FakeResource struct {
 		result3 bool
 		result4 error
 	}
+	WebhookStub        func() *atc.WebhookConfig
+	webhookMutex       sync.RWMutex
+	webhookArgsForCall []struct {
+	}
+	webhookReturns struct {
+		result1 *atc.WebhookConfig
+	}
+	webhookReturnsOnCall map[int]struct {
+		result1 *atc.WebhookConfig
+	}
+	WebhookDeliveriesStub        func(int) ([]atc.WebhookDelivery, error)
+	webhookDeliveriesMutex       sync.RWMutex
+	webhookDeliveriesArgsForCall []struct {
+		arg1 int
+	}
+	webhookDeliveriesReturns struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}
+	webhookDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}
 	WebhookTokenStub        func() string
 	webhookTokenMutex       sync.RWMutex
 	webhookTokenArgsForCall []struct {
@@ -600,6 +665,58 @@ func (fake *FakeResource) CheckEveryReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) CheckFailures() int {
+	fake.checkFailuresMutex.Lock()
+	ret, specificReturn := fake.checkFailuresReturnsOnCall[len(fake.checkFailuresArgsForCall)]
+	fake.checkFailuresArgsForCall = append(fake.checkFailuresArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CheckFailures", []interface{}{})
+	fake.checkFailuresMutex.Unlock()
+	if fake.CheckFailuresStub != nil {
+		return fake.CheckFailuresStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.checkFailuresReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) CheckFailuresCallCount() int {
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
+	return len(fake.checkFailuresArgsForCall)
+}
+
+func (fake *FakeResource) CheckFailuresCalls(stub func() int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = stub
+}
+
+func (fake *FakeResource) CheckFailuresReturns(result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	fake.checkFailuresReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResource) CheckFailuresReturnsOnCall(i int, result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	if fake.checkFailuresReturnsOnCall == nil {
+		fake.checkFailuresReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.checkFailuresReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeResource) CheckSetupError() error {
 	fake.checkSetupErrorMutex.Lock()
 	ret, specificReturn := fake.checkSetupErrorReturnsOnCall[len(fake.checkSetupErrorArgsForCall)]
@@ -1569,6 +1686,58 @@ func (fake *FakeResource) PipelineNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) Prefetch() bool {
+	fake.prefetchMutex.Lock()
+	ret, specificReturn := fake.prefetchReturnsOnCall[len(fake.prefetchArgsForCall)]
+	fake.prefetchArgsForCall = append(fake.prefetchArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Prefetch", []interface{}{})
+	fake.prefetchMutex.Unlock()
+	if fake.PrefetchStub != nil {
+		return fake.PrefetchStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.prefetchReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) PrefetchCallCount() int {
+	fake.prefetchMutex.RLock()
+	defer fake.prefetchMutex.RUnlock()
+	return len(fake.prefetchArgsForCall)
+}
+
+func (fake *FakeResource) PrefetchCalls(stub func() bool) {
+	fake.prefetchMutex.Lock()
+	defer fake.prefetchMutex.Unlock()
+	fake.PrefetchStub = stub
+}
+
+func (fake *FakeResource) PrefetchReturns(result1 bool) {
+	fake.prefetchMutex.Lock()
+	defer fake.prefetchMutex.Unlock()
+	fake.PrefetchStub = nil
+	fake.prefetchReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeResource) PrefetchReturnsOnCall(i int, result1 bool) {
+	fake.prefetchMutex.Lock()
+	defer fake.prefetchMutex.Unlock()
+	fake.PrefetchStub = nil
+	if fake.prefetchReturnsOnCall == nil {
+		fake.prefetchReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.prefetchReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeResource) Public() bool {
 	fake.publicMutex.Lock()
 	ret, specificReturn := fake.publicReturnsOnCall[len(fake.publicArgsForCall)]
@@ -1621,6 +1790,126 @@ func (fake *FakeResource) PublicReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeResource) RecordWebhookDelivery(arg1 atc.WebhookDelivery) error {
+	fake.recordWebhookDeliveryMutex.Lock()
+	ret, specificReturn := fake.recordWebhookDeliveryReturnsOnCall[len(fake.recordWebhookDeliveryArgsForCall)]
+	fake.recordWebhookDeliveryArgsForCall = append(fake.recordWebhookDeliveryArgsForCall, struct {
+		arg1 atc.WebhookDelivery
+	}{arg1})
+	fake.recordInvocation("RecordWebhookDelivery", []interface{}{arg1})
+	fake.recordWebhookDeliveryMutex.Unlock()
+	if fake.RecordWebhookDeliveryStub != nil {
+		return fake.RecordWebhookDeliveryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordWebhookDeliveryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) RecordWebhookDeliveryCallCount() int {
+	fake.recordWebhookDeliveryMutex.RLock()
+	defer fake.recordWebhookDeliveryMutex.RUnlock()
+	return len(fake.recordWebhookDeliveryArgsForCall)
+}
+
+func (fake *FakeResource) RecordWebhookDeliveryCalls(stub func(atc.WebhookDelivery) error) {
+	fake.recordWebhookDeliveryMutex.Lock()
+	defer fake.recordWebhookDeliveryMutex.Unlock()
+	fake.RecordWebhookDeliveryStub = stub
+}
+
+func (fake *FakeResource) RecordWebhookDeliveryArgsForCall(i int) atc.WebhookDelivery {
+	fake.recordWebhookDeliveryMutex.RLock()
+	defer fake.recordWebhookDeliveryMutex.RUnlock()
+	argsForCall := fake.recordWebhookDeliveryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) RecordWebhookDeliveryReturns(result1 error) {
+	fake.recordWebhookDeliveryMutex.Lock()
+	defer fake.recordWebhookDeliveryMutex.Unlock()
+	fake.RecordWebhookDeliveryStub = nil
+	fake.recordWebhookDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) RecordWebhookDeliveryReturnsOnCall(i int, result1 error) {
+	fake.recordWebhookDeliveryMutex.Lock()
+	defer fake.recordWebhookDeliveryMutex.Unlock()
+	fake.RecordWebhookDeliveryStub = nil
+	if fake.recordWebhookDeliveryReturnsOnCall == nil {
+		fake.recordWebhookDeliveryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordWebhookDeliveryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) ReleasePrefetchedCaches(arg1 db.UsedResourceCache) error {
+	fake.releasePrefetchedCachesMutex.Lock()
+	ret, specificReturn := fake.releasePrefetchedCachesReturnsOnCall[len(fake.releasePrefetchedCachesArgsForCall)]
+	fake.releasePrefetchedCachesArgsForCall = append(fake.releasePrefetchedCachesArgsForCall, struct {
+		arg1 db.UsedResourceCache
+	}{arg1})
+	fake.recordInvocation("ReleasePrefetchedCaches", []interface{}{arg1})
+	fake.releasePrefetchedCachesMutex.Unlock()
+	if fake.ReleasePrefetchedCachesStub != nil {
+		return fake.ReleasePrefetchedCachesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.releasePrefetchedCachesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) ReleasePrefetchedCachesCallCount() int {
+	fake.releasePrefetchedCachesMutex.RLock()
+	defer fake.releasePrefetchedCachesMutex.RUnlock()
+	return len(fake.releasePrefetchedCachesArgsForCall)
+}
+
+func (fake *FakeResource) ReleasePrefetchedCachesCalls(stub func(db.UsedResourceCache) error) {
+	fake.releasePrefetchedCachesMutex.Lock()
+	defer fake.releasePrefetchedCachesMutex.Unlock()
+	fake.ReleasePrefetchedCachesStub = stub
+}
+
+func (fake *FakeResource) ReleasePrefetchedCachesArgsForCall(i int) db.UsedResourceCache {
+	fake.releasePrefetchedCachesMutex.RLock()
+	defer fake.releasePrefetchedCachesMutex.RUnlock()
+	argsForCall := fake.releasePrefetchedCachesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) ReleasePrefetchedCachesReturns(result1 error) {
+	fake.releasePrefetchedCachesMutex.Lock()
+	defer fake.releasePrefetchedCachesMutex.Unlock()
+	fake.ReleasePrefetchedCachesStub = nil
+	fake.releasePrefetchedCachesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) ReleasePrefetchedCachesReturnsOnCall(i int, result1 error) {
+	fake.releasePrefetchedCachesMutex.Lock()
+	defer fake.releasePrefetchedCachesMutex.Unlock()
+	fake.ReleasePrefetchedCachesStub = nil
+	if fake.releasePrefetchedCachesReturnsOnCall == nil {
+		fake.releasePrefetchedCachesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releasePrefetchedCachesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResource) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -2542,6 +2831,121 @@ func (fake *FakeResource) VersionsReturnsOnCall(i int, result1 []atc.ResourceVer
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeResource) Webhook() *atc.WebhookConfig {
+	fake.webhookMutex.Lock()
+	ret, specificReturn := fake.webhookReturnsOnCall[len(fake.webhookArgsForCall)]
+	fake.webhookArgsForCall = append(fake.webhookArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Webhook", []interface{}{})
+	fake.webhookMutex.Unlock()
+	if fake.WebhookStub != nil {
+		return fake.WebhookStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.webhookReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) WebhookCallCount() int {
+	fake.webhookMutex.RLock()
+	defer fake.webhookMutex.RUnlock()
+	return len(fake.webhookArgsForCall)
+}
+
+func (fake *FakeResource) WebhookCalls(stub func() *atc.WebhookConfig) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = stub
+}
+
+func (fake *FakeResource) WebhookReturns(result1 *atc.WebhookConfig) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = nil
+	fake.webhookReturns = struct {
+		result1 *atc.WebhookConfig
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookReturnsOnCall(i int, result1 *atc.WebhookConfig) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = nil
+	if fake.webhookReturnsOnCall == nil {
+		fake.webhookReturnsOnCall = make(map[int]struct {
+			result1 *atc.WebhookConfig
+		})
+	}
+	fake.webhookReturnsOnCall[i] = struct {
+		result1 *atc.WebhookConfig
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookDeliveries(arg1 int) ([]atc.WebhookDelivery, error) {
+	fake.webhookDeliveriesMutex.Lock()
+	ret, specificReturn := fake.webhookDeliveriesReturnsOnCall[len(fake.webhookDeliveriesArgsForCall)]
+	fake.webhookDeliveriesArgsForCall = append(fake.webhookDeliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("WebhookDeliveries", []interface{}{arg1})
+	fake.webhookDeliveriesMutex.Unlock()
+	if fake.WebhookDeliveriesStub != nil {
+		return fake.WebhookDeliveriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.webhookDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) WebhookDeliveriesCallCount() int {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	return len(fake.webhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeResource) WebhookDeliveriesCalls(stub func(int) ([]atc.WebhookDelivery, error)) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = stub
+}
+
+func (fake *FakeResource) WebhookDeliveriesArgsForCall(i int) int {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	argsForCall := fake.webhookDeliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) WebhookDeliveriesReturns(result1 []atc.WebhookDelivery, result2 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	fake.webhookDeliveriesReturns = struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) WebhookDeliveriesReturnsOnCall(i int, result1 []atc.WebhookDelivery, result2 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	if fake.webhookDeliveriesReturnsOnCall == nil {
+		fake.webhookDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.WebhookDelivery
+			result2 error
+		})
+	}
+	fake.webhookDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) WebhookToken() string {
 	fake.webhookTokenMutex.Lock()
 	ret, specificReturn := fake.webhookTokenReturnsOnCall[len(fake.webhookTokenArgsForCall)]
@@ -2603,6 +3007,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.checkErrorMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
 	fake.checkSetupErrorMutex.RLock()
 	defer fake.checkSetupErrorMutex.RUnlock()
 	fake.checkTimeoutMutex.RLock()
@@ -2639,8 +3045,14 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.pipelineIDMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
+	fake.prefetchMutex.RLock()
+	defer fake.prefetchMutex.RUnlock()
 	fake.publicMutex.RLock()
 	defer fake.publicMutex.RUnlock()
+	fake.recordWebhookDeliveryMutex.RLock()
+	defer fake.recordWebhookDeliveryMutex.RUnlock()
+	fake.releasePrefetchedCachesMutex.RLock()
+	defer fake.releasePrefetchedCachesMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
 	fake.resourceConfigIDMutex.RLock()
@@ -2673,6 +3085,10 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.updateMetadataMutex.RUnlock()
 	fake.versionsMutex.RLock()
 	defer fake.versionsMutex.RUnlock()
+	fake.webhookMutex.RLock()
+	defer fake.webhookMutex.RUnlock()
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
 	fake.webhookTokenMutex.RLock()
 	defer fake.webhookTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
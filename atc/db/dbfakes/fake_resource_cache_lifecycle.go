@@ -42,6 +42,17 @@ type FakeResourceCacheLifecycle struct {
 	cleanUsesForFinishedBuildsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CleanUsesForInactivePrefetchesStub        func(lager.Logger) error
+	cleanUsesForInactivePrefetchesMutex       sync.RWMutex
+	cleanUsesForInactivePrefetchesArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	cleanUsesForInactivePrefetchesReturns struct {
+		result1 error
+	}
+	cleanUsesForInactivePrefetchesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -226,6 +237,66 @@ func (fake *FakeResourceCacheLifecycle) CleanUsesForFinishedBuildsReturnsOnCall(
 	}{result1}
 }
 
+func (fake *FakeResourceCacheLifecycle) CleanUsesForInactivePrefetches(arg1 lager.Logger) error {
+	fake.cleanUsesForInactivePrefetchesMutex.Lock()
+	ret, specificReturn := fake.cleanUsesForInactivePrefetchesReturnsOnCall[len(fake.cleanUsesForInactivePrefetchesArgsForCall)]
+	fake.cleanUsesForInactivePrefetchesArgsForCall = append(fake.cleanUsesForInactivePrefetchesArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	fake.recordInvocation("CleanUsesForInactivePrefetches", []interface{}{arg1})
+	fake.cleanUsesForInactivePrefetchesMutex.Unlock()
+	if fake.CleanUsesForInactivePrefetchesStub != nil {
+		return fake.CleanUsesForInactivePrefetchesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cleanUsesForInactivePrefetchesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceCacheLifecycle) CleanUsesForInactivePrefetchesCallCount() int {
+	fake.cleanUsesForInactivePrefetchesMutex.RLock()
+	defer fake.cleanUsesForInactivePrefetchesMutex.RUnlock()
+	return len(fake.cleanUsesForInactivePrefetchesArgsForCall)
+}
+
+func (fake *FakeResourceCacheLifecycle) CleanUsesForInactivePrefetchesCalls(stub func(lager.Logger) error) {
+	fake.cleanUsesForInactivePrefetchesMutex.Lock()
+	defer fake.cleanUsesForInactivePrefetchesMutex.Unlock()
+	fake.CleanUsesForInactivePrefetchesStub = stub
+}
+
+func (fake *FakeResourceCacheLifecycle) CleanUsesForInactivePrefetchesArgsForCall(i int) lager.Logger {
+	fake.cleanUsesForInactivePrefetchesMutex.RLock()
+	defer fake.cleanUsesForInactivePrefetchesMutex.RUnlock()
+	argsForCall := fake.cleanUsesForInactivePrefetchesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResourceCacheLifecycle) CleanUsesForInactivePrefetchesReturns(result1 error) {
+	fake.cleanUsesForInactivePrefetchesMutex.Lock()
+	defer fake.cleanUsesForInactivePrefetchesMutex.Unlock()
+	fake.CleanUsesForInactivePrefetchesStub = nil
+	fake.cleanUsesForInactivePrefetchesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResourceCacheLifecycle) CleanUsesForInactivePrefetchesReturnsOnCall(i int, result1 error) {
+	fake.cleanUsesForInactivePrefetchesMutex.Lock()
+	defer fake.cleanUsesForInactivePrefetchesMutex.Unlock()
+	fake.CleanUsesForInactivePrefetchesStub = nil
+	if fake.cleanUsesForInactivePrefetchesReturnsOnCall == nil {
+		fake.cleanUsesForInactivePrefetchesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cleanUsesForInactivePrefetchesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResourceCacheLifecycle) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -235,6 +306,8 @@ func (fake *FakeResourceCacheLifecycle) Invocations() map[string][][]interface{}
 	defer fake.cleanUpInvalidCachesMutex.RUnlock()
 	fake.cleanUsesForFinishedBuildsMutex.RLock()
 	defer fake.cleanUsesForFinishedBuildsMutex.RUnlock()
+	fake.cleanUsesForInactivePrefetchesMutex.RLock()
+	defer fake.cleanUsesForInactivePrefetchesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -108,6 +108,18 @@ type FakeResourceConfigScope struct {
 	saveVersionsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveVersionsWithMetadataStub        func(db.SpanContext, []atc.VersionWithMetadata) error
+	saveVersionsWithMetadataMutex       sync.RWMutex
+	saveVersionsWithMetadataArgsForCall []struct {
+		arg1 db.SpanContext
+		arg2 []atc.VersionWithMetadata
+	}
+	saveVersionsWithMetadataReturns struct {
+		result1 error
+	}
+	saveVersionsWithMetadataReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetCheckErrorStub        func(error) error
 	setCheckErrorMutex       sync.RWMutex
 	setCheckErrorArgsForCall []struct {
@@ -613,6 +625,72 @@ func (fake *FakeResourceConfigScope) SaveVersionsReturnsOnCall(i int, result1 er
 	}{result1}
 }
 
+func (fake *FakeResourceConfigScope) SaveVersionsWithMetadata(arg1 db.SpanContext, arg2 []atc.VersionWithMetadata) error {
+	var arg2Copy []atc.VersionWithMetadata
+	if arg2 != nil {
+		arg2Copy = make([]atc.VersionWithMetadata, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.saveVersionsWithMetadataMutex.Lock()
+	ret, specificReturn := fake.saveVersionsWithMetadataReturnsOnCall[len(fake.saveVersionsWithMetadataArgsForCall)]
+	fake.saveVersionsWithMetadataArgsForCall = append(fake.saveVersionsWithMetadataArgsForCall, struct {
+		arg1 db.SpanContext
+		arg2 []atc.VersionWithMetadata
+	}{arg1, arg2Copy})
+	fake.recordInvocation("SaveVersionsWithMetadata", []interface{}{arg1, arg2Copy})
+	fake.saveVersionsWithMetadataMutex.Unlock()
+	if fake.SaveVersionsWithMetadataStub != nil {
+		return fake.SaveVersionsWithMetadataStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveVersionsWithMetadataReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceConfigScope) SaveVersionsWithMetadataCallCount() int {
+	fake.saveVersionsWithMetadataMutex.RLock()
+	defer fake.saveVersionsWithMetadataMutex.RUnlock()
+	return len(fake.saveVersionsWithMetadataArgsForCall)
+}
+
+func (fake *FakeResourceConfigScope) SaveVersionsWithMetadataCalls(stub func(db.SpanContext, []atc.VersionWithMetadata) error) {
+	fake.saveVersionsWithMetadataMutex.Lock()
+	defer fake.saveVersionsWithMetadataMutex.Unlock()
+	fake.SaveVersionsWithMetadataStub = stub
+}
+
+func (fake *FakeResourceConfigScope) SaveVersionsWithMetadataArgsForCall(i int) (db.SpanContext, []atc.VersionWithMetadata) {
+	fake.saveVersionsWithMetadataMutex.RLock()
+	defer fake.saveVersionsWithMetadataMutex.RUnlock()
+	argsForCall := fake.saveVersionsWithMetadataArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResourceConfigScope) SaveVersionsWithMetadataReturns(result1 error) {
+	fake.saveVersionsWithMetadataMutex.Lock()
+	defer fake.saveVersionsWithMetadataMutex.Unlock()
+	fake.SaveVersionsWithMetadataStub = nil
+	fake.saveVersionsWithMetadataReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResourceConfigScope) SaveVersionsWithMetadataReturnsOnCall(i int, result1 error) {
+	fake.saveVersionsWithMetadataMutex.Lock()
+	defer fake.saveVersionsWithMetadataMutex.Unlock()
+	fake.SaveVersionsWithMetadataStub = nil
+	if fake.saveVersionsWithMetadataReturnsOnCall == nil {
+		fake.saveVersionsWithMetadataReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveVersionsWithMetadataReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResourceConfigScope) SetCheckError(arg1 error) error {
 	fake.setCheckErrorMutex.Lock()
 	ret, specificReturn := fake.setCheckErrorReturnsOnCall[len(fake.setCheckErrorArgsForCall)]
@@ -811,6 +889,8 @@ func (fake *FakeResourceConfigScope) Invocations() map[string][][]interface{} {
 	defer fake.resourceConfigMutex.RUnlock()
 	fake.saveVersionsMutex.RLock()
 	defer fake.saveVersionsMutex.RUnlock()
+	fake.saveVersionsWithMetadataMutex.RLock()
+	defer fake.saveVersionsWithMetadataMutex.RUnlock()
 	fake.setCheckErrorMutex.RLock()
 	defer fake.setCheckErrorMutex.RUnlock()
 	fake.updateLastCheckEndTimeMutex.RLock()
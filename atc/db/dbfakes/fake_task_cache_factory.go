@@ -25,6 +25,22 @@ type FakeTaskCacheFactory struct {
 		result2 bool
 		result3 error
 	}
+	FindNamedStub        func(int, string) (db.UsedTaskCache, bool, error)
+	findNamedMutex       sync.RWMutex
+	findNamedArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	findNamedReturns struct {
+		result1 db.UsedTaskCache
+		result2 bool
+		result3 error
+	}
+	findNamedReturnsOnCall map[int]struct {
+		result1 db.UsedTaskCache
+		result2 bool
+		result3 error
+	}
 	FindOrCreateStub        func(int, string, string) (db.UsedTaskCache, error)
 	findOrCreateMutex       sync.RWMutex
 	findOrCreateArgsForCall []struct {
@@ -40,6 +56,20 @@ type FakeTaskCacheFactory struct {
 		result1 db.UsedTaskCache
 		result2 error
 	}
+	FindOrCreateNamedStub        func(int, string) (db.UsedTaskCache, error)
+	findOrCreateNamedMutex       sync.RWMutex
+	findOrCreateNamedArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	findOrCreateNamedReturns struct {
+		result1 db.UsedTaskCache
+		result2 error
+	}
+	findOrCreateNamedReturnsOnCall map[int]struct {
+		result1 db.UsedTaskCache
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -112,6 +142,73 @@ func (fake *FakeTaskCacheFactory) FindReturnsOnCall(i int, result1 db.UsedTaskCa
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTaskCacheFactory) FindNamed(arg1 int, arg2 string) (db.UsedTaskCache, bool, error) {
+	fake.findNamedMutex.Lock()
+	ret, specificReturn := fake.findNamedReturnsOnCall[len(fake.findNamedArgsForCall)]
+	fake.findNamedArgsForCall = append(fake.findNamedArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("FindNamed", []interface{}{arg1, arg2})
+	fake.findNamedMutex.Unlock()
+	if fake.FindNamedStub != nil {
+		return fake.FindNamedStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findNamedReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTaskCacheFactory) FindNamedCallCount() int {
+	fake.findNamedMutex.RLock()
+	defer fake.findNamedMutex.RUnlock()
+	return len(fake.findNamedArgsForCall)
+}
+
+func (fake *FakeTaskCacheFactory) FindNamedCalls(stub func(int, string) (db.UsedTaskCache, bool, error)) {
+	fake.findNamedMutex.Lock()
+	defer fake.findNamedMutex.Unlock()
+	fake.FindNamedStub = stub
+}
+
+func (fake *FakeTaskCacheFactory) FindNamedArgsForCall(i int) (int, string) {
+	fake.findNamedMutex.RLock()
+	defer fake.findNamedMutex.RUnlock()
+	argsForCall := fake.findNamedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskCacheFactory) FindNamedReturns(result1 db.UsedTaskCache, result2 bool, result3 error) {
+	fake.findNamedMutex.Lock()
+	defer fake.findNamedMutex.Unlock()
+	fake.FindNamedStub = nil
+	fake.findNamedReturns = struct {
+		result1 db.UsedTaskCache
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskCacheFactory) FindNamedReturnsOnCall(i int, result1 db.UsedTaskCache, result2 bool, result3 error) {
+	fake.findNamedMutex.Lock()
+	defer fake.findNamedMutex.Unlock()
+	fake.FindNamedStub = nil
+	if fake.findNamedReturnsOnCall == nil {
+		fake.findNamedReturnsOnCall = make(map[int]struct {
+			result1 db.UsedTaskCache
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findNamedReturnsOnCall[i] = struct {
+		result1 db.UsedTaskCache
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTaskCacheFactory) FindOrCreate(arg1 int, arg2 string, arg3 string) (db.UsedTaskCache, error) {
 	fake.findOrCreateMutex.Lock()
 	ret, specificReturn := fake.findOrCreateReturnsOnCall[len(fake.findOrCreateArgsForCall)]
@@ -177,13 +274,81 @@ func (fake *FakeTaskCacheFactory) FindOrCreateReturnsOnCall(i int, result1 db.Us
 	}{result1, result2}
 }
 
+func (fake *FakeTaskCacheFactory) FindOrCreateNamed(arg1 int, arg2 string) (db.UsedTaskCache, error) {
+	fake.findOrCreateNamedMutex.Lock()
+	ret, specificReturn := fake.findOrCreateNamedReturnsOnCall[len(fake.findOrCreateNamedArgsForCall)]
+	fake.findOrCreateNamedArgsForCall = append(fake.findOrCreateNamedArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("FindOrCreateNamed", []interface{}{arg1, arg2})
+	fake.findOrCreateNamedMutex.Unlock()
+	if fake.FindOrCreateNamedStub != nil {
+		return fake.FindOrCreateNamedStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.findOrCreateNamedReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskCacheFactory) FindOrCreateNamedCallCount() int {
+	fake.findOrCreateNamedMutex.RLock()
+	defer fake.findOrCreateNamedMutex.RUnlock()
+	return len(fake.findOrCreateNamedArgsForCall)
+}
+
+func (fake *FakeTaskCacheFactory) FindOrCreateNamedCalls(stub func(int, string) (db.UsedTaskCache, error)) {
+	fake.findOrCreateNamedMutex.Lock()
+	defer fake.findOrCreateNamedMutex.Unlock()
+	fake.FindOrCreateNamedStub = stub
+}
+
+func (fake *FakeTaskCacheFactory) FindOrCreateNamedArgsForCall(i int) (int, string) {
+	fake.findOrCreateNamedMutex.RLock()
+	defer fake.findOrCreateNamedMutex.RUnlock()
+	argsForCall := fake.findOrCreateNamedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskCacheFactory) FindOrCreateNamedReturns(result1 db.UsedTaskCache, result2 error) {
+	fake.findOrCreateNamedMutex.Lock()
+	defer fake.findOrCreateNamedMutex.Unlock()
+	fake.FindOrCreateNamedStub = nil
+	fake.findOrCreateNamedReturns = struct {
+		result1 db.UsedTaskCache
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCacheFactory) FindOrCreateNamedReturnsOnCall(i int, result1 db.UsedTaskCache, result2 error) {
+	fake.findOrCreateNamedMutex.Lock()
+	defer fake.findOrCreateNamedMutex.Unlock()
+	fake.FindOrCreateNamedStub = nil
+	if fake.findOrCreateNamedReturnsOnCall == nil {
+		fake.findOrCreateNamedReturnsOnCall = make(map[int]struct {
+			result1 db.UsedTaskCache
+			result2 error
+		})
+	}
+	fake.findOrCreateNamedReturnsOnCall[i] = struct {
+		result1 db.UsedTaskCache
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskCacheFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.findMutex.RLock()
 	defer fake.findMutex.RUnlock()
+	fake.findNamedMutex.RLock()
+	defer fake.findNamedMutex.RUnlock()
 	fake.findOrCreateMutex.RLock()
 	defer fake.findOrCreateMutex.RUnlock()
+	fake.findOrCreateNamedMutex.RLock()
+	defer fake.findOrCreateNamedMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
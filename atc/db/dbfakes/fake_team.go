@@ -32,6 +32,19 @@ type FakeTeam struct {
 	authReturnsOnCall map[int]struct {
 		result1 atc.TeamAuth
 	}
+	BuildLifecycleEventsStub        func(int64) (db.BuildLifecycleEventSource, error)
+	buildLifecycleEventsMutex       sync.RWMutex
+	buildLifecycleEventsArgsForCall []struct {
+		arg1 int64
+	}
+	buildLifecycleEventsReturns struct {
+		result1 db.BuildLifecycleEventSource
+		result2 error
+	}
+	buildLifecycleEventsReturnsOnCall map[int]struct {
+		result1 db.BuildLifecycleEventSource
+		result2 error
+	}
 	BuildsStub        func(db.Page) ([]db.Build, db.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -62,6 +75,29 @@ type FakeTeam struct {
 		result2 db.Pagination
 		result3 error
 	}
+	CachesStub        func() atc.NamedCacheConfigs
+	cachesMutex       sync.RWMutex
+	cachesArgsForCall []struct {
+	}
+	cachesReturns struct {
+		result1 atc.NamedCacheConfigs
+	}
+	cachesReturnsOnCall map[int]struct {
+		result1 atc.NamedCacheConfigs
+	}
+	ClearNamedCacheStub        func(string) (int64, error)
+	clearNamedCacheMutex       sync.RWMutex
+	clearNamedCacheArgsForCall []struct {
+		arg1 string
+	}
+	clearNamedCacheReturns struct {
+		result1 int64
+		result2 error
+	}
+	clearNamedCacheReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
 	ContainersStub        func() ([]db.Container, error)
 	containersMutex       sync.RWMutex
 	containersArgsForCall []struct {
@@ -86,10 +122,11 @@ type FakeTeam struct {
 		result1 db.Build
 		result2 error
 	}
-	CreateStartedBuildStub        func(atc.Plan) (db.Build, error)
+	CreateStartedBuildStub        func(atc.Plan, string) (db.Build, error)
 	createStartedBuildMutex       sync.RWMutex
 	createStartedBuildArgsForCall []struct {
 		arg1 atc.Plan
+		arg2 string
 	}
 	createStartedBuildReturns struct {
 		result1 db.Build
@@ -263,6 +300,16 @@ type FakeTeam struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NetworkStub        func() *atc.TeamNetworkConfig
+	networkMutex       sync.RWMutex
+	networkArgsForCall []struct {
+	}
+	networkReturns struct {
+		result1 *atc.TeamNetworkConfig
+	}
+	networkReturnsOnCall map[int]struct {
+		result1 *atc.TeamNetworkConfig
+	}
 	OrderPipelinesStub        func([]string) error
 	orderPipelinesMutex       sync.RWMutex
 	orderPipelinesArgsForCall []struct {
@@ -371,6 +418,41 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	SearchBuildLogsStub        func(db.BuildLogSearch) ([]atc.BuildLogMatch, error)
+	searchBuildLogsMutex       sync.RWMutex
+	searchBuildLogsArgsForCall []struct {
+		arg1 db.BuildLogSearch
+	}
+	searchBuildLogsReturns struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}
+	searchBuildLogsReturnsOnCall map[int]struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}
+	UpdateCachesStub        func(atc.NamedCacheConfigs) error
+	updateCachesMutex       sync.RWMutex
+	updateCachesArgsForCall []struct {
+		arg1 atc.NamedCacheConfigs
+	}
+	updateCachesReturns struct {
+		result1 error
+	}
+	updateCachesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateNetworkStub        func(*atc.TeamNetworkConfig) error
+	updateNetworkMutex       sync.RWMutex
+	updateNetworkArgsForCall []struct {
+		arg1 *atc.TeamNetworkConfig
+	}
+	updateNetworkReturns struct {
+		result1 error
+	}
+	updateNetworkReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -502,6 +584,69 @@ func (fake *FakeTeam) AuthReturnsOnCall(i int, result1 atc.TeamAuth) {
 	}{result1}
 }
 
+func (fake *FakeTeam) BuildLifecycleEvents(arg1 int64) (db.BuildLifecycleEventSource, error) {
+	fake.buildLifecycleEventsMutex.Lock()
+	ret, specificReturn := fake.buildLifecycleEventsReturnsOnCall[len(fake.buildLifecycleEventsArgsForCall)]
+	fake.buildLifecycleEventsArgsForCall = append(fake.buildLifecycleEventsArgsForCall, struct {
+		arg1 int64
+	}{arg1})
+	fake.recordInvocation("BuildLifecycleEvents", []interface{}{arg1})
+	fake.buildLifecycleEventsMutex.Unlock()
+	if fake.BuildLifecycleEventsStub != nil {
+		return fake.BuildLifecycleEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.buildLifecycleEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsCallCount() int {
+	fake.buildLifecycleEventsMutex.RLock()
+	defer fake.buildLifecycleEventsMutex.RUnlock()
+	return len(fake.buildLifecycleEventsArgsForCall)
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsCalls(stub func(int64) (db.BuildLifecycleEventSource, error)) {
+	fake.buildLifecycleEventsMutex.Lock()
+	defer fake.buildLifecycleEventsMutex.Unlock()
+	fake.BuildLifecycleEventsStub = stub
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsArgsForCall(i int) int64 {
+	fake.buildLifecycleEventsMutex.RLock()
+	defer fake.buildLifecycleEventsMutex.RUnlock()
+	argsForCall := fake.buildLifecycleEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsReturns(result1 db.BuildLifecycleEventSource, result2 error) {
+	fake.buildLifecycleEventsMutex.Lock()
+	defer fake.buildLifecycleEventsMutex.Unlock()
+	fake.BuildLifecycleEventsStub = nil
+	fake.buildLifecycleEventsReturns = struct {
+		result1 db.BuildLifecycleEventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsReturnsOnCall(i int, result1 db.BuildLifecycleEventSource, result2 error) {
+	fake.buildLifecycleEventsMutex.Lock()
+	defer fake.buildLifecycleEventsMutex.Unlock()
+	fake.BuildLifecycleEventsStub = nil
+	if fake.buildLifecycleEventsReturnsOnCall == nil {
+		fake.buildLifecycleEventsReturnsOnCall = make(map[int]struct {
+			result1 db.BuildLifecycleEventSource
+			result2 error
+		})
+	}
+	fake.buildLifecycleEventsReturnsOnCall[i] = struct {
+		result1 db.BuildLifecycleEventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Builds(arg1 db.Page) ([]db.Build, db.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -634,6 +779,121 @@ func (fake *FakeTeam) BuildsWithTimeReturnsOnCall(i int, result1 []db.Build, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) Caches() atc.NamedCacheConfigs {
+	fake.cachesMutex.Lock()
+	ret, specificReturn := fake.cachesReturnsOnCall[len(fake.cachesArgsForCall)]
+	fake.cachesArgsForCall = append(fake.cachesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Caches", []interface{}{})
+	fake.cachesMutex.Unlock()
+	if fake.CachesStub != nil {
+		return fake.CachesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cachesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) CachesCallCount() int {
+	fake.cachesMutex.RLock()
+	defer fake.cachesMutex.RUnlock()
+	return len(fake.cachesArgsForCall)
+}
+
+func (fake *FakeTeam) CachesCalls(stub func() atc.NamedCacheConfigs) {
+	fake.cachesMutex.Lock()
+	defer fake.cachesMutex.Unlock()
+	fake.CachesStub = stub
+}
+
+func (fake *FakeTeam) CachesReturns(result1 atc.NamedCacheConfigs) {
+	fake.cachesMutex.Lock()
+	defer fake.cachesMutex.Unlock()
+	fake.CachesStub = nil
+	fake.cachesReturns = struct {
+		result1 atc.NamedCacheConfigs
+	}{result1}
+}
+
+func (fake *FakeTeam) CachesReturnsOnCall(i int, result1 atc.NamedCacheConfigs) {
+	fake.cachesMutex.Lock()
+	defer fake.cachesMutex.Unlock()
+	fake.CachesStub = nil
+	if fake.cachesReturnsOnCall == nil {
+		fake.cachesReturnsOnCall = make(map[int]struct {
+			result1 atc.NamedCacheConfigs
+		})
+	}
+	fake.cachesReturnsOnCall[i] = struct {
+		result1 atc.NamedCacheConfigs
+	}{result1}
+}
+
+func (fake *FakeTeam) ClearNamedCache(arg1 string) (int64, error) {
+	fake.clearNamedCacheMutex.Lock()
+	ret, specificReturn := fake.clearNamedCacheReturnsOnCall[len(fake.clearNamedCacheArgsForCall)]
+	fake.clearNamedCacheArgsForCall = append(fake.clearNamedCacheArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ClearNamedCache", []interface{}{arg1})
+	fake.clearNamedCacheMutex.Unlock()
+	if fake.ClearNamedCacheStub != nil {
+		return fake.ClearNamedCacheStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.clearNamedCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ClearNamedCacheCallCount() int {
+	fake.clearNamedCacheMutex.RLock()
+	defer fake.clearNamedCacheMutex.RUnlock()
+	return len(fake.clearNamedCacheArgsForCall)
+}
+
+func (fake *FakeTeam) ClearNamedCacheCalls(stub func(string) (int64, error)) {
+	fake.clearNamedCacheMutex.Lock()
+	defer fake.clearNamedCacheMutex.Unlock()
+	fake.ClearNamedCacheStub = stub
+}
+
+func (fake *FakeTeam) ClearNamedCacheArgsForCall(i int) string {
+	fake.clearNamedCacheMutex.RLock()
+	defer fake.clearNamedCacheMutex.RUnlock()
+	argsForCall := fake.clearNamedCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) ClearNamedCacheReturns(result1 int64, result2 error) {
+	fake.clearNamedCacheMutex.Lock()
+	defer fake.clearNamedCacheMutex.Unlock()
+	fake.ClearNamedCacheStub = nil
+	fake.clearNamedCacheReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ClearNamedCacheReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.clearNamedCacheMutex.Lock()
+	defer fake.clearNamedCacheMutex.Unlock()
+	fake.ClearNamedCacheStub = nil
+	if fake.clearNamedCacheReturnsOnCall == nil {
+		fake.clearNamedCacheReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.clearNamedCacheReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Containers() ([]db.Container, error) {
 	fake.containersMutex.Lock()
 	ret, specificReturn := fake.containersReturnsOnCall[len(fake.containersArgsForCall)]
@@ -744,16 +1004,17 @@ func (fake *FakeTeam) CreateOneOffBuildReturnsOnCall(i int, result1 db.Build, re
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) CreateStartedBuild(arg1 atc.Plan) (db.Build, error) {
+func (fake *FakeTeam) CreateStartedBuild(arg1 atc.Plan, arg2 string) (db.Build, error) {
 	fake.createStartedBuildMutex.Lock()
 	ret, specificReturn := fake.createStartedBuildReturnsOnCall[len(fake.createStartedBuildArgsForCall)]
 	fake.createStartedBuildArgsForCall = append(fake.createStartedBuildArgsForCall, struct {
 		arg1 atc.Plan
-	}{arg1})
-	fake.recordInvocation("CreateStartedBuild", []interface{}{arg1})
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CreateStartedBuild", []interface{}{arg1, arg2})
 	fake.createStartedBuildMutex.Unlock()
 	if fake.CreateStartedBuildStub != nil {
-		return fake.CreateStartedBuildStub(arg1)
+		return fake.CreateStartedBuildStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -768,17 +1029,17 @@ func (fake *FakeTeam) CreateStartedBuildCallCount() int {
 	return len(fake.createStartedBuildArgsForCall)
 }
 
-func (fake *FakeTeam) CreateStartedBuildCalls(stub func(atc.Plan) (db.Build, error)) {
+func (fake *FakeTeam) CreateStartedBuildCalls(stub func(atc.Plan, string) (db.Build, error)) {
 	fake.createStartedBuildMutex.Lock()
 	defer fake.createStartedBuildMutex.Unlock()
 	fake.CreateStartedBuildStub = stub
 }
 
-func (fake *FakeTeam) CreateStartedBuildArgsForCall(i int) atc.Plan {
+func (fake *FakeTeam) CreateStartedBuildArgsForCall(i int) (atc.Plan, string) {
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
 	argsForCall := fake.createStartedBuildArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeTeam) CreateStartedBuildReturns(result1 db.Build, result2 error) {
@@ -1553,6 +1814,58 @@ func (fake *FakeTeam) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeTeam) Network() *atc.TeamNetworkConfig {
+	fake.networkMutex.Lock()
+	ret, specificReturn := fake.networkReturnsOnCall[len(fake.networkArgsForCall)]
+	fake.networkArgsForCall = append(fake.networkArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Network", []interface{}{})
+	fake.networkMutex.Unlock()
+	if fake.NetworkStub != nil {
+		return fake.NetworkStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.networkReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) NetworkCallCount() int {
+	fake.networkMutex.RLock()
+	defer fake.networkMutex.RUnlock()
+	return len(fake.networkArgsForCall)
+}
+
+func (fake *FakeTeam) NetworkCalls(stub func() *atc.TeamNetworkConfig) {
+	fake.networkMutex.Lock()
+	defer fake.networkMutex.Unlock()
+	fake.NetworkStub = stub
+}
+
+func (fake *FakeTeam) NetworkReturns(result1 *atc.TeamNetworkConfig) {
+	fake.networkMutex.Lock()
+	defer fake.networkMutex.Unlock()
+	fake.NetworkStub = nil
+	fake.networkReturns = struct {
+		result1 *atc.TeamNetworkConfig
+	}{result1}
+}
+
+func (fake *FakeTeam) NetworkReturnsOnCall(i int, result1 *atc.TeamNetworkConfig) {
+	fake.networkMutex.Lock()
+	defer fake.networkMutex.Unlock()
+	fake.NetworkStub = nil
+	if fake.networkReturnsOnCall == nil {
+		fake.networkReturnsOnCall = make(map[int]struct {
+			result1 *atc.TeamNetworkConfig
+		})
+	}
+	fake.networkReturnsOnCall[i] = struct {
+		result1 *atc.TeamNetworkConfig
+	}{result1}
+}
+
 func (fake *FakeTeam) OrderPipelines(arg1 []string) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -2053,6 +2366,189 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SearchBuildLogs(arg1 db.BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	fake.searchBuildLogsMutex.Lock()
+	ret, specificReturn := fake.searchBuildLogsReturnsOnCall[len(fake.searchBuildLogsArgsForCall)]
+	fake.searchBuildLogsArgsForCall = append(fake.searchBuildLogsArgsForCall, struct {
+		arg1 db.BuildLogSearch
+	}{arg1})
+	fake.recordInvocation("SearchBuildLogs", []interface{}{arg1})
+	fake.searchBuildLogsMutex.Unlock()
+	if fake.SearchBuildLogsStub != nil {
+		return fake.SearchBuildLogsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.searchBuildLogsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) SearchBuildLogsCallCount() int {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	return len(fake.searchBuildLogsArgsForCall)
+}
+
+func (fake *FakeTeam) SearchBuildLogsCalls(stub func(db.BuildLogSearch) ([]atc.BuildLogMatch, error)) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = stub
+}
+
+func (fake *FakeTeam) SearchBuildLogsArgsForCall(i int) db.BuildLogSearch {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	argsForCall := fake.searchBuildLogsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturns(result1 []atc.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	fake.searchBuildLogsReturns = struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturnsOnCall(i int, result1 []atc.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	if fake.searchBuildLogsReturnsOnCall == nil {
+		fake.searchBuildLogsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildLogMatch
+			result2 error
+		})
+	}
+	fake.searchBuildLogsReturnsOnCall[i] = struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) UpdateCaches(arg1 atc.NamedCacheConfigs) error {
+	fake.updateCachesMutex.Lock()
+	ret, specificReturn := fake.updateCachesReturnsOnCall[len(fake.updateCachesArgsForCall)]
+	fake.updateCachesArgsForCall = append(fake.updateCachesArgsForCall, struct {
+		arg1 atc.NamedCacheConfigs
+	}{arg1})
+	fake.recordInvocation("UpdateCaches", []interface{}{arg1})
+	fake.updateCachesMutex.Unlock()
+	if fake.UpdateCachesStub != nil {
+		return fake.UpdateCachesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateCachesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateCachesCallCount() int {
+	fake.updateCachesMutex.RLock()
+	defer fake.updateCachesMutex.RUnlock()
+	return len(fake.updateCachesArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateCachesCalls(stub func(atc.NamedCacheConfigs) error) {
+	fake.updateCachesMutex.Lock()
+	defer fake.updateCachesMutex.Unlock()
+	fake.UpdateCachesStub = stub
+}
+
+func (fake *FakeTeam) UpdateCachesArgsForCall(i int) atc.NamedCacheConfigs {
+	fake.updateCachesMutex.RLock()
+	defer fake.updateCachesMutex.RUnlock()
+	argsForCall := fake.updateCachesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateCachesReturns(result1 error) {
+	fake.updateCachesMutex.Lock()
+	defer fake.updateCachesMutex.Unlock()
+	fake.UpdateCachesStub = nil
+	fake.updateCachesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateCachesReturnsOnCall(i int, result1 error) {
+	fake.updateCachesMutex.Lock()
+	defer fake.updateCachesMutex.Unlock()
+	fake.UpdateCachesStub = nil
+	if fake.updateCachesReturnsOnCall == nil {
+		fake.updateCachesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateCachesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateNetwork(arg1 *atc.TeamNetworkConfig) error {
+	fake.updateNetworkMutex.Lock()
+	ret, specificReturn := fake.updateNetworkReturnsOnCall[len(fake.updateNetworkArgsForCall)]
+	fake.updateNetworkArgsForCall = append(fake.updateNetworkArgsForCall, struct {
+		arg1 *atc.TeamNetworkConfig
+	}{arg1})
+	fake.recordInvocation("UpdateNetwork", []interface{}{arg1})
+	fake.updateNetworkMutex.Unlock()
+	if fake.UpdateNetworkStub != nil {
+		return fake.UpdateNetworkStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateNetworkReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateNetworkCallCount() int {
+	fake.updateNetworkMutex.RLock()
+	defer fake.updateNetworkMutex.RUnlock()
+	return len(fake.updateNetworkArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateNetworkCalls(stub func(*atc.TeamNetworkConfig) error) {
+	fake.updateNetworkMutex.Lock()
+	defer fake.updateNetworkMutex.Unlock()
+	fake.UpdateNetworkStub = stub
+}
+
+func (fake *FakeTeam) UpdateNetworkArgsForCall(i int) *atc.TeamNetworkConfig {
+	fake.updateNetworkMutex.RLock()
+	defer fake.updateNetworkMutex.RUnlock()
+	argsForCall := fake.updateNetworkArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateNetworkReturns(result1 error) {
+	fake.updateNetworkMutex.Lock()
+	defer fake.updateNetworkMutex.Unlock()
+	fake.UpdateNetworkStub = nil
+	fake.updateNetworkReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateNetworkReturnsOnCall(i int, result1 error) {
+	fake.updateNetworkMutex.Lock()
+	defer fake.updateNetworkMutex.Unlock()
+	fake.UpdateNetworkStub = nil
+	if fake.updateNetworkReturnsOnCall == nil {
+		fake.updateNetworkReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateNetworkReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2175,10 +2671,16 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.adminMutex.RUnlock()
 	fake.authMutex.RLock()
 	defer fake.authMutex.RUnlock()
+	fake.buildLifecycleEventsMutex.RLock()
+	defer fake.buildLifecycleEventsMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.buildsWithTimeMutex.RLock()
 	defer fake.buildsWithTimeMutex.RUnlock()
+	fake.cachesMutex.RLock()
+	defer fake.cachesMutex.RUnlock()
+	fake.clearNamedCacheMutex.RLock()
+	defer fake.clearNamedCacheMutex.RUnlock()
 	fake.containersMutex.RLock()
 	defer fake.containersMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
@@ -2209,6 +2711,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.isContainerWithinTeamMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.networkMutex.RLock()
+	defer fake.networkMutex.RUnlock()
 	fake.orderPipelinesMutex.RLock()
 	defer fake.orderPipelinesMutex.RUnlock()
 	fake.pipelineMutex.RLock()
@@ -2225,6 +2729,12 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	fake.updateCachesMutex.RLock()
+	defer fake.updateCachesMutex.RUnlock()
+	fake.updateNetworkMutex.RLock()
+	defer fake.updateNetworkMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
 	fake.workersMutex.RLock()
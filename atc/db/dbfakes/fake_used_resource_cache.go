@@ -62,6 +62,18 @@ type FakeUsedResourceCache struct {
 	versionReturnsOnCall map[int]struct {
 		result1 atc.Version
 	}
+	WorkerNamesStub        func() ([]string, error)
+	workerNamesMutex       sync.RWMutex
+	workerNamesArgsForCall []struct {
+	}
+	workerNamesReturns struct {
+		result1 []string
+		result2 error
+	}
+	workerNamesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -337,6 +349,61 @@ func (fake *FakeUsedResourceCache) VersionReturnsOnCall(i int, result1 atc.Versi
 	}{result1}
 }
 
+func (fake *FakeUsedResourceCache) WorkerNames() ([]string, error) {
+	fake.workerNamesMutex.Lock()
+	ret, specificReturn := fake.workerNamesReturnsOnCall[len(fake.workerNamesArgsForCall)]
+	fake.workerNamesArgsForCall = append(fake.workerNamesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WorkerNames", []interface{}{})
+	fake.workerNamesMutex.Unlock()
+	if fake.WorkerNamesStub != nil {
+		return fake.WorkerNamesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.workerNamesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUsedResourceCache) WorkerNamesCallCount() int {
+	fake.workerNamesMutex.RLock()
+	defer fake.workerNamesMutex.RUnlock()
+	return len(fake.workerNamesArgsForCall)
+}
+
+func (fake *FakeUsedResourceCache) WorkerNamesCalls(stub func() ([]string, error)) {
+	fake.workerNamesMutex.Lock()
+	defer fake.workerNamesMutex.Unlock()
+	fake.WorkerNamesStub = stub
+}
+
+func (fake *FakeUsedResourceCache) WorkerNamesReturns(result1 []string, result2 error) {
+	fake.workerNamesMutex.Lock()
+	defer fake.workerNamesMutex.Unlock()
+	fake.WorkerNamesStub = nil
+	fake.workerNamesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUsedResourceCache) WorkerNamesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.workerNamesMutex.Lock()
+	defer fake.workerNamesMutex.Unlock()
+	fake.WorkerNamesStub = nil
+	if fake.workerNamesReturnsOnCall == nil {
+		fake.workerNamesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.workerNamesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUsedResourceCache) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -350,6 +417,8 @@ func (fake *FakeUsedResourceCache) Invocations() map[string][][]interface{} {
 	defer fake.resourceConfigMutex.RUnlock()
 	fake.versionMutex.RLock()
 	defer fake.versionMutex.RUnlock()
+	fake.workerNamesMutex.RLock()
+	defer fake.workerNamesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
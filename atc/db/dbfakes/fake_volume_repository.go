@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -282,6 +283,18 @@ type FakeVolumeRepository struct {
 		result1 int
 		result2 error
 	}
+	UpdateVolumesDiskUsageStub        func(string, atc.VolumesDiskUsage) error
+	updateVolumesDiskUsageMutex       sync.RWMutex
+	updateVolumesDiskUsageArgsForCall []struct {
+		arg1 string
+		arg2 atc.VolumesDiskUsage
+	}
+	updateVolumesDiskUsageReturns struct {
+		result1 error
+	}
+	updateVolumesDiskUsageReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateVolumesMissingSinceStub        func(string, []string) error
 	updateVolumesMissingSinceMutex       sync.RWMutex
 	updateVolumesMissingSinceArgsForCall []struct {
@@ -1523,6 +1536,67 @@ func (fake *FakeVolumeRepository) RemoveMissingVolumesReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeVolumeRepository) UpdateVolumesDiskUsage(arg1 string, arg2 atc.VolumesDiskUsage) error {
+	fake.updateVolumesDiskUsageMutex.Lock()
+	ret, specificReturn := fake.updateVolumesDiskUsageReturnsOnCall[len(fake.updateVolumesDiskUsageArgsForCall)]
+	fake.updateVolumesDiskUsageArgsForCall = append(fake.updateVolumesDiskUsageArgsForCall, struct {
+		arg1 string
+		arg2 atc.VolumesDiskUsage
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateVolumesDiskUsage", []interface{}{arg1, arg2})
+	fake.updateVolumesDiskUsageMutex.Unlock()
+	if fake.UpdateVolumesDiskUsageStub != nil {
+		return fake.UpdateVolumesDiskUsageStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateVolumesDiskUsageReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeVolumeRepository) UpdateVolumesDiskUsageCallCount() int {
+	fake.updateVolumesDiskUsageMutex.RLock()
+	defer fake.updateVolumesDiskUsageMutex.RUnlock()
+	return len(fake.updateVolumesDiskUsageArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) UpdateVolumesDiskUsageCalls(stub func(string, atc.VolumesDiskUsage) error) {
+	fake.updateVolumesDiskUsageMutex.Lock()
+	defer fake.updateVolumesDiskUsageMutex.Unlock()
+	fake.UpdateVolumesDiskUsageStub = stub
+}
+
+func (fake *FakeVolumeRepository) UpdateVolumesDiskUsageArgsForCall(i int) (string, atc.VolumesDiskUsage) {
+	fake.updateVolumesDiskUsageMutex.RLock()
+	defer fake.updateVolumesDiskUsageMutex.RUnlock()
+	argsForCall := fake.updateVolumesDiskUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVolumeRepository) UpdateVolumesDiskUsageReturns(result1 error) {
+	fake.updateVolumesDiskUsageMutex.Lock()
+	defer fake.updateVolumesDiskUsageMutex.Unlock()
+	fake.UpdateVolumesDiskUsageStub = nil
+	fake.updateVolumesDiskUsageReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeVolumeRepository) UpdateVolumesDiskUsageReturnsOnCall(i int, result1 error) {
+	fake.updateVolumesDiskUsageMutex.Lock()
+	defer fake.updateVolumesDiskUsageMutex.Unlock()
+	fake.UpdateVolumesDiskUsageStub = nil
+	if fake.updateVolumesDiskUsageReturnsOnCall == nil {
+		fake.updateVolumesDiskUsageReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateVolumesDiskUsageReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeVolumeRepository) UpdateVolumesMissingSince(arg1 string, arg2 []string) error {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -1630,6 +1704,8 @@ func (fake *FakeVolumeRepository) Invocations() map[string][][]interface{} {
 	defer fake.removeDestroyingVolumesMutex.RUnlock()
 	fake.removeMissingVolumesMutex.RLock()
 	defer fake.removeMissingVolumesMutex.RUnlock()
+	fake.updateVolumesDiskUsageMutex.RLock()
+	defer fake.updateVolumesDiskUsageMutex.RUnlock()
 	fake.updateVolumesMissingSinceMutex.RLock()
 	defer fake.updateVolumesMissingSinceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
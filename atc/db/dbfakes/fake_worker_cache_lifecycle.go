@@ -0,0 +1,195 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeWorkerCacheLifecycle struct {
+	EvictLeastRecentlyUsedCachesStub        func(string, int) (db.EvictedCaches, error)
+	evictLeastRecentlyUsedCachesMutex       sync.RWMutex
+	evictLeastRecentlyUsedCachesArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	evictLeastRecentlyUsedCachesReturns struct {
+		result1 db.EvictedCaches
+		result2 error
+	}
+	evictLeastRecentlyUsedCachesReturnsOnCall map[int]struct {
+		result1 db.EvictedCaches
+		result2 error
+	}
+	WorkersOverDiskUsageStub        func(int) ([]string, error)
+	workersOverDiskUsageMutex       sync.RWMutex
+	workersOverDiskUsageArgsForCall []struct {
+		arg1 int
+	}
+	workersOverDiskUsageReturns struct {
+		result1 []string
+		result2 error
+	}
+	workersOverDiskUsageReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWorkerCacheLifecycle) EvictLeastRecentlyUsedCaches(arg1 string, arg2 int) (db.EvictedCaches, error) {
+	fake.evictLeastRecentlyUsedCachesMutex.Lock()
+	ret, specificReturn := fake.evictLeastRecentlyUsedCachesReturnsOnCall[len(fake.evictLeastRecentlyUsedCachesArgsForCall)]
+	fake.evictLeastRecentlyUsedCachesArgsForCall = append(fake.evictLeastRecentlyUsedCachesArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("EvictLeastRecentlyUsedCaches", []interface{}{arg1, arg2})
+	fake.evictLeastRecentlyUsedCachesMutex.Unlock()
+	if fake.EvictLeastRecentlyUsedCachesStub != nil {
+		return fake.EvictLeastRecentlyUsedCachesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.evictLeastRecentlyUsedCachesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerCacheLifecycle) EvictLeastRecentlyUsedCachesCallCount() int {
+	fake.evictLeastRecentlyUsedCachesMutex.RLock()
+	defer fake.evictLeastRecentlyUsedCachesMutex.RUnlock()
+	return len(fake.evictLeastRecentlyUsedCachesArgsForCall)
+}
+
+func (fake *FakeWorkerCacheLifecycle) EvictLeastRecentlyUsedCachesCalls(stub func(string, int) (db.EvictedCaches, error)) {
+	fake.evictLeastRecentlyUsedCachesMutex.Lock()
+	defer fake.evictLeastRecentlyUsedCachesMutex.Unlock()
+	fake.EvictLeastRecentlyUsedCachesStub = stub
+}
+
+func (fake *FakeWorkerCacheLifecycle) EvictLeastRecentlyUsedCachesArgsForCall(i int) (string, int) {
+	fake.evictLeastRecentlyUsedCachesMutex.RLock()
+	defer fake.evictLeastRecentlyUsedCachesMutex.RUnlock()
+	argsForCall := fake.evictLeastRecentlyUsedCachesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorkerCacheLifecycle) EvictLeastRecentlyUsedCachesReturns(result1 db.EvictedCaches, result2 error) {
+	fake.evictLeastRecentlyUsedCachesMutex.Lock()
+	defer fake.evictLeastRecentlyUsedCachesMutex.Unlock()
+	fake.EvictLeastRecentlyUsedCachesStub = nil
+	fake.evictLeastRecentlyUsedCachesReturns = struct {
+		result1 db.EvictedCaches
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerCacheLifecycle) EvictLeastRecentlyUsedCachesReturnsOnCall(i int, result1 db.EvictedCaches, result2 error) {
+	fake.evictLeastRecentlyUsedCachesMutex.Lock()
+	defer fake.evictLeastRecentlyUsedCachesMutex.Unlock()
+	fake.EvictLeastRecentlyUsedCachesStub = nil
+	if fake.evictLeastRecentlyUsedCachesReturnsOnCall == nil {
+		fake.evictLeastRecentlyUsedCachesReturnsOnCall = make(map[int]struct {
+			result1 db.EvictedCaches
+			result2 error
+		})
+	}
+	fake.evictLeastRecentlyUsedCachesReturnsOnCall[i] = struct {
+		result1 db.EvictedCaches
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerCacheLifecycle) WorkersOverDiskUsage(arg1 int) ([]string, error) {
+	fake.workersOverDiskUsageMutex.Lock()
+	ret, specificReturn := fake.workersOverDiskUsageReturnsOnCall[len(fake.workersOverDiskUsageArgsForCall)]
+	fake.workersOverDiskUsageArgsForCall = append(fake.workersOverDiskUsageArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("WorkersOverDiskUsage", []interface{}{arg1})
+	fake.workersOverDiskUsageMutex.Unlock()
+	if fake.WorkersOverDiskUsageStub != nil {
+		return fake.WorkersOverDiskUsageStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.workersOverDiskUsageReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerCacheLifecycle) WorkersOverDiskUsageCallCount() int {
+	fake.workersOverDiskUsageMutex.RLock()
+	defer fake.workersOverDiskUsageMutex.RUnlock()
+	return len(fake.workersOverDiskUsageArgsForCall)
+}
+
+func (fake *FakeWorkerCacheLifecycle) WorkersOverDiskUsageCalls(stub func(int) ([]string, error)) {
+	fake.workersOverDiskUsageMutex.Lock()
+	defer fake.workersOverDiskUsageMutex.Unlock()
+	fake.WorkersOverDiskUsageStub = stub
+}
+
+func (fake *FakeWorkerCacheLifecycle) WorkersOverDiskUsageArgsForCall(i int) int {
+	fake.workersOverDiskUsageMutex.RLock()
+	defer fake.workersOverDiskUsageMutex.RUnlock()
+	argsForCall := fake.workersOverDiskUsageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorkerCacheLifecycle) WorkersOverDiskUsageReturns(result1 []string, result2 error) {
+	fake.workersOverDiskUsageMutex.Lock()
+	defer fake.workersOverDiskUsageMutex.Unlock()
+	fake.WorkersOverDiskUsageStub = nil
+	fake.workersOverDiskUsageReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerCacheLifecycle) WorkersOverDiskUsageReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.workersOverDiskUsageMutex.Lock()
+	defer fake.workersOverDiskUsageMutex.Unlock()
+	fake.WorkersOverDiskUsageStub = nil
+	if fake.workersOverDiskUsageReturnsOnCall == nil {
+		fake.workersOverDiskUsageReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.workersOverDiskUsageReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerCacheLifecycle) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.evictLeastRecentlyUsedCachesMutex.RLock()
+	defer fake.evictLeastRecentlyUsedCachesMutex.RUnlock()
+	fake.workersOverDiskUsageMutex.RLock()
+	defer fake.workersOverDiskUsageMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeWorkerCacheLifecycle) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.WorkerCacheLifecycle = new(FakeWorkerCacheLifecycle)
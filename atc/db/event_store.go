@@ -0,0 +1,183 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+const deleteBuildEventsBatchSize = 500
+
+//go:generate counterfeiter . EventStore
+
+// EventStore is where the events emitted by builds are kept.
+type EventStore interface {
+	// Save appends an event to the build's events as part of the transaction.
+	Save(tx Tx, build Build, event atc.Event) error
+
+	// Events returns a source of the build's events, starting from the given
+	// offset.
+	Events(conn Conn, build Build, from uint) (EventSource, error)
+
+	// Finalize is called once a build has completed and will not emit any
+	// more events. It is called in the background rather than as the build
+	// finishes, and called again if it fails.
+	Finalize(conn Conn, build Build) error
+
+	// Delete removes the events of the given builds as part of the
+	// transaction.
+	Delete(tx Tx, buildIDs []int) error
+
+	// Collect removes whatever is left of the events of the builds passed to
+	// Delete outside of Postgres, once the transaction has been committed.
+	// It is called periodically, so anything it fails to remove is retried.
+	Collect(conn Conn) error
+}
+
+// NewPostgresEventStore returns the EventStore which keeps events in the
+// per-pipeline and per-team build event tables.
+func NewPostgresEventStore() EventStore {
+	return postgresEventStore{}
+}
+
+type postgresEventStore struct{}
+
+func (postgresEventStore) Save(tx Tx, build Build, event atc.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert(buildEventsTable(build)).
+		Columns("event_id", "build_id", "type", "version", "payload").
+		Values(sq.Expr("nextval('"+buildEventSeq(build.ID())+"')"), build.ID(), string(event.EventType()), string(event.Version()), payload).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+func (postgresEventStore) Events(conn Conn, build Build, from uint) (EventSource, error) {
+	notifier, err := newConditionNotifier(conn.Bus(), buildEventsChannel(build.ID()), func() (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newBuildEventSource(
+		build.ID(),
+		buildEventsTable(build),
+		conn,
+		notifier,
+		from,
+	), nil
+}
+
+func (postgresEventStore) Finalize(Conn, Build) error {
+	return nil
+}
+
+func (postgresEventStore) Collect(Conn) error {
+	return nil
+}
+
+func (postgresEventStore) Delete(tx Tx, buildIDs []int) error {
+	if len(buildIDs) == 0 {
+		return nil
+	}
+
+	interfaceBuildIDs := make([]interface{}, len(buildIDs))
+	for i, buildID := range buildIDs {
+		interfaceBuildIDs[i] = buildID
+	}
+
+	indexStrings := make([]string, len(buildIDs))
+	for i := range indexStrings {
+		indexStrings[i] = "$" + strconv.Itoa(i+1)
+	}
+
+	_, err := tx.Exec(`
+		DELETE FROM build_events
+		WHERE build_id IN (`+strings.Join(indexStrings, ",")+`)
+	`, interfaceBuildIDs...)
+	return err
+}
+
+// deleteBuildEvents removes the events which have been offloaded for the
+// builds matching the condition, e.g. before the pipeline or team they belong
+// to is deleted. Events still in Postgres go away along with their table.
+func deleteBuildEvents(tx Tx, store EventStore, condition sq.Sqlizer) error {
+	rows, err := psql.Select("id").
+		From("builds").
+		Where(condition).
+		Where(sq.Eq{"events_offloaded": true}).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	var buildIDs []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			_ = rows.Close()
+			return err
+		}
+
+		buildIDs = append(buildIDs, id)
+	}
+
+	err = rows.Close()
+	if err != nil {
+		return err
+	}
+
+	for len(buildIDs) > 0 {
+		batch := buildIDs
+		if len(batch) > deleteBuildEventsBatchSize {
+			batch = batch[:deleteBuildEventsBatchSize]
+		}
+
+		err = store.Delete(tx, batch)
+		if err != nil {
+			return err
+		}
+
+		buildIDs = buildIDs[len(batch):]
+	}
+
+	return nil
+}
+
+func buildEventsTable(build Build) string {
+	if build.PipelineID() != 0 {
+		return fmt.Sprintf("pipeline_build_events_%d", build.PipelineID())
+	}
+
+	return fmt.Sprintf("team_build_events_%d", build.TeamID())
+}
+
+// WithEventStore returns a Conn whose builds keep their events in the given
+// EventStore instead of only in Postgres.
+func WithEventStore(conn Conn, store EventStore) Conn {
+	return &eventStoreConn{
+		Conn:  conn,
+		store: store,
+	}
+}
+
+type eventStoreConn struct {
+	Conn
+
+	store EventStore
+}
+
+func (conn *eventStoreConn) EventStore() EventStore {
+	return conn.store
+}
@@ -79,8 +79,10 @@ type Job interface {
 	Unpause() error
 
 	ScheduleBuild(Build) (bool, error)
-	CreateBuild() (Build, error)
-	RerunBuild(Build) (Build, error)
+	CreateBuild(createdBy string) (Build, error)
+	CreateBuildWithBreakpoints(createdBy string, breakpoints map[string]atc.Breakpoint) (Build, error)
+	RerunBuild(buildToRerun Build, createdBy string) (Build, error)
+	RerunBuildFromStep(buildToRerun Build, stepName string, createdBy string) (Build, error)
 
 	RequestSchedule() error
 	UpdateLastScheduled(time.Time) error
@@ -89,6 +91,7 @@ type Job interface {
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 	Build(name string) (Build, bool, error)
 	FinishedAndNextBuild() (Build, Build, error)
+	Stats(since time.Time) (JobStats, error)
 	UpdateFirstLoggedBuildID(newFirstLoggedBuildID int) error
 	EnsurePendingBuildExists(context.Context) error
 	GetPendingBuilds() ([]Build, error)
@@ -99,6 +102,8 @@ type Job interface {
 
 	ClearTaskCache(string, string) (int64, error)
 
+	ReleasePrefetchedImages(except []UsedResourceCache) error
+
 	AcquireSchedulingLock(lager.Logger) (lock.Lock, bool, error)
 
 	SetHasNewInputs(bool) error
@@ -772,7 +777,24 @@ func (j *job) GetPendingBuilds() ([]Build, error) {
 	return builds, nil
 }
 
-func (j *job) CreateBuild() (Build, error) {
+func (j *job) CreateBuild(createdBy string) (Build, error) {
+	return j.CreateBuildWithBreakpoints(createdBy, nil)
+}
+
+// CreateBuildWithBreakpoints creates a build which pauses before or after
+// each of the get, put or task steps with the given names, as if they were
+// configured with a breakpoint.
+func (j *job) CreateBuildWithBreakpoints(createdBy string, breakpoints map[string]atc.Breakpoint) (Build, error) {
+	var breakpointsJSON interface{}
+	if len(breakpoints) > 0 {
+		payload, err := json.Marshal(breakpoints)
+		if err != nil {
+			return nil, err
+		}
+
+		breakpointsJSON = string(payload)
+	}
+
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -793,6 +815,8 @@ func (j *job) CreateBuild() (Build, error) {
 		"team_id":            j.teamID,
 		"status":             BuildStatusPending,
 		"manually_triggered": true,
+		"created_by":         nullableString(createdBy),
+		"breakpoints":        breakpointsJSON,
 	})
 	if err != nil {
 		return nil, err
@@ -821,9 +845,17 @@ func (j *job) CreateBuild() (Build, error) {
 	return build, nil
 }
 
-func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
+func (j *job) RerunBuild(buildToRerun Build, createdBy string) (Build, error) {
+	return j.RerunBuildFromStep(buildToRerun, "", createdBy)
+}
+
+// RerunBuildFromStep reruns the build with the same inputs, reusing what the
+// steps which come before the given step produced in the build, so that only
+// the given step onward run again. If the step name is empty, every step runs
+// again.
+func (j *job) RerunBuildFromStep(buildToRerun Build, stepName string, createdBy string) (Build, error) {
 	for {
-		rerunBuild, err := j.tryRerunBuild(buildToRerun)
+		rerunBuild, err := j.tryRerunBuild(buildToRerun, stepName, createdBy)
 		if err != nil {
 			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
 				continue
@@ -836,7 +868,7 @@ func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
 	}
 }
 
-func (j *job) tryRerunBuild(buildToRerun Build) (Build, error) {
+func (j *job) tryRerunBuild(buildToRerun Build, stepName string, createdBy string) (Build, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -854,8 +886,7 @@ func (j *job) tryRerunBuild(buildToRerun Build) (Build, error) {
 		return nil, err
 	}
 
-	rerunBuild := newEmptyBuild(j.conn, j.lockFactory)
-	err = createBuild(tx, rerunBuild, map[string]interface{}{
+	values := map[string]interface{}{
 		"name":         rerunBuildName,
 		"job_id":       j.id,
 		"pipeline_id":  j.pipelineID,
@@ -863,11 +894,29 @@ func (j *job) tryRerunBuild(buildToRerun Build) (Build, error) {
 		"status":       BuildStatusPending,
 		"rerun_of":     buildToRerunID,
 		"rerun_number": rerunNumber,
-	})
+		"created_by":   nullableString(createdBy),
+	}
+
+	if stepName != "" {
+		// steps are reused from the build being rerun rather than the original
+		// build, as it may itself have been rerun from a step
+		values["rerun_from_build_id"] = buildToRerun.ID()
+		values["rerun_from_step"] = stepName
+	}
+
+	rerunBuild := newEmptyBuild(j.conn, j.lockFactory)
+	err = createBuild(tx, rerunBuild, values)
 	if err != nil {
 		return nil, err
 	}
 
+	if stepName != "" {
+		err = useReusableResourceCaches(tx, rerunBuild.ID(), buildToRerun.ID())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	latestNonRerunID, err := latestCompletedNonRerunBuild(tx, j.id)
 	if err != nil {
 		return nil, err
@@ -997,6 +1046,24 @@ func (j *job) getSerialGroups(tx Tx) ([]string, error) {
 	return serialGroups, nil
 }
 
+// ReleasePrefetchedImages releases the images prefetched for the job's tasks,
+// other than the given ones, so that they can be garbage collected.
+func (j *job) ReleasePrefetchedImages(except []UsedResourceCache) error {
+	var ids []int
+	for _, cache := range except {
+		ids = append(ids, cache.ID())
+	}
+
+	_, err := psql.Delete("resource_cache_uses").
+		Where(sq.And{
+			sq.Eq{"job_id": j.id},
+			sq.NotEq{"resource_cache_id": ids},
+		}).
+		RunWith(j.conn).
+		Exec()
+	return err
+}
+
 func (j *job) RequestSchedule() error {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -21,6 +21,7 @@ type JobFactory interface {
 	VisibleJobs([]string) (atc.Dashboard, error)
 	AllActiveJobs() (atc.Dashboard, error)
 	JobsToSchedule() (SchedulerJobs, error)
+	JobsPrefetchingImages() (Jobs, error)
 }
 
 type jobFactory struct {
@@ -209,6 +210,25 @@ func (j *jobFactory) VisibleJobs(teamNames []string) (atc.Dashboard, error) {
 	return dashboard, nil
 }
 
+// JobsPrefetchingImages returns the active jobs of unpaused pipelines which
+// have tasks whose image is to be prefetched.
+func (j *jobFactory) JobsPrefetchingImages() (Jobs, error) {
+	rows, err := jobsQuery.
+		Where(sq.Eq{
+			"j.active":          true,
+			"j.prefetch_images": true,
+			"p.paused":          false,
+			"p.archived":        false,
+		}).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobs(j.conn, j.lockFactory, rows)
+}
+
 func (j *jobFactory) AllActiveJobs() (atc.Dashboard, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -181,7 +181,7 @@ var _ = Describe("Job Factory", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				transitionBuild, err := job.CreateBuild()
+				transitionBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 
 				err = transitionBuild.Finish(db.BuildStatusSucceeded)
@@ -191,7 +191,7 @@ var _ = Describe("Job Factory", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				finishedBuild, err := job.CreateBuild()
+				finishedBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 
 				err = finishedBuild.Finish(db.BuildStatusSucceeded)
@@ -201,7 +201,7 @@ var _ = Describe("Job Factory", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				nextBuild, err := job.CreateBuild()
+				nextBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 
 				visibleJobs, err := jobFactory.VisibleJobs([]string{"default-team"})
@@ -1020,4 +1020,76 @@ var _ = Describe("Job Factory", func() {
 			})
 		})
 	})
+
+	Describe("JobsPrefetchingImages", func() {
+		var pipeline db.Pipeline
+
+		BeforeEach(func() {
+			var err error
+			pipeline, _, err = defaultTeam.SavePipeline("prefetching-pipeline", atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name: "prefetching-job",
+						PlanSequence: atc.PlanSequence{
+							{
+								Task: "some-task",
+								TaskConfig: &atc.TaskConfig{
+									Platform: "linux",
+									ImageResource: &atc.ImageResource{
+										Type:     "some-type",
+										Source:   atc.Source{"some": "source"},
+										Prefetch: true,
+									},
+									Run: atc.TaskRunConfig{Path: "true"},
+								},
+							},
+						},
+					},
+					{
+						Name: "other-job",
+						PlanSequence: atc.PlanSequence{
+							{
+								Task: "some-task",
+								TaskConfig: &atc.TaskConfig{
+									Platform: "linux",
+									ImageResource: &atc.ImageResource{
+										Type:   "some-type",
+										Source: atc.Source{"some": "source"},
+									},
+									Run: atc.TaskRunConfig{Path: "true"},
+								},
+							},
+						},
+					},
+				},
+			}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		jobNames := func() []string {
+			jobs, err := jobFactory.JobsPrefetchingImages()
+			Expect(err).ToNot(HaveOccurred())
+
+			var names []string
+			for _, job := range jobs {
+				names = append(names, job.Name())
+			}
+
+			return names
+		}
+
+		It("returns the jobs with task images to prefetch", func() {
+			Expect(jobNames()).To(ConsistOf("prefetching-job"))
+		})
+
+		Context("when the pipeline is paused", func() {
+			BeforeEach(func() {
+				Expect(pipeline.Pause()).To(Succeed())
+			})
+
+			It("does not return its jobs", func() {
+				Expect(jobNames()).To(BeEmpty())
+			})
+		})
+	})
 })
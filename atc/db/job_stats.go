@@ -0,0 +1,178 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// JobStats summarizes the builds of a job which finished since a point in
+// time.
+type JobStats struct {
+	Since time.Time
+
+	Builds    int
+	Succeeded int
+	Failed    int
+	Errored   int
+	Aborted   int
+
+	// Durations of the builds which ran, by percentile.
+	DurationP50 time.Duration
+	DurationP90 time.Duration
+	DurationP99 time.Duration
+
+	// Recoveries is how many times the job went from failing (or erroring)
+	// back to succeeding, and MeanTimeToRecovery how long that took on average
+	// from the end of the first broken build.
+	Recoveries         int
+	MeanTimeToRecovery time.Duration
+
+	// FlakyBuilds is how many of the failed builds succeeded when rerun with
+	// the same inputs.
+	FlakyBuilds int
+}
+
+func (j *job) Stats(since time.Time) (JobStats, error) {
+	stats := JobStats{Since: since}
+
+	finished := sq.And{
+		sq.Eq{"b.job_id": j.id},
+		sq.Eq{"b.completed": true},
+		sq.GtOrEq{"b.end_time": since},
+	}
+
+	var p50, p90, p99 sql.NullFloat64
+	err := psql.Select(
+		"COUNT(*)",
+		"COUNT(*) FILTER (WHERE b.status = 'succeeded')",
+		"COUNT(*) FILTER (WHERE b.status = 'failed')",
+		"COUNT(*) FILTER (WHERE b.status = 'errored')",
+		"COUNT(*) FILTER (WHERE b.status = 'aborted')",
+		"percentile_disc(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM b.end_time - b.start_time)) FILTER (WHERE b.start_time IS NOT NULL)",
+		"percentile_disc(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM b.end_time - b.start_time)) FILTER (WHERE b.start_time IS NOT NULL)",
+		"percentile_disc(0.99) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM b.end_time - b.start_time)) FILTER (WHERE b.start_time IS NOT NULL)",
+	).
+		From("builds b").
+		Where(finished).
+		RunWith(j.conn).
+		QueryRow().
+		Scan(&stats.Builds, &stats.Succeeded, &stats.Failed, &stats.Errored, &stats.Aborted, &p50, &p90, &p99)
+	if err != nil {
+		return JobStats{}, err
+	}
+
+	stats.DurationP50 = secondsToDuration(p50.Float64)
+	stats.DurationP90 = secondsToDuration(p90.Float64)
+	stats.DurationP99 = secondsToDuration(p99.Float64)
+
+	stats.Recoveries, stats.MeanTimeToRecovery, err = j.timeToRecovery(finished)
+	if err != nil {
+		return JobStats{}, err
+	}
+
+	// a failed build is flaky if a rerun of it (or of the build it reran)
+	// which came after it succeeded with exactly the same inputs
+	err = psql.Select("COUNT(*)").
+		From("builds b").
+		Where(finished).
+		Where(sq.Eq{"b.status": BuildStatusFailed}).
+		Where(`EXISTS (
+			SELECT 1
+			FROM builds r
+			WHERE r.rerun_of = COALESCE(b.rerun_of, b.id)
+			AND r.id > b.id
+			AND r.status = 'succeeded'
+			AND NOT EXISTS (
+				(
+					SELECT resource_id, version_md5, name FROM build_resource_config_version_inputs WHERE build_id = b.id
+					EXCEPT
+					SELECT resource_id, version_md5, name FROM build_resource_config_version_inputs WHERE build_id = r.id
+				)
+				UNION ALL
+				(
+					SELECT resource_id, version_md5, name FROM build_resource_config_version_inputs WHERE build_id = r.id
+					EXCEPT
+					SELECT resource_id, version_md5, name FROM build_resource_config_version_inputs WHERE build_id = b.id
+				)
+			)
+		)`).
+		RunWith(j.conn).
+		QueryRow().
+		Scan(&stats.FlakyBuilds)
+	if err != nil {
+		return JobStats{}, err
+	}
+
+	return stats, nil
+}
+
+// timeToRecovery walks the job's builds in the order they finished, measuring
+// how long each run of failed or errored builds took to be followed by a
+// succeeded one.
+func (j *job) timeToRecovery(finished sq.Sqlizer) (int, time.Duration, error) {
+	rows, err := psql.Select("b.status", "b.end_time").
+		From("builds b").
+		Where(finished).
+		Where(sq.Eq{"b.status": []BuildStatus{
+			BuildStatusSucceeded,
+			BuildStatusFailed,
+			BuildStatusErrored,
+		}}).
+		OrderBy("b.end_time ASC", "b.id ASC").
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer Close(rows)
+
+	var (
+		recoveries int
+		total      time.Duration
+		brokenAt   time.Time
+	)
+
+	for rows.Next() {
+		var (
+			status  BuildStatus
+			endTime time.Time
+		)
+
+		err := rows.Scan(&status, &endTime)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if status != BuildStatusSucceeded {
+			if brokenAt.IsZero() {
+				brokenAt = endTime
+			}
+
+			continue
+		}
+
+		if !brokenAt.IsZero() {
+			recoveries++
+			total += endTime.Sub(brokenAt)
+			brokenAt = time.Time{}
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if recoveries == 0 {
+		return 0, 0, nil
+	}
+
+	return recoveries, total / time.Duration(recoveries), nil
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
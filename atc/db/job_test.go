@@ -203,13 +203,13 @@ var _ = Describe("Job", func() {
 			Expect(next).To(BeNil())
 			Expect(finished).To(BeNil())
 
-			finishedBuild, err := job.CreateBuild()
+			finishedBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			err = finishedBuild.Finish(db.BuildStatusSucceeded)
 			Expect(err).NotTo(HaveOccurred())
 
-			otherFinishedBuild, err := otherJob.CreateBuild()
+			otherFinishedBuild, err := otherJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			err = otherFinishedBuild.Finish(db.BuildStatusSucceeded)
@@ -221,14 +221,14 @@ var _ = Describe("Job", func() {
 			Expect(next).To(BeNil())
 			Expect(finished.ID()).To(Equal(finishedBuild.ID()))
 
-			nextBuild, err := job.CreateBuild()
+			nextBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			started, err := nextBuild.Start(atc.Plan{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(started).To(BeTrue())
 
-			otherNextBuild, err := otherJob.CreateBuild()
+			otherNextBuild, err := otherJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			otherStarted, err := otherNextBuild.Start(atc.Plan{})
@@ -241,7 +241,7 @@ var _ = Describe("Job", func() {
 			Expect(next.ID()).To(Equal(nextBuild.ID()))
 			Expect(finished.ID()).To(Equal(finishedBuild.ID()))
 
-			anotherRunningBuild, err := job.CreateBuild()
+			anotherRunningBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
 			finished, next, err = job.FinishedAndNextBuild()
@@ -318,10 +318,10 @@ var _ = Describe("Job", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := someJob.CreateBuild()
+				build, err := someJob.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = someOtherJob.CreateBuild()
+				_, err = someOtherJob.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				builds[i] = build
@@ -420,7 +420,7 @@ var _ = Describe("Job", func() {
 			Expect(found).To(BeTrue())
 
 			for i := range builds {
-				builds[i], err = job.CreateBuild()
+				builds[i], err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 
 				buildStart := time.Date(2020, 11, i+1, 0, 0, 0, 0, time.UTC)
@@ -499,12 +499,12 @@ var _ = Describe("Job", func() {
 		Context("when a build exists", func() {
 			BeforeEach(func() {
 				var err error
-				firstBuild, err = job.CreateBuild()
+				firstBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("finds the latest build", func() {
-				secondBuild, err := job.CreateBuild()
+				secondBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				build, found, err := job.Build("latest")
@@ -543,7 +543,7 @@ var _ = Describe("Job", func() {
 			It("requests schedule on the job", func() {
 				requestedSchedule := job.ScheduleRequestedTime()
 
-				_, err := job.CreateBuild()
+				_, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				found, err := job.Reload()
@@ -555,6 +555,115 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("CreateBuildWithBreakpoints", func() {
+		It("records the steps the build pauses before or after", func() {
+			breakpoints := map[string]atc.Breakpoint{
+				"some-task":       atc.BreakpointAfter,
+				"some-other-task": atc.BreakpointBefore,
+			}
+
+			build, err := job.CreateBuildWithBreakpoints(defaultBuildCreatedBy, breakpoints)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.Breakpoints()).To(Equal(breakpoints))
+
+			reloaded, found, err := job.Build(build.Name())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(reloaded.Breakpoints()).To(Equal(breakpoints))
+		})
+
+		It("records no breakpoints when none are given", func() {
+			build, err := job.CreateBuildWithBreakpoints(defaultBuildCreatedBy, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.Breakpoints()).To(BeEmpty())
+		})
+	})
+
+	Describe("RerunBuildFromStep", func() {
+		var (
+			firstBuild  db.Build
+			secondRerun db.Build
+		)
+
+		BeforeEach(func() {
+			var err error
+			firstBuild, err = job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			firstRerun, err := job.RerunBuildFromStep(firstBuild, "some-task", defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			secondRerun, err = job.RerunBuildFromStep(firstRerun, "some-other-task", defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("creates a rerun of the original build", func() {
+			Expect(secondRerun.Name()).To(Equal(fmt.Sprintf("%s.2", firstBuild.Name())))
+			Expect(secondRerun.RerunOf()).To(Equal(firstBuild.ID()))
+		})
+
+		It("records the step it is rerun from", func() {
+			Expect(secondRerun.RerunFromStep()).To(Equal("some-other-task"))
+
+			build, found, err := job.Build(secondRerun.Name())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.RerunFromStep()).To(Equal("some-other-task"))
+		})
+
+		Context("when a step of the build being rerun got a resource cache", func() {
+			var (
+				resourceCache db.UsedResourceCache
+				rerun         db.Build
+			)
+
+			BeforeEach(func() {
+				var err error
+				resourceCache, err = resourceCacheFactory.FindOrCreateResourceCache(
+					db.ForBuild(secondRerun.ID()),
+					"some-base-resource-type",
+					atc.Version{"some": "version"},
+					atc.Source{"some": "source"},
+					nil,
+					atc.VersionedResourceTypes{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(secondRerun.ID(), "some-plan", defaultTeam.ID()), db.ContainerMetadata{
+					Type:     "get",
+					StepName: "some-input",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), creatingContainer, "some-path")
+				Expect(err).NotTo(HaveOccurred())
+
+				createdVolume, err := creatingVolume.Created()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(createdVolume.InitializeResourceCache(resourceCache)).To(Succeed())
+
+				err = secondRerun.SaveStep(db.BuildStep{
+					Path:      "do.0/get:some-input",
+					Type:      db.BuildStepTypeGet,
+					Name:      "some-input",
+					Artifacts: map[string]string{"some-input": createdVolume.Handle()},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				rerun, err = job.RerunBuildFromStep(secondRerun, "some-task", defaultBuildCreatedBy)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("uses the resource cache for the rerun, so that it is kept until the rerun finishes", func() {
+				var count int
+				err := dbConn.QueryRow(`SELECT COUNT(*) FROM resource_cache_uses WHERE build_id = $1 AND resource_cache_id = $2`, rerun.ID(), resourceCache.ID()).Scan(&count)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(Equal(1))
+			})
+		})
+	})
+
 	Describe("RerunBuild", func() {
 		var firstBuild db.Build
 		var rerunErr error
@@ -562,13 +671,13 @@ var _ = Describe("Job", func() {
 		var buildToRerun db.Build
 
 		JustBeforeEach(func() {
-			rerunBuild, rerunErr = job.RerunBuild(buildToRerun)
+			rerunBuild, rerunErr = job.RerunBuild(buildToRerun, defaultBuildCreatedBy)
 		})
 
 		Context("when the first build exists", func() {
 			BeforeEach(func() {
 				var err error
-				firstBuild, err = job.CreateBuild()
+				firstBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				buildToRerun = firstBuild
@@ -586,10 +695,16 @@ var _ = Describe("Job", func() {
 				Expect(build.Status()).To(Equal(rerunBuild.Status()))
 			})
 
+			It("records who reran the build", func() {
+				Expect(rerunErr).ToNot(HaveOccurred())
+				Expect(firstBuild.CreatedBy()).To(Equal(defaultBuildCreatedBy))
+				Expect(rerunBuild.CreatedBy()).To(Equal(defaultBuildCreatedBy))
+			})
+
 			It("requests schedule on the job", func() {
 				requestedSchedule := job.ScheduleRequestedTime()
 
-				_, err := job.RerunBuild(buildToRerun)
+				_, err := job.RerunBuild(buildToRerun, defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				found, err := job.Reload()
@@ -604,7 +719,7 @@ var _ = Describe("Job", func() {
 
 				BeforeEach(func() {
 					var err error
-					rerun1, err = job.RerunBuild(buildToRerun)
+					rerun1, err = job.RerunBuild(buildToRerun, defaultBuildCreatedBy)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(rerun1.Name()).To(Equal(fmt.Sprintf("%s.1", firstBuild.Name())))
 					Expect(rerun1.RerunNumber()).To(Equal(1))
@@ -622,7 +737,7 @@ var _ = Describe("Job", func() {
 
 				BeforeEach(func() {
 					var err error
-					rerun1, err = job.RerunBuild(buildToRerun)
+					rerun1, err = job.RerunBuild(buildToRerun, defaultBuildCreatedBy)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(rerun1.Name()).To(Equal(fmt.Sprintf("%s.1", firstBuild.Name())))
 					Expect(rerun1.RerunNumber()).To(Equal(1))
@@ -639,6 +754,76 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("Stats", func() {
+		finishBuild := func(build db.Build, status db.BuildStatus) {
+			started, err := build.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			err = build.Finish(status)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			failedBuild, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+			finishBuild(failedBuild, db.BuildStatusFailed)
+
+			rerunBuild, err := job.RerunBuild(failedBuild, defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+			finishBuild(rerunBuild, db.BuildStatusSucceeded)
+
+			erroredBuild, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+			finishBuild(erroredBuild, db.BuildStatusErrored)
+
+			succeededBuild, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+			finishBuild(succeededBuild, db.BuildStatusSucceeded)
+
+			abortedBuild, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+			err = abortedBuild.Finish(db.BuildStatusAborted)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("summarizes the builds which finished within the window", func() {
+			stats, err := job.Stats(time.Now().Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(stats.Builds).To(Equal(5))
+			Expect(stats.Succeeded).To(Equal(2))
+			Expect(stats.Failed).To(Equal(1))
+			Expect(stats.Errored).To(Equal(1))
+			Expect(stats.Aborted).To(Equal(1))
+		})
+
+		It("counts the failed builds which succeeded on rerun as flaky", func() {
+			stats, err := job.Stats(time.Now().Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stats.FlakyBuilds).To(Equal(1))
+		})
+
+		It("counts each recovery from failing or erroring", func() {
+			stats, err := job.Stats(time.Now().Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stats.Recoveries).To(Equal(2))
+		})
+
+		Context("when no builds finished within the window", func() {
+			It("returns empty stats", func() {
+				since := time.Now().Add(time.Hour)
+
+				stats, err := job.Stats(since)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stats).To(Equal(db.JobStats{Since: since}))
+			})
+		})
+	})
+
 	Describe("ScheduleBuild", func() {
 		var (
 			schedulingBuild            db.Build
@@ -814,7 +999,7 @@ var _ = Describe("Job", func() {
 		Context("when the scheduling build is created first", func() {
 			BeforeEach(func() {
 				var err error
-				schedulingBuild, err = job.CreateBuild()
+				schedulingBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -905,7 +1090,7 @@ var _ = Describe("Job", func() {
 
 					BeforeEach(func() {
 						var err error
-						startedBuild, err = job.CreateBuild()
+						startedBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 						Expect(err).ToNot(HaveOccurred())
 						scheduled, err := job.ScheduleBuild(startedBuild)
 						Expect(err).ToNot(HaveOccurred())
@@ -913,7 +1098,7 @@ var _ = Describe("Job", func() {
 						_, err = startedBuild.Start(atc.Plan{})
 						Expect(err).NotTo(HaveOccurred())
 
-						scheduledBuild, err = job.CreateBuild()
+						scheduledBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 						Expect(err).NotTo(HaveOccurred())
 						scheduled, err = job.ScheduleBuild(scheduledBuild)
 						Expect(err).ToNot(HaveOccurred())
@@ -922,7 +1107,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 
 						for _, s := range []db.BuildStatus{db.BuildStatusSucceeded, db.BuildStatusFailed, db.BuildStatusErrored, db.BuildStatusAborted} {
-							finishedBuild, err := job.CreateBuild()
+							finishedBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 							Expect(err).NotTo(HaveOccurred())
 
 							scheduled, err = job.ScheduleBuild(finishedBuild)
@@ -937,7 +1122,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						_, err = otherJob.CreateBuild()
+						_, err = otherJob.CreateBuild(defaultBuildCreatedBy)
 						Expect(err).NotTo(HaveOccurred())
 					})
 
@@ -952,7 +1137,7 @@ var _ = Describe("Job", func() {
 
 				Context("when there is 1 build running", func() {
 					BeforeEach(func() {
-						startedBuild, err := job.CreateBuild()
+						startedBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 						Expect(err).NotTo(HaveOccurred())
 						scheduled, err := job.ScheduleBuild(startedBuild)
 						Expect(err).NotTo(HaveOccurred())
@@ -961,7 +1146,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 
 						for _, s := range []db.BuildStatus{db.BuildStatusSucceeded, db.BuildStatusFailed, db.BuildStatusErrored, db.BuildStatusAborted} {
-							finishedBuild, err := job.CreateBuild()
+							finishedBuild, err := job.CreateBuild(defaultBuildCreatedBy)
 							Expect(err).NotTo(HaveOccurred())
 
 							scheduled, err = job.ScheduleBuild(finishedBuild)
@@ -990,14 +1175,14 @@ var _ = Describe("Job", func() {
 				Context("when multiple jobs in the serial group is running", func() {
 					BeforeEach(func() {
 						var err error
-						_, err = job.CreateBuild()
+						_, err = job.CreateBuild(defaultBuildCreatedBy)
 						Expect(err).NotTo(HaveOccurred())
 
 						otherSerialJob, found, err := pipeline.Job("other-serial-group-job")
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						serialGroupBuild, err := otherSerialJob.CreateBuild()
+						serialGroupBuild, err := otherSerialJob.CreateBuild(defaultBuildCreatedBy)
 						Expect(err).NotTo(HaveOccurred())
 
 						scheduled, err := otherSerialJob.ScheduleBuild(serialGroupBuild)
@@ -1008,7 +1193,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						differentSerialGroupBuild, err := differentSerialJob.CreateBuild()
+						differentSerialGroupBuild, err := differentSerialJob.CreateBuild(defaultBuildCreatedBy)
 						Expect(err).NotTo(HaveOccurred())
 
 						scheduled, err = differentSerialJob.ScheduleBuild(differentSerialGroupBuild)
@@ -1030,7 +1215,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						serialGroupBuild, err := otherSerialJob.CreateBuild()
+						serialGroupBuild, err := otherSerialJob.CreateBuild(defaultBuildCreatedBy)
 						Expect(err).NotTo(HaveOccurred())
 
 						scheduled, err := otherSerialJob.ScheduleBuild(serialGroupBuild)
@@ -1044,7 +1229,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						differentSerialGroupBuild, err := differentSerialJob.CreateBuild()
+						differentSerialGroupBuild, err := differentSerialJob.CreateBuild(defaultBuildCreatedBy)
 						Expect(err).NotTo(HaveOccurred())
 
 						scheduled, err = differentSerialJob.ScheduleBuild(differentSerialGroupBuild)
@@ -1070,7 +1255,7 @@ var _ = Describe("Job", func() {
 			Context("when the scheduling build has inputs determined as false", func() {
 				BeforeEach(func() {
 					var err error
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, false)
@@ -1092,13 +1277,13 @@ var _ = Describe("Job", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					_, err = otherSerialJob.CreateBuild()
+					_, err = otherSerialJob.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 
 					err = otherSerialJob.SaveNextInputMapping(nil, true)
 					Expect(err).NotTo(HaveOccurred())
 
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, true)
@@ -1117,14 +1302,14 @@ var _ = Describe("Job", func() {
 			Context("when the scheduling build has it's inputs determined and created earlier", func() {
 				BeforeEach(func() {
 					var err error
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 
 					otherSerialJob, found, err := pipeline.Job("other-serial-group-job")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					_, err = otherSerialJob.CreateBuild()
+					_, err = otherSerialJob.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, true)
@@ -1145,14 +1330,14 @@ var _ = Describe("Job", func() {
 			Context("when the job is paused but has inputs determined", func() {
 				BeforeEach(func() {
 					var err error
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 
 					otherSerialJob, found, err := pipeline.Job("other-serial-group-job")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					_, err = otherSerialJob.CreateBuild()
+					_, err = otherSerialJob.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, true)
@@ -1179,7 +1364,7 @@ var _ = Describe("Job", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					succeededBuild, err := otherSerialJob.CreateBuild()
+					succeededBuild, err := otherSerialJob.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 
 					err = succeededBuild.Finish(db.BuildStatusSucceeded)
@@ -1190,7 +1375,7 @@ var _ = Describe("Job", func() {
 					err = otherSerialJob.SaveNextInputMapping(nil, true)
 					Expect(err).NotTo(HaveOccurred())
 
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 				})
 
@@ -1209,14 +1394,14 @@ var _ = Describe("Job", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					_, err = otherSerialJob.CreateBuild()
+					_, err = otherSerialJob.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 
 					job, found, err = pipeline.Job("other-serial-group-job")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, true)
@@ -1682,7 +1867,7 @@ var _ = Describe("Job", func() {
 			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
-			build1DB, err = job.CreateBuild()
+			build1DB, err = job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(build1DB.ID()).NotTo(BeZero())
@@ -1707,7 +1892,7 @@ var _ = Describe("Job", func() {
 
 		Context("and another build for a different pipeline is created with the same job name", func() {
 			BeforeEach(func() {
-				otherBuild, err := otherJob.CreateBuild()
+				otherBuild, err := otherJob.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(otherBuild.ID()).NotTo(BeZero())
@@ -1785,7 +1970,7 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				build2DB, err = job.CreateBuild()
+				build2DB, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(build2DB.ID()).NotTo(BeZero())
@@ -1812,16 +1997,16 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				newBuild, err = job.CreateBuild()
+				newBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
-				newerBuild, err = job.CreateBuild()
+				newerBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				err = newBuild.Finish(db.BuildStatusSucceeded)
 				Expect(err).NotTo(HaveOccurred())
 
-				rerunBuild, err = job.RerunBuild(newBuild)
+				rerunBuild, err = job.RerunBuild(newBuild, defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(rerunBuild.ID()).NotTo(BeZero())
@@ -1847,13 +2032,13 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				newBuild, err = job.CreateBuild()
+				newBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
-				rerunBuild, err = job.RerunBuild(newBuild)
+				rerunBuild, err = job.RerunBuild(newBuild, defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
-				newerBuild, err = job.CreateBuild()
+				newerBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(rerunBuild.ID()).NotTo(BeZero())
@@ -1882,19 +2067,19 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				newBuild, err = job.CreateBuild()
+				newBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
-				newerBuild, err = job.CreateBuild()
+				newerBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
-				rerunBuild3, err = job.RerunBuild(newerBuild)
+				rerunBuild3, err = job.RerunBuild(newerBuild, defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
-				rerunBuild, err = job.RerunBuild(newBuild)
+				rerunBuild, err = job.RerunBuild(newBuild, defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
-				rerunBuild2, err = job.RerunBuild(rerunBuild)
+				rerunBuild2, err = job.RerunBuild(rerunBuild, defaultBuildCreatedBy)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(rerunBuild.ID()).NotTo(BeZero())
@@ -2113,6 +2298,66 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("ReleasePrefetchedImages", func() {
+		var (
+			oldCache   db.UsedResourceCache
+			newCache   db.UsedResourceCache
+			cacheUsers func() []int
+		)
+
+		BeforeEach(func() {
+			var err error
+			oldCache, err = resourceCacheFactory.FindOrCreateResourceCache(
+				db.ForJob(job.ID()),
+				"some-base-resource-type",
+				atc.Version{"ref": "old"},
+				atc.Source{"some": "source"},
+				nil,
+				atc.VersionedResourceTypes{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			newCache, err = resourceCacheFactory.FindOrCreateResourceCache(
+				db.ForJob(job.ID()),
+				"some-base-resource-type",
+				atc.Version{"ref": "new"},
+				atc.Source{"some": "source"},
+				nil,
+				atc.VersionedResourceTypes{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			cacheUsers = func() []int {
+				rows, err := dbConn.Query(`SELECT resource_cache_id FROM resource_cache_uses WHERE job_id = $1`, job.ID())
+				Expect(err).ToNot(HaveOccurred())
+				defer rows.Close()
+
+				var ids []int
+				for rows.Next() {
+					var id int
+					Expect(rows.Scan(&id)).To(Succeed())
+					ids = append(ids, id)
+				}
+
+				return ids
+			}
+		})
+
+		It("releases every prefetched image but the given ones", func() {
+			Expect(cacheUsers()).To(ConsistOf(oldCache.ID(), newCache.ID()))
+
+			Expect(job.ReleasePrefetchedImages([]db.UsedResourceCache{newCache})).To(Succeed())
+
+			Expect(cacheUsers()).To(ConsistOf(newCache.ID()))
+		})
+
+		It("releases all of them when given none", func() {
+			Expect(job.ReleasePrefetchedImages(nil)).To(Succeed())
+
+			Expect(cacheUsers()).To(BeEmpty())
+		})
+	})
+
 	Describe("New Inputs", func() {
 		It("starts out as false", func() {
 			Expect(job.HasNewInputs()).To(BeFalse())
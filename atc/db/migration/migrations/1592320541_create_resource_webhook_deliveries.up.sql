@@ -0,0 +1,12 @@
+BEGIN;
+  CREATE TABLE resource_webhook_deliveries (
+    id serial PRIMARY KEY,
+    resource_id integer NOT NULL REFERENCES resources (id) ON DELETE CASCADE,
+    delivered_at timestamp with time zone NOT NULL DEFAULT now(),
+    outcome text NOT NULL,
+    message text,
+    check_id integer
+  );
+
+  CREATE INDEX resource_webhook_deliveries_resource_id_idx ON resource_webhook_deliveries (resource_id);
+COMMIT;
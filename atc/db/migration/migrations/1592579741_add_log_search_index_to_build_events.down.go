@@ -0,0 +1,57 @@
+package migrations
+
+import "fmt"
+
+func (self *migrations) Down_1592579741() error {
+	tx, err := self.DB.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		CREATE OR REPLACE FUNCTION on_pipeline_insert() RETURNS TRIGGER AS $$
+		BEGIN
+			EXECUTE format('CREATE TABLE IF NOT EXISTS pipeline_build_events_%s () INHERITS (build_events)', NEW.id);
+			EXECUTE format('CREATE INDEX IF NOT EXISTS pipeline_build_events_%s_build_id ON pipeline_build_events_%s (build_id)', NEW.id, NEW.id);
+			EXECUTE format('CREATE UNIQUE INDEX IF NOT EXISTS pipeline_build_events_%s_build_id_event_id ON pipeline_build_events_%s (build_id, event_id)', NEW.id, NEW.id);
+			RETURN NULL;
+		END;
+		$$ LANGUAGE plpgsql
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE OR REPLACE FUNCTION on_team_insert() RETURNS TRIGGER AS $$
+		BEGIN
+			EXECUTE format('CREATE TABLE IF NOT EXISTS team_build_events_%s () INHERITS (build_events)', NEW.id);
+			RETURN NULL;
+		END;
+		$$ LANGUAGE plpgsql
+	`)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	tables, err := self.buildEventsTables()
+	if err != nil {
+		return err
+	}
+
+	for _, table := range tables {
+		_, err = self.DB.Exec(fmt.Sprintf(`DROP INDEX CONCURRENTLY IF EXISTS %s_log_search`, table))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
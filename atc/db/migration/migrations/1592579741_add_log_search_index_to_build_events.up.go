@@ -0,0 +1,93 @@
+package migrations
+
+import "fmt"
+
+// Up_1592579741 indexes the logs of builds for full-text search.
+//
+// The build events tables of new pipelines and teams are indexed as they are
+// created. The existing ones may be large, so their indexes are built
+// concurrently, one table at a time, rather than in a single transaction
+// which would block writing build events until all of them are built.
+func (self *migrations) Up_1592579741() error {
+	tx, err := self.DB.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		CREATE OR REPLACE FUNCTION on_pipeline_insert() RETURNS TRIGGER AS $$
+		BEGIN
+			EXECUTE format('CREATE TABLE IF NOT EXISTS pipeline_build_events_%s () INHERITS (build_events)', NEW.id);
+			EXECUTE format('CREATE INDEX IF NOT EXISTS pipeline_build_events_%s_build_id ON pipeline_build_events_%s (build_id)', NEW.id, NEW.id);
+			EXECUTE format('CREATE UNIQUE INDEX IF NOT EXISTS pipeline_build_events_%s_build_id_event_id ON pipeline_build_events_%s (build_id, event_id)', NEW.id, NEW.id);
+			EXECUTE format('CREATE INDEX IF NOT EXISTS pipeline_build_events_%s_log_search ON pipeline_build_events_%s USING gin (to_tsvector(''simple'', payload::json->>''payload'')) WHERE type = ''log''', NEW.id, NEW.id);
+			RETURN NULL;
+		END;
+		$$ LANGUAGE plpgsql
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE OR REPLACE FUNCTION on_team_insert() RETURNS TRIGGER AS $$
+		BEGIN
+			EXECUTE format('CREATE TABLE IF NOT EXISTS team_build_events_%s () INHERITS (build_events)', NEW.id);
+			EXECUTE format('CREATE INDEX IF NOT EXISTS team_build_events_%s_log_search ON team_build_events_%s USING gin (to_tsvector(''simple'', payload::json->>''payload'')) WHERE type = ''log''', NEW.id, NEW.id);
+			RETURN NULL;
+		END;
+		$$ LANGUAGE plpgsql
+	`)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	tables, err := self.buildEventsTables()
+	if err != nil {
+		return err
+	}
+
+	for _, table := range tables {
+		err = self.createIndexConcurrently(table+"_log_search", fmt.Sprintf(`%s USING gin (to_tsvector('simple', payload::json->>'payload')) WHERE type = 'log'`, table))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildEventsTables returns the names of the build events tables of all of
+// the pipelines and teams.
+func (self *migrations) buildEventsTables() ([]string, error) {
+	rows, err := self.DB.Query(`
+		SELECT 'pipeline_build_events_' || id FROM pipelines
+		UNION ALL
+		SELECT 'team_build_events_' || id FROM teams
+	`)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		err = rows.Scan(&table)
+		if err != nil {
+			return nil, err
+		}
+
+		tables = append(tables, table)
+	}
+
+	return tables, rows.Err()
+}
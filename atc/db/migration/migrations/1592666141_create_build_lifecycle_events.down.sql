@@ -0,0 +1,7 @@
+BEGIN;
+  DROP TRIGGER build_lifecycle_events_trigger ON builds;
+
+  DROP FUNCTION on_build_lifecycle_change();
+
+  DROP TABLE build_lifecycle_events;
+COMMIT;
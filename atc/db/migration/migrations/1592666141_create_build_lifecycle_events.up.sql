@@ -0,0 +1,39 @@
+BEGIN;
+  CREATE TABLE build_lifecycle_events (
+    id bigserial PRIMARY KEY,
+    txid bigint NOT NULL DEFAULT txid_current(),
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    type text NOT NULL,
+    status build_status NOT NULL,
+    time timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+  CREATE INDEX build_lifecycle_events_txid_id ON build_lifecycle_events (txid, id);
+  CREATE INDEX build_lifecycle_events_team_id_txid_id ON build_lifecycle_events (team_id, txid, id);
+  CREATE INDEX build_lifecycle_events_build_id ON build_lifecycle_events (build_id);
+  CREATE INDEX build_lifecycle_events_time ON build_lifecycle_events (time);
+
+  CREATE FUNCTION on_build_lifecycle_change() RETURNS TRIGGER AS $$
+  BEGIN
+          IF TG_OP = 'INSERT' THEN
+                  INSERT INTO build_lifecycle_events (build_id, team_id, type, status) VALUES (NEW.id, NEW.team_id, 'created', NEW.status);
+          ELSIF NEW.status = OLD.status THEN
+                  RETURN NULL;
+          END IF;
+
+          IF NEW.completed THEN
+                  INSERT INTO build_lifecycle_events (build_id, team_id, type, status) VALUES (NEW.id, NEW.team_id, 'finished', NEW.status);
+          ELSIF NEW.status = 'started' THEN
+                  INSERT INTO build_lifecycle_events (build_id, team_id, type, status) VALUES (NEW.id, NEW.team_id, 'started', NEW.status);
+          ELSIF TG_OP = 'UPDATE' THEN
+                  INSERT INTO build_lifecycle_events (build_id, team_id, type, status) VALUES (NEW.id, NEW.team_id, 'status', NEW.status);
+          END IF;
+
+          PERFORM pg_notify('build_lifecycle_events', '');
+          RETURN NULL;
+  END;
+  $$ LANGUAGE plpgsql;
+
+  CREATE TRIGGER build_lifecycle_events_trigger AFTER INSERT OR UPDATE OF status ON builds FOR EACH ROW EXECUTE PROCEDURE on_build_lifecycle_change();
+COMMIT;
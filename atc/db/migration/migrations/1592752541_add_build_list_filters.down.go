@@ -0,0 +1,21 @@
+package migrations
+
+func (self *migrations) Down_1592752541() error {
+	_, err := self.DB.Exec(`DROP INDEX CONCURRENTLY IF EXISTS builds_status_id_idx`)
+	if err != nil {
+		return err
+	}
+
+	_, err = self.DB.Exec(`DROP INDEX CONCURRENTLY IF EXISTS builds_manually_triggered_idx`)
+	if err != nil {
+		return err
+	}
+
+	_, err = self.DB.Exec(`DROP INDEX CONCURRENTLY IF EXISTS builds_created_by_idx`)
+	if err != nil {
+		return err
+	}
+
+	_, err = self.DB.Exec(`ALTER TABLE builds DROP COLUMN IF EXISTS created_by`)
+	return err
+}
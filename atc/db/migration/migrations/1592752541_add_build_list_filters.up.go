@@ -0,0 +1,42 @@
+package migrations
+
+// Up_1592752541 records who created each build, and indexes builds by their
+// creator, by whether they were manually triggered and by their status, to
+// filter lists of builds by them.
+//
+// The builds table may be large, so the indexes are built concurrently rather
+// than in a transaction which would block writing builds until they are built.
+func (self *migrations) Up_1592752541() error {
+	// the column exists already if a previous attempt at the migration failed
+	// to build the indexes
+	var exists bool
+	err := self.DB.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1
+			FROM information_schema.columns
+			WHERE table_name = 'builds' AND column_name = 'created_by'
+		)
+	`).Scan(&exists)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		_, err = self.DB.Exec(`ALTER TABLE builds ADD COLUMN created_by text`)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = self.createIndexConcurrently("builds_created_by_idx", `builds (created_by, id DESC) WHERE created_by IS NOT NULL`)
+	if err != nil {
+		return err
+	}
+
+	err = self.createIndexConcurrently("builds_manually_triggered_idx", `builds (id DESC) WHERE manually_triggered`)
+	if err != nil {
+		return err
+	}
+
+	return self.createIndexConcurrently("builds_status_id_idx", `builds (status, id DESC)`)
+}
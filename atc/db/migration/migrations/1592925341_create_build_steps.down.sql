@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE builds
+    DROP COLUMN rerun_from_build_id,
+    DROP COLUMN rerun_from_step;
+
+  DROP TABLE build_steps;
+COMMIT;
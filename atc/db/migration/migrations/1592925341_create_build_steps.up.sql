@@ -0,0 +1,16 @@
+BEGIN;
+  CREATE TABLE build_steps (
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    path text NOT NULL,
+    type text NOT NULL,
+    name text NOT NULL,
+    artifacts jsonb NOT NULL DEFAULT '{}',
+    version jsonb,
+    metadata jsonb,
+    PRIMARY KEY (build_id, path)
+  );
+
+  ALTER TABLE builds
+    ADD COLUMN rerun_from_build_id integer REFERENCES builds (id) ON DELETE SET NULL,
+    ADD COLUMN rerun_from_step text;
+COMMIT;
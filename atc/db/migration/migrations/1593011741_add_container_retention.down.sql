@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE jobs
+    DROP COLUMN keep_failed_containers;
+
+  ALTER TABLE builds
+    DROP COLUMN held;
+COMMIT;
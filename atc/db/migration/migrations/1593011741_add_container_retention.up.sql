@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN held boolean NOT NULL DEFAULT false;
+
+  ALTER TABLE jobs
+    ADD COLUMN keep_failed_containers interval;
+COMMIT;
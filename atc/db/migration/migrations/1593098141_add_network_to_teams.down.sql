@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE teams
+    DROP COLUMN network;
+COMMIT;
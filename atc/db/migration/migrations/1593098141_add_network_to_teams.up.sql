@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE teams
+    ADD COLUMN network json;
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+  ALTER TABLE teams
+    DROP COLUMN caches;
+
+  ALTER TABLE worker_task_caches
+    DROP COLUMN last_used;
+
+  DELETE FROM task_caches WHERE named_cache_id IS NOT NULL;
+
+  ALTER TABLE task_caches
+    DROP COLUMN named_cache_id;
+
+  DROP TABLE named_caches;
+COMMIT;
@@ -0,0 +1,35 @@
+BEGIN;
+  CREATE TABLE named_caches (
+    id serial,
+    team_id integer NOT NULL,
+    name text NOT NULL,
+    pipeline_id integer,
+    team_declared boolean NOT NULL DEFAULT false,
+    size_limit bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY ("id")
+  );
+
+  CREATE UNIQUE INDEX named_caches_team_id_name_uniq
+    ON named_caches (team_id, name);
+
+  ALTER TABLE ONLY named_caches
+    ADD CONSTRAINT named_caches_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE;
+
+  ALTER TABLE ONLY named_caches
+    ADD CONSTRAINT named_caches_pipeline_id_fkey FOREIGN KEY (pipeline_id) REFERENCES pipelines(id) ON DELETE SET NULL;
+
+  ALTER TABLE task_caches
+    ADD COLUMN named_cache_id integer;
+
+  ALTER TABLE ONLY task_caches
+    ADD CONSTRAINT task_caches_named_cache_id_fkey FOREIGN KEY (named_cache_id) REFERENCES named_caches(id) ON DELETE CASCADE;
+
+  CREATE UNIQUE INDEX task_caches_named_cache_id_uniq
+    ON task_caches (named_cache_id);
+
+  ALTER TABLE worker_task_caches
+    ADD COLUMN last_used timestamp with time zone NOT NULL DEFAULT now();
+
+  ALTER TABLE teams
+    ADD COLUMN caches json;
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+  DROP INDEX containers_prefetch_resource_cache_id;
+
+  ALTER TABLE containers
+    DROP COLUMN prefetch_resource_cache_id,
+    DROP COLUMN prefetch_expires_at;
+
+  DELETE FROM resource_cache_uses WHERE resource_id IS NOT NULL OR job_id IS NOT NULL;
+
+  DROP INDEX resource_cache_uses_resource_id;
+
+  DROP INDEX resource_cache_uses_job_id;
+
+  ALTER TABLE resource_cache_uses
+    DROP COLUMN resource_id,
+    DROP COLUMN job_id;
+
+  ALTER TABLE jobs
+    DROP COLUMN prefetch_images;
+
+  ALTER TABLE resources
+    DROP COLUMN prefetch;
+COMMIT;
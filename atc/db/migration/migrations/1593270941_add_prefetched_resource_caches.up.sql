@@ -0,0 +1,33 @@
+BEGIN;
+  ALTER TABLE resources
+    ADD COLUMN prefetch boolean NOT NULL DEFAULT false;
+
+  ALTER TABLE jobs
+    ADD COLUMN prefetch_images boolean NOT NULL DEFAULT false;
+
+  ALTER TABLE resource_cache_uses
+    ADD COLUMN resource_id integer,
+    ADD COLUMN job_id integer;
+
+  ALTER TABLE ONLY resource_cache_uses
+    ADD CONSTRAINT resource_cache_uses_resource_id_fkey FOREIGN KEY (resource_id) REFERENCES resources(id) ON DELETE CASCADE;
+
+  ALTER TABLE ONLY resource_cache_uses
+    ADD CONSTRAINT resource_cache_uses_job_id_fkey FOREIGN KEY (job_id) REFERENCES jobs(id) ON DELETE CASCADE;
+
+  CREATE INDEX resource_cache_uses_resource_id
+    ON resource_cache_uses (resource_id);
+
+  CREATE INDEX resource_cache_uses_job_id
+    ON resource_cache_uses (job_id);
+
+  ALTER TABLE containers
+    ADD COLUMN prefetch_resource_cache_id integer,
+    ADD COLUMN prefetch_expires_at timestamp with time zone;
+
+  ALTER TABLE ONLY containers
+    ADD CONSTRAINT containers_prefetch_resource_cache_id_fkey FOREIGN KEY (prefetch_resource_cache_id) REFERENCES resource_caches(id) ON DELETE SET NULL;
+
+  CREATE INDEX containers_prefetch_resource_cache_id
+    ON containers (prefetch_resource_cache_id);
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+  ALTER TABLE worker_resource_caches
+    DROP COLUMN last_used;
+
+  ALTER TABLE workers
+    DROP COLUMN volumes_disk_used,
+    DROP COLUMN volumes_disk_capacity;
+COMMIT;
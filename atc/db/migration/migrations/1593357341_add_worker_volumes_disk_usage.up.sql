@@ -0,0 +1,8 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN volumes_disk_used bigint NOT NULL DEFAULT 0,
+    ADD COLUMN volumes_disk_capacity bigint NOT NULL DEFAULT 0;
+
+  ALTER TABLE worker_resource_caches
+    ADD COLUMN last_used timestamp with time zone NOT NULL DEFAULT now();
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE builds
+    DROP COLUMN breakpoints,
+    DROP COLUMN paused_at_breakpoint;
+COMMIT;
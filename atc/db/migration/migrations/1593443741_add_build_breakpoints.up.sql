@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN breakpoints jsonb,
+    ADD COLUMN paused_at_breakpoint boolean NOT NULL DEFAULT false;
+COMMIT;
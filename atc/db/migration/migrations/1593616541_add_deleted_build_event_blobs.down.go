@@ -0,0 +1,11 @@
+package migrations
+
+func (self *migrations) Down_1593616541() error {
+	_, err := self.DB.Exec(`DROP INDEX CONCURRENTLY IF EXISTS builds_unfinalized_events_idx`)
+	if err != nil {
+		return err
+	}
+
+	_, err = self.DB.Exec(`DROP TABLE IF EXISTS deleted_build_event_blobs`)
+	return err
+}
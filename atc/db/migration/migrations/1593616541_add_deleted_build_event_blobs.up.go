@@ -0,0 +1,20 @@
+package migrations
+
+// Up_1593616541 keeps track of the offloaded events of deleted builds which
+// are yet to be removed, and indexes the builds whose events are yet to be
+// offloaded.
+//
+// The builds table may be large, so its index is built concurrently rather
+// than in a transaction which would block writing builds until it is built.
+func (self *migrations) Up_1593616541() error {
+	_, err := self.DB.Exec(`
+		CREATE TABLE IF NOT EXISTS deleted_build_event_blobs (
+			build_id integer PRIMARY KEY
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	return self.createIndexConcurrently("builds_unfinalized_events_idx", `builds (id) WHERE completed AND NOT events_offloaded AND reap_time IS NULL`)
+}
@@ -0,0 +1,8 @@
+BEGIN;
+  CREATE TABLE build_log_search_index (
+    build_id integer PRIMARY KEY REFERENCES builds (id) ON DELETE CASCADE,
+    log_vector tsvector
+  );
+
+  CREATE INDEX build_log_search_index_log_vector_idx ON build_log_search_index USING gin (log_vector);
+COMMIT;
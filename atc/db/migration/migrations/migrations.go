@@ -28,3 +28,35 @@ func (self *migrations) Run(name string) error {
 
 	return nil
 }
+
+// createIndexConcurrently creates the index unless it already exists, without
+// blocking writes to the table while it is built. It must not be called in a
+// transaction.
+//
+// A concurrent build which failed, e.g. when a previous attempt at the
+// migration was interrupted, leaves an invalid index behind, which is dropped
+// and built again.
+func (self *migrations) createIndexConcurrently(index string, definition string) error {
+	var invalid bool
+	err := self.DB.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1
+			FROM pg_index i
+			JOIN pg_class c ON c.oid = i.indexrelid
+			WHERE c.relname = $1 AND NOT i.indisvalid
+		)
+	`, index).Scan(&invalid)
+	if err != nil {
+		return err
+	}
+
+	if invalid {
+		_, err = self.DB.Exec(`DROP INDEX CONCURRENTLY IF EXISTS ` + index)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = self.DB.Exec(`CREATE INDEX CONCURRENTLY IF NOT EXISTS ` + index + ` ON ` + definition)
+	return err
+}
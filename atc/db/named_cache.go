@@ -0,0 +1,260 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// namedTaskCache is the task cache of a cache which is shared by name between
+// all of a team's tasks, rather than by the builds of a single task.
+type namedTaskCache struct {
+	teamID int
+	name   string
+}
+
+func (f namedTaskCache) findOrCreate(tx Tx) (UsedTaskCache, error) {
+	utc, found, err := f.find(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if found {
+		return utc, nil
+	}
+
+	// caches do not have to be declared in order to be used; declaring them
+	// only caps their size
+	var namedCacheID int
+	err = psql.Insert("named_caches").
+		Columns("team_id", "name").
+		Values(f.teamID, f.name).
+		Suffix(`
+			ON CONFLICT (team_id, name) DO UPDATE SET
+				name = EXCLUDED.name
+			RETURNING id
+		`).
+		RunWith(tx).
+		QueryRow().
+		Scan(&namedCacheID)
+	if err != nil {
+		return nil, err
+	}
+
+	var id int
+	err = psql.Insert("task_caches").
+		Columns(
+			"named_cache_id",
+			"step_name",
+			"path",
+		).
+		Values(namedCacheID, "", "").
+		Suffix(`
+			ON CONFLICT (named_cache_id) DO UPDATE SET
+				named_cache_id = EXCLUDED.named_cache_id
+			RETURNING id
+		`).
+		RunWith(tx).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usedTaskCache{id: id}, nil
+}
+
+func (f namedTaskCache) find(runner sq.Runner) (UsedTaskCache, bool, error) {
+	var id int
+	err := psql.Select("tc.id").
+		From("task_caches tc").
+		Join("named_caches nc ON nc.id = tc.named_cache_id").
+		Where(sq.Eq{
+			"nc.team_id": f.teamID,
+			"nc.name":    f.name,
+		}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return &usedTaskCache{id: id}, true, nil
+}
+
+// clear removes the copies of the cache from all workers, leaving their
+// volumes to be garbage collected.
+func (f namedTaskCache) clear(tx Tx) (int64, error) {
+	result, err := psql.Delete("task_caches tc USING named_caches nc").
+		Where(sq.Expr("nc.id = tc.named_cache_id")).
+		Where(sq.Eq{
+			"nc.team_id": f.teamID,
+			"nc.name":    f.name,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// sizeLimit returns the cap on the size of each worker's copy of the cache,
+// or 0 if it has none. The limit of a cache declared by a pipeline which has
+// since been destroyed no longer applies.
+func (f namedTaskCache) sizeLimit(runner sq.Runner) (uint64, error) {
+	var sizeLimit uint64
+	err := psql.Select("size_limit").
+		From("named_caches").
+		Where(sq.Eq{
+			"team_id": f.teamID,
+			"name":    f.name,
+		}).
+		Where(sq.Or{
+			sq.NotEq{"pipeline_id": nil},
+			sq.Eq{"team_declared": true},
+		}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&sizeLimit)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return sizeLimit, nil
+}
+
+// saveNamedCaches makes the pipeline the owner of the size limits of the
+// caches it declares. A cache can only be declared by the team or one of its
+// pipelines at a time; caches which the pipeline no longer declares, like
+// those of destroyed pipelines, are left without a limit.
+func saveNamedCaches(tx Tx, teamID int, pipelineID int, caches atc.NamedCacheConfigs) error {
+	names := []string{}
+	for _, cache := range caches {
+		names = append(names, cache.Name)
+	}
+
+	_, err := psql.Update("named_caches").
+		Set("pipeline_id", nil).
+		Set("size_limit", 0).
+		Where(sq.Eq{"pipeline_id": pipelineID}).
+		Where(sq.NotEq{"name": names}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	for _, cache := range caches {
+		sizeLimit, err := cache.SizeLimit()
+		if err != nil {
+			return err
+		}
+
+		var id int
+		err = psql.Insert("named_caches").
+			Columns("team_id", "name", "pipeline_id", "size_limit").
+			Values(teamID, cache.Name, pipelineID, sizeLimit).
+			Suffix(`
+				ON CONFLICT (team_id, name) DO UPDATE SET
+					pipeline_id = EXCLUDED.pipeline_id,
+					size_limit = EXCLUDED.size_limit
+				WHERE NOT named_caches.team_declared
+				AND (
+					named_caches.pipeline_id IS NULL
+					OR named_caches.pipeline_id = EXCLUDED.pipeline_id
+				)
+				RETURNING id
+			`).
+			RunWith(tx).
+			QueryRow().
+			Scan(&id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return NamedCacheDeclaredError{Name: cache.Name}
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// saveTeamNamedCaches makes the team itself the owner of the size limits of
+// the caches it declares, unless one of its pipelines already declares them.
+// Caches which the team no longer declares are left without a limit.
+func saveTeamNamedCaches(tx Tx, teamID int, caches atc.NamedCacheConfigs) error {
+	names := []string{}
+	for _, cache := range caches {
+		names = append(names, cache.Name)
+	}
+
+	_, err := psql.Update("named_caches").
+		Set("team_declared", false).
+		Set("size_limit", 0).
+		Where(sq.Eq{
+			"team_id":       teamID,
+			"team_declared": true,
+		}).
+		Where(sq.NotEq{"name": names}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	for _, cache := range caches {
+		sizeLimit, err := cache.SizeLimit()
+		if err != nil {
+			return err
+		}
+
+		var id int
+		err = psql.Insert("named_caches").
+			Columns("team_id", "name", "team_declared", "size_limit").
+			Values(teamID, cache.Name, true, sizeLimit).
+			Suffix(`
+				ON CONFLICT (team_id, name) DO UPDATE SET
+					team_declared = EXCLUDED.team_declared,
+					size_limit = EXCLUDED.size_limit
+				WHERE named_caches.pipeline_id IS NULL
+				RETURNING id
+			`).
+			RunWith(tx).
+			QueryRow().
+			Scan(&id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return NamedCacheDeclaredError{Name: cache.Name}
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NamedCacheDeclaredError is returned when the team or one of its pipelines
+// declares a cache which another pipeline of the team has already declared,
+// or which the team declares itself.
+type NamedCacheDeclaredError struct {
+	Name string
+}
+
+func (err NamedCacheDeclaredError) Error() string {
+	return fmt.Sprintf("cache '%s' is already declared by the team or one of its pipelines", err.Name)
+}
@@ -0,0 +1,415 @@
+package db
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/lib/pq"
+)
+
+//go:generate counterfeiter . BuildEventBlobStore
+
+// BuildEventBlobStore is where an offloading EventStore keeps the compressed
+// events of completed builds, e.g. a directory or an S3 bucket.
+type BuildEventBlobStore interface {
+	Put(ctx context.Context, key string, data io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// NewOffloadingEventStore returns an EventStore which keeps the events of
+// running builds in Postgres, and moves them to the blob store as a gzipped
+// stream of JSON lines once the build completes.
+func NewOffloadingEventStore(blobs BuildEventBlobStore) EventStore {
+	return &offloadingEventStore{
+		postgres: postgresEventStore{},
+		blobs:    blobs,
+	}
+}
+
+type offloadingEventStore struct {
+	postgres postgresEventStore
+	blobs    BuildEventBlobStore
+}
+
+func (store *offloadingEventStore) Save(tx Tx, build Build, event atc.Event) error {
+	return store.postgres.Save(tx, build, event)
+}
+
+func (store *offloadingEventStore) Events(conn Conn, build Build, from uint) (EventSource, error) {
+	offloaded, err := eventsOffloaded(conn, build.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	if offloaded {
+		return store.blobEvents(build.ID(), from)
+	}
+
+	source, err := store.postgres.Events(conn, build, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return &offloadingEventSource{
+		store:   store,
+		conn:    conn,
+		buildID: build.ID(),
+		cursor:  from,
+		source:  source,
+	}, nil
+}
+
+func (store *offloadingEventStore) Finalize(conn Conn, build Build) error {
+	offloaded, err := eventsOffloaded(conn, build.ID())
+	if err != nil {
+		return err
+	}
+
+	if offloaded {
+		return nil
+	}
+
+	table := buildEventsTable(build)
+
+	rows, err := conn.Query(`
+		SELECT type, version, payload
+		FROM `+table+`
+		WHERE build_id = $1
+		ORDER BY event_id ASC
+	`, build.ID())
+	if err != nil {
+		return err
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		defer Close(rows)
+		writer.CloseWithError(writeEvents(writer, rows))
+	}()
+
+	err = store.blobs.Put(context.Background(), buildEventsKey(build.ID()), reader)
+	_ = reader.CloseWithError(io.ErrClosedPipe)
+	if err != nil {
+		return err
+	}
+
+	tx, err := conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Update("builds").
+		Set("events_offloaded", true).
+		Where(sq.Eq{"id": build.ID()}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = indexBuildLogs(tx, table, build.ID())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM `+table+` WHERE build_id = $1`, build.ID())
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// indexBuildLogs records the words in the build's logs before its events are
+// deleted from Postgres, so that the build can still be found by searching
+// its logs once they have been offloaded.
+//
+// A tsvector is limited to 1MB, which the words of a large enough log exceed.
+// Such a build is indexed without any words, and its offloaded logs are read
+// whenever they are searched instead.
+func indexBuildLogs(tx Tx, table string, buildID int) error {
+	_, err := tx.Exec(`SAVEPOINT index_build_logs`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO build_log_search_index (build_id, log_vector)
+		SELECT $1, strip(to_tsvector('simple', COALESCE(string_agg(e.payload::json->>'payload', E'\n' ORDER BY e.event_id), '')))
+		FROM `+table+` e
+		WHERE e.build_id = $1
+		AND e.type = 'log'
+		ON CONFLICT (build_id) DO UPDATE SET log_vector = EXCLUDED.log_vector
+	`, buildID)
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqProgramLimitExceededErrCode {
+		_, err = tx.Exec(`ROLLBACK TO SAVEPOINT index_build_logs`)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO build_log_search_index (build_id, log_vector)
+			VALUES ($1, NULL)
+			ON CONFLICT (build_id) DO UPDATE SET log_vector = NULL
+		`, buildID)
+	}
+
+	return err
+}
+
+func (store *offloadingEventStore) Delete(tx Tx, buildIDs []int) error {
+	if len(buildIDs) == 0 {
+		return nil
+	}
+
+	err := store.postgres.Delete(tx, buildIDs)
+	if err != nil {
+		return err
+	}
+
+	// the blobs are only deleted by Collect, as the transaction may yet be
+	// rolled back
+	_, err = tx.Exec(`
+		INSERT INTO deleted_build_event_blobs (build_id)
+		SELECT id FROM builds WHERE id = ANY($1) AND events_offloaded
+		ON CONFLICT DO NOTHING
+	`, pq.Array(buildIDs))
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("builds").
+		Set("events_offloaded", false).
+		Where(sq.Eq{
+			"id":               buildIDs,
+			"events_offloaded": true,
+		}).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+func (store *offloadingEventStore) Collect(conn Conn) error {
+	rows, err := psql.Select("build_id").
+		From("deleted_build_event_blobs").
+		Limit(deleteBuildEventsBatchSize).
+		RunWith(conn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	var buildIDs []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			_ = rows.Close()
+			return err
+		}
+
+		buildIDs = append(buildIDs, id)
+	}
+
+	err = rows.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range buildIDs {
+		err = store.blobs.Delete(context.Background(), buildEventsKey(id))
+		if err != nil {
+			return err
+		}
+
+		_, err = psql.Delete("deleted_build_event_blobs").
+			Where(sq.Eq{"build_id": id}).
+			RunWith(conn).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (store *offloadingEventStore) blobEvents(buildID int, from uint) (EventSource, error) {
+	blob, err := store.blobs.Get(context.Background(), buildEventsKey(buildID))
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := gzip.NewReader(blob)
+	if err != nil {
+		_ = blob.Close()
+		return nil, err
+	}
+
+	source := &blobEventSource{
+		blob:    blob,
+		decoder: json.NewDecoder(reader),
+	}
+
+	for i := uint(0); i < from; i++ {
+		_, err := source.Next()
+		if err == ErrEndOfBuildEventStream {
+			break
+		}
+
+		if err != nil {
+			_ = source.Close()
+			return nil, err
+		}
+	}
+
+	return source, nil
+}
+
+func eventsOffloaded(conn Conn, buildID int) (bool, error) {
+	var offloaded bool
+	err := psql.Select("events_offloaded").
+		From("builds").
+		Where(sq.Eq{"id": buildID}).
+		RunWith(conn).
+		QueryRow().
+		Scan(&offloaded)
+	return offloaded, err
+}
+
+func writeEvents(w io.Writer, rows *sql.Rows) error {
+	zw := gzip.NewWriter(w)
+	buf := bufio.NewWriter(zw)
+	encoder := json.NewEncoder(buf)
+
+	for rows.Next() {
+		var t, v, p string
+		err := rows.Scan(&t, &v, &p)
+		if err != nil {
+			return err
+		}
+
+		data := json.RawMessage(p)
+
+		err = encoder.Encode(event.Envelope{
+			Data:    &data,
+			Event:   atc.EventType(t),
+			Version: atc.EventVersion(v),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if err := buf.Flush(); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func buildEventsKey(buildID int) string {
+	return fmt.Sprintf("builds/%d/events.json.gz", buildID)
+}
+
+// blobEventSource reads the events of a build from its offloaded blob.
+type blobEventSource struct {
+	blob    io.Closer
+	decoder *json.Decoder
+}
+
+func (source *blobEventSource) Next() (event.Envelope, error) {
+	var envelope event.Envelope
+	err := source.decoder.Decode(&envelope)
+	if err != nil {
+		if err == io.EOF {
+			return event.Envelope{}, ErrEndOfBuildEventStream
+		}
+
+		return event.Envelope{}, err
+	}
+
+	return envelope, nil
+}
+
+func (source *blobEventSource) Close() error {
+	return source.blob.Close()
+}
+
+// offloadingEventSource streams the events of a build from Postgres. If the
+// build's events are offloaded while they are being streamed, it carries on
+// from the offloaded blob so that no events are missed.
+type offloadingEventSource struct {
+	store   *offloadingEventStore
+	conn    Conn
+	buildID int
+	cursor  uint
+
+	source      EventSource
+	switchedOut bool
+	sourceL     sync.Mutex
+}
+
+func (source *offloadingEventSource) Next() (event.Envelope, error) {
+	source.sourceL.Lock()
+	current := source.source
+	source.sourceL.Unlock()
+
+	ev, err := current.Next()
+	if err == ErrEndOfBuildEventStream && !source.switchedOut {
+		offloaded, offloadedErr := eventsOffloaded(source.conn, source.buildID)
+		if offloadedErr != nil {
+			return event.Envelope{}, offloadedErr
+		}
+
+		if !offloaded {
+			return event.Envelope{}, err
+		}
+
+		blobSource, blobErr := source.store.blobEvents(source.buildID, source.cursor)
+		if blobErr != nil {
+			return event.Envelope{}, blobErr
+		}
+
+		_ = current.Close()
+
+		source.sourceL.Lock()
+		source.source = blobSource
+		source.switchedOut = true
+		source.sourceL.Unlock()
+
+		return source.Next()
+	}
+
+	if err != nil {
+		return event.Envelope{}, err
+	}
+
+	source.cursor++
+
+	return ev, nil
+}
+
+func (source *offloadingEventSource) Close() error {
+	source.sourceL.Lock()
+	defer source.sourceL.Unlock()
+
+	return source.source.Close()
+}
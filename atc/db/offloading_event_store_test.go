@@ -0,0 +1,201 @@
+package db_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/eventstore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OffloadingEventStore", func() {
+	var (
+		dir       string
+		team      db.Team
+		build     db.Build
+		lifecycle db.BuildEventLifecycle
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "build-events")
+		Expect(err).NotTo(HaveOccurred())
+
+		conn := db.WithEventStore(dbConn, db.NewOffloadingEventStore(eventstore.NewFilesystem(dir)))
+		lifecycle = db.NewBuildEventLifecycle(conn, lockFactory)
+
+		var found bool
+		team, found, err = db.NewTeamFactory(conn, lockFactory).FindTeam(defaultTeam.Name())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		build, err = team.CreateOneOffBuild()
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = build.Start(atc.Plan{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(build.SaveEvent(event.Log{Payload: "some "})).To(Succeed())
+		Expect(build.SaveEvent(event.Log{Payload: "log"})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	unfinalizedBuildIDs := func() []int {
+		builds, err := lifecycle.UnfinalizedBuilds(100)
+		Expect(err).NotTo(HaveOccurred())
+
+		var ids []int
+		for _, b := range builds {
+			ids = append(ids, b.ID())
+		}
+
+		return ids
+	}
+
+	offloadedFile := func() string {
+		return filepath.Join(dir, "builds", strconv.Itoa(build.ID()), "events.json.gz")
+	}
+
+	Context("while the build is running", func() {
+		It("keeps the events in postgres", func() {
+			Expect(offloadedFile()).ToNot(BeAnExistingFile())
+
+			events, err := build.Events(1)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer db.Close(events)
+
+			Expect(events.Next()).To(Equal(envelope(event.Log{Payload: "some "})))
+			Expect(events.Next()).To(Equal(envelope(event.Log{Payload: "log"})))
+		})
+	})
+
+	Context("when the build finishes", func() {
+		var events db.EventSource
+
+		BeforeEach(func() {
+			var err error
+			events, err = build.Events(1)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		AfterEach(func() {
+			db.Close(events)
+		})
+
+		It("does not offload the events right away", func() {
+			Expect(offloadedFile()).ToNot(BeAnExistingFile())
+
+			Expect(unfinalizedBuildIDs()).To(ContainElement(build.ID()))
+		})
+	})
+
+	Context("when the events of the finished build are finalized", func() {
+		var events db.EventSource
+
+		BeforeEach(func() {
+			var err error
+			events, err = build.Events(1)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(lifecycle.FinalizeBuildEvents(build)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			db.Close(events)
+		})
+
+		It("is no longer unfinalized", func() {
+			Expect(unfinalizedBuildIDs()).ToNot(ContainElement(build.ID()))
+		})
+
+		It("offloads the events", func() {
+			Expect(offloadedFile()).To(BeAnExistingFile())
+
+			var count int
+			err := dbConn.QueryRow(`SELECT COUNT(*) FROM team_build_events_`+strconv.Itoa(team.ID())+` WHERE build_id = $1`, build.ID()).Scan(&count)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+
+		It("carries on streaming events to existing subscribers", func() {
+			Expect(events.Next()).To(Equal(envelope(event.Log{Payload: "some "})))
+			Expect(events.Next()).To(Equal(envelope(event.Log{Payload: "log"})))
+			Expect(events.Next()).To(Equal(envelope(event.Status{
+				Status: atc.StatusSucceeded,
+				Time:   build.EndTime().Unix(),
+			})))
+
+			_, err := events.Next()
+			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+		})
+
+		It("streams the offloaded events from an offset", func() {
+			offloaded, err := build.Events(2)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer db.Close(offloaded)
+
+			Expect(offloaded.Next()).To(Equal(envelope(event.Log{Payload: "log"})))
+			Expect(offloaded.Next()).To(Equal(envelope(event.Status{
+				Status: atc.StatusSucceeded,
+				Time:   build.EndTime().Unix(),
+			})))
+
+			_, err = offloaded.Next()
+			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+		})
+
+		It("can still be found by searching its logs", func() {
+			matches, err := team.SearchBuildLogs(db.BuildLogSearch{Query: "log"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(Equal([]atc.BuildLogMatch{
+				{
+					BuildID:   build.ID(),
+					BuildName: build.Name(),
+					TeamName:  team.Name(),
+					StartTime: build.StartTime().Unix(),
+					Lines:     []string{"log"},
+				},
+			}))
+
+			matches, err = team.SearchBuildLogs(db.BuildLogSearch{Query: "missing"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(BeEmpty())
+		})
+
+		Context("when the team is deleted", func() {
+			BeforeEach(func() {
+				Expect(team.Delete()).To(Succeed())
+			})
+
+			It("only deletes the offloaded events once they are collected", func() {
+				Expect(offloadedFile()).To(BeAnExistingFile())
+
+				Expect(lifecycle.CollectDeletedBuildEvents()).To(Succeed())
+				Expect(offloadedFile()).ToNot(BeAnExistingFile())
+			})
+		})
+	})
+})
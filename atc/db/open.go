@@ -24,6 +24,7 @@ import (
 type Conn interface {
 	Bus() NotificationsBus
 	EncryptionStrategy() encryption.Strategy
+	EventStore() EventStore
 
 	Ping() error
 	Driver() driver.Driver
@@ -367,6 +368,10 @@ func (db *db) EncryptionStrategy() encryption.Strategy {
 	return db.encryption
 }
 
+func (db *db) EventStore() EventStore {
+	return postgresEventStore{}
+}
+
 func (db *db) Close() error {
 	var errs error
 	dbErr := db.DB.Close()
@@ -9,6 +9,10 @@ type Page struct {
 
 	Limit   int
 	UseDate bool
+
+	// Filter narrows down the builds being paginated over. It is ignored when
+	// paginating over anything else.
+	Filter BuildFilter
 }
 
 type Pagination struct {
@@ -60,7 +60,7 @@ type Pipeline interface {
 	Builds(page Page) ([]Build, Pagination, error)
 
 	CreateOneOffBuild() (Build, error)
-	CreateStartedBuild(plan atc.Plan) (Build, error)
+	CreateStartedBuild(plan atc.Plan, createdBy string) (Build, error)
 
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 
@@ -705,14 +705,29 @@ func (p *pipeline) Rename(name string) error {
 }
 
 func (p *pipeline) Destroy() error {
-	_, err := psql.Delete("pipelines").
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	err = deleteBuildEvents(tx, p.conn.EventStore(), sq.Eq{"pipeline_id": p.id})
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("pipelines").
 		Where(sq.Eq{
 			"id": p.id,
 		}).
-		RunWith(p.conn).
+		RunWith(tx).
 		Exec()
+	if err != nil {
+		return err
+	}
 
-	return err
+	return tx.Commit()
 }
 
 func (p *pipeline) LoadDebugVersionsDB() (*atc.DebugVersionsDB, error) {
@@ -955,10 +970,7 @@ func (p *pipeline) DeleteBuildEventsByBuildIDs(buildIDs []int) error {
 
 	defer Rollback(tx)
 
-	_, err = tx.Exec(`
-   DELETE FROM build_events
-	 WHERE build_id IN (`+strings.Join(indexStrings, ",")+`)
-	 `, interfaceBuildIDs...)
+	err = p.conn.EventStore().Delete(tx, buildIDs)
 	if err != nil {
 		return err
 	}
@@ -1003,7 +1015,7 @@ func (p *pipeline) CreateOneOffBuild() (Build, error) {
 	return build, nil
 }
 
-func (p *pipeline) CreateStartedBuild(plan atc.Plan) (Build, error) {
+func (p *pipeline) CreateStartedBuild(plan atc.Plan, createdBy string) (Build, error) {
 	tx, err := p.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -1032,6 +1044,7 @@ func (p *pipeline) CreateStartedBuild(plan atc.Plan) (Build, error) {
 		"private_plan": encryptedPlan,
 		"public_plan":  plan.Public(),
 		"nonce":        nonce,
+		"created_by":   nullableString(createdBy),
 	})
 	if err != nil {
 		return nil, err
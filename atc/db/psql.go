@@ -4,5 +4,6 @@ import sq "github.com/Masterminds/squirrel"
 
 const pqUniqueViolationErrCode = "unique_violation"
 const pqFKeyViolationErrCode = "foreign_key_violation"
+const pqProgramLimitExceededErrCode = "program_limit_exceeded"
 
 var psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
@@ -33,17 +33,22 @@ type Resource interface {
 	CheckTimeout() string
 	LastCheckStartTime() time.Time
 	LastCheckEndTime() time.Time
+	CheckFailures() int
 	Tags() atc.Tags
 	CheckSetupError() error
 	CheckError() error
 	WebhookToken() string
+	Webhook() *atc.WebhookConfig
 	ConfigPinnedVersion() atc.Version
 	APIPinnedVersion() atc.Version
 	PinComment() string
 	SetPinComment(string) error
+	RecordWebhookDelivery(atc.WebhookDelivery) error
+	WebhookDeliveries(limit int) ([]atc.WebhookDelivery, error)
 	ResourceConfigID() int
 	ResourceConfigScopeID() int
 	Icon() string
+	Prefetch() bool
 
 	HasWebhook() bool
 
@@ -64,6 +69,8 @@ type Resource interface {
 	SetCheckSetupError(error) error
 	NotifyScan() error
 
+	ReleasePrefetchedCaches(except UsedResourceCache) error
+
 	Reload() (bool, error)
 }
 
@@ -75,6 +82,7 @@ var resourcesQuery = psql.Select(
 	"r.check_error",
 	"rs.last_check_start_time",
 	"rs.last_check_end_time",
+	"rs.check_failures",
 	"r.pipeline_id",
 	"r.nonce",
 	"r.resource_config_id",
@@ -108,16 +116,19 @@ type resource struct {
 	checkTimeout          string
 	lastCheckStartTime    time.Time
 	lastCheckEndTime      time.Time
+	checkFailures         int
 	tags                  atc.Tags
 	checkSetupError       error
 	checkError            error
 	webhookToken          string
+	webhook               *atc.WebhookConfig
 	configPinnedVersion   atc.Version
 	apiPinnedVersion      atc.Version
 	pinComment            string
 	resourceConfigID      int
 	resourceConfigScopeID int
 	icon                  string
+	prefetch              bool
 }
 
 func newEmptyResource(conn Conn, lockFactory lock.LockFactory) *resource {
@@ -152,6 +163,7 @@ func (resources Resources) Configs() atc.ResourceConfigs {
 			Name:         r.Name(),
 			Public:       r.Public(),
 			WebhookToken: r.WebhookToken(),
+			Webhook:      r.Webhook(),
 			Type:         r.Type(),
 			Source:       r.Source(),
 			CheckEvery:   r.CheckEvery(),
@@ -175,18 +187,21 @@ func (r *resource) CheckEvery() string               { return r.checkEvery }
 func (r *resource) CheckTimeout() string             { return r.checkTimeout }
 func (r *resource) LastCheckStartTime() time.Time    { return r.lastCheckStartTime }
 func (r *resource) LastCheckEndTime() time.Time      { return r.lastCheckEndTime }
+func (r *resource) CheckFailures() int               { return r.checkFailures }
 func (r *resource) Tags() atc.Tags                   { return r.tags }
 func (r *resource) CheckSetupError() error           { return r.checkSetupError }
 func (r *resource) CheckError() error                { return r.checkError }
 func (r *resource) WebhookToken() string             { return r.webhookToken }
+func (r *resource) Webhook() *atc.WebhookConfig      { return r.webhook }
 func (r *resource) ConfigPinnedVersion() atc.Version { return r.configPinnedVersion }
 func (r *resource) APIPinnedVersion() atc.Version    { return r.apiPinnedVersion }
 func (r *resource) PinComment() string               { return r.pinComment }
 func (r *resource) ResourceConfigID() int            { return r.resourceConfigID }
 func (r *resource) ResourceConfigScopeID() int       { return r.resourceConfigScopeID }
 func (r *resource) Icon() string                     { return r.icon }
+func (r *resource) Prefetch() bool                   { return r.prefetch }
 
-func (r *resource) HasWebhook() bool { return r.WebhookToken() != "" }
+func (r *resource) HasWebhook() bool { return r.WebhookToken() != "" || r.Webhook() != nil }
 
 func (r *resource) Reload() (bool, error) {
 	row := resourcesQuery.Where(sq.Eq{"r.id": r.id}).
@@ -385,6 +400,102 @@ func (r *resource) SetPinComment(comment string) error {
 	return err
 }
 
+// maxWebhookDeliveries is the number of deliveries kept in a resource's
+// webhook delivery log.
+const maxWebhookDeliveries = 100
+
+func (r *resource) RecordWebhookDelivery(delivery atc.WebhookDelivery) error {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	var checkID sql.NullInt64
+	if delivery.CheckID != 0 {
+		checkID = sql.NullInt64{Int64: int64(delivery.CheckID), Valid: true}
+	}
+
+	_, err = psql.Insert("resource_webhook_deliveries").
+		Columns("resource_id", "outcome", "message", "check_id").
+		Values(r.id, string(delivery.Outcome), delivery.Message, checkID).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		DELETE FROM resource_webhook_deliveries
+		WHERE resource_id = $1
+		AND id NOT IN (
+			SELECT id
+			FROM resource_webhook_deliveries
+			WHERE resource_id = $1
+			ORDER BY id DESC
+			LIMIT $2
+		)`, r.id, maxWebhookDeliveries)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *resource) WebhookDeliveries(limit int) ([]atc.WebhookDelivery, error) {
+	rows, err := psql.Select("id", "delivered_at", "outcome", "message", "check_id").
+		From("resource_webhook_deliveries").
+		Where(sq.Eq{"resource_id": r.id}).
+		OrderBy("id DESC").
+		Limit(uint64(limit)).
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	deliveries := []atc.WebhookDelivery{}
+	for rows.Next() {
+		var (
+			delivery    atc.WebhookDelivery
+			deliveredAt time.Time
+			outcome     string
+			message     sql.NullString
+			checkID     sql.NullInt64
+		)
+
+		err = rows.Scan(&delivery.ID, &deliveredAt, &outcome, &message, &checkID)
+		if err != nil {
+			return nil, err
+		}
+
+		delivery.DeliveredAt = deliveredAt.Unix()
+		delivery.Outcome = atc.WebhookDeliveryOutcome(outcome)
+		delivery.Message = message.String
+		delivery.CheckID = int(checkID.Int64)
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// ReleasePrefetchedCaches releases the caches prefetched for the resource,
+// other than the given one, so that they can be garbage collected.
+func (r *resource) ReleasePrefetchedCaches(except UsedResourceCache) error {
+	_, err := psql.Delete("resource_cache_uses").
+		Where(sq.And{
+			sq.Eq{"resource_id": r.id},
+			sq.NotEq{"resource_cache_id": except.ID()},
+		}).
+		RunWith(r.conn).
+		Exec()
+	return err
+}
+
 func (r *resource) CurrentPinnedVersion() atc.Version {
 	if r.configPinnedVersion != nil {
 		return r.configPinnedVersion
@@ -735,16 +846,18 @@ func scanResource(r *resource, row scannable) error {
 		configBlob                                                               sql.NullString
 		checkErr, rcsCheckErr, nonce, rcID, rcScopeID, pinnedVersion, pinComment sql.NullString
 		lastCheckStartTime, lastCheckEndTime                                     pq.NullTime
+		checkFailures                                                            sql.NullInt64
 		pinnedThroughConfig                                                      sql.NullBool
 	)
 
-	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &checkErr, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &r.teamID, &r.teamName, &rcsCheckErr, &pinnedVersion, &pinComment, &pinnedThroughConfig)
+	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &checkErr, &lastCheckStartTime, &lastCheckEndTime, &checkFailures, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &r.teamID, &r.teamName, &rcsCheckErr, &pinnedVersion, &pinComment, &pinnedThroughConfig)
 	if err != nil {
 		return err
 	}
 
 	r.lastCheckStartTime = lastCheckStartTime.Time
 	r.lastCheckEndTime = lastCheckEndTime.Time
+	r.checkFailures = int(checkFailures.Int64)
 
 	es := r.conn.EncryptionStrategy()
 
@@ -774,7 +887,9 @@ func scanResource(r *resource, row scannable) error {
 	r.checkTimeout = config.CheckTimeout
 	r.tags = config.Tags
 	r.webhookToken = config.WebhookToken
+	r.webhook = config.Webhook
 	r.icon = config.Icon
+	r.prefetch = config.Prefetch
 
 	if pinnedVersion.Valid {
 		var version atc.Version
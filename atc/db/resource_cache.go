@@ -195,6 +195,8 @@ type UsedResourceCache interface {
 
 	Destroy(Tx) (bool, error)
 	BaseResourceType() *UsedBaseResourceType
+
+	WorkerNames() ([]string, error)
 }
 
 type usedResourceCache struct {
@@ -210,6 +212,38 @@ func (cache *usedResourceCache) ID() int                        { return cache.i
 func (cache *usedResourceCache) ResourceConfig() ResourceConfig { return cache.resourceConfig }
 func (cache *usedResourceCache) Version() atc.Version           { return cache.version }
 
+// WorkerNames returns the names of the workers which have a volume of the
+// cache, in one query rather than one per worker.
+func (cache *usedResourceCache) WorkerNames() ([]string, error) {
+	rows, err := psql.Select("DISTINCT v.worker_name").
+		From("volumes v").
+		Join("worker_resource_caches wrc ON wrc.id = v.worker_resource_cache_id").
+		Where(sq.Eq{
+			"wrc.resource_cache_id": cache.id,
+			"v.state":               VolumeStateCreated,
+		}).
+		RunWith(cache.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var names []string
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
 func (cache *usedResourceCache) Destroy(tx Tx) (bool, error) {
 	rows, err := psql.Delete("resource_caches").
 		Where(sq.Eq{
@@ -29,6 +29,8 @@ type ResourceCacheFactory interface {
 	ResourceCacheMetadata(UsedResourceCache) (ResourceConfigMetadataFields, error)
 
 	FindResourceCacheByID(id int) (UsedResourceCache, bool, error)
+
+	UpdateResourceCacheLastUsed(resourceCache UsedResourceCache, workerName string) error
 }
 
 type resourceCacheFactory struct {
@@ -100,6 +102,19 @@ func (f *resourceCacheFactory) UpdateResourceCacheMetadata(resourceCache UsedRes
 	return err
 }
 
+// UpdateResourceCacheLastUsed records that the worker's copy of the cache has
+// just been used, by which unused copies are evicted once the worker's disk
+// fills up.
+func (f *resourceCacheFactory) UpdateResourceCacheLastUsed(resourceCache UsedResourceCache, workerName string) error {
+	_, err := psql.Update("worker_resource_caches").
+		Set("last_used", sq.Expr("now()")).
+		Where(sq.Eq{"resource_cache_id": resourceCache.ID()}).
+		Where(sq.Expr("worker_base_resource_type_id IN (SELECT id FROM worker_base_resource_types WHERE worker_name = ?)", workerName)).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
 func (f *resourceCacheFactory) ResourceCacheMetadata(resourceCache UsedResourceCache) (ResourceConfigMetadataFields, error) {
 	var metadataJSON sql.NullString
 	err := psql.Select("metadata").
@@ -12,6 +12,7 @@ import (
 
 type ResourceCacheLifecycle interface {
 	CleanUsesForFinishedBuilds(lager.Logger) error
+	CleanUsesForInactivePrefetches(lager.Logger) error
 	CleanBuildImageResourceCaches(lager.Logger) error
 	CleanUpInvalidCaches(lager.Logger) error
 }
@@ -47,6 +48,41 @@ func (f *resourceCacheLifecycle) CleanUsesForFinishedBuilds(logger lager.Logger)
 	return err
 }
 
+// CleanUsesForInactivePrefetches releases the caches prefetched for resources
+// and jobs which have been removed from their pipeline, or whose pipeline is
+// paused or archived.
+func (f *resourceCacheLifecycle) CleanUsesForInactivePrefetches(logger lager.Logger) error {
+	_, err := psql.Delete("resource_cache_uses rcu USING resources r, pipelines p").
+		Where(sq.And{
+			sq.Expr("rcu.resource_id = r.id"),
+			sq.Expr("r.pipeline_id = p.id"),
+			sq.Or{
+				sq.Eq{"r.active": false},
+				sq.Eq{"p.paused": true},
+				sq.Eq{"p.archived": true},
+			},
+		}).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("resource_cache_uses rcu USING jobs j, pipelines p").
+		Where(sq.And{
+			sq.Expr("rcu.job_id = j.id"),
+			sq.Expr("j.pipeline_id = p.id"),
+			sq.Or{
+				sq.Eq{"j.active": false},
+				sq.Eq{"p.paused": true},
+				sq.Eq{"p.archived": true},
+			},
+		}).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
 func (f *resourceCacheLifecycle) CleanUpInvalidCaches(logger lager.Logger) error {
 	stillInUseCacheIds, _, err := sq.
 		Select("resource_cache_id").
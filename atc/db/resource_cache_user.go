@@ -33,3 +33,35 @@ func (user forContainer) SQLMap() map[string]interface{} {
 		"container_id": user.ContainerID,
 	}
 }
+
+type forResource struct {
+	ResourceID int
+}
+
+// ForResource is the user of the caches prefetched for a resource, which are
+// kept until they are released or the resource stops being prefetched.
+func ForResource(id int) ResourceCacheUser {
+	return forResource{id}
+}
+
+func (user forResource) SQLMap() map[string]interface{} {
+	return map[string]interface{}{
+		"resource_id": user.ResourceID,
+	}
+}
+
+type forJob struct {
+	JobID int
+}
+
+// ForJob is the user of the images prefetched for the tasks of a job, which
+// are kept until they are released or the job stops prefetching them.
+func ForJob(id int) ResourceCacheUser {
+	return forJob{id}
+}
+
+func (user forJob) SQLMap() map[string]interface{} {
+	return map[string]interface{}{
+		"job_id": user.JobID,
+	}
+}
@@ -27,6 +27,7 @@ type ResourceConfigScope interface {
 	CheckError() error
 
 	SaveVersions(SpanContext, []atc.Version) error
+	SaveVersionsWithMetadata(SpanContext, []atc.VersionWithMetadata) error
 	FindVersion(atc.Version) (ResourceConfigVersion, bool, error)
 	LatestVersion() (ResourceConfigVersion, bool, error)
 
@@ -70,7 +71,23 @@ func (r *resourceConfigScope) SaveVersions(spanContext SpanContext, versions []a
 	return saveVersions(r.conn, r.ID(), versions, spanContext)
 }
 
+// SaveVersionsWithMetadata stores a list of versions along with their
+// metadata in the same way as SaveVersions. It is used for versions which are
+// pushed through the API rather than discovered by a check.
+func (r *resourceConfigScope) SaveVersionsWithMetadata(spanContext SpanContext, versions []atc.VersionWithMetadata) error {
+	return saveVersionsWithMetadata(r.conn, r.ID(), versions, spanContext)
+}
+
 func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext SpanContext) error {
+	versionsWithMetadata := make([]atc.VersionWithMetadata, len(versions))
+	for i, version := range versions {
+		versionsWithMetadata[i] = atc.VersionWithMetadata{Version: version}
+	}
+
+	return saveVersionsWithMetadata(conn, rcsID, versionsWithMetadata, spanContext)
+}
+
+func saveVersionsWithMetadata(conn Conn, rcsID int, versions []atc.VersionWithMetadata, spanContext SpanContext) error {
 	tx, err := conn.Begin()
 	if err != nil {
 		return err
@@ -78,9 +95,14 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 
 	defer Rollback(tx)
 
-	var containsNewVersion bool
+	var containsNewVersion, prefetched bool
 	for _, version := range versions {
-		newVersion, err := saveResourceVersion(tx, rcsID, version, nil, spanContext)
+		var metadata ResourceConfigMetadataFields
+		if version.Metadata != nil {
+			metadata = NewResourceConfigMetadataFields(version.Metadata)
+		}
+
+		newVersion, err := saveResourceVersion(tx, rcsID, version.Version, metadata, spanContext)
 		if err != nil {
 			return err
 		}
@@ -92,7 +114,7 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 		// bump the check order of all the versions returned by the check if there
 		// is at least one new version within the set of returned versions
 		for _, version := range versions {
-			versionJSON, err := json.Marshal(version)
+			versionJSON, err := json.Marshal(version.Version)
 			if err != nil {
 				return err
 			}
@@ -107,6 +129,11 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 		if err != nil {
 			return err
 		}
+
+		prefetched, err = isPrefetched(tx, rcsID)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = tx.Commit()
@@ -114,9 +141,33 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 		return err
 	}
 
+	if prefetched {
+		// the new version is prefetched right away rather than on the
+		// prefetcher's next interval
+		return conn.Bus().Notify(atc.ComponentPrefetcher)
+	}
+
 	return nil
 }
 
+// isPrefetched returns whether the versions of the scope are prefetched for
+// any active resource of an unpaused pipeline.
+func isPrefetched(tx Tx, rcsID int) (bool, error) {
+	var prefetched bool
+	err := tx.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1
+			FROM resources r
+			JOIN pipelines p ON p.id = r.pipeline_id
+			WHERE r.resource_config_scope_id = $1
+			AND r.prefetch
+			AND r.active
+			AND NOT p.paused
+			AND NOT p.archived
+		)`, rcsID).Scan(&prefetched)
+	return prefetched, err
+}
+
 func (r *resourceConfigScope) FindVersion(v atc.Version) (ResourceConfigVersion, bool, error) {
 	rcv := &resourceConfigVersion{
 		resourceConfigScope: r,
@@ -219,6 +219,66 @@ var _ = Describe("Resource Config Scope", func() {
 		})
 	})
 
+	Describe("SaveVersionsWithMetadata", func() {
+		BeforeEach(func() {
+			err := resourceScope.SaveVersionsWithMetadata(nil, []atc.VersionWithMetadata{
+				{Version: atc.Version{"ref": "v1"}},
+				{
+					Version:  atc.Version{"ref": "v2"},
+					Metadata: []atc.MetadataField{{Name: "author", Value: "someone"}},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("saves the versions in order", func() {
+			latestVR, found, err := resourceScope.LatestVersion()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(latestVR.Version()).To(Equal(db.Version{"ref": "v2"}))
+		})
+
+		It("saves the metadata", func() {
+			savedVR, found, err := resourceScope.FindVersion(atc.Version{"ref": "v2"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(savedVR.Metadata()).To(Equal(db.ResourceConfigMetadataFields{
+				{Name: "author", Value: "someone"},
+			}))
+		})
+
+		It("does not clear existing metadata when saving a version without any", func() {
+			err := resourceScope.SaveVersionsWithMetadata(nil, []atc.VersionWithMetadata{
+				{Version: atc.Version{"ref": "v2"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			savedVR, found, err := resourceScope.FindVersion(atc.Version{"ref": "v2"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(savedVR.Metadata()).To(HaveLen(1))
+		})
+
+		It("requests schedule on the jobs that use the resource", func() {
+			job, found, err := pipeline.Job("some-job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			requestedSchedule := job.ScheduleRequestedTime()
+
+			err = resourceScope.SaveVersionsWithMetadata(nil, []atc.VersionWithMetadata{
+				{Version: atc.Version{"ref": "v3"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err = job.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(job.ScheduleRequestedTime()).Should(BeTemporally(">", requestedSchedule))
+		})
+	})
+
 	Describe("LatestVersion", func() {
 		Context("when the resource config exists", func() {
 			var latestCV db.ResourceConfigVersion
@@ -833,6 +833,60 @@ var _ = Describe("Resource", func() {
 		})
 	})
 
+	Describe("WebhookDeliveries", func() {
+		var resource db.Resource
+
+		BeforeEach(func() {
+			var err error
+			resource, _, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when no deliveries have been recorded", func() {
+			It("returns no deliveries", func() {
+				deliveries, err := resource.WebhookDeliveries(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(BeEmpty())
+			})
+		})
+
+		Context("when deliveries have been recorded", func() {
+			BeforeEach(func() {
+				err := resource.RecordWebhookDelivery(atc.WebhookDelivery{
+					Outcome: atc.WebhookDeliveryRejected,
+					Message: "invalid webhook signature",
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = resource.RecordWebhookDelivery(atc.WebhookDelivery{
+					Outcome: atc.WebhookDeliveryAccepted,
+					CheckID: 42,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the most recent deliveries first", func() {
+				deliveries, err := resource.WebhookDeliveries(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(2))
+
+				Expect(deliveries[0].Outcome).To(Equal(atc.WebhookDeliveryAccepted))
+				Expect(deliveries[0].CheckID).To(Equal(42))
+				Expect(deliveries[0].DeliveredAt).ToNot(BeZero())
+
+				Expect(deliveries[1].Outcome).To(Equal(atc.WebhookDeliveryRejected))
+				Expect(deliveries[1].Message).To(Equal("invalid webhook signature"))
+			})
+
+			It("respects the limit", func() {
+				deliveries, err := resource.WebhookDeliveries(1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+				Expect(deliveries[0].Outcome).To(Equal(atc.WebhookDeliveryAccepted))
+			})
+		})
+	})
+
 	Describe("ResourceConfigVersion", func() {
 		var (
 			resource                   db.Resource
@@ -1774,4 +1828,67 @@ var _ = Describe("Resource", func() {
 			})
 		})
 	})
+
+	Describe("ReleasePrefetchedCaches", func() {
+		var (
+			resource   db.Resource
+			oldCache   db.UsedResourceCache
+			newCache   db.UsedResourceCache
+			cacheUsers func() []int
+		)
+
+		BeforeEach(func() {
+			var (
+				found bool
+				err   error
+			)
+
+			resource, found, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			oldCache, err = resourceCacheFactory.FindOrCreateResourceCache(
+				db.ForResource(resource.ID()),
+				"some-base-resource-type",
+				atc.Version{"ref": "old"},
+				atc.Source{"some": "source"},
+				nil,
+				atc.VersionedResourceTypes{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			newCache, err = resourceCacheFactory.FindOrCreateResourceCache(
+				db.ForResource(resource.ID()),
+				"some-base-resource-type",
+				atc.Version{"ref": "new"},
+				atc.Source{"some": "source"},
+				nil,
+				atc.VersionedResourceTypes{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			cacheUsers = func() []int {
+				rows, err := dbConn.Query(`SELECT resource_cache_id FROM resource_cache_uses WHERE resource_id = $1`, resource.ID())
+				Expect(err).ToNot(HaveOccurred())
+				defer rows.Close()
+
+				var ids []int
+				for rows.Next() {
+					var id int
+					Expect(rows.Scan(&id)).To(Succeed())
+					ids = append(ids, id)
+				}
+
+				return ids
+			}
+		})
+
+		It("releases every prefetched cache but the given one", func() {
+			Expect(cacheUsers()).To(ConsistOf(oldCache.ID(), newCache.ID()))
+
+			Expect(resource.ReleasePrefetchedCaches(newCache)).To(Succeed())
+
+			Expect(cacheUsers()).To(ConsistOf(newCache.ID()))
+		})
+	})
 })
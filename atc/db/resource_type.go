@@ -40,6 +40,7 @@ type ResourceType interface {
 	CheckTimeout() string
 	LastCheckStartTime() time.Time
 	LastCheckEndTime() time.Time
+	CheckFailures() int
 	CheckSetupError() error
 	CheckError() error
 	UniqueVersionHistory() bool
@@ -140,6 +141,7 @@ var resourceTypesQuery = psql.Select(
 	"ro.check_error",
 	"ro.last_check_start_time",
 	"ro.last_check_end_time",
+	"ro.check_failures",
 ).
 	From("resource_types r").
 	Join("pipelines p ON p.id = r.pipeline_id").
@@ -172,6 +174,7 @@ type resourceType struct {
 	checkEvery            string
 	lastCheckStartTime    time.Time
 	lastCheckEndTime      time.Time
+	checkFailures         int
 	checkSetupError       error
 	checkError            error
 	uniqueVersionHistory  bool
@@ -187,6 +190,7 @@ func (t *resourceType) CheckEvery() string            { return t.checkEvery }
 func (t *resourceType) CheckTimeout() string          { return "" }
 func (r *resourceType) LastCheckStartTime() time.Time { return r.lastCheckStartTime }
 func (r *resourceType) LastCheckEndTime() time.Time   { return r.lastCheckEndTime }
+func (r *resourceType) CheckFailures() int            { return r.checkFailures }
 func (t *resourceType) Source() atc.Source            { return t.source }
 func (t *resourceType) Params() atc.Params            { return t.params }
 func (t *resourceType) Tags() atc.Tags                { return t.tags }
@@ -288,15 +292,17 @@ func scanResourceType(t *resourceType, row scannable) error {
 		configJSON                                   sql.NullString
 		checkErr, rcsCheckErr, rcsID, version, nonce sql.NullString
 		lastCheckStartTime, lastCheckEndTime         pq.NullTime
+		checkFailures                                sql.NullInt64
 	)
 
-	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &checkErr, &t.pipelineName, &t.teamID, &t.teamName, &rcsID, &rcsCheckErr, &lastCheckStartTime, &lastCheckEndTime)
+	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &checkErr, &t.pipelineName, &t.teamID, &t.teamName, &rcsID, &rcsCheckErr, &lastCheckStartTime, &lastCheckEndTime, &checkFailures)
 	if err != nil {
 		return err
 	}
 
 	t.lastCheckStartTime = lastCheckStartTime.Time
 	t.lastCheckEndTime = lastCheckEndTime.Time
+	t.checkFailures = int(checkFailures.Int64)
 
 	if version.Valid {
 		err = json.Unmarshal([]byte(version.String), &t.version)
@@ -5,6 +5,9 @@ package db
 type TaskCacheFactory interface {
 	Find(jobID int, stepName string, path string) (UsedTaskCache, bool, error)
 	FindOrCreate(jobID int, stepName string, path string) (UsedTaskCache, error)
+
+	FindNamed(teamID int, name string) (UsedTaskCache, bool, error)
+	FindOrCreateNamed(teamID int, name string) (UsedTaskCache, error)
 }
 
 type taskCacheFactory struct {
@@ -50,3 +53,34 @@ func (f *taskCacheFactory) FindOrCreate(jobID int, stepName string, path string)
 
 	return utc, nil
 }
+
+func (f *taskCacheFactory) FindNamed(teamID int, name string) (UsedTaskCache, bool, error) {
+	return namedTaskCache{
+		teamID: teamID,
+		name:   name,
+	}.find(f.conn)
+}
+
+func (f *taskCacheFactory) FindOrCreateNamed(teamID int, name string) (UsedTaskCache, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	utc, err := namedTaskCache{
+		teamID: teamID,
+		name:   name,
+	}.findOrCreate(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return utc, nil
+}
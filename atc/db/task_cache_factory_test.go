@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 
 	. "github.com/onsi/ginkgo"
@@ -86,4 +87,77 @@ var _ = Describe("TaskCacheFactory", func() {
 			})
 		})
 	})
+
+	Describe("FindOrCreateNamed", func() {
+		It("creates the named cache's task cache", func() {
+			usedTaskCache, err := taskCacheFactory.FindOrCreateNamed(defaultTeam.ID(), "go-mod")
+			Expect(err).ToNot(HaveOccurred())
+
+			sameTaskCache, err := taskCacheFactory.FindOrCreateNamed(defaultTeam.ID(), "go-mod")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sameTaskCache.ID()).To(Equal(usedTaskCache.ID()))
+		})
+
+		It("creates a new task cache for another name", func() {
+			usedTaskCache, err := taskCacheFactory.FindOrCreateNamed(defaultTeam.ID(), "go-mod")
+			Expect(err).ToNot(HaveOccurred())
+
+			otherTaskCache, err := taskCacheFactory.FindOrCreateNamed(defaultTeam.ID(), "npm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(otherTaskCache.ID()).ToNot(Equal(usedTaskCache.ID()))
+		})
+
+		It("does not share the cache with other teams", func() {
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			usedTaskCache, err := taskCacheFactory.FindOrCreateNamed(defaultTeam.ID(), "go-mod")
+			Expect(err).ToNot(HaveOccurred())
+
+			otherTaskCache, err := taskCacheFactory.FindOrCreateNamed(otherTeam.ID(), "go-mod")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(otherTaskCache.ID()).ToNot(Equal(usedTaskCache.ID()))
+		})
+	})
+
+	Describe("FindNamed", func() {
+		Context("when there is no existing named cache", func() {
+			It("returns not found", func() {
+				_, found, err := taskCacheFactory.FindNamed(defaultTeam.ID(), "go-mod")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the named cache exists", func() {
+			var usedTaskCache db.UsedTaskCache
+
+			BeforeEach(func() {
+				var err error
+				usedTaskCache, err = taskCacheFactory.FindOrCreateNamed(defaultTeam.ID(), "go-mod")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("finds it", func() {
+				utc, found, err := taskCacheFactory.FindNamed(defaultTeam.ID(), "go-mod")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(utc.ID()).To(Equal(usedTaskCache.ID()))
+			})
+
+			Context("when it has been cleared", func() {
+				BeforeEach(func() {
+					cleared, err := defaultTeam.ClearNamedCache("go-mod")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cleared).To(Equal(int64(1)))
+				})
+
+				It("returns not found", func() {
+					_, found, err := taskCacheFactory.FindNamed(defaultTeam.ID(), "go-mod")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+			})
+		})
+	})
 })
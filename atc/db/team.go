@@ -28,6 +28,8 @@ type Team interface {
 	Admin() bool
 
 	Auth() atc.TeamAuth
+	Network() *atc.TeamNetworkConfig
+	Caches() atc.NamedCacheConfigs
 
 	Delete() error
 	Rename(string) error
@@ -45,11 +47,13 @@ type Team interface {
 	OrderPipelines([]string) error
 
 	CreateOneOffBuild() (Build, error)
-	CreateStartedBuild(plan atc.Plan) (Build, error)
+	CreateStartedBuild(plan atc.Plan, createdBy string) (Build, error)
 
 	PrivateAndPublicBuilds(Page) ([]Build, Pagination, error)
 	Builds(page Page) ([]Build, Pagination, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
+	SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, error)
+	BuildLifecycleEvents(after int64) (BuildLifecycleEventSource, error)
 
 	SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error)
 	Workers() ([]Worker, error)
@@ -67,6 +71,10 @@ type Team interface {
 	FindWorkerForVolume(handle string) (Worker, bool, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+	UpdateNetwork(network *atc.TeamNetworkConfig) error
+	UpdateCaches(caches atc.NamedCacheConfigs) error
+
+	ClearNamedCache(name string) (int64, error)
 }
 
 type team struct {
@@ -77,7 +85,9 @@ type team struct {
 	name  string
 	admin bool
 
-	auth atc.TeamAuth
+	auth    atc.TeamAuth
+	network *atc.TeamNetworkConfig
+	caches  atc.NamedCacheConfigs
 }
 
 func (t *team) ID() int      { return t.id }
@@ -86,15 +96,53 @@ func (t *team) Admin() bool  { return t.admin }
 
 func (t *team) Auth() atc.TeamAuth { return t.auth }
 
+func (t *team) Network() *atc.TeamNetworkConfig { return t.network }
+
+func (t *team) Caches() atc.NamedCacheConfigs { return t.caches }
+
+func (t *team) ClearNamedCache(name string) (int64, error) {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	defer Rollback(tx)
+
+	cleared, err := namedTaskCache{
+		teamID: t.id,
+		name:   name,
+	}.clear(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	return cleared, tx.Commit()
+}
+
 func (t *team) Delete() error {
-	_, err := psql.Delete("teams").
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	err = deleteBuildEvents(tx, t.conn.EventStore(), sq.Eq{"team_id": t.id})
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("teams").
 		Where(sq.Eq{
 			"name": t.name,
 		}).
-		RunWith(t.conn).
+		RunWith(tx).
 		Exec()
+	if err != nil {
+		return err
+	}
 
-	return err
+	return tx.Commit()
 }
 
 func (t *team) Rename(name string) error {
@@ -466,6 +514,11 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
+	err = saveNamedCaches(tx, t.id, pipelineID, config.Caches)
+	if err != nil {
+		return nil, false, err
+	}
+
 	err = t.insertJobPipes(tx, config.Jobs, resourceNameToID, jobNameToID, pipelineID)
 	if err != nil {
 		return nil, false, err
@@ -619,7 +672,7 @@ func (t *team) CreateOneOffBuild() (Build, error) {
 	return build, nil
 }
 
-func (t *team) CreateStartedBuild(plan atc.Plan) (Build, error) {
+func (t *team) CreateStartedBuild(plan atc.Plan, createdBy string) (Build, error) {
 	tx, err := t.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -647,6 +700,7 @@ func (t *team) CreateStartedBuild(plan atc.Plan) (Build, error) {
 		"private_plan": encryptedPlan,
 		"public_plan":  plan.Public(),
 		"nonce":        nonce,
+		"created_by":   nullableString(createdBy),
 	})
 	if err != nil {
 		return nil, err
@@ -691,6 +745,10 @@ func (t *team) Builds(page Page) ([]Build, Pagination, error) {
 	return getBuildsWithPagination(buildsQuery.Where(sq.Eq{"t.id": t.id}), minMaxIdQuery, page, t.conn, t.lockFactory)
 }
 
+func (t *team) BuildLifecycleEvents(after int64) (BuildLifecycleEventSource, error) {
+	return newBuildLifecycleEventSource(t.conn, t.id, after)
+}
+
 func (t *team) SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error) {
 	tx, err := t.conn.Begin()
 	if err != nil {
@@ -728,7 +786,7 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 		UPDATE teams
 		SET auth = $1, legacy_auth = NULL, nonce = NULL
 		WHERE id = $2
-		RETURNING id, name, admin, auth, nonce
+		RETURNING id, name, admin, auth, nonce, network, caches
 	`
 	err = t.queryTeam(tx, query, jsonEncodedProviderAuth, t.id)
 	if err != nil {
@@ -738,6 +796,66 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+func (t *team) UpdateNetwork(network *atc.TeamNetworkConfig) error {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer Rollback(tx)
+
+	jsonEncodedNetwork, err := json.Marshal(network)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE teams
+		SET network = $1
+		WHERE id = $2
+		RETURNING id, name, admin, auth, nonce, network, caches
+	`
+	err = t.queryTeam(tx, query, jsonEncodedNetwork, t.id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateCaches replaces the caches declared by the team itself. It fails with
+// a NamedCacheDeclaredError if one of the team's pipelines already declares
+// one of them.
+func (t *team) UpdateCaches(caches atc.NamedCacheConfigs) error {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer Rollback(tx)
+
+	err = saveTeamNamedCaches(tx, t.id, caches)
+	if err != nil {
+		return err
+	}
+
+	jsonEncodedCaches, err := json.Marshal(caches)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE teams
+		SET caches = $1
+		WHERE id = $2
+		RETURNING id, name, admin, auth, nonce, network, caches
+	`
+	err = t.queryTeam(tx, query, jsonEncodedCaches, t.id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
 	pipeline, found, err := t.Pipeline(pipelineName)
 	if err != nil {
@@ -950,11 +1068,18 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 		return 0, err
 	}
 
+	var keepFailedContainers interface{}
+	if duration := job.KeepFailedContainersFor(); duration > 0 {
+		keepFailedContainers = fmt.Sprintf("%d seconds", int(duration.Seconds()))
+	}
+
+	prefetchImages := len(job.PrefetchedImages()) > 0
+
 	var jobID int
 	err = psql.Insert("jobs").
-		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "interruptible", "active", "nonce", "tags").
-		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.Interruptible, true, nonce, pq.Array(groups)).
-		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags").
+		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "interruptible", "active", "nonce", "tags", "keep_failed_containers", "prefetch_images").
+		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.Interruptible, true, nonce, pq.Array(groups), keepFailedContainers, prefetchImages).
+		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags, keep_failed_containers = EXCLUDED.keep_failed_containers, prefetch_images = EXCLUDED.prefetch_images").
 		Suffix("RETURNING id").
 		RunWith(tx).
 		QueryRow().
@@ -963,6 +1088,16 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 		return 0, err
 	}
 
+	if !prefetchImages {
+		_, err = psql.Delete("resource_cache_uses").
+			Where(sq.Eq{"job_id": jobID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	return jobID, nil
 }
 
@@ -989,9 +1124,9 @@ func (t *team) saveResource(tx Tx, resource atc.ResourceConfig, pipelineID int)
 
 	var resourceID int
 	err = psql.Insert("resources").
-		Columns("name", "pipeline_id", "config", "active", "nonce", "type").
-		Values(resource.Name, pipelineID, encryptedPayload, true, nonce, resource.Type).
-		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, active = EXCLUDED.active, nonce = EXCLUDED.nonce, type = EXCLUDED.type").
+		Columns("name", "pipeline_id", "config", "active", "nonce", "type", "prefetch").
+		Values(resource.Name, pipelineID, encryptedPayload, true, nonce, resource.Type, resource.Prefetch).
+		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, active = EXCLUDED.active, nonce = EXCLUDED.nonce, type = EXCLUDED.type, prefetch = EXCLUDED.prefetch").
 		Suffix("RETURNING id").
 		RunWith(tx).
 		QueryRow().
@@ -1028,6 +1163,16 @@ func (t *team) saveResource(tx Tx, resource atc.ResourceConfig, pipelineID int)
 		}
 	}
 
+	if !resource.Prefetch {
+		_, err = psql.Delete("resource_cache_uses").
+			Where(sq.Eq{"resource_id": resourceID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	return resourceID, nil
 }
 
@@ -1200,7 +1345,7 @@ func scanContainers(rows *sql.Rows, conn Conn, initContainers []Container) ([]Co
 }
 
 func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
-	var providerAuth, nonce sql.NullString
+	var providerAuth, nonce, network, caches sql.NullString
 
 	err := tx.QueryRow(query, params...).Scan(
 		&t.id,
@@ -1208,6 +1353,8 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 		&t.admin,
 		&providerAuth,
 		&nonce,
+		&network,
+		&caches,
 	)
 	if err != nil {
 		return err
@@ -1222,6 +1369,22 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 		t.auth = auth
 	}
 
+	t.network = nil
+	if network.Valid {
+		err = json.Unmarshal([]byte(network.String), &t.network)
+		if err != nil {
+			return err
+		}
+	}
+
+	t.caches = nil
+	if caches.Valid {
+		err = json.Unmarshal([]byte(caches.String), &t.caches)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -52,10 +52,20 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 		return nil, err
 	}
 
+	network, err := json.Marshal(t.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	caches, err := json.Marshal(t.Caches)
+	if err != nil {
+		return nil, err
+	}
+
 	row := psql.Insert("teams").
-		Columns("name, auth, admin").
-		Values(t.Name, auth, admin).
-		Suffix("RETURNING id, name, admin, auth").
+		Columns("name, auth, admin, network, caches").
+		Values(t.Name, auth, admin, network, caches).
+		Suffix("RETURNING id, name, admin, auth, network, caches").
 		RunWith(tx).
 		QueryRow()
 
@@ -69,6 +79,11 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 		return nil, err
 	}
 
+	err = saveTeamNamedCaches(tx, team.id, t.Caches)
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -91,7 +106,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 		lockFactory: factory.lockFactory,
 	}
 
-	row := psql.Select("id, name, admin, auth").
+	row := psql.Select("id, name, admin, auth, network, caches").
 		From("teams").
 		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
 		RunWith(factory.conn).
@@ -110,7 +125,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 }
 
 func (factory *teamFactory) GetTeams() ([]Team, error) {
-	rows, err := psql.Select("id, name, admin, auth").
+	rows, err := psql.Select("id, name, admin, auth, network, caches").
 		From("teams").
 		OrderBy("name ASC").
 		RunWith(factory.conn).
@@ -176,13 +191,15 @@ func (factory *teamFactory) NotifyCacher() error {
 }
 
 func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
-	var providerAuth sql.NullString
+	var providerAuth, network, caches sql.NullString
 
 	err := rows.Scan(
 		&t.id,
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&network,
+		&caches,
 	)
 
 	if providerAuth.Valid {
@@ -192,5 +209,19 @@ func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
 		}
 	}
 
+	if network.Valid {
+		err = json.Unmarshal([]byte(network.String), &t.network)
+		if err != nil {
+			return err
+		}
+	}
+
+	if caches.Valid {
+		err = json.Unmarshal([]byte(caches.String), &t.caches)
+		if err != nil {
+			return err
+		}
+	}
+
 	return err
 }
@@ -299,7 +299,7 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 
 			metaContainers = make(map[db.ContainerMetadata][]db.Container)
@@ -425,7 +425,7 @@ var _ = Describe("Team", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 
 				firstContainerCreating, err = defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-job"), defaultTeam.ID()), db.ContainerMetadata{Type: "task", StepName: "some-task"})
@@ -674,7 +674,7 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 
 			creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-job"), defaultTeam.ID()), db.ContainerMetadata{Type: "task", StepName: "some-task"})
@@ -858,6 +858,25 @@ var _ = Describe("Team", func() {
 				})
 			})
 		})
+
+		Describe("UpdateCaches", func() {
+			var caches atc.NamedCacheConfigs
+
+			BeforeEach(func() {
+				caches = atc.NamedCacheConfigs{{Name: "go-mod", Size: "10GB"}}
+			})
+
+			It("saves the caches to the existing team", func() {
+				err := team.UpdateCaches(caches)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(team.Caches()).To(Equal(caches))
+
+				found, _, err := teamFactory.FindTeam(team.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found.Caches()).To(Equal(caches))
+			})
+		})
 	})
 
 	Describe("Pipelines", func() {
@@ -1053,7 +1072,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			startedBuild, err = team.CreateStartedBuild(plan)
+			startedBuild, err = team.CreateStartedBuild(plan, defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -1130,7 +1149,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 
 				for i := 3; i < 5; i++ {
-					build, err := job.CreateBuild()
+					build, err := job.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).ToNot(HaveOccurred())
 					allBuilds[i] = build
 					pipelineBuilds[i-3] = build
@@ -1281,7 +1300,7 @@ var _ = Describe("Team", func() {
 			Expect(found).To(BeTrue())
 
 			for i := range builds {
-				builds[i], err = job.CreateBuild()
+				builds[i], err = job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 
 				buildStart := time.Date(2020, 11, i+1, 0, 0, 0, 0, time.UTC)
@@ -1386,11 +1405,11 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, build)
 
-			secondBuild, err = job.CreateBuild()
+			secondBuild, err = job.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, secondBuild)
 
@@ -1398,7 +1417,7 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			thirdBuild, err = someOtherJob.CreateBuild()
+			thirdBuild, err = someOtherJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, thirdBuild)
 		})
@@ -1521,6 +1540,154 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("BuildLifecycleEvents", func() {
+		It("only streams the lifecycle of the team's builds", func() {
+			source, err := team.BuildLifecycleEvents(-1)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer source.Close()
+
+			_, err = otherTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			build, err := team.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			ev, err := source.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.BuildID).To(Equal(build.ID()))
+			Expect(ev.BuildName).To(Equal(build.Name()))
+			Expect(ev.TeamName).To(Equal(team.Name()))
+			Expect(ev.Type).To(Equal(atc.BuildCreated))
+		})
+	})
+
+	Describe("SearchBuildLogs", func() {
+		var (
+			oneOffBuild, jobBuild, otherJobBuild db.Build
+			matches                              []atc.BuildLogMatch
+			search                               db.BuildLogSearch
+		)
+
+		BeforeEach(func() {
+			var err error
+
+			oneOffBuild, err = team.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			config := atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "some-job"},
+					{Name: "some-other-job"},
+				},
+			}
+			pipeline, _, err := team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			job, found, err := pipeline.Job("some-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			jobBuild, err = job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			otherJob, found, err := pipeline.Job("some-other-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			otherJobBuild, err = otherJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			otherTeamBuild, err := otherTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, b := range []db.Build{oneOffBuild, jobBuild, otherJobBuild, otherTeamBuild} {
+				_, err = b.Start(atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Expect(oneOffBuild.SaveEvent(event.Log{Payload: "fetching\nconnection refused\n"})).To(Succeed())
+			Expect(jobBuild.SaveEvent(event.Log{Payload: "compiling\n"})).To(Succeed())
+			Expect(jobBuild.SaveEvent(event.Log{Payload: "Connection Refused by upstream\ndone\n"})).To(Succeed())
+			Expect(otherJobBuild.SaveEvent(event.Log{Payload: "all good\n"})).To(Succeed())
+			Expect(otherTeamBuild.SaveEvent(event.Log{Payload: "connection refused\n"})).To(Succeed())
+
+			search = db.BuildLogSearch{Query: "connection refused"}
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			matches, err = team.SearchBuildLogs(search)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the matching lines of the team's builds, newest build first", func() {
+			Expect(matches).To(Equal([]atc.BuildLogMatch{
+				{
+					BuildID:      jobBuild.ID(),
+					BuildName:    jobBuild.Name(),
+					TeamName:     "some-team",
+					PipelineName: "some-pipeline",
+					JobName:      "some-job",
+					StartTime:    matches[0].StartTime,
+					Lines:        []string{"Connection Refused by upstream"},
+				},
+				{
+					BuildID:   oneOffBuild.ID(),
+					BuildName: oneOffBuild.Name(),
+					TeamName:  "some-team",
+					StartTime: matches[1].StartTime,
+					Lines:     []string{"connection refused"},
+				},
+			}))
+		})
+
+		Context("when filtering by pipeline and job", func() {
+			BeforeEach(func() {
+				search.PipelineName = "some-pipeline"
+				search.JobName = "some-job"
+			})
+
+			It("only searches the job's builds", func() {
+				Expect(matches).To(HaveLen(1))
+				Expect(matches[0].BuildID).To(Equal(jobBuild.ID()))
+			})
+		})
+
+		Context("when filtering by a time range which excludes the builds", func() {
+			BeforeEach(func() {
+				search.Until = time.Now().Add(-time.Hour)
+			})
+
+			It("returns no matches", func() {
+				Expect(matches).To(BeEmpty())
+			})
+		})
+
+		Context("when the events of a build have been offloaded", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec(`UPDATE builds SET events_offloaded = true WHERE id = $1`, oneOffBuild.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not search them", func() {
+				Expect(matches).To(HaveLen(1))
+				Expect(matches[0].BuildID).To(Equal(jobBuild.ID()))
+			})
+		})
+
+		Context("when limiting the number of lines", func() {
+			BeforeEach(func() {
+				search.Limit = 1
+			})
+
+			It("returns the most recent matches", func() {
+				Expect(matches).To(HaveLen(1))
+				Expect(matches[0].BuildID).To(Equal(jobBuild.ID()))
+			})
+		})
+	})
+
 	Describe("SavePipeline", func() {
 		type SerialGroup struct {
 			JobID int
@@ -3145,7 +3312,7 @@ var _ = Describe("Team", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild(defaultBuildCreatedBy)
 				Expect(err).ToNot(HaveOccurred())
 
 				creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-job"), defaultTeam.ID()), db.ContainerMetadata{Type: "task", StepName: "some-task"})
@@ -155,6 +155,7 @@ type CreatedVolume interface {
 	GetResourceCacheID() int
 	InitializeArtifact(name string, buildID int) (WorkerArtifact, error)
 	InitializeTaskCache(jobID int, stepName string, path string) error
+	InitializeNamedCache(teamID int, name string) (uint64, error)
 
 	ContainerHandle() string
 	ParentHandle() string
@@ -467,14 +468,84 @@ func (volume *createdVolume) InitializeTaskCache(jobID int, stepName string, pat
 		return err
 	}
 
+	initialized, err := volume.initializeWorkerTaskCache(tx, usedWorkerTaskCache)
+	if err != nil || !initialized {
+		return err
+	}
+
+	_, err = psql.Update("worker_task_caches").
+		Set("last_used", sq.Expr("now()")).
+		Where(sq.Eq{"id": usedWorkerTaskCache.ID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// InitializeNamedCache makes the volume the worker's copy of the named cache
+// and returns the cap on the copy's size, or 0 if it has none.
+func (volume *createdVolume) InitializeNamedCache(teamID int, name string) (uint64, error) {
+	tx, err := volume.conn.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	defer Rollback(tx)
+
+	namedCache := namedTaskCache{
+		teamID: teamID,
+		name:   name,
+	}
+
+	usedTaskCache, err := namedCache.findOrCreate(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	usedWorkerTaskCache, err := WorkerTaskCache{
+		WorkerName: volume.WorkerName(),
+		TaskCache:  usedTaskCache,
+	}.findOrCreate(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	sizeLimit, err := namedCache.sizeLimit(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	initialized, err := volume.initializeWorkerTaskCache(tx, usedWorkerTaskCache)
+	if err != nil || !initialized {
+		return sizeLimit, err
+	}
+
+	_, err = psql.Update("worker_task_caches").
+		Set("last_used", sq.Expr("now()")).
+		Where(sq.Eq{"id": usedWorkerTaskCache.ID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	return sizeLimit, tx.Commit()
+}
+
+// initializeWorkerTaskCache makes the volume the worker's copy of the task
+// cache. It returns false if another volume has become the copy first.
+func (volume *createdVolume) initializeWorkerTaskCache(tx Tx, usedWorkerTaskCache *UsedWorkerTaskCache) (bool, error) {
 	// release other old volumes for gc
-	_, err = psql.Update("volumes").
+	_, err := psql.Update("volumes").
 		Set("worker_task_cache_id", nil).
 		Where(sq.Eq{"worker_task_cache_id": usedWorkerTaskCache.ID}).
 		RunWith(tx).
 		Exec()
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	rows, err := psql.Update("volumes").
@@ -486,27 +557,22 @@ func (volume *createdVolume) InitializeTaskCache(jobID int, stepName string, pat
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
 			// another volume was 'blessed' as the cache volume - leave this one
 			// owned by the container so it just expires when the container is GCed
-			return nil
+			return false, nil
 		}
 
-		return err
+		return false, err
 	}
 
 	affected, err := rows.RowsAffected()
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	if affected == 0 {
-		return ErrVolumeMissing
+		return false, ErrVolumeMissing
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return true, nil
 }
 
 func (volume *createdVolume) CreateChildForContainer(container CreatingContainer, mountPath string) (CreatingVolume, error) {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
 	uuid "github.com/nu7hatch/gouuid"
 )
 
@@ -44,6 +45,8 @@ type VolumeRepository interface {
 	RemoveMissingVolumes(gracePeriod time.Duration) (removed int, err error)
 
 	DestroyUnknownVolumes(workerName string, handles []string) (int, error)
+
+	UpdateVolumesDiskUsage(workerName string, usage atc.VolumesDiskUsage) error
 }
 
 const noTeam = 0
@@ -87,6 +90,18 @@ func (repository *volumeRepository) queryVolumeHandles(tx Tx, cond sq.Eq) ([]str
 	return handles, nil
 }
 
+// UpdateVolumesDiskUsage records the usage of the disk holding the worker's
+// volumes, by which its caches are evicted once it fills up.
+func (repository *volumeRepository) UpdateVolumesDiskUsage(workerName string, usage atc.VolumesDiskUsage) error {
+	_, err := psql.Update("workers").
+		Set("volumes_disk_used", usage.Used).
+		Set("volumes_disk_capacity", usage.Capacity).
+		Where(sq.Eq{"name": workerName}).
+		RunWith(repository.conn).
+		Exec()
+	return err
+}
+
 func (repository *volumeRepository) UpdateVolumesMissingSince(workerName string, reportedHandles []string) error {
 	// clear out missing_since for reported volumes
 	query, args, err := psql.Update("volumes").
@@ -349,6 +349,123 @@ var _ = Describe("Volume", func() {
 		})
 	})
 
+	Describe("createdVolume.InitializeNamedCache", func() {
+		var volume db.CreatedVolume
+		var sizeLimit uint64
+
+		JustBeforeEach(func() {
+			v, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), defaultCreatingContainer, "some-path")
+			Expect(err).ToNot(HaveOccurred())
+
+			volume, err = v.Created()
+			Expect(err).ToNot(HaveOccurred())
+
+			sizeLimit, err = volume.InitializeNamedCache(defaultTeam.ID(), "go-mod")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("makes the volume the worker's copy of the named cache", func() {
+			taskCache, found, err := taskCacheFactory.FindNamed(defaultTeam.ID(), "go-mod")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			createdVolume, found, err := volumeRepository.FindTaskCacheVolume(defaultTeam.ID(), defaultWorker.Name(), taskCache)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(createdVolume.Handle()).To(Equal(volume.Handle()))
+		})
+
+		Context("when the cache is not declared", func() {
+			It("returns no size limit", func() {
+				Expect(sizeLimit).To(BeZero())
+			})
+		})
+
+		Context("when a pipeline declares the cache", func() {
+			var pipeline db.Pipeline
+
+			BeforeEach(func() {
+				var err error
+				pipeline, _, err = defaultTeam.SavePipeline("some-pipeline-with-caches", atc.Config{
+					Caches: atc.NamedCacheConfigs{{Name: "go-mod", Size: "1KB"}},
+				}, db.ConfigVersion(0), false)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the size limit of the cache", func() {
+				Expect(sizeLimit).To(Equal(uint64(1024)))
+			})
+
+			Context("when the pipeline no longer declares the cache", func() {
+				BeforeEach(func() {
+					_, _, err := defaultTeam.SavePipeline("some-pipeline-with-caches", atc.Config{}, pipeline.ConfigVersion(), false)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns no size limit", func() {
+					Expect(sizeLimit).To(BeZero())
+				})
+			})
+
+			Context("when the pipeline is destroyed", func() {
+				BeforeEach(func() {
+					Expect(pipeline.Destroy()).To(Succeed())
+				})
+
+				It("returns no size limit", func() {
+					Expect(sizeLimit).To(BeZero())
+				})
+			})
+
+			Context("when another pipeline of the team declares the cache", func() {
+				It("fails to save the other pipeline", func() {
+					_, _, err := defaultTeam.SavePipeline("some-other-pipeline-with-caches", atc.Config{
+						Caches: atc.NamedCacheConfigs{{Name: "go-mod", Size: "1GB"}},
+					}, db.ConfigVersion(0), false)
+					Expect(err).To(Equal(db.NamedCacheDeclaredError{Name: "go-mod"}))
+				})
+			})
+
+			Context("when the team declares the cache", func() {
+				It("fails to update the team", func() {
+					err := defaultTeam.UpdateCaches(atc.NamedCacheConfigs{{Name: "go-mod", Size: "1GB"}})
+					Expect(err).To(Equal(db.NamedCacheDeclaredError{Name: "go-mod"}))
+				})
+			})
+		})
+
+		Context("when the team declares the cache", func() {
+			BeforeEach(func() {
+				err := defaultTeam.UpdateCaches(atc.NamedCacheConfigs{{Name: "go-mod", Size: "2KB"}})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the size limit of the cache", func() {
+				Expect(sizeLimit).To(Equal(uint64(2048)))
+			})
+
+			Context("when the team no longer declares the cache", func() {
+				BeforeEach(func() {
+					err := defaultTeam.UpdateCaches(nil)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns no size limit", func() {
+					Expect(sizeLimit).To(BeZero())
+				})
+			})
+
+			Context("when a pipeline of the team declares the cache", func() {
+				It("fails to save the pipeline", func() {
+					_, _, err := defaultTeam.SavePipeline("some-pipeline-with-caches", atc.Config{
+						Caches: atc.NamedCacheConfigs{{Name: "go-mod", Size: "1GB"}},
+					}, db.ConfigVersion(0), false)
+					Expect(err).To(Equal(db.NamedCacheDeclaredError{Name: "go-mod"}))
+				})
+			})
+		})
+	})
+
 	Describe("Container volumes", func() {
 		It("returns volume type, container handle, mount path", func() {
 			creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), defaultCreatingContainer, "/path/to/volume")
@@ -0,0 +1,172 @@
+package db
+
+import (
+	sq "github.com/Masterminds/squirrel"
+)
+
+//go:generate counterfeiter . WorkerCacheLifecycle
+
+type WorkerCacheLifecycle interface {
+	WorkersOverDiskUsage(percent int) ([]string, error)
+	EvictLeastRecentlyUsedCaches(workerName string, limit int) (EvictedCaches, error)
+}
+
+// EvictedCaches counts the copies of each kind of cache evicted from a worker.
+type EvictedCaches struct {
+	ResourceCaches int
+	TaskCaches     int
+}
+
+type workerCacheLifecycle struct {
+	conn Conn
+}
+
+func NewWorkerCacheLifecycle(conn Conn) *workerCacheLifecycle {
+	return &workerCacheLifecycle{
+		conn: conn,
+	}
+}
+
+// WorkersOverDiskUsage returns the names of the workers which last reported
+// more than the given percentage of the disk holding their volumes as used.
+func (lifecycle *workerCacheLifecycle) WorkersOverDiskUsage(percent int) ([]string, error) {
+	rows, err := psql.Select("name").
+		From("workers").
+		Where(sq.Gt{"volumes_disk_capacity": 0}).
+		Where(sq.Expr("volumes_disk_used * 100 > volumes_disk_capacity * ?", percent)).
+		OrderBy("name").
+		RunWith(lifecycle.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var names []string
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, name)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+// EvictLeastRecentlyUsedCaches removes up to the given number of the worker's
+// least recently used copies of resource caches and task caches. Copies of
+// resource caches which are still in use, and copies whose volume is mounted
+// into a container as the parent of a copy-on-write volume, are never
+// evicted. The volumes of the evicted copies are left to be garbage
+// collected.
+func (lifecycle *workerCacheLifecycle) EvictLeastRecentlyUsedCaches(workerName string, limit int) (EvictedCaches, error) {
+	tx, err := lifecycle.conn.Begin()
+	if err != nil {
+		return EvictedCaches{}, err
+	}
+
+	defer Rollback(tx)
+
+	rows, err := tx.Query(`
+		SELECT kind, id FROM (
+			SELECT 'resource' AS kind, wrc.id, wrc.last_used
+			FROM worker_resource_caches wrc
+			JOIN worker_base_resource_types wbrt ON wbrt.id = wrc.worker_base_resource_type_id
+			WHERE wbrt.worker_name = $1
+			AND NOT EXISTS (
+				SELECT 1 FROM resource_cache_uses rcu
+				WHERE rcu.resource_cache_id = wrc.resource_cache_id
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM volumes v
+				JOIN volumes child ON child.parent_id = v.id
+				WHERE v.worker_resource_cache_id = wrc.id
+			)
+			UNION ALL
+			SELECT 'task' AS kind, wtc.id, wtc.last_used
+			FROM worker_task_caches wtc
+			WHERE wtc.worker_name = $1
+			AND NOT EXISTS (
+				SELECT 1 FROM volumes v
+				JOIN volumes child ON child.parent_id = v.id
+				WHERE v.worker_task_cache_id = wtc.id
+			)
+		) candidates
+		ORDER BY last_used ASC, kind, id
+		LIMIT $2
+	`, workerName, limit)
+	if err != nil {
+		return EvictedCaches{}, err
+	}
+
+	var resourceCacheIDs, taskCacheIDs []int
+	for rows.Next() {
+		var kind string
+		var id int
+		err = rows.Scan(&kind, &id)
+		if err != nil {
+			Close(rows)
+			return EvictedCaches{}, err
+		}
+
+		if kind == "resource" {
+			resourceCacheIDs = append(resourceCacheIDs, id)
+		} else {
+			taskCacheIDs = append(taskCacheIDs, id)
+		}
+	}
+
+	Close(rows)
+
+	var evicted EvictedCaches
+
+	if len(resourceCacheIDs) > 0 {
+		result, err := psql.Delete("worker_resource_caches").
+			Where(sq.Eq{"id": resourceCacheIDs}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return EvictedCaches{}, err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return EvictedCaches{}, err
+		}
+
+		evicted.ResourceCaches = int(affected)
+	}
+
+	if len(taskCacheIDs) > 0 {
+		result, err := psql.Delete("worker_task_caches").
+			Where(sq.Eq{"id": taskCacheIDs}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return EvictedCaches{}, err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return EvictedCaches{}, err
+		}
+
+		evicted.TaskCaches = int(affected)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return EvictedCaches{}, err
+	}
+
+	return evicted, nil
+}
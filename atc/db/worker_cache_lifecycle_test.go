@@ -0,0 +1,116 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerCacheLifecycle", func() {
+	var lifecycle db.WorkerCacheLifecycle
+
+	BeforeEach(func() {
+		lifecycle = db.NewWorkerCacheLifecycle(dbConn)
+	})
+
+	Describe("WorkersOverDiskUsage", func() {
+		BeforeEach(func() {
+			err := volumeRepository.UpdateVolumesDiskUsage(defaultWorker.Name(), atc.VolumesDiskUsage{Used: 95, Capacity: 100})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = volumeRepository.UpdateVolumesDiskUsage(otherWorker.Name(), atc.VolumesDiskUsage{Used: 50, Capacity: 100})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the workers using more than the given percentage of their disk", func() {
+			names, err := lifecycle.WorkersOverDiskUsage(90)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(ConsistOf(defaultWorker.Name()))
+		})
+	})
+
+	Describe("EvictLeastRecentlyUsedCaches", func() {
+		var resourceCache db.UsedResourceCache
+
+		createVolume := func() db.CreatedVolume {
+			build, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			container, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID()), db.ContainerMetadata{})
+			Expect(err).ToNot(HaveOccurred())
+
+			creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), container, "some-path")
+			Expect(err).ToNot(HaveOccurred())
+
+			volume, err := creatingVolume.Created()
+			Expect(err).ToNot(HaveOccurred())
+
+			return volume
+		}
+
+		BeforeEach(func() {
+			build, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			resourceCache, err = resourceCacheFactory.FindOrCreateResourceCache(
+				db.ForBuild(build.ID()),
+				"some-base-resource-type",
+				atc.Version{"some": "version"},
+				atc.Source{"some": "source"},
+				nil,
+				atc.VersionedResourceTypes{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = createVolume().InitializeResourceCache(resourceCache)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = createVolume().InitializeTaskCache(defaultJob.ID(), "some-step", "some-path")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dbConn.Exec(`UPDATE worker_task_caches SET last_used = now() - interval '1 hour'`)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the resource cache is no longer in use", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec(`DELETE FROM resource_cache_uses WHERE resource_cache_id = $1`, resourceCache.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("evicts the least recently used caches first", func() {
+				evicted, err := lifecycle.EvictLeastRecentlyUsedCaches(defaultWorker.Name(), 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(evicted).To(Equal(db.EvictedCaches{TaskCaches: 1}))
+
+				_, found, err := volumeRepository.FindResourceCacheVolume(defaultWorker.Name(), resourceCache)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				evicted, err = lifecycle.EvictLeastRecentlyUsedCaches(defaultWorker.Name(), 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(evicted).To(Equal(db.EvictedCaches{ResourceCaches: 1}))
+
+				_, found, err = volumeRepository.FindResourceCacheVolume(defaultWorker.Name(), resourceCache)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("does not evict caches from other workers", func() {
+				evicted, err := lifecycle.EvictLeastRecentlyUsedCaches(otherWorker.Name(), 10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(evicted).To(BeZero())
+			})
+		})
+
+		Context("when the resource cache is still in use", func() {
+			It("does not evict it", func() {
+				evicted, err := lifecycle.EvictLeastRecentlyUsedCaches(defaultWorker.Name(), 10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(evicted).To(Equal(db.EvictedCaches{TaskCaches: 1}))
+			})
+		})
+	})
+})
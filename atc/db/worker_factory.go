@@ -54,7 +54,8 @@ var workersQuery = psql.Select(`
 		w.team_id,
 		w.start_time,
 		w.expires,
-		w.ephemeral
+		w.ephemeral,
+		w.capabilities
 	`).
 	From("workers w").
 	LeftJoin("teams t ON w.team_id = t.id")
@@ -162,6 +163,7 @@ func scanWorker(worker *worker, row scannable) error {
 		&startTime,
 		&expiresAt,
 		&ephemeral,
+		pq.Array(&worker.capabilities),
 	)
 	if err != nil {
 		return err
@@ -411,6 +413,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		string(workerState),
 		teamID,
 		atcWorker.Ephemeral,
+		pq.Array(atcWorker.Capabilities),
 	}
 
 	conflictValues := values
@@ -442,6 +445,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"state",
 			"team_id",
 			"ephemeral",
+			"capabilities",
 		).
 		Values(append([]interface{}{
 			sq.Expr(expires),
@@ -466,7 +470,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				version = ?,
 				state = ?,
 				team_id = ?,
-				ephemeral = ?
+				ephemeral = ?,
+				capabilities = ?
 			WHERE `+matchTeamUpsert,
 			conflictValues...,
 		).
@@ -509,6 +514,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		teamID:           workerTeamID,
 		startTime:        time.Unix(atcWorker.StartTime, 0),
 		ephemeral:        atcWorker.Ephemeral,
+		capabilities:     atcWorker.Capabilities,
 		conn:             conn,
 	}
 
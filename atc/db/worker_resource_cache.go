@@ -55,7 +55,8 @@ func (workerResourceCache WorkerResourceCache) FindOrCreate(tx Tx) (*UsedWorkerR
 		Suffix(`
 			ON CONFLICT (resource_cache_id, worker_base_resource_type_id) DO UPDATE SET
 				resource_cache_id = ?,
-				worker_base_resource_type_id = ?
+				worker_base_resource_type_id = ?,
+				last_used = now()
 			RETURNING id
 		`, workerResourceCache.ResourceCache.ID(), usedWorkerBaseResourceType.ID).
 		RunWith(tx).
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
@@ -32,11 +33,18 @@ type StepFactory interface {
 //go:generate counterfeiter . DelegateFactory
 
 type DelegateFactory interface {
-	GetDelegate(db.Build, atc.PlanID, vars.CredVarsTracker) exec.GetDelegate
-	PutDelegate(db.Build, atc.PlanID, vars.CredVarsTracker) exec.PutDelegate
-	TaskDelegate(db.Build, atc.PlanID, vars.CredVarsTracker) exec.TaskDelegate
+	GetDelegate(db.Build, atc.Plan, vars.CredVarsTracker) exec.GetDelegate
+	PutDelegate(db.Build, atc.Plan, vars.CredVarsTracker) exec.PutDelegate
+	TaskDelegate(db.Build, atc.Plan, vars.CredVarsTracker) exec.TaskDelegate
 	CheckDelegate(db.Check, atc.PlanID, vars.CredVarsTracker) exec.CheckDelegate
-	BuildStepDelegate(db.Build, atc.PlanID, vars.CredVarsTracker) exec.BuildStepDelegate
+	BuildStepDelegate(db.Build, atc.Plan, vars.CredVarsTracker) exec.BuildStepDelegate
+	RetryDelegate(db.Build, atc.Plan) exec.RetryDelegate
+	BreakpointDelegate(db.Build, atc.Plan) exec.BreakpointDelegate
+
+	// LimitBuildOutput returns a DelegateFactory whose delegates count the
+	// output of their steps towards the output limits of the build's plan, and
+	// the watcher which enforces them on the build as a whole.
+	LimitBuildOutput(db.Build, atc.Plan) (DelegateFactory, exec.OutputWatcher)
 }
 
 func NewStepBuilder(
@@ -46,14 +54,16 @@ func NewStepBuilder(
 	secrets creds.Secrets,
 	varSourcePool creds.VarSourcePool,
 	redactSecrets bool,
+	breakpointTimeout time.Duration,
 ) *stepBuilder {
 	return &stepBuilder{
-		stepFactory:     stepFactory,
-		delegateFactory: delegateFactory,
-		externalURL:     externalURL,
-		globalSecrets:   secrets,
-		varSourcePool:   varSourcePool,
-		redactSecrets:   redactSecrets,
+		stepFactory:       stepFactory,
+		delegateFactory:   delegateFactory,
+		externalURL:       externalURL,
+		globalSecrets:     secrets,
+		varSourcePool:     varSourcePool,
+		redactSecrets:     redactSecrets,
+		breakpointTimeout: breakpointTimeout,
 	}
 }
 
@@ -64,6 +74,18 @@ type stepBuilder struct {
 	globalSecrets   creds.Secrets
 	varSourcePool   creds.VarSourcePool
 	redactSecrets   bool
+
+	// breakpointTimeout is how long the build stays paused at a breakpoint
+	// before it is resumed on its own; 0 means no limit.
+	breakpointTimeout time.Duration
+
+	// stepPaths identify the get, put and task steps of the build being
+	// built across builds of its job, by their plan ID.
+	stepPaths map[atc.PlanID]string
+
+	// reusableSteps are the steps of the build being rerun which the build
+	// being built reuses instead of running them again, by their path.
+	reusableSteps map[string]db.BuildStep
 }
 
 func (builder *stepBuilder) BuildStep(logger lager.Logger, build db.Build) (exec.Step, error) {
@@ -97,11 +119,253 @@ func (builder *stepBuilder) BuildStep(logger lager.Logger, build db.Build) (exec
 		credVarsTracker = vars.NewCredVarsTracker(varss, builder.redactSecrets)
 	}
 
-	return builder.buildStep(build, build.PrivatePlan(), credVarsTracker), nil
+	plan := build.PrivatePlan()
+
+	buildBuilder := *builder
+	if build.JobID() != 0 {
+		buildBuilder.stepPaths = map[atc.PlanID]string{}
+		walkSteps(plan, "", func(path string, step atc.Plan) {
+			buildBuilder.stepPaths[step.ID] = path
+		})
+	}
+
+	if build.RerunFromStep() != "" {
+		reusableSteps, err := findReusableSteps(plan, build)
+		if err != nil {
+			return exec.IdentityStep{}, fmt.Errorf("find reusable steps: %w", err)
+		}
+
+		buildBuilder.reusableSteps = reusableSteps
+	}
+
+	if plan.OutputLimits != nil {
+		var watcher exec.OutputWatcher
+		buildBuilder.delegateFactory, watcher = builder.delegateFactory.LimitBuildOutput(build, plan)
+
+		return exec.LimitOutput(buildBuilder.buildStep(build, plan, credVarsTracker), watcher), nil
+	}
+
+	return buildBuilder.buildStep(build, plan, credVarsTracker), nil
+}
+
+// findReusableSteps determines which steps of the build being rerun can be
+// reused: those which have finished by the time the step the build is rerun
+// from starts, as long as they succeeded and their artifacts still exist.
+//
+// If several steps have the name of the step the build is rerun from, it is
+// rerun from the first of them.
+func findReusableSteps(plan atc.Plan, build db.Build) (map[string]db.BuildStep, error) {
+	var target string
+	walkSteps(plan, "", func(path string, step atc.Plan) {
+		if target == "" && stepName(step) == build.RerunFromStep() {
+			target = path
+		}
+	})
+
+	if target == "" {
+		return nil, nil
+	}
+
+	preceding, _ := precedingSteps(plan, "", target)
+
+	steps, err := build.ReusableSteps()
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := map[string]db.BuildStep{}
+	for _, step := range steps {
+		recorded[step.Path] = step
+	}
+
+	reusable := map[string]db.BuildStep{}
+	for _, path := range preceding {
+		if step, ok := recorded[path]; ok {
+			reusable[path] = step
+		}
+	}
+
+	return reusable, nil
+}
+
+// subPlan is a plan nested in another one, along with the element it adds to
+// the paths of its steps.
+type subPlan struct {
+	path string
+	plan atc.Plan
+}
+
+// subPlans returns the plans nested in the given plan, and whether each of
+// them only starts once the ones before it have finished.
+//
+// The attempts of a retried step share their path, so that the step is
+// identified the same way whichever attempt succeeded.
+func subPlans(plan atc.Plan) ([]subPlan, bool) {
+	var (
+		subs       []subPlan
+		sequential bool
+	)
+
+	hook := func(name string, step atc.Plan, next atc.Plan) {
+		subs = append(subs, subPlan{name + ".step", step}, subPlan{name + ".next", next})
+		sequential = true
+	}
+
+	switch {
+	case plan.Do != nil:
+		for i, p := range *plan.Do {
+			subs = append(subs, subPlan{fmt.Sprintf("do.%d", i), p})
+		}
+		sequential = true
+	case plan.InParallel != nil:
+		for i, p := range plan.InParallel.Steps {
+			subs = append(subs, subPlan{fmt.Sprintf("in_parallel.%d", i), p})
+		}
+	case plan.Aggregate != nil:
+		for i, p := range *plan.Aggregate {
+			subs = append(subs, subPlan{fmt.Sprintf("aggregate.%d", i), p})
+		}
+	case plan.OnSuccess != nil:
+		hook("on_success", plan.OnSuccess.Step, plan.OnSuccess.Next)
+	case plan.OnFailure != nil:
+		hook("on_failure", plan.OnFailure.Step, plan.OnFailure.Next)
+	case plan.OnAbort != nil:
+		hook("on_abort", plan.OnAbort.Step, plan.OnAbort.Next)
+	case plan.OnError != nil:
+		hook("on_error", plan.OnError.Step, plan.OnError.Next)
+	case plan.Ensure != nil:
+		hook("ensure", plan.Ensure.Step, plan.Ensure.Next)
+	case plan.Try != nil:
+		subs = append(subs, subPlan{"try", plan.Try.Step})
+	case plan.Timeout != nil:
+		subs = append(subs, subPlan{"timeout", plan.Timeout.Step})
+	case plan.Retry != nil:
+		for _, p := range *plan.Retry {
+			subs = append(subs, subPlan{"retry", p})
+		}
+	}
+
+	return subs, sequential
+}
+
+// walkSteps calls the given function with each get, put and task step of the
+// plan in order, along with its path. The path of a step identifies it across
+// builds of a job, as long as the job's plan does not change.
+func walkSteps(plan atc.Plan, path string, f func(string, atc.Plan)) {
+	if step, ok := rerunnableStep(plan); ok {
+		f(joinPath(path, step), plan)
+		return
+	}
+
+	subs, _ := subPlans(plan)
+	for _, sub := range subs {
+		walkSteps(sub.plan, joinPath(path, sub.path), f)
+	}
+}
+
+// precedingSteps returns the paths of the steps of the plan which have
+// finished by the time the step with the given path starts, and whether the
+// step is in the plan at all. Steps running in parallel with it and hooks
+// running after it do not count.
+func precedingSteps(plan atc.Plan, path string, target string) ([]string, bool) {
+	if step, ok := rerunnableStep(plan); ok {
+		return nil, joinPath(path, step) == target
+	}
+
+	subs, sequential := subPlans(plan)
+
+	var preceding []string
+	for _, sub := range subs {
+		subPath := joinPath(path, sub.path)
+
+		steps, found := precedingSteps(sub.plan, subPath, target)
+		if found {
+			return append(preceding, steps...), true
+		}
+
+		if sequential {
+			walkSteps(sub.plan, subPath, func(stepPath string, _ atc.Plan) {
+				preceding = append(preceding, stepPath)
+			})
+		}
+	}
+
+	return nil, false
+}
+
+func joinPath(path string, element string) string {
+	if path == "" {
+		return element
+	}
+
+	return path + "/" + element
+}
+
+// rerunnableStep returns the last element of the path of a get, put or task
+// step, which includes its type and name so that a step is not mistaken for
+// another one if the job's plan changes.
+func rerunnableStep(plan atc.Plan) (string, bool) {
+	stepType, ok := rerunnableStepType(plan)
+	if !ok {
+		return "", false
+	}
+
+	return stepType + ":" + stepName(plan), true
+}
+
+func rerunnableStepType(plan atc.Plan) (string, bool) {
+	switch {
+	case plan.Get != nil:
+		return db.BuildStepTypeGet, true
+	case plan.Put != nil:
+		return db.BuildStepTypePut, true
+	case plan.Task != nil:
+		return db.BuildStepTypeTask, true
+	default:
+		return "", false
+	}
+}
+
+func stepName(plan atc.Plan) string {
+	switch {
+	case plan.Get != nil:
+		return plan.Get.Name
+	case plan.Put != nil:
+		return plan.Put.Name
+	case plan.Task != nil:
+		return plan.Task.Name
+	default:
+		return ""
+	}
+}
+
+// rerunnable wraps a get, put or task step of a job build so that a rerun of
+// the build from a later step can reuse it.
+func (builder *stepBuilder) rerunnable(step exec.Step, build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+	path, ok := builder.stepPaths[plan.ID]
+	if !ok {
+		return step
+	}
+
+	stepType, _ := rerunnableStepType(plan)
+
+	var reused *db.BuildStep
+	if reusable, ok := builder.reusableSteps[path]; ok {
+		reused = &reusable
+	}
+
+	return exec.Rerunnable(
+		step,
+		plan.ID,
+		db.BuildStep{Path: path, Type: stepType, Name: stepName(plan)},
+		reused,
+		build,
+		builder.delegateFactory.BuildStepDelegate(build, plan, credVarsTracker),
+	)
 }
 
 func (builder *stepBuilder) BuildStepErrored(logger lager.Logger, build db.Build, err error) {
-	builder.delegateFactory.BuildStepDelegate(build, build.PrivatePlan().ID, nil).Errored(logger, err.Error())
+	builder.delegateFactory.BuildStepDelegate(build, build.PrivatePlan(), nil).Errored(logger, err.Error())
 }
 
 func (builder *stepBuilder) CheckStep(logger lager.Logger, check db.Check) (exec.Step, error) {
@@ -131,6 +395,10 @@ func (builder *stepBuilder) CheckStep(logger lager.Logger, check db.Check) (exec
 }
 
 func (builder *stepBuilder) buildStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+	if plan.Breakpoint != "" {
+		return builder.buildBreakpointStep(build, plan, credVarsTracker)
+	}
+
 	if plan.Aggregate != nil {
 		return builder.buildAggregateStep(build, plan, credVarsTracker)
 	}
@@ -246,6 +514,18 @@ func (builder *stepBuilder) buildDoStep(build db.Build, plan atc.Plan, credVarsT
 	return step
 }
 
+func (builder *stepBuilder) buildBreakpointStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+	innerPlan := plan
+	innerPlan.Breakpoint = ""
+	step := builder.buildStep(build, innerPlan, credVarsTracker)
+	return exec.Breakpoint(
+		step,
+		plan.Breakpoint,
+		builder.breakpointTimeout,
+		builder.delegateFactory.BreakpointDelegate(build, plan),
+	)
+}
+
 func (builder *stepBuilder) buildTimeoutStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
 	innerPlan := plan.Timeout.Step
 	innerPlan.Attempts = plan.Attempts
@@ -310,7 +590,12 @@ func (builder *stepBuilder) buildRetryStep(build db.Build, plan atc.Plan, credVa
 		steps = append(steps, step)
 	}
 
-	return exec.Retry(steps...)
+	var policy atc.RetryPolicy
+	if plan.RetryPolicy != nil {
+		policy = *plan.RetryPolicy
+	}
+
+	return exec.RetryWithPolicy(policy, builder.delegateFactory.RetryDelegate(build, plan), steps...)
 }
 
 func (builder *stepBuilder) buildGetStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
@@ -327,12 +612,14 @@ func (builder *stepBuilder) buildGetStep(build db.Build, plan atc.Plan, credVars
 		builder.externalURL,
 	)
 
-	return builder.stepFactory.GetStep(
+	step := builder.stepFactory.GetStep(
 		plan,
 		stepMetadata,
 		containerMetadata,
-		builder.delegateFactory.GetDelegate(build, plan.ID, credVarsTracker),
+		builder.delegateFactory.GetDelegate(build, plan, credVarsTracker),
 	)
+
+	return builder.rerunnable(step, build, plan, credVarsTracker)
 }
 
 func (builder *stepBuilder) buildPutStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
@@ -349,12 +636,14 @@ func (builder *stepBuilder) buildPutStep(build db.Build, plan atc.Plan, credVars
 		builder.externalURL,
 	)
 
-	return builder.stepFactory.PutStep(
+	step := builder.stepFactory.PutStep(
 		plan,
 		stepMetadata,
 		containerMetadata,
-		builder.delegateFactory.PutDelegate(build, plan.ID, credVarsTracker),
+		builder.delegateFactory.PutDelegate(build, plan, credVarsTracker),
 	)
+
+	return builder.rerunnable(step, build, plan, credVarsTracker)
 }
 
 func (builder *stepBuilder) buildCheckStep(check db.Check, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
@@ -396,12 +685,14 @@ func (builder *stepBuilder) buildTaskStep(build db.Build, plan atc.Plan, credVar
 		builder.externalURL,
 	)
 
-	return builder.stepFactory.TaskStep(
+	step := builder.stepFactory.TaskStep(
 		plan,
 		stepMetadata,
 		containerMetadata,
-		builder.delegateFactory.TaskDelegate(build, plan.ID, credVarsTracker),
+		builder.delegateFactory.TaskDelegate(build, plan, credVarsTracker),
 	)
+
+	return builder.rerunnable(step, build, plan, credVarsTracker)
 }
 
 func (builder *stepBuilder) buildSetPipelineStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
@@ -414,7 +705,7 @@ func (builder *stepBuilder) buildSetPipelineStep(build db.Build, plan atc.Plan,
 	return builder.stepFactory.SetPipelineStep(
 		plan,
 		stepMetadata,
-		builder.delegateFactory.BuildStepDelegate(build, plan.ID, credVarsTracker),
+		builder.delegateFactory.BuildStepDelegate(build, plan, credVarsTracker),
 	)
 }
 
@@ -428,7 +719,7 @@ func (builder *stepBuilder) buildLoadVarStep(build db.Build, plan atc.Plan, cred
 	return builder.stepFactory.LoadVarStep(
 		plan,
 		stepMetadata,
-		builder.delegateFactory.BuildStepDelegate(build, plan.ID, credVarsTracker),
+		builder.delegateFactory.BuildStepDelegate(build, plan, credVarsTracker),
 	)
 }
 
@@ -437,7 +728,7 @@ func (builder *stepBuilder) buildArtifactInputStep(build db.Build, plan atc.Plan
 	return builder.stepFactory.ArtifactInputStep(
 		plan,
 		build,
-		builder.delegateFactory.BuildStepDelegate(build, plan.ID, credVarsTracker),
+		builder.delegateFactory.BuildStepDelegate(build, plan, credVarsTracker),
 	)
 }
 
@@ -446,7 +737,7 @@ func (builder *stepBuilder) buildArtifactOutputStep(build db.Build, plan atc.Pla
 	return builder.stepFactory.ArtifactOutputStep(
 		plan,
 		build,
-		builder.delegateFactory.BuildStepDelegate(build, plan.ID, credVarsTracker),
+		builder.delegateFactory.BuildStepDelegate(build, plan, credVarsTracker),
 	)
 }
 
@@ -491,5 +782,6 @@ func (builder *stepBuilder) stepMetadata(
 		PipelineID:   build.PipelineID(),
 		PipelineName: build.PipelineName(),
 		ExternalURL:  externalURL,
+		CreatedBy:    build.CreatedBy(),
 	}
 }
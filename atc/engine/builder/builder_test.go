@@ -1,6 +1,10 @@
 package builder_test
 
 import (
+	"context"
+	"io/ioutil"
+	"time"
+
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
@@ -13,6 +17,7 @@ import (
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/engine/builder/builderfakes"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
 )
 
 type StepBuilder interface {
@@ -51,6 +56,7 @@ var _ = Describe("Builder", func() {
 				fakeSecretManager,
 				fakeVarSourcePool,
 				false,
+				time.Hour,
 			)
 
 			planFactory = atc.NewPlanFactory(123)
@@ -75,6 +81,8 @@ var _ = Describe("Builder", func() {
 
 				expectedPlan     atc.Plan
 				expectedMetadata exec.StepMetadata
+
+				step exec.Step
 			)
 
 			BeforeEach(func() {
@@ -92,6 +100,7 @@ var _ = Describe("Builder", func() {
 				fakeBuild.PipelineReturns(fakePipeline, true, nil)
 				fakeBuild.TeamNameReturns("some-team")
 				fakeBuild.TeamIDReturns(1111)
+				fakeBuild.CreatedByReturns("some-user")
 
 				expectedMetadata = exec.StepMetadata{
 					BuildID:      4444,
@@ -103,13 +112,14 @@ var _ = Describe("Builder", func() {
 					PipelineID:   2222,
 					PipelineName: "some-pipeline",
 					ExternalURL:  "http://example.com",
+					CreatedBy:    "some-user",
 				}
 			})
 
 			JustBeforeEach(func() {
 				fakeBuild.PrivatePlanReturns(expectedPlan)
 
-				_, err = stepBuilder.BuildStep(logger, fakeBuild)
+				step, err = stepBuilder.BuildStep(logger, fakeBuild)
 			})
 
 			Context("when the build has the wrong schema", func() {
@@ -131,6 +141,42 @@ var _ = Describe("Builder", func() {
 					Expect(err).NotTo(HaveOccurred())
 				})
 
+				Context("when the build's plan has output limits", func() {
+					var (
+						taskPlan           atc.Plan
+						fakeLimitedFactory *builderfakes.FakeDelegateFactory
+						buildOutputLimiter *builder.OutputLimiter
+					)
+
+					BeforeEach(func() {
+						taskPlan = planFactory.NewPlan(atc.TaskPlan{
+							Name:       "some-task",
+							ConfigPath: "some-input/build.yml",
+						})
+
+						expectedPlan = planFactory.NewPlan(atc.DoPlan{taskPlan})
+						expectedPlan.OutputLimits = &atc.OutputLimits{LogLimit: 1024}
+
+						fakeLimitedFactory = new(builderfakes.FakeDelegateFactory)
+						buildOutputLimiter = builder.NewBuildOutputLimiter(fakeBuild, expectedPlan.OutputLimits, nil)
+						fakeDelegateFactory.LimitBuildOutputReturns(fakeLimitedFactory, buildOutputLimiter)
+					})
+
+					It("limits the output of the build as a whole", func() {
+						Expect(fakeDelegateFactory.LimitBuildOutputCallCount()).To(Equal(1))
+						build, plan := fakeDelegateFactory.LimitBuildOutputArgsForCall(0)
+						Expect(build).To(Equal(fakeBuild))
+						Expect(plan).To(Equal(expectedPlan))
+
+						Expect(step).To(BeAssignableToTypeOf(&exec.LimitOutputStep{}))
+					})
+
+					It("counts the output of its steps towards the limits", func() {
+						Expect(fakeDelegateFactory.TaskDelegateCallCount()).To(BeZero())
+						Expect(fakeLimitedFactory.TaskDelegateCallCount()).To(Equal(1))
+					})
+				})
+
 				Context("with a putget in an aggregate", func() {
 					var (
 						putPlan               atc.Plan
@@ -275,6 +321,65 @@ var _ = Describe("Builder", func() {
 					})
 				})
 
+				Context("with a breakpoint", func() {
+					var taskPlan atc.Plan
+
+					BeforeEach(func() {
+						taskPlan = planFactory.NewPlan(atc.TaskPlan{
+							Name:       "some-task",
+							ConfigPath: "some-config-path",
+						})
+
+						expectedPlan = planFactory.NewPlan(atc.TimeoutPlan{
+							Step:     taskPlan,
+							Duration: "1m",
+						})
+						expectedPlan.Breakpoint = atc.BreakpointAfter
+					})
+
+					It("creates a breakpoint delegate for the plan", func() {
+						Expect(fakeDelegateFactory.BreakpointDelegateCallCount()).To(Equal(1))
+
+						_, plan := fakeDelegateFactory.BreakpointDelegateArgsForCall(0)
+						Expect(plan).To(Equal(expectedPlan))
+					})
+
+					It("constructs the step it pauses after", func() {
+						Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(1))
+
+						plan, _, _, _ := fakeStepFactory.TaskStepArgsForCall(0)
+						Expect(plan).To(Equal(taskPlan))
+					})
+				})
+
+				Context("with a breakpoint before the step", func() {
+					var taskPlan atc.Plan
+
+					BeforeEach(func() {
+						taskPlan = planFactory.NewPlan(atc.TaskPlan{
+							Name:       "some-task",
+							ConfigPath: "some-config-path",
+						})
+
+						expectedPlan = taskPlan
+						expectedPlan.Breakpoint = atc.BreakpointBefore
+					})
+
+					It("creates a breakpoint delegate for the plan", func() {
+						Expect(fakeDelegateFactory.BreakpointDelegateCallCount()).To(Equal(1))
+
+						_, plan := fakeDelegateFactory.BreakpointDelegateArgsForCall(0)
+						Expect(plan).To(Equal(expectedPlan))
+					})
+
+					It("constructs the step it pauses before", func() {
+						Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(1))
+
+						plan, _, _, _ := fakeStepFactory.TaskStepArgsForCall(0)
+						Expect(plan).To(Equal(taskPlan))
+					})
+				})
+
 				Context("with a retry plan", func() {
 					var (
 						getPlan       atc.Plan
@@ -375,6 +480,16 @@ var _ = Describe("Builder", func() {
 						Expect(*retryPlanTwo.Retry).To(HaveLen(2))
 					})
 
+					It("creates a retry delegate for each retry plan", func() {
+						Expect(fakeDelegateFactory.RetryDelegateCallCount()).To(Equal(2))
+
+						_, plan := fakeDelegateFactory.RetryDelegateArgsForCall(0)
+						Expect(plan.ID).To(Equal(retryPlanTwo.ID))
+
+						_, plan = fakeDelegateFactory.RetryDelegateArgsForCall(1)
+						Expect(plan.ID).To(Equal(expectedPlan.ID))
+					})
+
 					It("constructs nested steps correctly", func() {
 						plan, stepMetadata, containerMetadata, _ := fakeStepFactory.TaskStepArgsForCall(0)
 						expectedPlan := taskPlan
@@ -766,6 +881,187 @@ var _ = Describe("Builder", func() {
 					})
 				})
 
+				Context("when rerunning from a step", func() {
+					var (
+						fakeGetStep       *execfakes.FakeStep
+						fakeCompileStep   *execfakes.FakeStep
+						fakeTestStep      *execfakes.FakeStep
+						fakeBuildDelegate *execfakes.FakeBuildStepDelegate
+						runErr            error
+					)
+
+					BeforeEach(func() {
+						expectedPlan = planFactory.NewPlan(atc.DoPlan{
+							planFactory.NewPlan(atc.GetPlan{Name: "some-input"}),
+							planFactory.NewPlan(atc.TaskPlan{Name: "compile"}),
+							planFactory.NewPlan(atc.TaskPlan{Name: "test"}),
+						})
+
+						fakeBuild.RerunFromStepReturns("test")
+						fakeBuild.ReusableStepsReturns([]db.BuildStep{
+							{Path: "do.0/get:some-input", Type: db.BuildStepTypeGet, Name: "some-input", Artifacts: map[string]string{"some-input": "some-input-handle"}},
+							{Path: "do.1/task:compile", Type: db.BuildStepTypeTask, Name: "compile", Artifacts: map[string]string{"binary": "binary-handle"}},
+							{Path: "do.2/task:test", Type: db.BuildStepTypeTask, Name: "test", Artifacts: map[string]string{}},
+						}, nil)
+
+						fakeGetStep = new(execfakes.FakeStep)
+						fakeCompileStep = new(execfakes.FakeStep)
+						fakeTestStep = new(execfakes.FakeStep)
+						fakeTestStep.SucceededReturns(true)
+
+						fakeStepFactory.GetStepReturns(fakeGetStep)
+						fakeStepFactory.TaskStepStub = func(plan atc.Plan, _ exec.StepMetadata, _ db.ContainerMetadata, _ exec.TaskDelegate) exec.Step {
+							if plan.Task.Name == "compile" {
+								return fakeCompileStep
+							}
+
+							return fakeTestStep
+						}
+
+						fakeBuildDelegate = new(execfakes.FakeBuildStepDelegate)
+						fakeBuildDelegate.StdoutReturns(ioutil.Discard)
+						fakeDelegateFactory.BuildStepDelegateReturns(fakeBuildDelegate)
+					})
+
+					JustBeforeEach(func() {
+						Expect(err).ToNot(HaveOccurred())
+						runErr = step.Run(context.Background(), exec.NewRunState())
+					})
+
+					It("reuses the steps before the step it is rerun from", func() {
+						Expect(runErr).ToNot(HaveOccurred())
+						Expect(fakeGetStep.RunCallCount()).To(BeZero())
+						Expect(fakeCompileStep.RunCallCount()).To(BeZero())
+					})
+
+					It("runs the step it is rerun from", func() {
+						Expect(fakeTestStep.RunCallCount()).To(Equal(1))
+
+						_, state := fakeTestStep.RunArgsForCall(0)
+						artifact, found := state.ArtifactRepository().ArtifactFor("binary")
+						Expect(found).To(BeTrue())
+						Expect(artifact.ID()).To(Equal("binary-handle"))
+					})
+
+					Context("when an earlier step can not be reused", func() {
+						BeforeEach(func() {
+							fakeBuild.ReusableStepsReturns([]db.BuildStep{
+								{Path: "do.0/get:some-input", Type: db.BuildStepTypeGet, Name: "some-input", Artifacts: map[string]string{"some-input": "some-input-handle"}},
+							}, nil)
+							fakeCompileStep.SucceededReturns(true)
+						})
+
+						It("runs it again", func() {
+							Expect(fakeGetStep.RunCallCount()).To(BeZero())
+							Expect(fakeCompileStep.RunCallCount()).To(Equal(1))
+							Expect(fakeTestStep.RunCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when a step which did not finish before it is recorded", func() {
+						var fakeHookStep, fakeParallelStep *execfakes.FakeStep
+
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.DoPlan{
+								planFactory.NewPlan(atc.GetPlan{Name: "some-input"}),
+								planFactory.NewPlan(atc.OnFailurePlan{
+									Step: planFactory.NewPlan(atc.InParallelPlan{
+										Steps: []atc.Plan{
+											planFactory.NewPlan(atc.TaskPlan{Name: "compile"}),
+											planFactory.NewPlan(atc.TaskPlan{Name: "test"}),
+										},
+									}),
+									Next: planFactory.NewPlan(atc.TaskPlan{Name: "notify"}),
+								}),
+							})
+
+							fakeBuild.ReusableStepsReturns([]db.BuildStep{
+								{Path: "do.0/get:some-input", Type: db.BuildStepTypeGet, Name: "some-input"},
+								{Path: "do.1/on_failure.step/in_parallel.0/task:compile", Type: db.BuildStepTypeTask, Name: "compile"},
+								{Path: "do.1/on_failure.next/task:notify", Type: db.BuildStepTypeTask, Name: "notify"},
+							}, nil)
+
+							fakeHookStep = new(execfakes.FakeStep)
+							fakeParallelStep = new(execfakes.FakeStep)
+							fakeParallelStep.SucceededReturns(true)
+							fakeTestStep.SucceededReturns(false)
+
+							fakeStepFactory.TaskStepStub = func(plan atc.Plan, _ exec.StepMetadata, _ db.ContainerMetadata, _ exec.TaskDelegate) exec.Step {
+								switch plan.Task.Name {
+								case "compile":
+									return fakeParallelStep
+								case "notify":
+									return fakeHookStep
+								default:
+									return fakeTestStep
+								}
+							}
+						})
+
+						It("runs the steps in parallel with it and the hooks after it again", func() {
+							Expect(fakeGetStep.RunCallCount()).To(BeZero())
+							Expect(fakeParallelStep.RunCallCount()).To(Equal(1))
+							Expect(fakeTestStep.RunCallCount()).To(Equal(1))
+							Expect(fakeHookStep.RunCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when steps share a name", func() {
+						var fakeOtherTestStep *execfakes.FakeStep
+
+						BeforeEach(func() {
+							firstTestPlan := planFactory.NewPlan(atc.TaskPlan{Name: "test"})
+							expectedPlan = planFactory.NewPlan(atc.DoPlan{
+								firstTestPlan,
+								planFactory.NewPlan(atc.TaskPlan{Name: "compile"}),
+								planFactory.NewPlan(atc.TaskPlan{Name: "test"}),
+							})
+
+							fakeBuild.RerunFromStepReturns("compile")
+							fakeBuild.ReusableStepsReturns([]db.BuildStep{
+								{Path: "do.0/task:test", Type: db.BuildStepTypeTask, Name: "test"},
+								{Path: "do.2/task:test", Type: db.BuildStepTypeTask, Name: "test"},
+							}, nil)
+
+							fakeOtherTestStep = new(execfakes.FakeStep)
+							fakeOtherTestStep.SucceededReturns(true)
+							fakeCompileStep.SucceededReturns(true)
+
+							fakeStepFactory.TaskStepStub = func(plan atc.Plan, _ exec.StepMetadata, _ db.ContainerMetadata, _ exec.TaskDelegate) exec.Step {
+								switch {
+								case plan.Task.Name == "compile":
+									return fakeCompileStep
+								case plan.ID == firstTestPlan.ID:
+									return fakeTestStep
+								default:
+									return fakeOtherTestStep
+								}
+							}
+						})
+
+						It("only reuses the one before the step it is rerun from", func() {
+							Expect(fakeTestStep.RunCallCount()).To(BeZero())
+							Expect(fakeCompileStep.RunCallCount()).To(Equal(1))
+							Expect(fakeOtherTestStep.RunCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when the step it is rerun from is not in the plan", func() {
+						BeforeEach(func() {
+							fakeBuild.RerunFromStepReturns("bogus")
+							fakeGetStep.SucceededReturns(true)
+							fakeCompileStep.SucceededReturns(true)
+						})
+
+						It("runs every step again", func() {
+							Expect(fakeBuild.ReusableStepsCallCount()).To(BeZero())
+							Expect(fakeGetStep.RunCallCount()).To(Equal(1))
+							Expect(fakeCompileStep.RunCallCount()).To(Equal(1))
+							Expect(fakeTestStep.RunCallCount()).To(Equal(1))
+						})
+					})
+				})
+
 				Context("running try steps", func() {
 					var inputPlan atc.Plan
 
@@ -829,6 +1125,7 @@ var _ = Describe("Builder", func() {
 				fakeSecretManager,
 				fakeVarSourcePool,
 				false,
+				time.Hour,
 			)
 
 			planFactory = atc.NewPlanFactory(123)
@@ -12,11 +12,23 @@ import (
 )
 
 type FakeDelegateFactory struct {
-	BuildStepDelegateStub        func(db.Build, atc.PlanID, vars.CredVarsTracker) exec.BuildStepDelegate
+	BreakpointDelegateStub        func(db.Build, atc.Plan) exec.BreakpointDelegate
+	breakpointDelegateMutex       sync.RWMutex
+	breakpointDelegateArgsForCall []struct {
+		arg1 db.Build
+		arg2 atc.Plan
+	}
+	breakpointDelegateReturns struct {
+		result1 exec.BreakpointDelegate
+	}
+	breakpointDelegateReturnsOnCall map[int]struct {
+		result1 exec.BreakpointDelegate
+	}
+	BuildStepDelegateStub        func(db.Build, atc.Plan, vars.CredVarsTracker) exec.BuildStepDelegate
 	buildStepDelegateMutex       sync.RWMutex
 	buildStepDelegateArgsForCall []struct {
 		arg1 db.Build
-		arg2 atc.PlanID
+		arg2 atc.Plan
 		arg3 vars.CredVarsTracker
 	}
 	buildStepDelegateReturns struct {
@@ -38,11 +50,11 @@ type FakeDelegateFactory struct {
 	checkDelegateReturnsOnCall map[int]struct {
 		result1 exec.CheckDelegate
 	}
-	GetDelegateStub        func(db.Build, atc.PlanID, vars.CredVarsTracker) exec.GetDelegate
+	GetDelegateStub        func(db.Build, atc.Plan, vars.CredVarsTracker) exec.GetDelegate
 	getDelegateMutex       sync.RWMutex
 	getDelegateArgsForCall []struct {
 		arg1 db.Build
-		arg2 atc.PlanID
+		arg2 atc.Plan
 		arg3 vars.CredVarsTracker
 	}
 	getDelegateReturns struct {
@@ -51,11 +63,25 @@ type FakeDelegateFactory struct {
 	getDelegateReturnsOnCall map[int]struct {
 		result1 exec.GetDelegate
 	}
-	PutDelegateStub        func(db.Build, atc.PlanID, vars.CredVarsTracker) exec.PutDelegate
+	LimitBuildOutputStub        func(db.Build, atc.Plan) (builder.DelegateFactory, exec.OutputWatcher)
+	limitBuildOutputMutex       sync.RWMutex
+	limitBuildOutputArgsForCall []struct {
+		arg1 db.Build
+		arg2 atc.Plan
+	}
+	limitBuildOutputReturns struct {
+		result1 builder.DelegateFactory
+		result2 exec.OutputWatcher
+	}
+	limitBuildOutputReturnsOnCall map[int]struct {
+		result1 builder.DelegateFactory
+		result2 exec.OutputWatcher
+	}
+	PutDelegateStub        func(db.Build, atc.Plan, vars.CredVarsTracker) exec.PutDelegate
 	putDelegateMutex       sync.RWMutex
 	putDelegateArgsForCall []struct {
 		arg1 db.Build
-		arg2 atc.PlanID
+		arg2 atc.Plan
 		arg3 vars.CredVarsTracker
 	}
 	putDelegateReturns struct {
@@ -64,11 +90,23 @@ type FakeDelegateFactory struct {
 	putDelegateReturnsOnCall map[int]struct {
 		result1 exec.PutDelegate
 	}
-	TaskDelegateStub        func(db.Build, atc.PlanID, vars.CredVarsTracker) exec.TaskDelegate
+	RetryDelegateStub        func(db.Build, atc.Plan) exec.RetryDelegate
+	retryDelegateMutex       sync.RWMutex
+	retryDelegateArgsForCall []struct {
+		arg1 db.Build
+		arg2 atc.Plan
+	}
+	retryDelegateReturns struct {
+		result1 exec.RetryDelegate
+	}
+	retryDelegateReturnsOnCall map[int]struct {
+		result1 exec.RetryDelegate
+	}
+	TaskDelegateStub        func(db.Build, atc.Plan, vars.CredVarsTracker) exec.TaskDelegate
 	taskDelegateMutex       sync.RWMutex
 	taskDelegateArgsForCall []struct {
 		arg1 db.Build
-		arg2 atc.PlanID
+		arg2 atc.Plan
 		arg3 vars.CredVarsTracker
 	}
 	taskDelegateReturns struct {
@@ -81,12 +119,73 @@ type FakeDelegateFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeDelegateFactory) BuildStepDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 vars.CredVarsTracker) exec.BuildStepDelegate {
+func (fake *FakeDelegateFactory) BreakpointDelegate(arg1 db.Build, arg2 atc.Plan) exec.BreakpointDelegate {
+	fake.breakpointDelegateMutex.Lock()
+	ret, specificReturn := fake.breakpointDelegateReturnsOnCall[len(fake.breakpointDelegateArgsForCall)]
+	fake.breakpointDelegateArgsForCall = append(fake.breakpointDelegateArgsForCall, struct {
+		arg1 db.Build
+		arg2 atc.Plan
+	}{arg1, arg2})
+	fake.recordInvocation("BreakpointDelegate", []interface{}{arg1, arg2})
+	fake.breakpointDelegateMutex.Unlock()
+	if fake.BreakpointDelegateStub != nil {
+		return fake.BreakpointDelegateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.breakpointDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeDelegateFactory) BreakpointDelegateCallCount() int {
+	fake.breakpointDelegateMutex.RLock()
+	defer fake.breakpointDelegateMutex.RUnlock()
+	return len(fake.breakpointDelegateArgsForCall)
+}
+
+func (fake *FakeDelegateFactory) BreakpointDelegateCalls(stub func(db.Build, atc.Plan) exec.BreakpointDelegate) {
+	fake.breakpointDelegateMutex.Lock()
+	defer fake.breakpointDelegateMutex.Unlock()
+	fake.BreakpointDelegateStub = stub
+}
+
+func (fake *FakeDelegateFactory) BreakpointDelegateArgsForCall(i int) (db.Build, atc.Plan) {
+	fake.breakpointDelegateMutex.RLock()
+	defer fake.breakpointDelegateMutex.RUnlock()
+	argsForCall := fake.breakpointDelegateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDelegateFactory) BreakpointDelegateReturns(result1 exec.BreakpointDelegate) {
+	fake.breakpointDelegateMutex.Lock()
+	defer fake.breakpointDelegateMutex.Unlock()
+	fake.BreakpointDelegateStub = nil
+	fake.breakpointDelegateReturns = struct {
+		result1 exec.BreakpointDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) BreakpointDelegateReturnsOnCall(i int, result1 exec.BreakpointDelegate) {
+	fake.breakpointDelegateMutex.Lock()
+	defer fake.breakpointDelegateMutex.Unlock()
+	fake.BreakpointDelegateStub = nil
+	if fake.breakpointDelegateReturnsOnCall == nil {
+		fake.breakpointDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.BreakpointDelegate
+		})
+	}
+	fake.breakpointDelegateReturnsOnCall[i] = struct {
+		result1 exec.BreakpointDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) BuildStepDelegate(arg1 db.Build, arg2 atc.Plan, arg3 vars.CredVarsTracker) exec.BuildStepDelegate {
 	fake.buildStepDelegateMutex.Lock()
 	ret, specificReturn := fake.buildStepDelegateReturnsOnCall[len(fake.buildStepDelegateArgsForCall)]
 	fake.buildStepDelegateArgsForCall = append(fake.buildStepDelegateArgsForCall, struct {
 		arg1 db.Build
-		arg2 atc.PlanID
+		arg2 atc.Plan
 		arg3 vars.CredVarsTracker
 	}{arg1, arg2, arg3})
 	fake.recordInvocation("BuildStepDelegate", []interface{}{arg1, arg2, arg3})
@@ -107,13 +206,13 @@ func (fake *FakeDelegateFactory) BuildStepDelegateCallCount() int {
 	return len(fake.buildStepDelegateArgsForCall)
 }
 
-func (fake *FakeDelegateFactory) BuildStepDelegateCalls(stub func(db.Build, atc.PlanID, vars.CredVarsTracker) exec.BuildStepDelegate) {
+func (fake *FakeDelegateFactory) BuildStepDelegateCalls(stub func(db.Build, atc.Plan, vars.CredVarsTracker) exec.BuildStepDelegate) {
 	fake.buildStepDelegateMutex.Lock()
 	defer fake.buildStepDelegateMutex.Unlock()
 	fake.BuildStepDelegateStub = stub
 }
 
-func (fake *FakeDelegateFactory) BuildStepDelegateArgsForCall(i int) (db.Build, atc.PlanID, vars.CredVarsTracker) {
+func (fake *FakeDelegateFactory) BuildStepDelegateArgsForCall(i int) (db.Build, atc.Plan, vars.CredVarsTracker) {
 	fake.buildStepDelegateMutex.RLock()
 	defer fake.buildStepDelegateMutex.RUnlock()
 	argsForCall := fake.buildStepDelegateArgsForCall[i]
@@ -205,12 +304,12 @@ func (fake *FakeDelegateFactory) CheckDelegateReturnsOnCall(i int, result1 exec.
 	}{result1}
 }
 
-func (fake *FakeDelegateFactory) GetDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 vars.CredVarsTracker) exec.GetDelegate {
+func (fake *FakeDelegateFactory) GetDelegate(arg1 db.Build, arg2 atc.Plan, arg3 vars.CredVarsTracker) exec.GetDelegate {
 	fake.getDelegateMutex.Lock()
 	ret, specificReturn := fake.getDelegateReturnsOnCall[len(fake.getDelegateArgsForCall)]
 	fake.getDelegateArgsForCall = append(fake.getDelegateArgsForCall, struct {
 		arg1 db.Build
-		arg2 atc.PlanID
+		arg2 atc.Plan
 		arg3 vars.CredVarsTracker
 	}{arg1, arg2, arg3})
 	fake.recordInvocation("GetDelegate", []interface{}{arg1, arg2, arg3})
@@ -231,13 +330,13 @@ func (fake *FakeDelegateFactory) GetDelegateCallCount() int {
 	return len(fake.getDelegateArgsForCall)
 }
 
-func (fake *FakeDelegateFactory) GetDelegateCalls(stub func(db.Build, atc.PlanID, vars.CredVarsTracker) exec.GetDelegate) {
+func (fake *FakeDelegateFactory) GetDelegateCalls(stub func(db.Build, atc.Plan, vars.CredVarsTracker) exec.GetDelegate) {
 	fake.getDelegateMutex.Lock()
 	defer fake.getDelegateMutex.Unlock()
 	fake.GetDelegateStub = stub
 }
 
-func (fake *FakeDelegateFactory) GetDelegateArgsForCall(i int) (db.Build, atc.PlanID, vars.CredVarsTracker) {
+func (fake *FakeDelegateFactory) GetDelegateArgsForCall(i int) (db.Build, atc.Plan, vars.CredVarsTracker) {
 	fake.getDelegateMutex.RLock()
 	defer fake.getDelegateMutex.RUnlock()
 	argsForCall := fake.getDelegateArgsForCall[i]
@@ -267,12 +366,76 @@ func (fake *FakeDelegateFactory) GetDelegateReturnsOnCall(i int, result1 exec.Ge
 	}{result1}
 }
 
-func (fake *FakeDelegateFactory) PutDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 vars.CredVarsTracker) exec.PutDelegate {
+func (fake *FakeDelegateFactory) LimitBuildOutput(arg1 db.Build, arg2 atc.Plan) (builder.DelegateFactory, exec.OutputWatcher) {
+	fake.limitBuildOutputMutex.Lock()
+	ret, specificReturn := fake.limitBuildOutputReturnsOnCall[len(fake.limitBuildOutputArgsForCall)]
+	fake.limitBuildOutputArgsForCall = append(fake.limitBuildOutputArgsForCall, struct {
+		arg1 db.Build
+		arg2 atc.Plan
+	}{arg1, arg2})
+	fake.recordInvocation("LimitBuildOutput", []interface{}{arg1, arg2})
+	fake.limitBuildOutputMutex.Unlock()
+	if fake.LimitBuildOutputStub != nil {
+		return fake.LimitBuildOutputStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.limitBuildOutputReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDelegateFactory) LimitBuildOutputCallCount() int {
+	fake.limitBuildOutputMutex.RLock()
+	defer fake.limitBuildOutputMutex.RUnlock()
+	return len(fake.limitBuildOutputArgsForCall)
+}
+
+func (fake *FakeDelegateFactory) LimitBuildOutputCalls(stub func(db.Build, atc.Plan) (builder.DelegateFactory, exec.OutputWatcher)) {
+	fake.limitBuildOutputMutex.Lock()
+	defer fake.limitBuildOutputMutex.Unlock()
+	fake.LimitBuildOutputStub = stub
+}
+
+func (fake *FakeDelegateFactory) LimitBuildOutputArgsForCall(i int) (db.Build, atc.Plan) {
+	fake.limitBuildOutputMutex.RLock()
+	defer fake.limitBuildOutputMutex.RUnlock()
+	argsForCall := fake.limitBuildOutputArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDelegateFactory) LimitBuildOutputReturns(result1 builder.DelegateFactory, result2 exec.OutputWatcher) {
+	fake.limitBuildOutputMutex.Lock()
+	defer fake.limitBuildOutputMutex.Unlock()
+	fake.LimitBuildOutputStub = nil
+	fake.limitBuildOutputReturns = struct {
+		result1 builder.DelegateFactory
+		result2 exec.OutputWatcher
+	}{result1, result2}
+}
+
+func (fake *FakeDelegateFactory) LimitBuildOutputReturnsOnCall(i int, result1 builder.DelegateFactory, result2 exec.OutputWatcher) {
+	fake.limitBuildOutputMutex.Lock()
+	defer fake.limitBuildOutputMutex.Unlock()
+	fake.LimitBuildOutputStub = nil
+	if fake.limitBuildOutputReturnsOnCall == nil {
+		fake.limitBuildOutputReturnsOnCall = make(map[int]struct {
+			result1 builder.DelegateFactory
+			result2 exec.OutputWatcher
+		})
+	}
+	fake.limitBuildOutputReturnsOnCall[i] = struct {
+		result1 builder.DelegateFactory
+		result2 exec.OutputWatcher
+	}{result1, result2}
+}
+
+func (fake *FakeDelegateFactory) PutDelegate(arg1 db.Build, arg2 atc.Plan, arg3 vars.CredVarsTracker) exec.PutDelegate {
 	fake.putDelegateMutex.Lock()
 	ret, specificReturn := fake.putDelegateReturnsOnCall[len(fake.putDelegateArgsForCall)]
 	fake.putDelegateArgsForCall = append(fake.putDelegateArgsForCall, struct {
 		arg1 db.Build
-		arg2 atc.PlanID
+		arg2 atc.Plan
 		arg3 vars.CredVarsTracker
 	}{arg1, arg2, arg3})
 	fake.recordInvocation("PutDelegate", []interface{}{arg1, arg2, arg3})
@@ -293,13 +456,13 @@ func (fake *FakeDelegateFactory) PutDelegateCallCount() int {
 	return len(fake.putDelegateArgsForCall)
 }
 
-func (fake *FakeDelegateFactory) PutDelegateCalls(stub func(db.Build, atc.PlanID, vars.CredVarsTracker) exec.PutDelegate) {
+func (fake *FakeDelegateFactory) PutDelegateCalls(stub func(db.Build, atc.Plan, vars.CredVarsTracker) exec.PutDelegate) {
 	fake.putDelegateMutex.Lock()
 	defer fake.putDelegateMutex.Unlock()
 	fake.PutDelegateStub = stub
 }
 
-func (fake *FakeDelegateFactory) PutDelegateArgsForCall(i int) (db.Build, atc.PlanID, vars.CredVarsTracker) {
+func (fake *FakeDelegateFactory) PutDelegateArgsForCall(i int) (db.Build, atc.Plan, vars.CredVarsTracker) {
 	fake.putDelegateMutex.RLock()
 	defer fake.putDelegateMutex.RUnlock()
 	argsForCall := fake.putDelegateArgsForCall[i]
@@ -329,12 +492,73 @@ func (fake *FakeDelegateFactory) PutDelegateReturnsOnCall(i int, result1 exec.Pu
 	}{result1}
 }
 
-func (fake *FakeDelegateFactory) TaskDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 vars.CredVarsTracker) exec.TaskDelegate {
+func (fake *FakeDelegateFactory) RetryDelegate(arg1 db.Build, arg2 atc.Plan) exec.RetryDelegate {
+	fake.retryDelegateMutex.Lock()
+	ret, specificReturn := fake.retryDelegateReturnsOnCall[len(fake.retryDelegateArgsForCall)]
+	fake.retryDelegateArgsForCall = append(fake.retryDelegateArgsForCall, struct {
+		arg1 db.Build
+		arg2 atc.Plan
+	}{arg1, arg2})
+	fake.recordInvocation("RetryDelegate", []interface{}{arg1, arg2})
+	fake.retryDelegateMutex.Unlock()
+	if fake.RetryDelegateStub != nil {
+		return fake.RetryDelegateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.retryDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeDelegateFactory) RetryDelegateCallCount() int {
+	fake.retryDelegateMutex.RLock()
+	defer fake.retryDelegateMutex.RUnlock()
+	return len(fake.retryDelegateArgsForCall)
+}
+
+func (fake *FakeDelegateFactory) RetryDelegateCalls(stub func(db.Build, atc.Plan) exec.RetryDelegate) {
+	fake.retryDelegateMutex.Lock()
+	defer fake.retryDelegateMutex.Unlock()
+	fake.RetryDelegateStub = stub
+}
+
+func (fake *FakeDelegateFactory) RetryDelegateArgsForCall(i int) (db.Build, atc.Plan) {
+	fake.retryDelegateMutex.RLock()
+	defer fake.retryDelegateMutex.RUnlock()
+	argsForCall := fake.retryDelegateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDelegateFactory) RetryDelegateReturns(result1 exec.RetryDelegate) {
+	fake.retryDelegateMutex.Lock()
+	defer fake.retryDelegateMutex.Unlock()
+	fake.RetryDelegateStub = nil
+	fake.retryDelegateReturns = struct {
+		result1 exec.RetryDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) RetryDelegateReturnsOnCall(i int, result1 exec.RetryDelegate) {
+	fake.retryDelegateMutex.Lock()
+	defer fake.retryDelegateMutex.Unlock()
+	fake.RetryDelegateStub = nil
+	if fake.retryDelegateReturnsOnCall == nil {
+		fake.retryDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.RetryDelegate
+		})
+	}
+	fake.retryDelegateReturnsOnCall[i] = struct {
+		result1 exec.RetryDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) TaskDelegate(arg1 db.Build, arg2 atc.Plan, arg3 vars.CredVarsTracker) exec.TaskDelegate {
 	fake.taskDelegateMutex.Lock()
 	ret, specificReturn := fake.taskDelegateReturnsOnCall[len(fake.taskDelegateArgsForCall)]
 	fake.taskDelegateArgsForCall = append(fake.taskDelegateArgsForCall, struct {
 		arg1 db.Build
-		arg2 atc.PlanID
+		arg2 atc.Plan
 		arg3 vars.CredVarsTracker
 	}{arg1, arg2, arg3})
 	fake.recordInvocation("TaskDelegate", []interface{}{arg1, arg2, arg3})
@@ -355,13 +579,13 @@ func (fake *FakeDelegateFactory) TaskDelegateCallCount() int {
 	return len(fake.taskDelegateArgsForCall)
 }
 
-func (fake *FakeDelegateFactory) TaskDelegateCalls(stub func(db.Build, atc.PlanID, vars.CredVarsTracker) exec.TaskDelegate) {
+func (fake *FakeDelegateFactory) TaskDelegateCalls(stub func(db.Build, atc.Plan, vars.CredVarsTracker) exec.TaskDelegate) {
 	fake.taskDelegateMutex.Lock()
 	defer fake.taskDelegateMutex.Unlock()
 	fake.TaskDelegateStub = stub
 }
 
-func (fake *FakeDelegateFactory) TaskDelegateArgsForCall(i int) (db.Build, atc.PlanID, vars.CredVarsTracker) {
+func (fake *FakeDelegateFactory) TaskDelegateArgsForCall(i int) (db.Build, atc.Plan, vars.CredVarsTracker) {
 	fake.taskDelegateMutex.RLock()
 	defer fake.taskDelegateMutex.RUnlock()
 	argsForCall := fake.taskDelegateArgsForCall[i]
@@ -394,14 +618,20 @@ func (fake *FakeDelegateFactory) TaskDelegateReturnsOnCall(i int, result1 exec.T
 func (fake *FakeDelegateFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.breakpointDelegateMutex.RLock()
+	defer fake.breakpointDelegateMutex.RUnlock()
 	fake.buildStepDelegateMutex.RLock()
 	defer fake.buildStepDelegateMutex.RUnlock()
 	fake.checkDelegateMutex.RLock()
 	defer fake.checkDelegateMutex.RUnlock()
 	fake.getDelegateMutex.RLock()
 	defer fake.getDelegateMutex.RUnlock()
+	fake.limitBuildOutputMutex.RLock()
+	defer fake.limitBuildOutputMutex.RUnlock()
 	fake.putDelegateMutex.RLock()
 	defer fake.putDelegateMutex.RUnlock()
+	fake.retryDelegateMutex.RLock()
+	defer fake.retryDelegateMutex.RUnlock()
 	fake.taskDelegateMutex.RLock()
 	defer fake.taskDelegateMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
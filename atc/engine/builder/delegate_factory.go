@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"context"
 	"io"
 	"strings"
 	"time"
@@ -21,33 +22,50 @@ func NewDelegateFactory() *delegateFactory {
 	return &delegateFactory{}
 }
 
-type delegateFactory struct{}
+type delegateFactory struct {
+	// buildLimiter enforces the output limits of the job on the build the
+	// delegates are for, if it has any
+	buildLimiter *OutputLimiter
+}
 
-func (delegate *delegateFactory) GetDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker) exec.GetDelegate {
-	return NewGetDelegate(build, planID, credVarsTracker, clock.NewClock())
+func (delegate *delegateFactory) GetDelegate(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.GetDelegate {
+	return NewGetDelegate(build, plan, credVarsTracker, delegate.buildLimiter, clock.NewClock())
 }
 
-func (delegate *delegateFactory) PutDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker) exec.PutDelegate {
-	return NewPutDelegate(build, planID, credVarsTracker, clock.NewClock())
+func (delegate *delegateFactory) PutDelegate(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.PutDelegate {
+	return NewPutDelegate(build, plan, credVarsTracker, delegate.buildLimiter, clock.NewClock())
 }
 
-func (delegate *delegateFactory) TaskDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker) exec.TaskDelegate {
-	return NewTaskDelegate(build, planID, credVarsTracker, clock.NewClock())
+func (delegate *delegateFactory) TaskDelegate(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.TaskDelegate {
+	return NewTaskDelegate(build, plan, credVarsTracker, delegate.buildLimiter, clock.NewClock())
 }
 
 func (delegate *delegateFactory) CheckDelegate(check db.Check, planID atc.PlanID, credVarsTracker vars.CredVarsTracker) exec.CheckDelegate {
 	return NewCheckDelegate(check, planID, credVarsTracker, clock.NewClock())
 }
 
-func (delegate *delegateFactory) BuildStepDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker) exec.BuildStepDelegate {
-	return NewBuildStepDelegate(build, planID, credVarsTracker, clock.NewClock())
+func (delegate *delegateFactory) BuildStepDelegate(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.BuildStepDelegate {
+	return NewBuildStepDelegate(build, plan, credVarsTracker, delegate.buildLimiter, clock.NewClock())
+}
+
+func (delegate *delegateFactory) RetryDelegate(build db.Build, plan atc.Plan) exec.RetryDelegate {
+	return NewRetryDelegate(build, plan, clock.NewClock())
+}
+
+func (delegate *delegateFactory) BreakpointDelegate(build db.Build, plan atc.Plan) exec.BreakpointDelegate {
+	return NewBreakpointDelegate(build, plan, delegate.buildLimiter, clock.NewClock())
+}
+
+func (delegate *delegateFactory) LimitBuildOutput(build db.Build, plan atc.Plan) (DelegateFactory, exec.OutputWatcher) {
+	limiter := NewBuildOutputLimiter(build, plan.OutputLimits, clock.NewClock())
+	return &delegateFactory{buildLimiter: limiter}, limiter
 }
 
-func NewGetDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.GetDelegate {
+func NewGetDelegate(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker, buildLimiter *OutputLimiter, clock clock.Clock) exec.GetDelegate {
 	return &getDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, credVarsTracker, clock),
+		BuildStepDelegate: NewBuildStepDelegate(build, plan, credVarsTracker, buildLimiter, clock),
 
-		eventOrigin: event.Origin{ID: event.OriginID(planID)},
+		eventOrigin: event.Origin{ID: event.OriginID(plan.ID)},
 		build:       build,
 		clock:       clock,
 	}
@@ -145,11 +163,11 @@ func (d *getDelegate) UpdateVersion(log lager.Logger, plan atc.GetPlan, info run
 	}
 }
 
-func NewPutDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.PutDelegate {
+func NewPutDelegate(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker, buildLimiter *OutputLimiter, clock clock.Clock) exec.PutDelegate {
 	return &putDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, credVarsTracker, clock),
+		BuildStepDelegate: NewBuildStepDelegate(build, plan, credVarsTracker, buildLimiter, clock),
 
-		eventOrigin: event.Origin{ID: event.OriginID(planID)},
+		eventOrigin: event.Origin{ID: event.OriginID(plan.ID)},
 		build:       build,
 		clock:       clock,
 	}
@@ -232,12 +250,17 @@ func (d *putDelegate) SaveOutput(log lager.Logger, plan atc.PutPlan, source atc.
 	}
 }
 
-func NewTaskDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.TaskDelegate {
+func NewTaskDelegate(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker, buildLimiter *OutputLimiter, clock clock.Clock) exec.TaskDelegate {
 	return &taskDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, credVarsTracker, clock),
+		BuildStepDelegate: NewBuildStepDelegate(build, plan, credVarsTracker, buildLimiter, clock),
 
-		eventOrigin: event.Origin{ID: event.OriginID(planID)},
-		build:       build,
+		eventOrigin:     event.Origin{ID: event.OriginID(plan.ID)},
+		build:           build,
+		planID:          plan.ID,
+		credVarsTracker: credVarsTracker,
+		clock:           clock,
+		buildLimiter:    buildLimiter,
+		services:        map[string]exec.BuildStepDelegate{},
 	}
 }
 
@@ -246,6 +269,24 @@ type taskDelegate struct {
 	config      atc.TaskConfig
 	build       db.Build
 	eventOrigin event.Origin
+
+	planID          atc.PlanID
+	credVarsTracker vars.CredVarsTracker
+	clock           clock.Clock
+	buildLimiter    *OutputLimiter
+	services        map[string]exec.BuildStepDelegate
+}
+
+// ServiceOutput saves the output of the named service as logs under its own
+// origin, so that it is not interleaved with the task's.
+func (d *taskDelegate) ServiceOutput(name string) (io.Writer, io.Writer) {
+	delegate, found := d.services[name]
+	if !found {
+		delegate = NewBuildStepDelegate(d.build, atc.Plan{ID: exec.ServicePlanID(d.planID, name)}, d.credVarsTracker, d.buildLimiter, d.clock)
+		d.services[name] = delegate
+	}
+
+	return delegate.Stdout(), delegate.Stderr()
 }
 
 func (d *taskDelegate) SetTaskConfig(config atc.TaskConfig) {
@@ -285,6 +326,11 @@ func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus)
 	d.Stdout().(io.Closer).Close()
 	d.Stderr().(io.Closer).Close()
 
+	for _, service := range d.services {
+		service.Stdout().(io.Closer).Close()
+		service.Stderr().(io.Closer).Close()
+	}
+
 	err := d.build.SaveEvent(event.FinishTask{
 		ExitStatus: int(exitStatus),
 		Time:       time.Now().Unix(),
@@ -300,7 +346,7 @@ func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus)
 
 func NewCheckDelegate(check db.Check, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.CheckDelegate {
 	return &checkDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(nil, planID, credVarsTracker, clock),
+		BuildStepDelegate: NewBuildStepDelegate(nil, atc.Plan{ID: planID}, credVarsTracker, nil, clock),
 
 		eventOrigin: event.Origin{ID: event.OriginID(planID)},
 		check:       check,
@@ -320,6 +366,177 @@ func (d *checkDelegate) SaveVersions(spanContext db.SpanContext, versions []atc.
 	return d.check.SaveVersions(spanContext, versions)
 }
 
+func NewRetryDelegate(build db.Build, plan atc.Plan, clock clock.Clock) exec.RetryDelegate {
+	attempts := 0
+	if plan.Retry != nil {
+		attempts = len(*plan.Retry)
+	}
+
+	return &retryDelegate{
+		eventOrigin: event.Origin{ID: event.OriginID(plan.ID)},
+		attempts:    attempts,
+		build:       build,
+		clock:       clock,
+	}
+}
+
+type retryDelegate struct {
+	build       db.Build
+	eventOrigin event.Origin
+	attempts    int
+	clock       clock.Clock
+}
+
+func (d *retryDelegate) Retrying(logger lager.Logger, attempt int, previousStatus atc.BuildStatus, delay time.Duration) {
+	err := d.build.SaveEvent(event.Retry{
+		Origin:         d.eventOrigin,
+		Time:           d.clock.Now().Unix(),
+		Attempt:        attempt,
+		Attempts:       d.attempts,
+		PreviousStatus: previousStatus,
+		Delay:          delay.String(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-retry-event", err)
+		return
+	}
+
+	logger.Info("retrying", lager.Data{"attempt": attempt, "delay": delay.String()})
+}
+
+func NewBreakpointDelegate(build db.Build, plan atc.Plan, buildLimiter *OutputLimiter, clock clock.Clock) exec.BreakpointDelegate {
+	step := breakpointStep(plan)
+
+	named := step
+	if named.Retry != nil && len(*named.Retry) > 0 {
+		named = breakpointStep((*named.Retry)[0])
+	}
+
+	var name string
+	switch {
+	case named.Get != nil:
+		name = named.Get.Name
+	case named.Put != nil:
+		name = named.Put.Name
+	case named.Task != nil:
+		name = named.Task.Name
+	}
+
+	return &breakpointDelegate{
+		eventOrigin: event.Origin{ID: event.OriginID(step.ID)},
+		stepName:    name,
+		breakpoint:  plan.Breakpoint,
+		build:       build,
+		limiter:     buildLimiter,
+		clock:       clock,
+	}
+}
+
+// breakpointStep finds the step within the hooks, try and timeout wrapped
+// around it, which is where the events about the breakpoint are shown. A
+// retry is returned as-is, as which of its attempts ran last is only known
+// once it has run.
+func breakpointStep(plan atc.Plan) atc.Plan {
+	switch {
+	case plan.OnSuccess != nil:
+		return breakpointStep(plan.OnSuccess.Step)
+	case plan.OnFailure != nil:
+		return breakpointStep(plan.OnFailure.Step)
+	case plan.OnAbort != nil:
+		return breakpointStep(plan.OnAbort.Step)
+	case plan.OnError != nil:
+		return breakpointStep(plan.OnError.Step)
+	case plan.Ensure != nil:
+		return breakpointStep(plan.Ensure.Step)
+	case plan.Try != nil:
+		return breakpointStep(plan.Try.Step)
+	case plan.Timeout != nil:
+		return breakpointStep(plan.Timeout.Step)
+	default:
+		return plan
+	}
+}
+
+type breakpointDelegate struct {
+	build       db.Build
+	eventOrigin event.Origin
+	stepName    string
+	breakpoint  atc.Breakpoint
+	limiter     *OutputLimiter
+	clock       clock.Clock
+}
+
+func (d *breakpointDelegate) Paused(logger lager.Logger) (db.Notifier, error) {
+	err := d.build.PauseAtBreakpoint()
+	if err != nil {
+		logger.Error("failed-to-pause-at-breakpoint", err)
+		return nil, err
+	}
+
+	// listening only once paused, as the notifier fires straight away while
+	// the build is not paused; it still notices the build being resumed in
+	// between
+	notifier, err := d.build.ResumeNotifier()
+	if err != nil {
+		logger.Error("failed-to-listen-for-resume", err)
+		return nil, err
+	}
+
+	d.limiter.pause()
+
+	err = d.build.SaveEvent(event.PausedAtBreakpoint{
+		Origin:     d.eventOrigin,
+		Time:       d.clock.Now().Unix(),
+		Step:       d.stepName,
+		Breakpoint: d.breakpoint,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-paused-at-breakpoint-event", err)
+	}
+
+	logger.Info("paused-at-breakpoint", lager.Data{"step": d.stepName, "breakpoint": d.breakpoint})
+
+	return notifier, nil
+}
+
+func (d *breakpointDelegate) Resumed(logger lager.Logger) {
+	d.limiter.resume()
+
+	err := d.build.SaveEvent(event.ResumedFromBreakpoint{
+		Origin: d.eventOrigin,
+		Time:   d.clock.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-resumed-from-breakpoint-event", err)
+		return
+	}
+
+	logger.Info("resumed-from-breakpoint")
+}
+
+func (d *breakpointDelegate) TimedOut(logger lager.Logger) error {
+	_, err := d.build.Resume()
+	if err != nil {
+		logger.Error("failed-to-resume-after-breakpoint-timeout", err)
+		return err
+	}
+
+	d.limiter.resume()
+
+	err = d.build.SaveEvent(event.ResumedFromBreakpoint{
+		Origin:   d.eventOrigin,
+		Time:     d.clock.Now().Unix(),
+		TimedOut: true,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-resumed-from-breakpoint-event", err)
+	}
+
+	logger.Info("breakpoint-timed-out")
+
+	return nil
+}
+
 type discardCloser struct {
 }
 
@@ -338,15 +555,17 @@ func (*checkDelegate) Errored(lager.Logger, string)                      { retur
 
 func NewBuildStepDelegate(
 	build db.Build,
-	planID atc.PlanID,
+	plan atc.Plan,
 	credVarsTracker vars.CredVarsTracker,
+	buildLimiter *OutputLimiter,
 	clock clock.Clock,
 ) *buildStepDelegate {
 	return &buildStepDelegate{
 		build:           build,
-		planID:          planID,
+		planID:          plan.ID,
 		clock:           clock,
 		credVarsTracker: credVarsTracker,
+		limiter:         newStepOutputLimiter(build, plan.ID, plan.OutputLimits, buildLimiter, clock),
 		stdout:          nil,
 		stderr:          nil,
 	}
@@ -357,6 +576,7 @@ type buildStepDelegate struct {
 	planID          atc.PlanID
 	clock           clock.Clock
 	credVarsTracker vars.CredVarsTracker
+	limiter         *OutputLimiter
 	stderr          io.Writer
 	stdout          io.Writer
 }
@@ -399,6 +619,7 @@ func (delegate *buildStepDelegate) Stdout() io.Writer {
 					ID:     event.OriginID(delegate.planID),
 				},
 				delegate.clock,
+				delegate.limiter,
 				delegate.buildOutputFilter,
 			)
 		} else {
@@ -409,6 +630,7 @@ func (delegate *buildStepDelegate) Stdout() io.Writer {
 					ID:     event.OriginID(delegate.planID),
 				},
 				delegate.clock,
+				delegate.limiter,
 			)
 		}
 	}
@@ -425,6 +647,7 @@ func (delegate *buildStepDelegate) Stderr() io.Writer {
 					ID:     event.OriginID(delegate.planID),
 				},
 				delegate.clock,
+				delegate.limiter,
 				delegate.buildOutputFilter,
 			)
 		} else {
@@ -435,6 +658,7 @@ func (delegate *buildStepDelegate) Stderr() io.Writer {
 					ID:     event.OriginID(delegate.planID),
 				},
 				delegate.clock,
+				delegate.limiter,
 			)
 		}
 	}
@@ -504,11 +728,24 @@ func (delegate *buildStepDelegate) Errored(logger lager.Logger, message string)
 	}
 }
 
-func newDBEventWriter(build db.Build, origin event.Origin, clock clock.Clock) io.WriteCloser {
+// WatchOutput enforces the step's idle timeout, and fails the step once its
+// log limit is exceeded if it is configured to do so. The limits of its build
+// are enforced on the build as a whole instead.
+func (delegate *buildStepDelegate) WatchOutput(ctx context.Context) error {
+	if delegate.limiter == nil {
+		<-ctx.Done()
+		return nil
+	}
+
+	return delegate.limiter.WatchOutput(ctx)
+}
+
+func newDBEventWriter(build db.Build, origin event.Origin, clock clock.Clock, limiter *OutputLimiter) io.WriteCloser {
 	return &dbEventWriter{
-		build:  build,
-		origin: origin,
-		clock:  clock,
+		build:   build,
+		origin:  origin,
+		clock:   clock,
+		limiter: limiter,
 	}
 }
 
@@ -516,6 +753,7 @@ type dbEventWriter struct {
 	build    db.Build
 	origin   event.Origin
 	clock    clock.Clock
+	limiter  *OutputLimiter
 	dangling []byte
 }
 
@@ -547,6 +785,29 @@ func (writer *dbEventWriter) writeDangling(data []byte) []byte {
 }
 
 func (writer *dbEventWriter) saveLog(text string) error {
+	if writer.limiter == nil {
+		return writer.saveLogEvent(text)
+	}
+
+	text, exceeded := writer.limiter.admit(text)
+	if text != "" {
+		err := writer.saveLogEvent(text)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, limiter := range exceeded {
+		err := limiter.logLimitExceeded(event.Origin{ID: writer.origin.ID})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (writer *dbEventWriter) saveLogEvent(text string) error {
 	return writer.build.SaveEvent(event.Log{
 		Time:    writer.clock.Now().Unix(),
 		Payload: text,
@@ -558,12 +819,13 @@ func (writer *dbEventWriter) Close() error {
 	return nil
 }
 
-func newDBEventWriterWithSecretRedaction(build db.Build, origin event.Origin, clock clock.Clock, filter exec.BuildOutputFilter) io.Writer {
+func newDBEventWriterWithSecretRedaction(build db.Build, origin event.Origin, clock clock.Clock, limiter *OutputLimiter, filter exec.BuildOutputFilter) io.Writer {
 	return &dbEventWriterWithSecretRedaction{
 		dbEventWriter: dbEventWriter{
-			build:  build,
-			origin: origin,
-			clock:  clock,
+			build:   build,
+			origin:  origin,
+			clock:   clock,
+			limiter: limiter,
 		},
 		filter: filter,
 	}
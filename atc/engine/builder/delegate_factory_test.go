@@ -1,6 +1,7 @@
 package builder_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -59,7 +60,7 @@ var _ = Describe("DelegateFactory", func() {
 				Metadata: []atc.MetadataField{{Name: "baz", Value: "shmaz"}},
 			}
 
-			delegate = builder.NewGetDelegate(fakeBuild, "some-plan-id", credVarsTracker, fakeClock)
+			delegate = builder.NewGetDelegate(fakeBuild, atc.Plan{ID: "some-plan-id"}, credVarsTracker, nil, fakeClock)
 		})
 
 		Describe("Finished", func() {
@@ -169,7 +170,7 @@ var _ = Describe("DelegateFactory", func() {
 				Metadata: []atc.MetadataField{{Name: "baz", Value: "shmaz"}},
 			}
 
-			delegate = builder.NewPutDelegate(fakeBuild, "some-plan-id", credVarsTracker, fakeClock)
+			delegate = builder.NewPutDelegate(fakeBuild, atc.Plan{ID: "some-plan-id"}, credVarsTracker, nil, fakeClock)
 		})
 
 		Describe("Finished", func() {
@@ -228,7 +229,7 @@ var _ = Describe("DelegateFactory", func() {
 		)
 
 		BeforeEach(func() {
-			delegate = builder.NewTaskDelegate(fakeBuild, "some-plan-id", credVarsTracker, fakeClock)
+			delegate = builder.NewTaskDelegate(fakeBuild, atc.Plan{ID: "some-plan-id"}, credVarsTracker, nil, fakeClock)
 			someConfig = atc.TaskConfig{
 				Platform: "some-platform",
 				Run: atc.TaskRunConfig{
@@ -288,6 +289,47 @@ var _ = Describe("DelegateFactory", func() {
 				Expect(event.EventType()).To(Equal(atc.EventType("finish-task")))
 			})
 		})
+
+		Describe("ServiceOutput", func() {
+			JustBeforeEach(func() {
+				stdout, stderr := delegate.ServiceOutput("some-db")
+
+				_, err := stdout.Write([]byte("hello\n"))
+				Expect(err).ToNot(HaveOccurred())
+				_, err = stderr.Write([]byte("world\n"))
+				Expect(err).ToNot(HaveOccurred())
+
+				delegate.Finished(logger, exitStatus)
+			})
+
+			It("saves the service's output under its own origin", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(3))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
+					Time:    123456789,
+					Payload: "hello\n",
+					Origin: event.Origin{
+						Source: event.OriginSourceStdout,
+						ID:     "some-plan-id/some-db",
+					},
+				}))
+				Expect(fakeBuild.SaveEventArgsForCall(1)).To(Equal(event.Log{
+					Time:    123456789,
+					Payload: "world\n",
+					Origin: event.Origin{
+						Source: event.OriginSourceStderr,
+						ID:     "some-plan-id/some-db",
+					},
+				}))
+				Expect(fakeBuild.SaveEventArgsForCall(2).EventType()).To(Equal(atc.EventType("finish-task")))
+			})
+
+			It("returns the same writers for the same service", func() {
+				stdout, stderr := delegate.ServiceOutput("some-db")
+				otherStdout, otherStderr := delegate.ServiceOutput("some-db")
+				Expect(stdout).To(BeIdenticalTo(otherStdout))
+				Expect(stderr).To(BeIdenticalTo(otherStderr))
+			})
+		})
 	})
 
 	Describe("CheckDelegate", func() {
@@ -317,13 +359,192 @@ var _ = Describe("DelegateFactory", func() {
 		})
 	})
 
+	Describe("RetryDelegate", func() {
+		var delegate exec.RetryDelegate
+
+		BeforeEach(func() {
+			delegate = builder.NewRetryDelegate(fakeBuild, atc.Plan{
+				ID:    "some-plan-id",
+				Retry: &atc.RetryPlan{{ID: "attempt-1"}, {ID: "attempt-2"}, {ID: "attempt-3"}},
+			}, fakeClock)
+		})
+
+		Describe("Retrying", func() {
+			JustBeforeEach(func() {
+				delegate.Retrying(logger, 2, atc.StatusErrored, 20*time.Second)
+			})
+
+			It("saves an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Retry{
+					Origin:         event.Origin{ID: "some-plan-id"},
+					Time:           123456789,
+					Attempt:        2,
+					Attempts:       3,
+					PreviousStatus: atc.StatusErrored,
+					Delay:          "20s",
+				}))
+			})
+		})
+	})
+
+	Describe("BreakpointDelegate", func() {
+		var (
+			plan         atc.Plan
+			fakeNotifier *dbfakes.FakeNotifier
+			delegate     exec.BreakpointDelegate
+		)
+
+		BeforeEach(func() {
+			plan = atc.Plan{
+				ID:         "some-plan-id",
+				Breakpoint: atc.BreakpointAfter,
+				Timeout: &atc.TimeoutPlan{
+					Duration: "1h",
+					Step: atc.Plan{
+						ID: "some-hooked-plan-id",
+						OnSuccess: &atc.OnSuccessPlan{
+							Step: atc.Plan{
+								ID:   "some-task-plan-id",
+								Task: &atc.TaskPlan{Name: "some-task"},
+							},
+							Next: atc.Plan{ID: "some-hook-plan-id"},
+						},
+					},
+				},
+			}
+
+			fakeNotifier = new(dbfakes.FakeNotifier)
+			fakeBuild.ResumeNotifierReturns(fakeNotifier, nil)
+		})
+
+		JustBeforeEach(func() {
+			delegate = builder.NewBreakpointDelegate(fakeBuild, plan, nil, fakeClock)
+		})
+
+		Describe("Paused", func() {
+			var (
+				notifier db.Notifier
+				pauseErr error
+			)
+
+			JustBeforeEach(func() {
+				notifier, pauseErr = delegate.Paused(logger)
+			})
+
+			It("pauses the build", func() {
+				Expect(fakeBuild.PauseAtBreakpointCallCount()).To(Equal(1))
+			})
+
+			It("returns the build's resume notifier", func() {
+				Expect(pauseErr).ToNot(HaveOccurred())
+				Expect(notifier).To(Equal(fakeNotifier))
+			})
+
+			It("saves an event from the step within the hooks and timeout", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.PausedAtBreakpoint{
+					Origin:     event.Origin{ID: "some-task-plan-id"},
+					Time:       123456789,
+					Step:       "some-task",
+					Breakpoint: atc.BreakpointAfter,
+				}))
+			})
+
+			Context("when the step is retried", func() {
+				BeforeEach(func() {
+					plan = atc.Plan{
+						ID:         "some-retry-plan-id",
+						Breakpoint: atc.BreakpointBefore,
+						Retry: &atc.RetryPlan{
+							{ID: "attempt-1", Get: &atc.GetPlan{Name: "some-get"}},
+							{ID: "attempt-2", Get: &atc.GetPlan{Name: "some-get"}},
+						},
+					}
+				})
+
+				It("saves an event from the retry", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+					Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.PausedAtBreakpoint{
+						Origin:     event.Origin{ID: "some-retry-plan-id"},
+						Time:       123456789,
+						Step:       "some-get",
+						Breakpoint: atc.BreakpointBefore,
+					}))
+				})
+			})
+
+			Context("when pausing the build fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeBuild.PauseAtBreakpointReturns(disaster)
+				})
+
+				It("returns the error without listening for it to be resumed", func() {
+					Expect(pauseErr).To(Equal(disaster))
+					Expect(fakeBuild.ResumeNotifierCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Describe("Resumed", func() {
+			JustBeforeEach(func() {
+				delegate.Resumed(logger)
+			})
+
+			It("saves an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.ResumedFromBreakpoint{
+					Origin: event.Origin{ID: "some-task-plan-id"},
+					Time:   123456789,
+				}))
+			})
+		})
+
+		Describe("TimedOut", func() {
+			var timedOutErr error
+
+			JustBeforeEach(func() {
+				timedOutErr = delegate.TimedOut(logger)
+			})
+
+			It("resumes the build", func() {
+				Expect(timedOutErr).ToNot(HaveOccurred())
+				Expect(fakeBuild.ResumeCallCount()).To(Equal(1))
+			})
+
+			It("saves an event saying it timed out", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.ResumedFromBreakpoint{
+					Origin:   event.Origin{ID: "some-task-plan-id"},
+					Time:     123456789,
+					TimedOut: true,
+				}))
+			})
+
+			Context("when resuming the build fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeBuild.ResumeReturns(false, disaster)
+				})
+
+				It("returns the error without saving an event", func() {
+					Expect(timedOutErr).To(Equal(disaster))
+					Expect(fakeBuild.SaveEventCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+
 	Describe("BuildStepDelegate", func() {
 		var (
 			delegate exec.BuildStepDelegate
 		)
 
 		BeforeEach(func() {
-			delegate = builder.NewBuildStepDelegate(fakeBuild, "some-plan-id", credVarsTracker, fakeClock)
+			delegate = builder.NewBuildStepDelegate(fakeBuild, atc.Plan{ID: "some-plan-id"}, credVarsTracker, nil, fakeClock)
 		})
 
 		Describe("Initializing", func() {
@@ -526,7 +747,7 @@ var _ = Describe("DelegateFactory", func() {
 			BeforeEach(func() {
 				credVars := vars.StaticVariables{}
 				credVarsTracker = vars.NewCredVarsTracker(credVars, false)
-				delegate = builder.NewBuildStepDelegate(fakeBuild, "some-plan-id", credVarsTracker, fakeClock)
+				delegate = builder.NewBuildStepDelegate(fakeBuild, atc.Plan{ID: "some-plan-id"}, credVarsTracker, nil, fakeClock)
 			})
 
 			Context("Stdout", func() {
@@ -616,6 +837,284 @@ var _ = Describe("DelegateFactory", func() {
 			})
 		})
 
+		Describe("Output limits", func() {
+			var limits atc.OutputLimits
+
+			BeforeEach(func() {
+				credVarsTracker = vars.NewCredVarsTracker(vars.StaticVariables{}, false)
+			})
+
+			JustBeforeEach(func() {
+				delegate = builder.NewBuildStepDelegate(fakeBuild, atc.Plan{ID: "some-plan-id", OutputLimits: &limits}, credVarsTracker, nil, fakeClock)
+			})
+
+			Context("when the log limit is exceeded", func() {
+				BeforeEach(func() {
+					limits = atc.OutputLimits{LogLimit: 8}
+				})
+
+				JustBeforeEach(func() {
+					_, err := delegate.Stdout().Write([]byte("hello\n"))
+					Expect(err).ToNot(HaveOccurred())
+					_, err = delegate.Stderr().Write([]byte("world\n"))
+					Expect(err).ToNot(HaveOccurred())
+					_, err = delegate.Stdout().Write([]byte("more\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("saves the output up to the limit followed by an error", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(3))
+					Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
+						Time:    123456789,
+						Payload: "hello\n",
+						Origin: event.Origin{
+							Source: event.OriginSourceStdout,
+							ID:     "some-plan-id",
+						},
+					}))
+					Expect(fakeBuild.SaveEventArgsForCall(1)).To(Equal(event.Log{
+						Time:    123456789,
+						Payload: "wo",
+						Origin: event.Origin{
+							Source: event.OriginSourceStderr,
+							ID:     "some-plan-id",
+						},
+					}))
+					Expect(fakeBuild.SaveEventArgsForCall(2)).To(Equal(event.Error{
+						Time:    123456789,
+						Message: "log limit of 8 bytes exceeded; discarding further output",
+						Origin: event.Origin{
+							ID: "some-plan-id",
+						},
+					}))
+				})
+
+				It("lets the step carry on", func() {
+					ctx, cancel := context.WithCancel(context.Background())
+					cancel()
+
+					Expect(delegate.WatchOutput(ctx)).To(Succeed())
+				})
+
+				Context("when the step should fail", func() {
+					BeforeEach(func() {
+						limits.OnLogLimit = atc.LogLimitFail
+					})
+
+					It("saves an error saying the step is failing", func() {
+						Expect(fakeBuild.SaveEventCallCount()).To(Equal(3))
+						Expect(fakeBuild.SaveEventArgsForCall(2)).To(Equal(event.Error{
+							Time:    123456789,
+							Message: "log limit of 8 bytes exceeded; failing step",
+							Origin: event.Origin{
+								ID: "some-plan-id",
+							},
+						}))
+					})
+
+					It("reports the violation", func() {
+						Expect(delegate.WatchOutput(context.Background())).To(MatchError("log limit of 8 bytes exceeded"))
+					})
+				})
+			})
+
+			Context("when the step has an idle timeout", func() {
+				var watchErr chan error
+
+				BeforeEach(func() {
+					limits = atc.OutputLimits{IdleTimeout: "1m"}
+				})
+
+				JustBeforeEach(func() {
+					watchErr = make(chan error, 1)
+					go func() {
+						watchErr <- delegate.WatchOutput(context.Background())
+					}()
+				})
+
+				It("interrupts the step if it never prints anything", func() {
+					fakeClock.WaitForWatcherAndIncrement(40 * time.Second)
+					Consistently(watchErr).ShouldNot(Receive())
+
+					fakeClock.WaitForWatcherAndIncrement(20 * time.Second)
+					Eventually(watchErr).Should(Receive(MatchError("no output for 1m0s")))
+				})
+
+				It("interrupts the step once it has gone without output for too long", func() {
+					_, err := delegate.Stdout().Write([]byte("starting\n"))
+					Expect(err).ToNot(HaveOccurred())
+
+					fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+
+					_, err = delegate.Stdout().Write([]byte("still going\n"))
+					Expect(err).ToNot(HaveOccurred())
+
+					fakeClock.WaitForWatcherAndIncrement(40 * time.Second)
+					Consistently(watchErr).ShouldNot(Receive())
+
+					fakeClock.WaitForWatcherAndIncrement(20 * time.Second)
+					Eventually(watchErr).Should(Receive(MatchError("no output for 1m0s")))
+
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(3))
+					Expect(fakeBuild.SaveEventArgsForCall(2)).To(Equal(event.Error{
+						Time:    123456879,
+						Message: "no output for 1m0s; interrupting step",
+						Origin: event.Origin{
+							ID: "some-plan-id",
+						},
+					}))
+				})
+
+				It("returns once the step is done", func() {
+					ctx, cancel := context.WithCancel(context.Background())
+					cancel()
+
+					Expect(delegate.WatchOutput(ctx)).To(Succeed())
+				})
+			})
+		})
+
+		Describe("Build output limits", func() {
+			var (
+				limits       atc.OutputLimits
+				buildLimiter *builder.OutputLimiter
+				otherStep    exec.BuildStepDelegate
+			)
+
+			BeforeEach(func() {
+				credVarsTracker = vars.NewCredVarsTracker(vars.StaticVariables{}, false)
+			})
+
+			JustBeforeEach(func() {
+				buildLimiter = builder.NewBuildOutputLimiter(fakeBuild, &limits, fakeClock)
+				delegate = builder.NewBuildStepDelegate(fakeBuild, atc.Plan{ID: "some-plan-id"}, credVarsTracker, buildLimiter, fakeClock)
+				otherStep = builder.NewBuildStepDelegate(fakeBuild, atc.Plan{ID: "other-plan-id"}, credVarsTracker, buildLimiter, fakeClock)
+			})
+
+			Context("when the output of all of the steps exceeds the log limit", func() {
+				BeforeEach(func() {
+					limits = atc.OutputLimits{LogLimit: 8}
+				})
+
+				JustBeforeEach(func() {
+					_, err := delegate.Stdout().Write([]byte("hello\n"))
+					Expect(err).ToNot(HaveOccurred())
+					_, err = otherStep.Stdout().Write([]byte("world\n"))
+					Expect(err).ToNot(HaveOccurred())
+					_, err = delegate.Stdout().Write([]byte("more\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("saves the output up to the limit followed by an error from the step which exceeded it", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(3))
+					Expect(fakeBuild.SaveEventArgsForCall(1)).To(Equal(event.Log{
+						Time:    123456789,
+						Payload: "wo",
+						Origin: event.Origin{
+							Source: event.OriginSourceStdout,
+							ID:     "other-plan-id",
+						},
+					}))
+					Expect(fakeBuild.SaveEventArgsForCall(2)).To(Equal(event.Error{
+						Time:    123456789,
+						Message: "log limit of 8 bytes exceeded; discarding further output",
+						Origin: event.Origin{
+							ID: "other-plan-id",
+						},
+					}))
+				})
+
+				Context("when the build should fail", func() {
+					BeforeEach(func() {
+						limits.OnLogLimit = atc.LogLimitFail
+					})
+
+					It("saves an error saying the build is failing", func() {
+						Expect(fakeBuild.SaveEventArgsForCall(2)).To(Equal(event.Error{
+							Time:    123456789,
+							Message: "log limit of 8 bytes exceeded; failing build",
+							Origin: event.Origin{
+								ID: "other-plan-id",
+							},
+						}))
+					})
+
+					It("reports the violation for the build rather than the step", func() {
+						Expect(buildLimiter.WatchOutput(context.Background())).To(MatchError("log limit of 8 bytes exceeded"))
+
+						ctx, cancel := context.WithCancel(context.Background())
+						cancel()
+
+						Expect(otherStep.WatchOutput(ctx)).To(Succeed())
+					})
+				})
+			})
+
+			Context("when the build has an idle timeout", func() {
+				var watchErr chan error
+
+				BeforeEach(func() {
+					limits = atc.OutputLimits{IdleTimeout: "1m"}
+				})
+
+				JustBeforeEach(func() {
+					watchErr = make(chan error, 1)
+					go func() {
+						watchErr <- buildLimiter.WatchOutput(context.Background())
+					}()
+				})
+
+				It("interrupts the build once none of its steps have printed anything for too long", func() {
+					fakeClock.WaitForWatcherAndIncrement(40 * time.Second)
+					Consistently(watchErr).ShouldNot(Receive())
+
+					_, err := delegate.Stdout().Write([]byte("starting\n"))
+					Expect(err).ToNot(HaveOccurred())
+
+					fakeClock.WaitForWatcherAndIncrement(40 * time.Second)
+
+					_, err = otherStep.Stdout().Write([]byte("still going\n"))
+					Expect(err).ToNot(HaveOccurred())
+
+					fakeClock.WaitForWatcherAndIncrement(40 * time.Second)
+					Consistently(watchErr).ShouldNot(Receive())
+
+					fakeClock.WaitForWatcherAndIncrement(20 * time.Second)
+					Eventually(watchErr).Should(Receive(MatchError("no output for 1m0s")))
+
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(3))
+					Expect(fakeBuild.SaveEventArgsForCall(2)).To(Equal(event.Error{
+						Time:    123456929,
+						Message: "no output for 1m0s; interrupting build",
+					}))
+				})
+
+				It("does not count the time the build is paused at a breakpoint", func() {
+					fakeBuild.ResumeNotifierReturns(new(dbfakes.FakeNotifier), nil)
+
+					breakpoint := builder.NewBreakpointDelegate(fakeBuild, atc.Plan{
+						ID:         "some-plan-id",
+						Breakpoint: atc.BreakpointAfter,
+						Task:       &atc.TaskPlan{Name: "some-task"},
+					}, buildLimiter, fakeClock)
+
+					_, err := delegate.Stdout().Write([]byte("starting\n"))
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = breakpoint.Paused(logger)
+					Expect(err).ToNot(HaveOccurred())
+
+					fakeClock.WaitForWatcherAndIncrement(5 * time.Minute)
+					Consistently(watchErr).ShouldNot(Receive())
+
+					breakpoint.Resumed(logger)
+
+					fakeClock.WaitForWatcherAndIncrement(time.Minute)
+					Eventually(watchErr).Should(Receive(MatchError("no output for 1m0s")))
+				})
+			})
+		})
+
 		Describe("Secrets redaction", func() {
 			var (
 				writer       io.Writer
@@ -0,0 +1,254 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"code.cloudfoundry.org/clock"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// OutputLimiter enforces a log limit and idle timeout on the output of either
+// a single step, shared by its stdout and stderr writers, or of a whole build,
+// shared by the limiters of all of its steps.
+type OutputLimiter struct {
+	build   db.Build
+	origin  event.Origin
+	subject string
+	clock   clock.Clock
+
+	// parent is the limiter of the build, which the output of a step counts
+	// towards as well
+	parent *OutputLimiter
+
+	logLimit    uint64
+	onLogLimit  atc.LogLimitAction
+	idleTimeout time.Duration
+
+	violations chan error
+
+	lock       sync.Mutex
+	written    uint64
+	exceeded   bool
+	lastOutput time.Time
+	paused     int
+}
+
+// NewBuildOutputLimiter constructs the limiter of a build from the output
+// limits of its job. It returns nil if the job has none.
+func NewBuildOutputLimiter(build db.Build, limits *atc.OutputLimits, clock clock.Clock) *OutputLimiter {
+	if limits == nil {
+		return nil
+	}
+
+	return newOutputLimiter(build, event.Origin{}, "build", limits, nil, clock)
+}
+
+// newStepOutputLimiter constructs the limiter of a step. It returns nil if
+// neither the step nor its build have any limits.
+func newStepOutputLimiter(build db.Build, planID atc.PlanID, limits *atc.OutputLimits, parent *OutputLimiter, clock clock.Clock) *OutputLimiter {
+	if limits == nil {
+		if parent == nil {
+			return nil
+		}
+
+		limits = &atc.OutputLimits{}
+	}
+
+	return newOutputLimiter(build, event.Origin{ID: event.OriginID(planID)}, "step", limits, parent, clock)
+}
+
+func newOutputLimiter(build db.Build, origin event.Origin, subject string, limits *atc.OutputLimits, parent *OutputLimiter, clock clock.Clock) *OutputLimiter {
+	// validated when the pipeline was configured
+	idleTimeout, _ := time.ParseDuration(limits.IdleTimeout)
+
+	return &OutputLimiter{
+		build:   build,
+		origin:  origin,
+		subject: subject,
+		clock:   clock,
+		parent:  parent,
+
+		logLimit:    limits.LogLimit,
+		onLogLimit:  limits.OnLogLimit,
+		idleTimeout: idleTimeout,
+
+		violations: make(chan error, 1),
+	}
+}
+
+// admit records that the step printed text and returns the part of it which
+// fits within its log limit and that of its build, along with the limiters
+// whose limit this write exceeded.
+func (limiter *OutputLimiter) admit(text string) (string, []*OutputLimiter) {
+	text, exceeded := limiter.admitOwn(text)
+
+	var exceededLimiters []*OutputLimiter
+	if exceeded {
+		exceededLimiters = append(exceededLimiters, limiter)
+	}
+
+	if limiter.parent != nil {
+		var parentExceeded []*OutputLimiter
+		text, parentExceeded = limiter.parent.admit(text)
+		exceededLimiters = append(exceededLimiters, parentExceeded...)
+	}
+
+	return text, exceededLimiters
+}
+
+func (limiter *OutputLimiter) admitOwn(text string) (string, bool) {
+	limiter.lock.Lock()
+	defer limiter.lock.Unlock()
+
+	limiter.lastOutput = limiter.clock.Now()
+
+	if limiter.logLimit == 0 {
+		return text, false
+	}
+
+	if limiter.exceeded {
+		return "", false
+	}
+
+	remaining := limiter.logLimit - limiter.written
+	if uint64(len(text)) <= remaining {
+		limiter.written += uint64(len(text))
+		return text, false
+	}
+
+	// don't cut a multi-byte character in half
+	cut := int(remaining)
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+
+	limiter.exceeded = true
+	limiter.written = limiter.logLimit
+
+	return text[:cut], true
+}
+
+// logLimitExceeded reports that the log limit was exceeded by the output of
+// the given step and, if the limiter is configured to fail, has its step or
+// build interrupted.
+func (limiter *OutputLimiter) logLimitExceeded(origin event.Origin) error {
+	limit := formatLogLimit(limiter.logLimit)
+
+	if limiter.onLogLimit != atc.LogLimitFail {
+		return limiter.saveError(origin, fmt.Sprintf("log limit of %s exceeded; discarding further output", limit))
+	}
+
+	err := limiter.saveError(origin, fmt.Sprintf("log limit of %s exceeded; failing %s", limit, limiter.subject))
+
+	select {
+	case limiter.violations <- fmt.Errorf("log limit of %s exceeded", limit):
+	default:
+	}
+
+	return err
+}
+
+// pause stops the idle timeout from counting while the build is paused at a
+// breakpoint.
+func (limiter *OutputLimiter) pause() {
+	if limiter == nil {
+		return
+	}
+
+	limiter.lock.Lock()
+	limiter.paused++
+	limiter.lock.Unlock()
+}
+
+// resume has the idle timeout count again, from the time the build resumed.
+func (limiter *OutputLimiter) resume() {
+	if limiter == nil {
+		return
+	}
+
+	limiter.lock.Lock()
+	limiter.paused--
+	limiter.lastOutput = limiter.clock.Now()
+	limiter.lock.Unlock()
+}
+
+// WatchOutput blocks until the context is done, there has been no output for
+// longer than the idle timeout, or the log limit was exceeded and the limiter
+// is configured to fail.
+//
+// The idle timer starts when WatchOutput is called, i.e. when the step or
+// build starts, so that one which never prints anything is interrupted too.
+func (limiter *OutputLimiter) WatchOutput(ctx context.Context) error {
+	var timer clock.Timer
+	var idle <-chan time.Time
+	if limiter.idleTimeout > 0 {
+		limiter.lock.Lock()
+		if limiter.lastOutput.IsZero() {
+			limiter.lastOutput = limiter.clock.Now()
+		}
+		silence := limiter.clock.Since(limiter.lastOutput)
+		limiter.lock.Unlock()
+
+		timer = limiter.clock.NewTimer(limiter.idleTimeout - silence)
+		defer timer.Stop()
+
+		idle = timer.C()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case err := <-limiter.violations:
+			return err
+
+		case <-idle:
+			limiter.lock.Lock()
+			paused := limiter.paused > 0
+			silence := limiter.clock.Since(limiter.lastOutput)
+			limiter.lock.Unlock()
+
+			if paused {
+				timer.Reset(limiter.idleTimeout)
+				continue
+			}
+
+			if silence < limiter.idleTimeout {
+				timer.Reset(limiter.idleTimeout - silence)
+				continue
+			}
+
+			limiter.saveError(limiter.origin, fmt.Sprintf("no output for %s; interrupting %s", limiter.idleTimeout, limiter.subject))
+
+			return fmt.Errorf("no output for %s", limiter.idleTimeout)
+		}
+	}
+}
+
+func (limiter *OutputLimiter) saveError(origin event.Origin, message string) error {
+	return limiter.build.SaveEvent(event.Error{
+		Message: message,
+		Origin:  origin,
+		Time:    limiter.clock.Now().Unix(),
+	})
+}
+
+func formatLogLimit(bytes uint64) string {
+	switch {
+	case bytes >= 1<<30 && bytes%(1<<30) == 0:
+		return fmt.Sprintf("%dGB", bytes>>30)
+	case bytes >= 1<<20 && bytes%(1<<20) == 0:
+		return fmt.Sprintf("%dMB", bytes>>20)
+	case bytes >= 1<<10 && bytes%(1<<10) == 0:
+		return fmt.Sprintf("%dKB", bytes>>10)
+	default:
+		return fmt.Sprintf("%d bytes", bytes)
+	}
+}
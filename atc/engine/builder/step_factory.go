@@ -72,6 +72,10 @@ func (factory *stepFactory) GetStep(
 		factory.client,
 	)
 
+	if plan.OutputLimits != nil {
+		getStep = exec.LimitOutput(getStep, delegate)
+	}
+
 	getStep = exec.LogError(getStep, delegate)
 	if factory.enableRerunWhenWorkerDisappears {
 		getStep = exec.RetryError(getStep, delegate)
@@ -99,6 +103,10 @@ func (factory *stepFactory) PutStep(
 		delegate,
 	)
 
+	if plan.OutputLimits != nil {
+		putStep = exec.LimitOutput(putStep, delegate)
+	}
+
 	putStep = exec.LogError(putStep, delegate)
 	if factory.enableRerunWhenWorkerDisappears {
 		putStep = exec.RetryError(putStep, delegate)
@@ -147,9 +155,14 @@ func (factory *stepFactory) TaskStep(
 		factory.strategy,
 		factory.client,
 		delegate,
+		factory.teamFactory,
 		factory.lockFactory,
 	)
 
+	if plan.OutputLimits != nil {
+		taskStep = exec.LimitOutput(taskStep, delegate)
+	}
+
 	taskStep = exec.LogError(taskStep, delegate)
 	if factory.enableRerunWhenWorkerDisappears {
 		taskStep = exec.RetryError(taskStep, delegate)
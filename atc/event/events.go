@@ -191,3 +191,47 @@ type Finish struct {
 
 func (Finish) EventType() atc.EventType  { return EventTypeFinish }
 func (Finish) Version() atc.EventVersion { return "1.0" }
+
+type Retry struct {
+	Origin Origin `json:"origin"`
+	Time   int64  `json:"time"`
+
+	// the attempt about to be made, counting from 1, out of how many there are
+	Attempt  int `json:"attempt"`
+	Attempts int `json:"attempts"`
+
+	// whether the previous attempt failed or errored
+	PreviousStatus atc.BuildStatus `json:"previous_status"`
+
+	// how long until the attempt is made, e.g. 20s
+	Delay string `json:"delay"`
+}
+
+func (Retry) EventType() atc.EventType  { return EventTypeRetry }
+func (Retry) Version() atc.EventVersion { return "1.0" }
+
+type PausedAtBreakpoint struct {
+	Origin Origin `json:"origin"`
+	Time   int64  `json:"time"`
+
+	// the name of the step the build is paused before or after
+	Step string `json:"step"`
+
+	// whether the build is paused before or after the step
+	Breakpoint atc.Breakpoint `json:"breakpoint"`
+}
+
+func (PausedAtBreakpoint) EventType() atc.EventType  { return EventTypePausedAtBreakpoint }
+func (PausedAtBreakpoint) Version() atc.EventVersion { return "1.0" }
+
+type ResumedFromBreakpoint struct {
+	Origin Origin `json:"origin"`
+	Time   int64  `json:"time"`
+
+	// set when the build was resumed as it had been paused for longer than
+	// the breakpoint timeout
+	TimedOut bool `json:"timed_out,omitempty"`
+}
+
+func (ResumedFromBreakpoint) EventType() atc.EventType  { return EventTypeResumedFromBreakpoint }
+func (ResumedFromBreakpoint) Version() atc.EventVersion { return "1.0" }
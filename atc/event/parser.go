@@ -46,6 +46,9 @@ func init() {
 	RegisterEvent(Status{})
 	RegisterEvent(Log{})
 	RegisterEvent(Error{})
+	RegisterEvent(Retry{})
+	RegisterEvent(PausedAtBreakpoint{})
+	RegisterEvent(ResumedFromBreakpoint{})
 
 	// deprecated:
 	RegisterEvent(InitializeV10{})
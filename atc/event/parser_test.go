@@ -68,5 +68,8 @@ var _ = Describe("ParseEvent", func() {
 		Entry("Status", event.Status{}),
 		Entry("Log", event.Log{}),
 		Entry("Error", event.Error{}),
+		Entry("Retry", event.Retry{}),
+		Entry("PausedAtBreakpoint", event.PausedAtBreakpoint{}),
+		Entry("ResumedFromBreakpoint", event.ResumedFromBreakpoint{}),
 	)
 })
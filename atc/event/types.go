@@ -47,4 +47,13 @@ const (
 
 	// error occurred
 	EventTypeError atc.EventType = "error"
+
+	// retrying a step which did not succeed
+	EventTypeRetry atc.EventType = "retry"
+
+	// paused at a step's breakpoint, waiting to be resumed
+	EventTypePausedAtBreakpoint atc.EventType = "paused-at-breakpoint"
+
+	// resumed from a step's breakpoint
+	EventTypeResumedFromBreakpoint atc.EventType = "resumed-from-breakpoint"
 )
@@ -0,0 +1,76 @@
+package eventstore
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Filesystem keeps the offloaded events of builds as files in a directory.
+// When running more than one web node, the directory must be shared between
+// them, e.g. by mounting the same network filesystem.
+type Filesystem struct {
+	Dir string
+}
+
+func NewFilesystem(dir string) *Filesystem {
+	return &Filesystem{
+		Dir: dir,
+	}
+}
+
+func (fs *Filesystem) Put(ctx context.Context, key string, data io.Reader) error {
+	path := fs.path(key)
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that a failed or partial write never
+	// replaces a complete one
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".put-")
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tmp, data)
+	if err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (fs *Filesystem) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return os.Open(fs.path(key))
+}
+
+func (fs *Filesystem) Delete(ctx context.Context, key string) error {
+	path := fs.path(key)
+
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	// clean up the directory of the key if it is now empty; this fails
+	// harmlessly when it is not
+	_ = os.Remove(filepath.Dir(path))
+
+	return nil
+}
+
+func (fs *Filesystem) path(key string) string {
+	return filepath.Join(fs.Dir, filepath.FromSlash(key))
+}
@@ -0,0 +1,93 @@
+package eventstore_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc/eventstore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Filesystem", func() {
+	var (
+		dir string
+		fs  *eventstore.Filesystem
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "event-store")
+		Expect(err).NotTo(HaveOccurred())
+
+		fs = eventstore.NewFilesystem(dir)
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	get := func(key string) string {
+		blob, err := fs.Get(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+
+		defer blob.Close()
+
+		contents, err := ioutil.ReadAll(blob)
+		Expect(err).NotTo(HaveOccurred())
+
+		return string(contents)
+	}
+
+	Describe("Put", func() {
+		It("stores the data under the key", func() {
+			Expect(fs.Put(ctx, "builds/1/events.json.gz", strings.NewReader("some-events"))).To(Succeed())
+
+			Expect(filepath.Join(dir, "builds", "1", "events.json.gz")).To(BeAnExistingFile())
+			Expect(get("builds/1/events.json.gz")).To(Equal("some-events"))
+		})
+
+		It("replaces existing data", func() {
+			Expect(fs.Put(ctx, "builds/1/events.json.gz", strings.NewReader("some-events"))).To(Succeed())
+			Expect(fs.Put(ctx, "builds/1/events.json.gz", strings.NewReader("other-events"))).To(Succeed())
+
+			Expect(get("builds/1/events.json.gz")).To(Equal("other-events"))
+		})
+
+		It("does not leave temporary files behind", func() {
+			Expect(fs.Put(ctx, "builds/1/events.json.gz", strings.NewReader("some-events"))).To(Succeed())
+
+			files, err := ioutil.ReadDir(filepath.Join(dir, "builds", "1"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+		})
+	})
+
+	Describe("Get", func() {
+		It("errors when the key does not exist", func() {
+			_, err := fs.Get(ctx, "builds/1/events.json.gz")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			Expect(fs.Put(ctx, "builds/1/events.json.gz", strings.NewReader("some-events"))).To(Succeed())
+		})
+
+		It("removes the data and its empty directory", func() {
+			Expect(fs.Delete(ctx, "builds/1/events.json.gz")).To(Succeed())
+
+			Expect(filepath.Join(dir, "builds", "1")).ToNot(BeADirectory())
+		})
+
+		It("succeeds when the key does not exist", func() {
+			Expect(fs.Delete(ctx, "builds/2/events.json.gz")).To(Succeed())
+		})
+	})
+})
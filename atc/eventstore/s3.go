@@ -0,0 +1,102 @@
+package eventstore
+
+import (
+	"context"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+type S3Config struct {
+	Bucket          string `long:"bucket" description:"Bucket to keep the compressed events of completed builds in."`
+	Prefix          string `long:"prefix" description:"Prefix for the keys of the objects in the bucket."`
+	Region          string `long:"region" description:"AWS region of the bucket."`
+	Endpoint        string `long:"endpoint" description:"URL of an S3-compatible API to use instead of AWS."`
+	AccessKeyID     string `long:"access-key-id" description:"Access key ID. Uses the default AWS credential chain if not given."`
+	SecretAccessKey string `long:"secret-access-key" description:"Secret access key."`
+	SessionToken    string `long:"session-token" description:"Session token."`
+	ForcePathStyle  bool   `long:"force-path-style" description:"Address the bucket with path-style URLs, as required by some S3-compatible stores."`
+}
+
+// S3 keeps the offloaded events of builds as objects in an S3 bucket, or a
+// bucket of any S3-compatible object store.
+type S3 struct {
+	Bucket string
+	Prefix string
+
+	client   s3iface.S3API
+	uploader *s3manager.Uploader
+}
+
+func NewS3(config S3Config) (*S3, error) {
+	awsConfig := aws.NewConfig().
+		WithRegion(config.Region).
+		WithS3ForcePathStyle(config.ForcePathStyle)
+
+	if config.Endpoint != "" {
+		awsConfig = awsConfig.WithEndpoint(config.Endpoint)
+	}
+
+	if config.AccessKeyID != "" {
+		awsConfig = awsConfig.WithCredentials(credentials.NewStaticCredentials(
+			config.AccessKeyID,
+			config.SecretAccessKey,
+			config.SessionToken,
+		))
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s3.New(sess)
+
+	return &S3{
+		Bucket: config.Bucket,
+		Prefix: config.Prefix,
+
+		client:   client,
+		uploader: s3manager.NewUploaderWithClient(client),
+	}, nil
+}
+
+func (store *S3) Put(ctx context.Context, key string, data io.Reader) error {
+	_, err := store.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(store.Bucket),
+		Key:         aws.String(store.key(key)),
+		Body:        data,
+		ContentType: aws.String("application/gzip"),
+	})
+	return err
+}
+
+func (store *S3) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := store.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(store.Bucket),
+		Key:    aws.String(store.key(key)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Body, nil
+}
+
+func (store *S3) Delete(ctx context.Context, key string) error {
+	_, err := store.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(store.Bucket),
+		Key:    aws.String(store.key(key)),
+	})
+	return err
+}
+
+func (store *S3) key(key string) string {
+	return path.Join(store.Prefix, key)
+}
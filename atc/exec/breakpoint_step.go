@@ -0,0 +1,113 @@
+package exec
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+//go:generate counterfeiter . BreakpointDelegate
+
+type BreakpointDelegate interface {
+	// Paused is called when the build reaches the breakpoint. It marks the
+	// build as paused and returns a notifier which fires once the build is
+	// resumed.
+	Paused(logger lager.Logger) (db.Notifier, error)
+
+	// Resumed is called once the build has been resumed.
+	Resumed(logger lager.Logger)
+
+	// TimedOut is called once the build has been paused for longer than the
+	// breakpoint timeout. It resumes the build.
+	TimedOut(logger lager.Logger) error
+}
+
+// BreakpointStep pauses the build before or after running a step until it is
+// resumed, keeping the step's containers around so that they can be
+// hijacked.
+type BreakpointStep struct {
+	step       Step
+	breakpoint atc.Breakpoint
+	timeout    time.Duration
+	delegate   BreakpointDelegate
+}
+
+// Breakpoint constructs a BreakpointStep which pauses the build before or
+// after the given step runs. The build is resumed on its own once it has been
+// paused for the given timeout, unless the timeout is 0.
+func Breakpoint(step Step, breakpoint atc.Breakpoint, timeout time.Duration, delegate BreakpointDelegate) Step {
+	return &BreakpointStep{
+		step:       step,
+		breakpoint: breakpoint,
+		timeout:    timeout,
+		delegate:   delegate,
+	}
+}
+
+// Run pauses the build and then runs the step for a breakpoint before the
+// step. For a breakpoint after the step, it runs the step and then pauses the
+// build, regardless of whether the step succeeded, failed or errored. If the
+// build is aborted, it does not pause.
+//
+// Once resumed, the step's error, if any, is returned.
+func (step *BreakpointStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx)
+
+	if step.breakpoint == atc.BreakpointBefore {
+		err := step.pause(ctx, logger)
+		if err != nil {
+			return err
+		}
+
+		return step.step.Run(ctx, state)
+	}
+
+	stepErr := step.step.Run(ctx, state)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	err := step.pause(ctx, logger)
+	if err != nil {
+		return err
+	}
+
+	return stepErr
+}
+
+func (step *BreakpointStep) pause(ctx context.Context, logger lager.Logger) error {
+	notifier, err := step.delegate.Paused(logger)
+	if err != nil {
+		return err
+	}
+
+	defer notifier.Close()
+
+	var timedOut <-chan time.Time
+	if step.timeout > 0 {
+		timer := time.NewTimer(step.timeout)
+		defer timer.Stop()
+
+		timedOut = timer.C
+	}
+
+	select {
+	case <-notifier.Notify():
+		step.delegate.Resumed(logger)
+	case <-timedOut:
+		return step.delegate.TimedOut(logger)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+// Succeeded delegates to the step the build paused at.
+func (step *BreakpointStep) Succeeded() bool {
+	return step.step.Succeeded()
+}
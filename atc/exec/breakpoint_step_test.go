@@ -0,0 +1,271 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Breakpoint Step", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStep     *execfakes.FakeStep
+		fakeDelegate *execfakes.FakeBreakpointDelegate
+		fakeNotifier *dbfakes.FakeNotifier
+		resumed      chan struct{}
+
+		state *execfakes.FakeRunState
+
+		breakpoint atc.Breakpoint
+		timeout    time.Duration
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeDelegate = new(execfakes.FakeBreakpointDelegate)
+
+		resumed = make(chan struct{}, 1)
+		fakeNotifier = new(dbfakes.FakeNotifier)
+		fakeNotifier.NotifyReturns(resumed)
+		fakeDelegate.PausedReturns(fakeNotifier, nil)
+
+		state = new(execfakes.FakeRunState)
+
+		breakpoint = atc.BreakpointAfter
+		timeout = 0
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = Breakpoint(fakeStep, breakpoint, timeout, fakeDelegate)
+		stepErr = step.Run(ctx, state)
+	})
+
+	Context("when the build is resumed", func() {
+		BeforeEach(func() {
+			resumed <- struct{}{}
+		})
+
+		It("runs the step before pausing", func() {
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+			Expect(fakeDelegate.PausedCallCount()).To(Equal(1))
+		})
+
+		It("tells the delegate it was resumed", func() {
+			Expect(fakeDelegate.ResumedCallCount()).To(Equal(1))
+		})
+
+		It("closes the notifier", func() {
+			Expect(fakeNotifier.CloseCallCount()).To(Equal(1))
+		})
+
+		It("returns nil", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+		})
+
+		Context("when the step succeeded", func() {
+			BeforeEach(func() {
+				fakeStep.SucceededReturns(true)
+			})
+
+			It("succeeds", func() {
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+		})
+
+		Context("when the step failed", func() {
+			BeforeEach(func() {
+				fakeStep.SucceededReturns(false)
+			})
+
+			It("still pauses", func() {
+				Expect(fakeDelegate.PausedCallCount()).To(Equal(1))
+			})
+
+			It("fails", func() {
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+		})
+
+		Context("when the step errored", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeStep.RunReturns(disaster)
+			})
+
+			It("still pauses", func() {
+				Expect(fakeDelegate.PausedCallCount()).To(Equal(1))
+			})
+
+			It("returns the step's error once resumed", func() {
+				Expect(stepErr).To(Equal(disaster))
+			})
+		})
+	})
+
+	Context("when the build is aborted while paused", func() {
+		BeforeEach(func() {
+			fakeDelegate.PausedStub = func(lager.Logger) (db.Notifier, error) {
+				cancel()
+				return fakeNotifier, nil
+			}
+		})
+
+		It("returns the context's error", func() {
+			Expect(stepErr).To(Equal(context.Canceled))
+		})
+
+		It("does not tell the delegate it was resumed", func() {
+			Expect(fakeDelegate.ResumedCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the build is aborted while the step runs", func() {
+		BeforeEach(func() {
+			fakeStep.RunStub = func(context.Context, RunState) error {
+				cancel()
+				return nil
+			}
+		})
+
+		It("does not pause", func() {
+			Expect(fakeDelegate.PausedCallCount()).To(BeZero())
+		})
+
+		It("returns the context's error", func() {
+			Expect(stepErr).To(Equal(context.Canceled))
+		})
+	})
+
+	Context("when pausing the build fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeDelegate.PausedReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+		})
+	})
+
+	Context("when the build stays paused for longer than the timeout", func() {
+		BeforeEach(func() {
+			timeout = 10 * time.Millisecond
+		})
+
+		It("tells the delegate it timed out", func() {
+			Expect(fakeDelegate.TimedOutCallCount()).To(Equal(1))
+			Expect(fakeDelegate.ResumedCallCount()).To(BeZero())
+		})
+
+		It("closes the notifier", func() {
+			Expect(fakeNotifier.CloseCallCount()).To(Equal(1))
+		})
+
+		It("returns nil", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+		})
+
+		Context("when resuming the build fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeDelegate.TimedOutReturns(disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(stepErr).To(Equal(disaster))
+			})
+		})
+	})
+
+	Context("when the breakpoint is before the step", func() {
+		var runsBeforePause int
+
+		BeforeEach(func() {
+			breakpoint = atc.BreakpointBefore
+
+			runsBeforePause = -1
+			fakeDelegate.PausedStub = func(lager.Logger) (db.Notifier, error) {
+				runsBeforePause = fakeStep.RunCallCount()
+				return fakeNotifier, nil
+			}
+		})
+
+		Context("when the build is resumed", func() {
+			BeforeEach(func() {
+				resumed <- struct{}{}
+			})
+
+			It("pauses before running the step", func() {
+				Expect(runsBeforePause).To(BeZero())
+				Expect(fakeStep.RunCallCount()).To(Equal(1))
+			})
+
+			It("tells the delegate it was resumed", func() {
+				Expect(fakeDelegate.ResumedCallCount()).To(Equal(1))
+			})
+
+			Context("when the step errors", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeStep.RunReturns(disaster)
+				})
+
+				It("returns the step's error", func() {
+					Expect(stepErr).To(Equal(disaster))
+				})
+			})
+		})
+
+		Context("when the build stays paused for longer than the timeout", func() {
+			BeforeEach(func() {
+				timeout = 10 * time.Millisecond
+			})
+
+			It("runs the step once it times out", func() {
+				Expect(fakeDelegate.TimedOutCallCount()).To(Equal(1))
+				Expect(fakeStep.RunCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the build is aborted while paused", func() {
+			BeforeEach(func() {
+				fakeDelegate.PausedStub = func(lager.Logger) (db.Notifier, error) {
+					cancel()
+					return fakeNotifier, nil
+				}
+			})
+
+			It("does not run the step", func() {
+				Expect(fakeStep.RunCallCount()).To(BeZero())
+			})
+
+			It("returns the context's error", func() {
+				Expect(stepErr).To(Equal(context.Canceled))
+			})
+		})
+	})
+})
@@ -21,6 +21,8 @@ type ArtifactName string
 type Repository struct {
 	repo  map[ArtifactName]runtime.Artifact
 	repoL sync.RWMutex
+
+	parent *Repository
 }
 
 // NewArtifactRepository constructs a new repository.
@@ -30,6 +32,15 @@ func NewRepository() *Repository {
 	}
 }
 
+// NewLocalScope constructs a repository which can see the artifacts of this
+// one, and which registers artifacts in this one too. Unlike this one, it
+// knows which artifacts were registered through it.
+func (repo *Repository) NewLocalScope() *Repository {
+	child := NewRepository()
+	child.parent = repo
+	return child
+}
+
 //go:generate counterfeiter . RegisterableArtifact
 // A RegisterableArtifact is an Artifact which can be added to the registry
 type RegisterableArtifact interface {
@@ -43,6 +54,10 @@ func (repo *Repository) RegisterArtifact(name ArtifactName, artifact Registerabl
 	repo.repoL.Lock()
 	repo.repo[name] = artifact
 	repo.repoL.Unlock()
+
+	if repo.parent != nil {
+		repo.parent.RegisterArtifact(name, artifact)
+	}
 }
 
 // SourceFor looks up a Source for the given ArtifactName. Consumers of
@@ -51,6 +66,11 @@ func (repo *Repository) ArtifactFor(name ArtifactName) (runtime.Artifact, bool)
 	repo.repoL.RLock()
 	artifact, found := repo.repo[name]
 	repo.repoL.RUnlock()
+
+	if !found && repo.parent != nil {
+		return repo.parent.ArtifactFor(name)
+	}
+
 	return artifact, found
 }
 
@@ -60,6 +80,24 @@ func (repo *Repository) ArtifactFor(name ArtifactName) (runtime.Artifact, bool)
 func (repo *Repository) AsMap() map[ArtifactName]runtime.Artifact {
 	result := make(map[ArtifactName]runtime.Artifact)
 
+	if repo.parent != nil {
+		result = repo.parent.AsMap()
+	}
+
+	repo.repoL.RLock()
+	for name, artifact := range repo.repo {
+		result[name] = artifact
+	}
+	repo.repoL.RUnlock()
+
+	return result
+}
+
+// LocalArtifacts returns the artifacts registered through this repository,
+// leaving out those it can only see through its parent.
+func (repo *Repository) LocalArtifacts() map[ArtifactName]runtime.Artifact {
+	result := make(map[ArtifactName]runtime.Artifact)
+
 	repo.repoL.RLock()
 	for name, artifact := range repo.repo {
 		result[name] = artifact
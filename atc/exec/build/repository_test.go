@@ -2,6 +2,7 @@ package build_test
 
 import (
 	. "github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
 
 	. "github.com/onsi/ginkgo"
@@ -78,4 +79,49 @@ var _ = Describe("ArtifactRepository", func() {
 		})
 
 	})
+
+	Describe("NewLocalScope", func() {
+		var (
+			child          *Repository
+			parentArtifact *runtimefakes.FakeArtifact
+			childArtifact  *runtimefakes.FakeArtifact
+		)
+
+		BeforeEach(func() {
+			parentArtifact = new(runtimefakes.FakeArtifact)
+			parentArtifact.IDReturns("some-parent")
+			repo.RegisterArtifact("parent-artifact", parentArtifact)
+
+			child = repo.NewLocalScope()
+
+			childArtifact = new(runtimefakes.FakeArtifact)
+			childArtifact.IDReturns("some-child")
+			child.RegisterArtifact("child-artifact", childArtifact)
+		})
+
+		It("can see the artifacts of the parent", func() {
+			artifact, found := child.ArtifactFor("parent-artifact")
+			Expect(artifact).To(Equal(parentArtifact))
+			Expect(found).To(BeTrue())
+		})
+
+		It("registers artifacts in the parent too", func() {
+			artifact, found := repo.ArtifactFor("child-artifact")
+			Expect(artifact).To(Equal(childArtifact))
+			Expect(found).To(BeTrue())
+		})
+
+		It("includes the parent's artifacts in its map", func() {
+			Expect(child.AsMap()).To(Equal(map[ArtifactName]runtime.Artifact{
+				"parent-artifact": parentArtifact,
+				"child-artifact":  childArtifact,
+			}))
+		})
+
+		It("only returns its own artifacts as local artifacts", func() {
+			Expect(child.LocalArtifacts()).To(Equal(map[ArtifactName]runtime.Artifact{
+				"child-artifact": childArtifact,
+			}))
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package exec
 
 import (
+	"context"
 	"github.com/concourse/concourse/vars"
 	"io"
 
@@ -22,4 +23,8 @@ type BuildStepDelegate interface {
 	Starting(lager.Logger)
 	Finished(lager.Logger, bool)
 	Errored(lager.Logger, string)
+
+	// WatchOutput blocks until the context is done or the step's output has
+	// violated its limits, in which case the violation is returned.
+	WatchOutput(context.Context) error
 }
@@ -0,0 +1,228 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package execfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec"
+)
+
+type FakeBreakpointDelegate struct {
+	PausedStub        func(lager.Logger) (db.Notifier, error)
+	pausedMutex       sync.RWMutex
+	pausedArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	pausedReturns struct {
+		result1 db.Notifier
+		result2 error
+	}
+	pausedReturnsOnCall map[int]struct {
+		result1 db.Notifier
+		result2 error
+	}
+	ResumedStub        func(lager.Logger)
+	resumedMutex       sync.RWMutex
+	resumedArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	TimedOutStub        func(lager.Logger) error
+	timedOutMutex       sync.RWMutex
+	timedOutArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	timedOutReturns struct {
+		result1 error
+	}
+	timedOutReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBreakpointDelegate) Paused(arg1 lager.Logger) (db.Notifier, error) {
+	fake.pausedMutex.Lock()
+	ret, specificReturn := fake.pausedReturnsOnCall[len(fake.pausedArgsForCall)]
+	fake.pausedArgsForCall = append(fake.pausedArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	fake.recordInvocation("Paused", []interface{}{arg1})
+	fake.pausedMutex.Unlock()
+	if fake.PausedStub != nil {
+		return fake.PausedStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pausedReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBreakpointDelegate) PausedCallCount() int {
+	fake.pausedMutex.RLock()
+	defer fake.pausedMutex.RUnlock()
+	return len(fake.pausedArgsForCall)
+}
+
+func (fake *FakeBreakpointDelegate) PausedCalls(stub func(lager.Logger) (db.Notifier, error)) {
+	fake.pausedMutex.Lock()
+	defer fake.pausedMutex.Unlock()
+	fake.PausedStub = stub
+}
+
+func (fake *FakeBreakpointDelegate) PausedArgsForCall(i int) lager.Logger {
+	fake.pausedMutex.RLock()
+	defer fake.pausedMutex.RUnlock()
+	argsForCall := fake.pausedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBreakpointDelegate) PausedReturns(result1 db.Notifier, result2 error) {
+	fake.pausedMutex.Lock()
+	defer fake.pausedMutex.Unlock()
+	fake.PausedStub = nil
+	fake.pausedReturns = struct {
+		result1 db.Notifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBreakpointDelegate) PausedReturnsOnCall(i int, result1 db.Notifier, result2 error) {
+	fake.pausedMutex.Lock()
+	defer fake.pausedMutex.Unlock()
+	fake.PausedStub = nil
+	if fake.pausedReturnsOnCall == nil {
+		fake.pausedReturnsOnCall = make(map[int]struct {
+			result1 db.Notifier
+			result2 error
+		})
+	}
+	fake.pausedReturnsOnCall[i] = struct {
+		result1 db.Notifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBreakpointDelegate) Resumed(arg1 lager.Logger) {
+	fake.resumedMutex.Lock()
+	fake.resumedArgsForCall = append(fake.resumedArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	fake.recordInvocation("Resumed", []interface{}{arg1})
+	fake.resumedMutex.Unlock()
+	if fake.ResumedStub != nil {
+		fake.ResumedStub(arg1)
+	}
+}
+
+func (fake *FakeBreakpointDelegate) ResumedCallCount() int {
+	fake.resumedMutex.RLock()
+	defer fake.resumedMutex.RUnlock()
+	return len(fake.resumedArgsForCall)
+}
+
+func (fake *FakeBreakpointDelegate) ResumedCalls(stub func(lager.Logger)) {
+	fake.resumedMutex.Lock()
+	defer fake.resumedMutex.Unlock()
+	fake.ResumedStub = stub
+}
+
+func (fake *FakeBreakpointDelegate) ResumedArgsForCall(i int) lager.Logger {
+	fake.resumedMutex.RLock()
+	defer fake.resumedMutex.RUnlock()
+	argsForCall := fake.resumedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBreakpointDelegate) TimedOut(arg1 lager.Logger) error {
+	fake.timedOutMutex.Lock()
+	ret, specificReturn := fake.timedOutReturnsOnCall[len(fake.timedOutArgsForCall)]
+	fake.timedOutArgsForCall = append(fake.timedOutArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	fake.recordInvocation("TimedOut", []interface{}{arg1})
+	fake.timedOutMutex.Unlock()
+	if fake.TimedOutStub != nil {
+		return fake.TimedOutStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.timedOutReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBreakpointDelegate) TimedOutCallCount() int {
+	fake.timedOutMutex.RLock()
+	defer fake.timedOutMutex.RUnlock()
+	return len(fake.timedOutArgsForCall)
+}
+
+func (fake *FakeBreakpointDelegate) TimedOutCalls(stub func(lager.Logger) error) {
+	fake.timedOutMutex.Lock()
+	defer fake.timedOutMutex.Unlock()
+	fake.TimedOutStub = stub
+}
+
+func (fake *FakeBreakpointDelegate) TimedOutArgsForCall(i int) lager.Logger {
+	fake.timedOutMutex.RLock()
+	defer fake.timedOutMutex.RUnlock()
+	argsForCall := fake.timedOutArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBreakpointDelegate) TimedOutReturns(result1 error) {
+	fake.timedOutMutex.Lock()
+	defer fake.timedOutMutex.Unlock()
+	fake.TimedOutStub = nil
+	fake.timedOutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBreakpointDelegate) TimedOutReturnsOnCall(i int, result1 error) {
+	fake.timedOutMutex.Lock()
+	defer fake.timedOutMutex.Unlock()
+	fake.TimedOutStub = nil
+	if fake.timedOutReturnsOnCall == nil {
+		fake.timedOutReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.timedOutReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBreakpointDelegate) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pausedMutex.RLock()
+	defer fake.pausedMutex.RUnlock()
+	fake.resumedMutex.RLock()
+	defer fake.resumedMutex.RUnlock()
+	fake.timedOutMutex.RLock()
+	defer fake.timedOutMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBreakpointDelegate) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ exec.BreakpointDelegate = new(FakeBreakpointDelegate)
@@ -2,6 +2,7 @@
 package execfakes
 
 import (
+	"context"
 	"io"
 	"sync"
 
@@ -75,6 +76,17 @@ type FakeBuildStepDelegate struct {
 	variablesReturnsOnCall map[int]struct {
 		result1 vars.CredVarsTracker
 	}
+	WatchOutputStub        func(context.Context) error
+	watchOutputMutex       sync.RWMutex
+	watchOutputArgsForCall []struct {
+		arg1 context.Context
+	}
+	watchOutputReturns struct {
+		result1 error
+	}
+	watchOutputReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -421,6 +433,66 @@ func (fake *FakeBuildStepDelegate) VariablesReturnsOnCall(i int, result1 vars.Cr
 	}{result1}
 }
 
+func (fake *FakeBuildStepDelegate) WatchOutput(arg1 context.Context) error {
+	fake.watchOutputMutex.Lock()
+	ret, specificReturn := fake.watchOutputReturnsOnCall[len(fake.watchOutputArgsForCall)]
+	fake.watchOutputArgsForCall = append(fake.watchOutputArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("WatchOutput", []interface{}{arg1})
+	fake.watchOutputMutex.Unlock()
+	if fake.WatchOutputStub != nil {
+		return fake.WatchOutputStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.watchOutputReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildStepDelegate) WatchOutputCallCount() int {
+	fake.watchOutputMutex.RLock()
+	defer fake.watchOutputMutex.RUnlock()
+	return len(fake.watchOutputArgsForCall)
+}
+
+func (fake *FakeBuildStepDelegate) WatchOutputCalls(stub func(context.Context) error) {
+	fake.watchOutputMutex.Lock()
+	defer fake.watchOutputMutex.Unlock()
+	fake.WatchOutputStub = stub
+}
+
+func (fake *FakeBuildStepDelegate) WatchOutputArgsForCall(i int) context.Context {
+	fake.watchOutputMutex.RLock()
+	defer fake.watchOutputMutex.RUnlock()
+	argsForCall := fake.watchOutputArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildStepDelegate) WatchOutputReturns(result1 error) {
+	fake.watchOutputMutex.Lock()
+	defer fake.watchOutputMutex.Unlock()
+	fake.WatchOutputStub = nil
+	fake.watchOutputReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildStepDelegate) WatchOutputReturnsOnCall(i int, result1 error) {
+	fake.watchOutputMutex.Lock()
+	defer fake.watchOutputMutex.Unlock()
+	fake.WatchOutputStub = nil
+	if fake.watchOutputReturnsOnCall == nil {
+		fake.watchOutputReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.watchOutputReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuildStepDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -440,6 +512,8 @@ func (fake *FakeBuildStepDelegate) Invocations() map[string][][]interface{} {
 	defer fake.stdoutMutex.RUnlock()
 	fake.variablesMutex.RLock()
 	defer fake.variablesMutex.RUnlock()
+	fake.watchOutputMutex.RLock()
+	defer fake.watchOutputMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
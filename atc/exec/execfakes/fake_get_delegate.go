@@ -2,6 +2,7 @@
 package execfakes
 
 import (
+	"context"
 	"io"
 	"sync"
 
@@ -85,6 +86,17 @@ type FakeGetDelegate struct {
 	variablesReturnsOnCall map[int]struct {
 		result1 vars.CredVarsTracker
 	}
+	WatchOutputStub        func(context.Context) error
+	watchOutputMutex       sync.RWMutex
+	watchOutputArgsForCall []struct {
+		arg1 context.Context
+	}
+	watchOutputReturns struct {
+		result1 error
+	}
+	watchOutputReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -465,6 +477,66 @@ func (fake *FakeGetDelegate) VariablesReturnsOnCall(i int, result1 vars.CredVars
 	}{result1}
 }
 
+func (fake *FakeGetDelegate) WatchOutput(arg1 context.Context) error {
+	fake.watchOutputMutex.Lock()
+	ret, specificReturn := fake.watchOutputReturnsOnCall[len(fake.watchOutputArgsForCall)]
+	fake.watchOutputArgsForCall = append(fake.watchOutputArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("WatchOutput", []interface{}{arg1})
+	fake.watchOutputMutex.Unlock()
+	if fake.WatchOutputStub != nil {
+		return fake.WatchOutputStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.watchOutputReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeGetDelegate) WatchOutputCallCount() int {
+	fake.watchOutputMutex.RLock()
+	defer fake.watchOutputMutex.RUnlock()
+	return len(fake.watchOutputArgsForCall)
+}
+
+func (fake *FakeGetDelegate) WatchOutputCalls(stub func(context.Context) error) {
+	fake.watchOutputMutex.Lock()
+	defer fake.watchOutputMutex.Unlock()
+	fake.WatchOutputStub = stub
+}
+
+func (fake *FakeGetDelegate) WatchOutputArgsForCall(i int) context.Context {
+	fake.watchOutputMutex.RLock()
+	defer fake.watchOutputMutex.RUnlock()
+	argsForCall := fake.watchOutputArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGetDelegate) WatchOutputReturns(result1 error) {
+	fake.watchOutputMutex.Lock()
+	defer fake.watchOutputMutex.Unlock()
+	fake.WatchOutputStub = nil
+	fake.watchOutputReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGetDelegate) WatchOutputReturnsOnCall(i int, result1 error) {
+	fake.watchOutputMutex.Lock()
+	defer fake.watchOutputMutex.Unlock()
+	fake.WatchOutputStub = nil
+	if fake.watchOutputReturnsOnCall == nil {
+		fake.watchOutputReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.watchOutputReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGetDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -486,6 +558,8 @@ func (fake *FakeGetDelegate) Invocations() map[string][][]interface{} {
 	defer fake.updateVersionMutex.RUnlock()
 	fake.variablesMutex.RLock()
 	defer fake.variablesMutex.RUnlock()
+	fake.watchOutputMutex.RLock()
+	defer fake.watchOutputMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
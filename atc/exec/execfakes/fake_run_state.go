@@ -20,6 +20,16 @@ type FakeRunState struct {
 	artifactRepositoryReturnsOnCall map[int]struct {
 		result1 *build.Repository
 	}
+	NewLocalScopeStub        func() exec.RunState
+	newLocalScopeMutex       sync.RWMutex
+	newLocalScopeArgsForCall []struct {
+	}
+	newLocalScopeReturns struct {
+		result1 exec.RunState
+	}
+	newLocalScopeReturnsOnCall map[int]struct {
+		result1 exec.RunState
+	}
 	ResultStub        func(atc.PlanID, interface{}) bool
 	resultMutex       sync.RWMutex
 	resultArgsForCall []struct {
@@ -94,6 +104,58 @@ func (fake *FakeRunState) ArtifactRepositoryReturnsOnCall(i int, result1 *build.
 	}{result1}
 }
 
+func (fake *FakeRunState) NewLocalScope() exec.RunState {
+	fake.newLocalScopeMutex.Lock()
+	ret, specificReturn := fake.newLocalScopeReturnsOnCall[len(fake.newLocalScopeArgsForCall)]
+	fake.newLocalScopeArgsForCall = append(fake.newLocalScopeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("NewLocalScope", []interface{}{})
+	fake.newLocalScopeMutex.Unlock()
+	if fake.NewLocalScopeStub != nil {
+		return fake.NewLocalScopeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.newLocalScopeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeRunState) NewLocalScopeCallCount() int {
+	fake.newLocalScopeMutex.RLock()
+	defer fake.newLocalScopeMutex.RUnlock()
+	return len(fake.newLocalScopeArgsForCall)
+}
+
+func (fake *FakeRunState) NewLocalScopeCalls(stub func() exec.RunState) {
+	fake.newLocalScopeMutex.Lock()
+	defer fake.newLocalScopeMutex.Unlock()
+	fake.NewLocalScopeStub = stub
+}
+
+func (fake *FakeRunState) NewLocalScopeReturns(result1 exec.RunState) {
+	fake.newLocalScopeMutex.Lock()
+	defer fake.newLocalScopeMutex.Unlock()
+	fake.NewLocalScopeStub = nil
+	fake.newLocalScopeReturns = struct {
+		result1 exec.RunState
+	}{result1}
+}
+
+func (fake *FakeRunState) NewLocalScopeReturnsOnCall(i int, result1 exec.RunState) {
+	fake.newLocalScopeMutex.Lock()
+	defer fake.newLocalScopeMutex.Unlock()
+	fake.NewLocalScopeStub = nil
+	if fake.newLocalScopeReturnsOnCall == nil {
+		fake.newLocalScopeReturnsOnCall = make(map[int]struct {
+			result1 exec.RunState
+		})
+	}
+	fake.newLocalScopeReturnsOnCall[i] = struct {
+		result1 exec.RunState
+	}{result1}
+}
+
 func (fake *FakeRunState) Result(arg1 atc.PlanID, arg2 interface{}) bool {
 	fake.resultMutex.Lock()
 	ret, specificReturn := fake.resultReturnsOnCall[len(fake.resultArgsForCall)]
@@ -192,6 +254,8 @@ func (fake *FakeRunState) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.artifactRepositoryMutex.RLock()
 	defer fake.artifactRepositoryMutex.RUnlock()
+	fake.newLocalScopeMutex.RLock()
+	defer fake.newLocalScopeMutex.RUnlock()
 	fake.resultMutex.RLock()
 	defer fake.resultMutex.RUnlock()
 	fake.storeResultMutex.RLock()
@@ -2,6 +2,7 @@
 package execfakes
 
 import (
+	"context"
 	"io"
 	"sync"
 
@@ -41,6 +42,19 @@ type FakeTaskDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	ServiceOutputStub        func(string) (io.Writer, io.Writer)
+	serviceOutputMutex       sync.RWMutex
+	serviceOutputArgsForCall []struct {
+		arg1 string
+	}
+	serviceOutputReturns struct {
+		result1 io.Writer
+		result2 io.Writer
+	}
+	serviceOutputReturnsOnCall map[int]struct {
+		result1 io.Writer
+		result2 io.Writer
+	}
 	SetTaskConfigStub        func(atc.TaskConfig)
 	setTaskConfigMutex       sync.RWMutex
 	setTaskConfigArgsForCall []struct {
@@ -81,6 +95,17 @@ type FakeTaskDelegate struct {
 	variablesReturnsOnCall map[int]struct {
 		result1 vars.CredVarsTracker
 	}
+	WatchOutputStub        func(context.Context) error
+	watchOutputMutex       sync.RWMutex
+	watchOutputArgsForCall []struct {
+		arg1 context.Context
+	}
+	watchOutputReturns struct {
+		result1 error
+	}
+	watchOutputReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -240,6 +265,69 @@ func (fake *FakeTaskDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeTaskDelegate) ServiceOutput(arg1 string) (io.Writer, io.Writer) {
+	fake.serviceOutputMutex.Lock()
+	ret, specificReturn := fake.serviceOutputReturnsOnCall[len(fake.serviceOutputArgsForCall)]
+	fake.serviceOutputArgsForCall = append(fake.serviceOutputArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ServiceOutput", []interface{}{arg1})
+	fake.serviceOutputMutex.Unlock()
+	if fake.ServiceOutputStub != nil {
+		return fake.ServiceOutputStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.serviceOutputReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskDelegate) ServiceOutputCallCount() int {
+	fake.serviceOutputMutex.RLock()
+	defer fake.serviceOutputMutex.RUnlock()
+	return len(fake.serviceOutputArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) ServiceOutputCalls(stub func(string) (io.Writer, io.Writer)) {
+	fake.serviceOutputMutex.Lock()
+	defer fake.serviceOutputMutex.Unlock()
+	fake.ServiceOutputStub = stub
+}
+
+func (fake *FakeTaskDelegate) ServiceOutputArgsForCall(i int) string {
+	fake.serviceOutputMutex.RLock()
+	defer fake.serviceOutputMutex.RUnlock()
+	argsForCall := fake.serviceOutputArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTaskDelegate) ServiceOutputReturns(result1 io.Writer, result2 io.Writer) {
+	fake.serviceOutputMutex.Lock()
+	defer fake.serviceOutputMutex.Unlock()
+	fake.ServiceOutputStub = nil
+	fake.serviceOutputReturns = struct {
+		result1 io.Writer
+		result2 io.Writer
+	}{result1, result2}
+}
+
+func (fake *FakeTaskDelegate) ServiceOutputReturnsOnCall(i int, result1 io.Writer, result2 io.Writer) {
+	fake.serviceOutputMutex.Lock()
+	defer fake.serviceOutputMutex.Unlock()
+	fake.ServiceOutputStub = nil
+	if fake.serviceOutputReturnsOnCall == nil {
+		fake.serviceOutputReturnsOnCall = make(map[int]struct {
+			result1 io.Writer
+			result2 io.Writer
+		})
+	}
+	fake.serviceOutputReturnsOnCall[i] = struct {
+		result1 io.Writer
+		result2 io.Writer
+	}{result1, result2}
+}
+
 func (fake *FakeTaskDelegate) SetTaskConfig(arg1 atc.TaskConfig) {
 	fake.setTaskConfigMutex.Lock()
 	fake.setTaskConfigArgsForCall = append(fake.setTaskConfigArgsForCall, struct {
@@ -458,6 +546,66 @@ func (fake *FakeTaskDelegate) VariablesReturnsOnCall(i int, result1 vars.CredVar
 	}{result1}
 }
 
+func (fake *FakeTaskDelegate) WatchOutput(arg1 context.Context) error {
+	fake.watchOutputMutex.Lock()
+	ret, specificReturn := fake.watchOutputReturnsOnCall[len(fake.watchOutputArgsForCall)]
+	fake.watchOutputArgsForCall = append(fake.watchOutputArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("WatchOutput", []interface{}{arg1})
+	fake.watchOutputMutex.Unlock()
+	if fake.WatchOutputStub != nil {
+		return fake.WatchOutputStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.watchOutputReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDelegate) WatchOutputCallCount() int {
+	fake.watchOutputMutex.RLock()
+	defer fake.watchOutputMutex.RUnlock()
+	return len(fake.watchOutputArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) WatchOutputCalls(stub func(context.Context) error) {
+	fake.watchOutputMutex.Lock()
+	defer fake.watchOutputMutex.Unlock()
+	fake.WatchOutputStub = stub
+}
+
+func (fake *FakeTaskDelegate) WatchOutputArgsForCall(i int) context.Context {
+	fake.watchOutputMutex.RLock()
+	defer fake.watchOutputMutex.RUnlock()
+	argsForCall := fake.watchOutputArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTaskDelegate) WatchOutputReturns(result1 error) {
+	fake.watchOutputMutex.Lock()
+	defer fake.watchOutputMutex.Unlock()
+	fake.WatchOutputStub = nil
+	fake.watchOutputReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) WatchOutputReturnsOnCall(i int, result1 error) {
+	fake.watchOutputMutex.Lock()
+	defer fake.watchOutputMutex.Unlock()
+	fake.WatchOutputStub = nil
+	if fake.watchOutputReturnsOnCall == nil {
+		fake.watchOutputReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.watchOutputReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -469,6 +617,8 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.serviceOutputMutex.RLock()
+	defer fake.serviceOutputMutex.RUnlock()
 	fake.setTaskConfigMutex.RLock()
 	defer fake.setTaskConfigMutex.RUnlock()
 	fake.startingMutex.RLock()
@@ -479,6 +629,8 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.stdoutMutex.RUnlock()
 	fake.variablesMutex.RLock()
 	defer fake.variablesMutex.RUnlock()
+	fake.watchOutputMutex.RLock()
+	defer fake.watchOutputMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
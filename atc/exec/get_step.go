@@ -48,6 +48,7 @@ type GetDelegate interface {
 	Starting(lager.Logger)
 	Finished(lager.Logger, ExitStatus, runtime.VersionResult)
 	Errored(lager.Logger, string)
+	WatchOutput(context.Context) error
 
 	UpdateVersion(lager.Logger, atc.GetPlan, runtime.VersionResult)
 }
@@ -171,6 +172,8 @@ func (step *GetStep) run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	containerSpec.ResourceCache = resourceCache
+
 	processSpec := runtime.ProcessSpec{
 		Path:         "/opt/resource/in",
 		Args:         []string{resource.ResourcesDir("get")},
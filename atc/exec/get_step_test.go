@@ -184,8 +184,9 @@ var _ = Describe("GetStep", func() {
 				ImageSpec: worker.ImageSpec{
 					ResourceType: "some-resource-type",
 				},
-				TeamID: stepMetadata.TeamID,
-				Env:    stepMetadata.Env(),
+				TeamID:        stepMetadata.TeamID,
+				Env:           stepMetadata.Env(),
+				ResourceCache: fakeResourceCache,
 			},
 		))
 	})
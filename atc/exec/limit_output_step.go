@@ -0,0 +1,65 @@
+package exec
+
+import (
+	"context"
+)
+
+type OutputWatcher interface {
+	WatchOutput(context.Context) error
+}
+
+// LimitOutputStep interrupts a step once its output, or that of the steps
+// within it, violates the limits enforced by the watcher, e.g. by going silent
+// for too long.
+type LimitOutputStep struct {
+	step     Step
+	watcher  OutputWatcher
+	violated bool
+}
+
+// LimitOutput constructs a LimitOutputStep.
+func LimitOutput(step Step, watcher OutputWatcher) *LimitOutputStep {
+	return &LimitOutputStep{
+		step:    step,
+		watcher: watcher,
+	}
+}
+
+// Run invokes the nested step while watching its output.
+//
+// If the output violates a limit, the nested step is interrupted, and the
+// LimitOutputStep returns nil once the nested step exits (ignoring the nested
+// step's error). The watcher is responsible for reporting the violation.
+//
+// Otherwise, the result of the nested step's Run is returned.
+func (ls *LimitOutputStep) Run(ctx context.Context, state RunState) error {
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	violations := make(chan error, 1)
+	go func() {
+		err := ls.watcher.WatchOutput(watchCtx)
+		if err != nil {
+			cancel()
+		}
+
+		violations <- err
+	}()
+
+	err := ls.step.Run(watchCtx, state)
+
+	cancel()
+
+	if <-violations != nil {
+		ls.violated = true
+		return nil
+	}
+
+	return err
+}
+
+// Succeeded is true if the nested step completed successfully and its output
+// did not violate any limits.
+func (ls *LimitOutputStep) Succeeded() bool {
+	return !ls.violated && ls.step.Succeeded()
+}
@@ -0,0 +1,113 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LimitOutput Step", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStep     *execfakes.FakeStep
+		fakeDelegate *execfakes.FakeBuildStepDelegate
+
+		repo  *build.Repository
+		state *execfakes.FakeRunState
+
+		step Step
+
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegate.WatchOutputStub = func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		}
+
+		repo = build.NewRepository()
+		state = new(execfakes.FakeRunState)
+		state.ArtifactRepositoryReturns(repo)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = LimitOutput(fakeStep, fakeDelegate)
+		stepErr = step.Run(ctx, state)
+	})
+
+	Context("when the output stays within its limits", func() {
+		BeforeEach(func() {
+			fakeStep.SucceededReturns(true)
+		})
+
+		It("runs the step", func() {
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+			Expect(stepErr).ToNot(HaveOccurred())
+		})
+
+		It("stops watching the output once the step is done", func() {
+			Expect(fakeDelegate.WatchOutputCallCount()).To(Equal(1))
+			watchCtx := fakeDelegate.WatchOutputArgsForCall(0)
+			Expect(watchCtx.Err()).To(Equal(context.Canceled))
+		})
+
+		It("is successful", func() {
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+
+		Context("when the step returns an error", func() {
+			var someError error
+
+			BeforeEach(func() {
+				someError = errors.New("some error")
+				fakeStep.RunReturns(someError)
+			})
+
+			It("returns the error", func() {
+				Expect(stepErr).To(Equal(someError))
+			})
+		})
+	})
+
+	Context("when the output violates a limit", func() {
+		BeforeEach(func() {
+			fakeDelegate.WatchOutputReturns(errors.New("no output for 1m0s"))
+			fakeDelegate.WatchOutputStub = nil
+
+			fakeStep.SucceededReturns(true)
+			fakeStep.RunStub = func(ctx context.Context, state RunState) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+		})
+
+		It("interrupts the step", func() {
+			runCtx, _ := fakeStep.RunArgsForCall(0)
+			Expect(runCtx.Err()).To(Equal(context.Canceled))
+		})
+
+		It("returns no error", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+		})
+
+		It("is not successful", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+})
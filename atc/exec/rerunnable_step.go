@@ -0,0 +1,135 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+// RerunnableStep records what a get, put or task step produced once it
+// succeeds, so that a later rerun of the build from a subsequent step can
+// reuse it.
+//
+// When given the record of the same step from the build being rerun, the
+// nested step is not run at all. Instead, the artifacts and result recorded
+// for it are restored.
+type RerunnableStep struct {
+	step     Step
+	planID   atc.PlanID
+	record   db.BuildStep
+	reused   *db.BuildStep
+	build    db.Build
+	delegate BuildStepDelegate
+
+	succeeded bool
+}
+
+// Rerunnable constructs a RerunnableStep. The type and name of the given
+// record identify the step; the rest of it is filled in once it succeeds.
+func Rerunnable(
+	step Step,
+	planID atc.PlanID,
+	record db.BuildStep,
+	reused *db.BuildStep,
+	build db.Build,
+	delegate BuildStepDelegate,
+) *RerunnableStep {
+	return &RerunnableStep{
+		step:     step,
+		planID:   planID,
+		record:   record,
+		reused:   reused,
+		build:    build,
+		delegate: delegate,
+	}
+}
+
+// Run either restores the reused step or runs the nested step, recording what
+// it produced if it succeeds.
+//
+// Failing to record a step does not fail the build; it only means the step
+// cannot be reused later.
+func (step *RerunnableStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).WithData(lager.Data{
+		"step-type": step.record.Type,
+		"step-name": step.record.Name,
+	})
+
+	if step.reused != nil {
+		return step.reuse(logger, state)
+	}
+
+	localState := state.NewLocalScope()
+
+	err := step.step.Run(ctx, localState)
+	if err != nil {
+		return err
+	}
+
+	step.succeeded = step.step.Succeeded()
+	if !step.succeeded {
+		return nil
+	}
+
+	record := step.record
+	record.Artifacts = map[string]string{}
+	for name, artifact := range localState.ArtifactRepository().LocalArtifacts() {
+		record.Artifacts[string(name)] = artifact.ID()
+	}
+
+	var result runtime.VersionResult
+	if state.Result(step.planID, &result) {
+		record.Version = result.Version
+		record.Metadata = result.Metadata
+	}
+
+	err = step.build.SaveStep(record)
+	if err != nil {
+		logger.Error("failed-to-save-step", err)
+	}
+
+	return nil
+}
+
+func (step *RerunnableStep) reuse(logger lager.Logger, state RunState) error {
+	step.delegate.Initializing(logger)
+	step.delegate.Starting(logger)
+
+	for name, handle := range step.reused.Artifacts {
+		state.ArtifactRepository().RegisterArtifact(
+			build.ArtifactName(name),
+			&runtime.TaskArtifact{VolumeHandle: handle},
+		)
+	}
+
+	if step.reused.Version != nil {
+		state.StoreResult(step.planID, runtime.VersionResult{
+			Version:  step.reused.Version,
+			Metadata: step.reused.Metadata,
+		})
+	}
+
+	fmt.Fprintf(step.delegate.Stdout(), "\x1b[1mreusing the result of %s %s from the build being rerun\x1b[0m\n", step.record.Type, step.record.Name)
+
+	err := step.build.SaveStep(*step.reused)
+	if err != nil {
+		logger.Error("failed-to-save-step", err)
+	}
+
+	step.succeeded = true
+
+	step.delegate.Finished(logger, true)
+
+	return nil
+}
+
+// Succeeded is true if the step was reused or the nested step succeeded.
+func (step *RerunnableStep) Succeeded() bool {
+	return step.succeeded
+}
@@ -0,0 +1,211 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/runtime"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("RerunnableStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStep  *execfakes.FakeStep
+		fakeBuild *dbfakes.FakeBuild
+		delegate  *execfakes.FakeBuildStepDelegate
+		stdout    *gbytes.Buffer
+
+		reused *db.BuildStep
+		state  exec.RunState
+
+		step    *exec.RerunnableStep
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeBuild = new(dbfakes.FakeBuild)
+
+		stdout = gbytes.NewBuffer()
+		delegate = new(execfakes.FakeBuildStepDelegate)
+		delegate.StdoutReturns(stdout)
+
+		reused = nil
+
+		state = exec.NewRunState()
+		state.ArtifactRepository().RegisterArtifact("some-input", &runtime.TaskArtifact{VolumeHandle: "some-input-handle"})
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.Rerunnable(
+			fakeStep,
+			"some-plan-id",
+			db.BuildStep{Type: db.BuildStepTypePut, Name: "some-put"},
+			reused,
+			fakeBuild,
+			delegate,
+		)
+
+		stepErr = step.Run(ctx, state)
+	})
+
+	Context("when the step is not reused", func() {
+		It("runs the nested step", func() {
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+		})
+
+		It("lets the nested step see the existing artifacts", func() {
+			_, runState := fakeStep.RunArgsForCall(0)
+			artifact, found := runState.ArtifactRepository().ArtifactFor("some-input")
+			Expect(found).To(BeTrue())
+			Expect(artifact.ID()).To(Equal("some-input-handle"))
+		})
+
+		Context("when the nested step succeeds", func() {
+			BeforeEach(func() {
+				fakeStep.RunStub = func(_ context.Context, runState exec.RunState) error {
+					runState.ArtifactRepository().RegisterArtifact("some-output", &runtime.TaskArtifact{VolumeHandle: "some-output-handle"})
+					runState.StoreResult("some-plan-id", runtime.VersionResult{
+						Version:  atc.Version{"some": "version"},
+						Metadata: []atc.MetadataField{{Name: "some", Value: "metadata"}},
+					})
+					return nil
+				}
+				fakeStep.SucceededReturns(true)
+			})
+
+			It("succeeds", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+
+			It("makes the artifacts it registered visible to later steps", func() {
+				artifact, found := state.ArtifactRepository().ArtifactFor("some-output")
+				Expect(found).To(BeTrue())
+				Expect(artifact.ID()).To(Equal("some-output-handle"))
+			})
+
+			It("records the artifacts it registered and its result", func() {
+				Expect(fakeBuild.SaveStepCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveStepArgsForCall(0)).To(Equal(db.BuildStep{
+					Type:      db.BuildStepTypePut,
+					Name:      "some-put",
+					Artifacts: map[string]string{"some-output": "some-output-handle"},
+					Version:   atc.Version{"some": "version"},
+					Metadata:  []atc.MetadataField{{Name: "some", Value: "metadata"}},
+				}))
+			})
+
+			Context("when recording the step fails", func() {
+				BeforeEach(func() {
+					fakeBuild.SaveStepReturns(errors.New("nope"))
+				})
+
+				It("still succeeds", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(step.Succeeded()).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when the nested step fails", func() {
+			BeforeEach(func() {
+				fakeStep.SucceededReturns(false)
+			})
+
+			It("does not succeed", func() {
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+
+			It("does not record the step", func() {
+				Expect(fakeBuild.SaveStepCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the nested step errors", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeStep.RunReturns(disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(stepErr).To(Equal(disaster))
+			})
+
+			It("does not record the step", func() {
+				Expect(fakeBuild.SaveStepCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when the step is reused", func() {
+		BeforeEach(func() {
+			reused = &db.BuildStep{
+				Type:      db.BuildStepTypePut,
+				Name:      "some-put",
+				Artifacts: map[string]string{"some-output": "some-output-handle"},
+				Version:   atc.Version{"some": "version"},
+				Metadata:  []atc.MetadataField{{Name: "some", Value: "metadata"}},
+			}
+		})
+
+		It("does not run the nested step", func() {
+			Expect(fakeStep.RunCallCount()).To(BeZero())
+		})
+
+		It("succeeds", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+
+		It("registers the recorded artifacts", func() {
+			artifact, found := state.ArtifactRepository().ArtifactFor(build.ArtifactName("some-output"))
+			Expect(found).To(BeTrue())
+			Expect(artifact.ID()).To(Equal("some-output-handle"))
+		})
+
+		It("stores the recorded result", func() {
+			var result runtime.VersionResult
+			Expect(state.Result("some-plan-id", &result)).To(BeTrue())
+			Expect(result).To(Equal(runtime.VersionResult{
+				Version:  atc.Version{"some": "version"},
+				Metadata: []atc.MetadataField{{Name: "some", Value: "metadata"}},
+			}))
+		})
+
+		It("records the step for this build too", func() {
+			Expect(fakeBuild.SaveStepCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveStepArgsForCall(0)).To(Equal(*reused))
+		})
+
+		It("tells the user the step was reused", func() {
+			Expect(stdout).To(gbytes.Say("reusing the result of put some-put"))
+		})
+
+		It("reports the step as finished successfully", func() {
+			Expect(delegate.InitializingCallCount()).To(Equal(1))
+			Expect(delegate.StartingCallCount()).To(Equal(1))
+			Expect(delegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded := delegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeTrue())
+		})
+	})
+})
@@ -2,13 +2,29 @@ package exec
 
 import (
 	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 )
 
+//go:generate counterfeiter . RetryDelegate
+
+type RetryDelegate interface {
+	// Retrying is called before each attempt after the first, with how long
+	// the RetryStep is about to wait before making it.
+	Retrying(logger lager.Logger, attempt int, previousStatus atc.BuildStatus, delay time.Duration)
+}
+
 // RetryStep is a step that will run the steps in order until one of them
 // succeeds.
 type RetryStep struct {
 	Attempts    []Step
 	LastAttempt Step
+
+	policy   atc.RetryPolicy
+	delegate RetryDelegate
 }
 
 func Retry(attempts ...Step) Step {
@@ -17,12 +33,34 @@ func Retry(attempts ...Step) Step {
 	}
 }
 
+// RetryWithPolicy constructs a RetryStep which waits in between attempts and
+// decides which outcomes to retry according to the given policy.
+func RetryWithPolicy(policy atc.RetryPolicy, delegate RetryDelegate, attempts ...Step) Step {
+	return &RetryStep{
+		Attempts: attempts,
+		policy:   policy,
+		delegate: delegate,
+	}
+}
+
 // Run iterates through each step, stopping once a step succeeds. If all steps
 // fail, the RetryStep will fail.
+//
+// A step which errors is always retried, whereas a step which fails is only
+// retried unless the policy is to only retry errors.
 func (step *RetryStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx)
+
 	var attemptErr error
 
-	for _, attempt := range step.Attempts {
+	for i, attempt := range step.Attempts {
+		if i > 0 {
+			err := step.wait(ctx, logger, i+1, attemptErr)
+			if err != nil {
+				return err
+			}
+		}
+
 		step.LastAttempt = attempt
 
 		attemptErr = attempt.Run(ctx, state)
@@ -37,11 +75,45 @@ func (step *RetryStep) Run(ctx context.Context, state RunState) error {
 		if attempt.Succeeded() {
 			break
 		}
+
+		if step.policy.When == atc.RetryWhenErrored {
+			break
+		}
 	}
 
 	return attemptErr
 }
 
+func (step *RetryStep) wait(ctx context.Context, logger lager.Logger, attempt int, previousErr error) error {
+	delay, err := step.policy.Delay(attempt)
+	if err != nil {
+		return err
+	}
+
+	previousStatus := atc.StatusFailed
+	if previousErr != nil {
+		previousStatus = atc.StatusErrored
+	}
+
+	if step.delegate != nil {
+		step.delegate.Retrying(logger, attempt, previousStatus, delay)
+	}
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Succeeded delegates to the last step that it ran.
 func (step *RetryStep) Succeeded() bool {
 	return step.LastAttempt.Succeeded()
@@ -3,7 +3,10 @@ package exec_test
 import (
 	"context"
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
@@ -339,4 +342,117 @@ var _ = Describe("Retry Step", func() {
 			})
 		})
 	})
+
+	Context("with a policy", func() {
+		var (
+			policy   atc.RetryPolicy
+			delegate *execfakes.FakeRetryDelegate
+
+			stepErr error
+		)
+
+		BeforeEach(func() {
+			policy = atc.RetryPolicy{}
+			delegate = new(execfakes.FakeRetryDelegate)
+		})
+
+		JustBeforeEach(func() {
+			step = RetryWithPolicy(policy, delegate, attempt1, attempt2, attempt3)
+			stepErr = step.Run(ctx, state)
+		})
+
+		Context("when attempt 1 errors, attempt 2 fails, and attempt 3 succeeds", func() {
+			BeforeEach(func() {
+				policy.Backoff = "10ms"
+
+				attempt1.RunReturns(errors.New("nope"))
+				attempt2.SucceededReturns(false)
+				attempt3.SucceededReturns(true)
+			})
+
+			It("runs all 3 attempts", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+
+				Expect(attempt1.RunCallCount()).To(Equal(1))
+				Expect(attempt2.RunCallCount()).To(Equal(1))
+				Expect(attempt3.RunCallCount()).To(Equal(1))
+			})
+
+			It("tells the delegate about each retry, doubling the delay", func() {
+				Expect(delegate.RetryingCallCount()).To(Equal(2))
+
+				_, attempt, previousStatus, delay := delegate.RetryingArgsForCall(0)
+				Expect(attempt).To(Equal(2))
+				Expect(previousStatus).To(Equal(atc.StatusErrored))
+				Expect(delay).To(Equal(10 * time.Millisecond))
+
+				_, attempt, previousStatus, delay = delegate.RetryingArgsForCall(1)
+				Expect(attempt).To(Equal(3))
+				Expect(previousStatus).To(Equal(atc.StatusFailed))
+				Expect(delay).To(Equal(20 * time.Millisecond))
+			})
+		})
+
+		Context("when only retrying errors", func() {
+			BeforeEach(func() {
+				policy.When = atc.RetryWhenErrored
+			})
+
+			Context("when attempt 1 errors, and attempt 2 fails", func() {
+				BeforeEach(func() {
+					attempt1.RunReturns(errors.New("nope"))
+					attempt2.SucceededReturns(false)
+				})
+
+				It("does not retry the failure", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+
+					Expect(attempt1.RunCallCount()).To(Equal(1))
+					Expect(attempt2.RunCallCount()).To(Equal(1))
+					Expect(attempt3.RunCallCount()).To(Equal(0))
+
+					Expect(step.Succeeded()).To(BeFalse())
+				})
+
+				It("only tells the delegate about retrying the error", func() {
+					Expect(delegate.RetryingCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when interrupted while waiting to retry", func() {
+			BeforeEach(func() {
+				policy.Backoff = "1h"
+
+				attempt1.RunStub = func(context.Context, RunState) error {
+					return errors.New("nope")
+				}
+
+				delegate.RetryingStub = func(lager.Logger, int, atc.BuildStatus, time.Duration) {
+					cancel()
+				}
+			})
+
+			It("returns the context error without running another attempt", func() {
+				Expect(stepErr).To(Equal(context.Canceled))
+
+				Expect(attempt1.RunCallCount()).To(Equal(1))
+				Expect(attempt2.RunCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the backoff cannot be parsed", func() {
+			BeforeEach(func() {
+				policy.Backoff = "bogus"
+
+				attempt1.SucceededReturns(false)
+			})
+
+			It("errors", func() {
+				Expect(stepErr).To(HaveOccurred())
+
+				Expect(attempt2.RunCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -41,3 +41,12 @@ func (state *runState) Result(id atc.PlanID, to interface{}) bool {
 func (state *runState) StoreResult(id atc.PlanID, val interface{}) {
 	state.results.Store(id, val)
 }
+
+// NewLocalScope returns a run state sharing this one's results whose artifact
+// repository is a local scope of this one's.
+func (state *runState) NewLocalScope() RunState {
+	return &runState{
+		artifacts: state.artifacts.NewLocalScope(),
+		results:   state.results,
+	}
+}
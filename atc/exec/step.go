@@ -40,6 +40,8 @@ type RunState interface {
 
 	Result(atc.PlanID, interface{}) bool
 	StoreResult(atc.PlanID, interface{})
+
+	NewLocalScope() RunState
 }
 
 // ExitStatus is the resulting exit code from the process that the step ran.
@@ -17,6 +17,7 @@ type StepMetadata struct {
 	ResourceConfigID      int
 	BaseResourceTypeID    int
 	ExternalURL           string
+	CreatedBy             string
 }
 
 func (metadata StepMetadata) Env() []string {
@@ -54,6 +55,10 @@ func (metadata StepMetadata) Env() []string {
 		env = append(env, "BUILD_PIPELINE_NAME="+metadata.PipelineName)
 	}
 
+	if metadata.CreatedBy != "" {
+		env = append(env, "BUILD_CREATED_BY="+metadata.CreatedBy)
+	}
+
 	if metadata.ExternalURL != "" {
 		env = append(env, "ATC_EXTERNAL_URL="+metadata.ExternalURL)
 	}
@@ -23,6 +23,7 @@ var _ = Describe("StepMetadata", func() {
 					PipelineID:   4444,
 					PipelineName: "some-pipeline-name",
 					ExternalURL:  "http://www.example.com",
+					CreatedBy:    "some-user",
 				}
 			})
 
@@ -36,6 +37,7 @@ var _ = Describe("StepMetadata", func() {
 					"BUILD_JOB_NAME=some-job-name",
 					"BUILD_PIPELINE_ID=4444",
 					"BUILD_PIPELINE_NAME=some-pipeline-name",
+					"BUILD_CREATED_BY=some-user",
 					"ATC_EXTERNAL_URL=http://www.example.com",
 				))
 			})
@@ -7,6 +7,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -58,6 +59,9 @@ type TaskDelegate interface {
 	Stdout() io.Writer
 	Stderr() io.Writer
 
+	// ServiceOutput returns the writers for the output of the named service.
+	ServiceOutput(name string) (io.Writer, io.Writer)
+
 	Variables() vars.CredVarsTracker
 
 	SetTaskConfig(config atc.TaskConfig)
@@ -66,6 +70,7 @@ type TaskDelegate interface {
 	Starting(lager.Logger)
 	Finished(lager.Logger, ExitStatus)
 	Errored(lager.Logger, string)
+	WatchOutput(context.Context) error
 }
 
 // TaskStep executes a TaskConfig, whose inputs will be fetched from the
@@ -79,6 +84,7 @@ type TaskStep struct {
 	strategy          worker.ContainerPlacementStrategy
 	workerClient      worker.Client
 	delegate          TaskDelegate
+	teamFactory       db.TeamFactory
 	lockFactory       lock.LockFactory
 	succeeded         bool
 }
@@ -92,6 +98,7 @@ func NewTaskStep(
 	strategy worker.ContainerPlacementStrategy,
 	workerClient worker.Client,
 	delegate TaskDelegate,
+	teamFactory db.TeamFactory,
 	lockFactory lock.LockFactory,
 ) Step {
 	return &TaskStep{
@@ -103,6 +110,7 @@ func NewTaskStep(
 		strategy:          strategy,
 		workerClient:      workerClient,
 		delegate:          delegate,
+		teamFactory:       teamFactory,
 		lockFactory:       lockFactory,
 	}
 }
@@ -206,6 +214,17 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 	if config.Limits.Memory == nil {
 		config.Limits.Memory = step.defaultLimits.Memory
 	}
+	if config.Limits.Pids == nil {
+		config.Limits.Pids = step.defaultLimits.Pids
+	}
+	if config.Limits.OpenFiles == nil {
+		config.Limits.OpenFiles = step.defaultLimits.OpenFiles
+	}
+
+	network, err := step.network()
+	if err != nil {
+		return err
+	}
 
 	step.delegate.Initializing(logger)
 
@@ -218,6 +237,15 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 	if err != nil {
 		return err
 	}
+
+	if network.Restricted() {
+		containerSpec.Network = &network
+		workerSpec.Capabilities = append(workerSpec.Capabilities, atc.WorkerCapabilityNetworkIsolation)
+	}
+
+	if len(containerSpec.Services) > 0 {
+		workerSpec.Capabilities = append(workerSpec.Capabilities, atc.WorkerCapabilitySharedNetworkNamespace)
+	}
 	tracing.Inject(ctx, &containerSpec)
 
 	processSpec := runtime.ProcessSpec{
@@ -263,7 +291,7 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 
 	// Do not initialize caches for one-off builds
 	if step.metadata.JobID != 0 {
-		err = step.registerCaches(logger, repository, config, result.VolumeMounts, step.containerMetadata)
+		err = step.registerCaches(logger, config, result, step.containerMetadata)
 		if err != nil {
 			return err
 		}
@@ -345,6 +373,13 @@ func (step *TaskStep) containerInputs(logger lager.Logger, repository *build.Rep
 			StepName: step.plan.Name,
 			Path:     cacheConfig.Path,
 		}
+		if cacheConfig.Name != "" {
+			cacheArt = &runtime.CacheArtifact{
+				TeamID: step.metadata.TeamID,
+				Name:   cacheConfig.Name,
+				Path:   cacheConfig.Path,
+			}
+		}
 		ti := taskCacheInput{
 			artifact:      cacheArt,
 			artifactsRoot: metadata.WorkingDirectory,
@@ -356,6 +391,24 @@ func (step *TaskStep) containerInputs(logger lager.Logger, repository *build.Rep
 	return inputs, nil
 }
 
+// network resolves the network access of the task from its own config and
+// the team's default.
+func (step *TaskStep) network() (atc.NetworkConfig, error) {
+	team, found, err := step.teamFactory.FindTeam(step.metadata.TeamName)
+	if err != nil {
+		return atc.NetworkConfig{}, err
+	}
+
+	if !found {
+		return atc.NetworkConfig{}, fmt.Errorf("team '%s' not found", step.metadata.TeamName)
+	}
+
+	return atc.Team{
+		Name:    team.Name(),
+		Network: team.Network(),
+	}.TaskNetwork(step.plan.Network)
+}
+
 func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repository, config atc.TaskConfig, metadata db.ContainerMetadata) (worker.ContainerSpec, error) {
 	imageSpec, err := step.imageSpec(logger, repository, config)
 	if err != nil {
@@ -366,6 +419,8 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repos
 	if config.Limits != nil {
 		limits.CPU = config.Limits.CPU
 		limits.Memory = config.Limits.Memory
+		limits.Pids = config.Limits.Pids
+		limits.OpenFiles = config.Limits.OpenFiles
 	}
 
 	containerSpec := worker.ContainerSpec{
@@ -392,9 +447,96 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repos
 		containerSpec.Outputs[output.Name] = path
 	}
 
+	for _, service := range config.Services {
+		serviceSpec, err := step.serviceSpec(config, service, metadata)
+		if err != nil {
+			return worker.ContainerSpec{}, err
+		}
+
+		containerSpec.Services = append(containerSpec.Services, serviceSpec)
+	}
+
 	return containerSpec, nil
 }
 
+const (
+	defaultServiceReadinessInterval = time.Second
+	defaultServiceReadinessTimeout  = time.Minute
+)
+
+func (step *TaskStep) serviceSpec(config atc.TaskConfig, service atc.TaskServiceConfig, metadata db.ContainerMetadata) (worker.ServiceSpec, error) {
+	serviceMetadata := metadata
+	serviceMetadata.StepName = metadata.StepName + "/" + service.Name
+	serviceMetadata.WorkingDirectory = ""
+
+	stdout, stderr := step.delegate.ServiceOutput(service.Name)
+
+	spec := worker.ServiceSpec{
+		Name:     service.Name,
+		Owner:    db.NewBuildStepContainerOwner(step.metadata.BuildID, ServicePlanID(step.planID, service.Name), step.metadata.TeamID),
+		Metadata: serviceMetadata,
+		ContainerSpec: worker.ContainerSpec{
+			Platform: config.Platform,
+			Tags:     step.plan.Tags,
+			TeamID:   step.metadata.TeamID,
+			ImageSpec: worker.ImageSpec{
+				ImageResource: &worker.ImageResource{
+					Type:    service.ImageResource.Type,
+					Source:  service.ImageResource.Source,
+					Params:  service.ImageResource.Params,
+					Version: service.ImageResource.Version,
+				},
+			},
+			User: service.Run.User,
+			Env:  service.Params.Env(),
+			Type: metadata.Type,
+		},
+		ProcessSpec: runtime.ProcessSpec{
+			Path:         service.Run.Path,
+			Args:         service.Run.Args,
+			Dir:          service.Run.Dir,
+			StdoutWriter: stdout,
+			StderrWriter: stderr,
+		},
+		EnvPrefix: service.EnvPrefix(),
+		Ports:     service.Ports,
+	}
+
+	if service.Readiness != nil {
+		readiness := &worker.ServiceReadinessSpec{
+			Path:     service.Readiness.Run.Path,
+			Args:     service.Readiness.Run.Args,
+			Interval: defaultServiceReadinessInterval,
+			Timeout:  defaultServiceReadinessTimeout,
+		}
+
+		var err error
+		if service.Readiness.Interval != "" {
+			readiness.Interval, err = time.ParseDuration(service.Readiness.Interval)
+			if err != nil {
+				return worker.ServiceSpec{}, err
+			}
+		}
+
+		if service.Readiness.Timeout != "" {
+			readiness.Timeout, err = time.ParseDuration(service.Readiness.Timeout)
+			if err != nil {
+				return worker.ServiceSpec{}, err
+			}
+		}
+
+		spec.Readiness = readiness
+	}
+
+	return spec, nil
+}
+
+// ServicePlanID is the plan ID under which a service's container is owned and
+// its output is saved, so that it is distinct from the task's own.
+func ServicePlanID(planID atc.PlanID, name string) atc.PlanID {
+	return atc.PlanID(string(planID) + "/" + name)
+}
+
 func (step *TaskStep) workerSpec(logger lager.Logger, resourceTypes atc.VersionedResourceTypes, repository *build.Repository, config atc.TaskConfig) (worker.WorkerSpec, error) {
 	workerSpec := worker.WorkerSpec{
 		Platform:      config.Platform,
@@ -437,14 +579,26 @@ func (step *TaskStep) registerOutputs(logger lager.Logger, repository *build.Rep
 	}
 }
 
-func (step *TaskStep) registerCaches(logger lager.Logger, repository *build.Repository, config atc.TaskConfig, volumeMounts []worker.VolumeMount, metadata db.ContainerMetadata) error {
+func (step *TaskStep) registerCaches(logger lager.Logger, config atc.TaskConfig, result worker.TaskResult, metadata db.ContainerMetadata) error {
 	logger.Debug("initializing-caches", lager.Data{"caches": config.Caches})
 
 	for _, cacheConfig := range config.Caches {
-		for _, volumeMount := range volumeMounts {
+		for _, volumeMount := range result.VolumeMounts {
 			if volumeMount.MountPath == filepath.Join(metadata.WorkingDirectory, cacheConfig.Path) {
 				logger.Debug("initializing-cache", lager.Data{"path": volumeMount.MountPath})
 
+				if cacheConfig.Name != "" {
+					err := volumeMount.Volume.InitializeNamedCache(
+						logger,
+						cacheConfig.Name,
+						bool(step.plan.Privileged))
+					if err != nil {
+						return err
+					}
+
+					continue
+				}
+
 				err := volumeMount.Volume.InitializeTaskCache(
 					logger,
 					step.metadata.JobID,
@@ -3,10 +3,12 @@ package exec_test
 import (
 	"context"
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
@@ -38,6 +40,9 @@ var _ = Describe("TaskStep", func() {
 
 		fakeLockFactory *lockfakes.FakeLockFactory
 
+		fakeTeam        *dbfakes.FakeTeam
+		fakeTeamFactory *dbfakes.FakeTeamFactory
+
 		fakeDelegate *execfakes.FakeTaskDelegate
 		taskPlan     *atc.TaskPlan
 
@@ -49,6 +54,8 @@ var _ = Describe("TaskStep", func() {
 		taskStep exec.Step
 		stepErr  error
 
+		defaultLimits atc.ContainerLimits
+
 		credVarsTracker vars.CredVarsTracker
 
 		containerMetadata = db.ContainerMetadata{
@@ -58,9 +65,10 @@ var _ = Describe("TaskStep", func() {
 		}
 
 		stepMetadata = exec.StepMetadata{
-			TeamID:  123,
-			BuildID: 1234,
-			JobID:   12345,
+			TeamID:   123,
+			TeamName: "some-team",
+			BuildID:  1234,
+			JobID:    12345,
 		}
 
 		planID = atc.PlanID(42)
@@ -77,6 +85,11 @@ var _ = Describe("TaskStep", func() {
 
 		fakeLockFactory = new(lockfakes.FakeLockFactory)
 
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.NameReturns("some-team")
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
 		credVars := vars.StaticVariables{"source-param": "super-secret-source"}
 		credVarsTracker = vars.NewCredVarsTracker(credVars, true)
 
@@ -119,6 +132,8 @@ var _ = Describe("TaskStep", func() {
 			Tags:                   []string{"step", "tags"},
 			VersionedResourceTypes: uninterpolatedResourceTypes,
 		}
+
+		defaultLimits = atc.ContainerLimits{}
 	})
 
 	JustBeforeEach(func() {
@@ -131,12 +146,13 @@ var _ = Describe("TaskStep", func() {
 		taskStep = exec.NewTaskStep(
 			plan.ID,
 			*plan.Task,
-			atc.ContainerLimits{},
+			defaultLimits,
 			stepMetadata,
 			containerMetadata,
 			fakeStrategy,
 			fakeClient,
 			fakeDelegate,
+			fakeTeamFactory,
 			fakeLockFactory,
 		)
 
@@ -509,6 +525,74 @@ var _ = Describe("TaskStep", func() {
 					Expect(fakeVolume2.InitializeTaskCacheCallCount()).To(Equal(0))
 				})
 			})
+
+			Context("when a cache is named", func() {
+				BeforeEach(func() {
+					stepMetadata.JobID = 12
+					taskPlan.Config.Caches[1].Name = "some-cache"
+
+					taskResult := worker.TaskResult{
+						ExitStatus: 0,
+						VolumeMounts: []worker.VolumeMount{
+							{
+								Volume:    fakeVolume1,
+								MountPath: "some-artifact-root/some-path-1",
+							},
+							{
+								Volume:    fakeVolume2,
+								MountPath: "some-artifact-root/some-path-2",
+							},
+						},
+					}
+					fakeClient.RunTaskStepReturns(taskResult, nil)
+				})
+
+				It("looks up the named cache for the team", func() {
+					_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+					Expect(containerSpec.ArtifactByPath["some-artifact-root/some-path-2"]).To(Equal(&runtime.CacheArtifact{
+						TeamID: stepMetadata.TeamID,
+						Name:   "some-cache",
+						Path:   "some-path-2",
+					}))
+				})
+
+				It("registers the volume as the named cache", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+
+					Expect(fakeVolume1.InitializeTaskCacheCallCount()).To(Equal(1))
+					Expect(fakeVolume1.InitializeNamedCacheCallCount()).To(Equal(0))
+
+					Expect(fakeVolume2.InitializeTaskCacheCallCount()).To(Equal(0))
+					Expect(fakeVolume2.InitializeNamedCacheCallCount()).To(Equal(1))
+					_, name, p := fakeVolume2.InitializeNamedCacheArgsForCall(0)
+					Expect(name).To(Equal("some-cache"))
+					Expect(p).To(Equal(bool(taskPlan.Privileged)))
+				})
+
+				Context("when the task fails", func() {
+					BeforeEach(func() {
+						fakeClient.RunTaskStepReturns(worker.TaskResult{
+							ExitStatus: 1,
+							VolumeMounts: []worker.VolumeMount{
+								{
+									Volume:    fakeVolume1,
+									MountPath: "some-artifact-root/some-path-1",
+								},
+								{
+									Volume:    fakeVolume2,
+									MountPath: "some-artifact-root/some-path-2",
+								},
+							},
+						}, nil)
+					})
+
+					It("still updates the named cache", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(fakeVolume1.InitializeTaskCacheCallCount()).To(Equal(1))
+						Expect(fakeVolume2.InitializeNamedCacheCallCount()).To(Equal(1))
+					})
+				})
+			})
 		})
 
 		Context("when the configuration specifies paths for outputs", func() {
@@ -755,6 +839,26 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when pids and open_files limits are configured", func() {
+			BeforeEach(func() {
+				pids := uint64(100)
+				taskPlan.Config.Limits.Pids = &pids
+
+				defaultPids := uint64(200)
+				defaultOpenFiles := uint64(4096)
+				defaultLimits = atc.ContainerLimits{
+					Pids:      &defaultPids,
+					OpenFiles: &defaultOpenFiles,
+				}
+			})
+
+			It("limits the container, falling back on the defaults", func() {
+				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(*containerSpec.Limits.Pids).To(Equal(uint64(100)))
+				Expect(*containerSpec.Limits.OpenFiles).To(Equal(uint64(4096)))
+			})
+		})
+
 		Context("when a run dir is specified", func() {
 			var dir string
 			BeforeEach(func() {
@@ -784,6 +888,171 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when the network is not configured", func() {
+			It("looks up the team's network", func() {
+				Expect(fakeTeamFactory.FindTeamCallCount()).To(Equal(1))
+				Expect(fakeTeamFactory.FindTeamArgsForCall(0)).To(Equal("some-team"))
+			})
+
+			It("does not restrict the container's network access", func() {
+				_, _, _, containerSpec, workerSpec, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Network).To(BeNil())
+				Expect(workerSpec.Capabilities).To(BeEmpty())
+			})
+		})
+
+		Context("when the task configures its network", func() {
+			BeforeEach(func() {
+				taskPlan.Network = &atc.NetworkConfig{
+					Mode:  atc.NetworkModeAllowlist,
+					Allow: []string{"10.0.0.0/8"},
+				}
+			})
+
+			It("restricts the container's network access", func() {
+				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Network).To(Equal(&atc.NetworkConfig{
+					Mode:  atc.NetworkModeAllowlist,
+					Allow: []string{"10.0.0.0/8"},
+				}))
+			})
+
+			It("runs the task on a worker which can isolate its network", func() {
+				_, _, _, _, workerSpec, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(workerSpec.Capabilities).To(ConsistOf(atc.WorkerCapabilityNetworkIsolation))
+			})
+
+			Context("when the team has locked its network", func() {
+				BeforeEach(func() {
+					fakeTeam.NetworkReturns(&atc.TeamNetworkConfig{
+						Default: atc.NetworkConfig{Mode: atc.NetworkModeNone},
+						Locked:  true,
+					})
+				})
+
+				It("returns an error", func() {
+					Expect(stepErr).To(Equal(atc.NetworkLockedError{
+						Team:    "some-team",
+						Network: atc.NetworkConfig{Mode: atc.NetworkModeNone},
+					}))
+				})
+
+				It("does not run the task", func() {
+					Expect(fakeClient.RunTaskStepCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the team configures a default network", func() {
+			BeforeEach(func() {
+				fakeTeam.NetworkReturns(&atc.TeamNetworkConfig{
+					Default: atc.NetworkConfig{Mode: atc.NetworkModeNone},
+				})
+			})
+
+			It("restricts the container's network access", func() {
+				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Network).To(Equal(&atc.NetworkConfig{Mode: atc.NetworkModeNone}))
+			})
+		})
+
+		Context("when the team cannot be found", func() {
+			BeforeEach(func() {
+				fakeTeamFactory.FindTeamReturns(nil, false, nil)
+			})
+
+			It("returns an error", func() {
+				Expect(stepErr).To(MatchError("team 'some-team' not found"))
+			})
+		})
+
+		Context("when services are specified", func() {
+			var (
+				serviceStdout *gbytes.Buffer
+				serviceStderr *gbytes.Buffer
+			)
+
+			BeforeEach(func() {
+				serviceStdout = gbytes.NewBuffer()
+				serviceStderr = gbytes.NewBuffer()
+				fakeDelegate.ServiceOutputReturns(serviceStdout, serviceStderr)
+
+				taskPlan.Config.Services = []atc.TaskServiceConfig{
+					{
+						Name: "some-db",
+						ImageResource: &atc.ImageResource{
+							Type:   "docker",
+							Source: atc.Source{"repository": "postgres"},
+						},
+						Params: atc.TaskEnv{"POSTGRES_PASSWORD": "password"},
+						Run: atc.TaskRunConfig{
+							Path: "docker-entrypoint.sh",
+							Args: []string{"postgres"},
+							User: "postgres",
+						},
+						Ports: []uint16{5432},
+						Readiness: &atc.TaskServiceReadinessConfig{
+							Run: atc.TaskRunConfig{
+								Path: "pg_isready",
+							},
+							Interval: "5s",
+						},
+					},
+				}
+			})
+
+			It("asks the delegate where to write the service's output", func() {
+				Expect(fakeDelegate.ServiceOutputCallCount()).To(Equal(1))
+				Expect(fakeDelegate.ServiceOutputArgsForCall(0)).To(Equal("some-db"))
+			})
+
+			It("adds the service to the container spec", func() {
+				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Services).To(Equal([]worker.ServiceSpec{
+					{
+						Name:  "some-db",
+						Owner: db.NewBuildStepContainerOwner(stepMetadata.BuildID, exec.ServicePlanID(planID, "some-db"), stepMetadata.TeamID),
+						Metadata: db.ContainerMetadata{
+							Type:     db.ContainerTypeTask,
+							StepName: "some-step/some-db",
+						},
+						ContainerSpec: worker.ContainerSpec{
+							Platform: "some-platform",
+							Tags:     []string{"step", "tags"},
+							TeamID:   stepMetadata.TeamID,
+							ImageSpec: worker.ImageSpec{
+								ImageResource: &worker.ImageResource{
+									Type:   "docker",
+									Source: atc.Source{"repository": "postgres"},
+								},
+							},
+							User: "postgres",
+							Env:  []string{"POSTGRES_PASSWORD=password"},
+							Type: db.ContainerTypeTask,
+						},
+						ProcessSpec: runtime.ProcessSpec{
+							Path:         "docker-entrypoint.sh",
+							Args:         []string{"postgres"},
+							StdoutWriter: serviceStdout,
+							StderrWriter: serviceStderr,
+						},
+						EnvPrefix: "SOME_DB",
+						Ports:     []uint16{5432},
+						Readiness: &worker.ServiceReadinessSpec{
+							Path:     "pg_isready",
+							Interval: 5 * time.Second,
+							Timeout:  time.Minute,
+						},
+					},
+				}))
+			})
+
+			It("requires a worker which can share the task's network namespace with the service", func() {
+				_, _, _, _, workerSpec, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(workerSpec.Capabilities).To(ConsistOf(atc.WorkerCapabilitySharedNetworkNamespace))
+			})
+		})
+
 		Context("when running the task succeeds", func() {
 			var taskStepStatus int
 			BeforeEach(func() {
@@ -9,16 +9,19 @@ import (
 )
 
 type buildCollector struct {
-	buildFactory buildFactory
+	buildFactory                buildFactory
+	lifecycleEventRecyclePeriod time.Duration
 }
 
 type buildFactory interface {
 	MarkNonInterceptibleBuilds() error
+	RemoveExpiredBuildLifecycleEvents(time.Duration) error
 }
 
-func NewBuildCollector(buildFactory buildFactory) *buildCollector {
+func NewBuildCollector(buildFactory buildFactory, lifecycleEventRecyclePeriod time.Duration) *buildCollector {
 	return &buildCollector{
-		buildFactory: buildFactory,
+		buildFactory:                buildFactory,
+		lifecycleEventRecyclePeriod: lifecycleEventRecyclePeriod,
 	}
 }
 
@@ -35,5 +38,16 @@ func (b *buildCollector) Run(ctx context.Context) error {
 	logger.Debug("start")
 	defer logger.Debug("done")
 
-	return b.buildFactory.MarkNonInterceptibleBuilds()
+	err := b.buildFactory.MarkNonInterceptibleBuilds()
+	if err != nil {
+		return err
+	}
+
+	err = b.buildFactory.RemoveExpiredBuildLifecycleEvents(b.lifecycleEventRecyclePeriod)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-build-lifecycle-events", err)
+		return err
+	}
+
+	return nil
 }
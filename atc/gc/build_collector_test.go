@@ -0,0 +1,52 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildCollector", func() {
+	var collector GcCollector
+	var fakeBuildFactory *dbfakes.FakeBuildFactory
+
+	BeforeEach(func() {
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+
+		collector = gc.NewBuildCollector(fakeBuildFactory, time.Hour*24)
+	})
+
+	Describe("Run", func() {
+		It("marks non-interceptible builds", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeBuildFactory.MarkNonInterceptibleBuildsCallCount()).To(Equal(1))
+		})
+
+		It("removes expired build lifecycle events", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeBuildFactory.RemoveExpiredBuildLifecycleEventsCallCount()).To(Equal(1))
+			recyclePeriod := fakeBuildFactory.RemoveExpiredBuildLifecycleEventsArgsForCall(0)
+			Expect(recyclePeriod).To(Equal(time.Hour * 24))
+		})
+
+		Context("when removing expired build lifecycle events fails", func() {
+			BeforeEach(func() {
+				fakeBuildFactory.RemoveExpiredBuildLifecycleEventsReturns(errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("nope"))
+			})
+		})
+	})
+})
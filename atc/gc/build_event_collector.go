@@ -0,0 +1,57 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/hashicorp/go-multierror"
+)
+
+type buildEventCollector struct {
+	buildEventLifecycle db.BuildEventLifecycle
+	batchSize           int
+}
+
+// NewBuildEventCollector returns a collector which finalizes the events of
+// up to batchSize completed builds every time it runs, e.g. offloading them
+// out of Postgres, and removes those of deleted builds. Builds whose events
+// fail to be finalized are retried on the next run.
+func NewBuildEventCollector(buildEventLifecycle db.BuildEventLifecycle, batchSize int) *buildEventCollector {
+	return &buildEventCollector{
+		buildEventLifecycle: buildEventLifecycle,
+		batchSize:           batchSize,
+	}
+}
+
+func (c *buildEventCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("build-event-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	var errs error
+
+	err := c.buildEventLifecycle.CollectDeletedBuildEvents()
+	if err != nil {
+		logger.Error("failed-to-collect-deleted-build-events", err)
+		errs = multierror.Append(errs, err)
+	}
+
+	builds, err := c.buildEventLifecycle.UnfinalizedBuilds(c.batchSize)
+	if err != nil {
+		logger.Error("failed-to-find-unfinalized-builds", err)
+		return multierror.Append(errs, err)
+	}
+
+	for _, build := range builds {
+		err = c.buildEventLifecycle.FinalizeBuildEvents(build)
+		if err != nil {
+			logger.Error("failed-to-finalize-build-events", err, lager.Data{"build": build.ID()})
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,83 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildEventCollector", func() {
+	var collector GcCollector
+	var fakeBuildEventLifecycle *dbfakes.FakeBuildEventLifecycle
+
+	var fakeBuild1, fakeBuild2 *dbfakes.FakeBuild
+
+	BeforeEach(func() {
+		fakeBuildEventLifecycle = new(dbfakes.FakeBuildEventLifecycle)
+
+		fakeBuild1 = new(dbfakes.FakeBuild)
+		fakeBuild1.IDReturns(1)
+		fakeBuild2 = new(dbfakes.FakeBuild)
+		fakeBuild2.IDReturns(2)
+
+		fakeBuildEventLifecycle.UnfinalizedBuildsReturns([]db.Build{fakeBuild1, fakeBuild2}, nil)
+
+		collector = gc.NewBuildEventCollector(fakeBuildEventLifecycle, 10)
+	})
+
+	Describe("Run", func() {
+		var err error
+
+		JustBeforeEach(func() {
+			err = collector.Run(context.TODO())
+		})
+
+		It("collects the events of deleted builds", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeBuildEventLifecycle.CollectDeletedBuildEventsCallCount()).To(Equal(1))
+		})
+
+		It("finalizes a batch of completed builds", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeBuildEventLifecycle.UnfinalizedBuildsCallCount()).To(Equal(1))
+			Expect(fakeBuildEventLifecycle.UnfinalizedBuildsArgsForCall(0)).To(Equal(10))
+
+			Expect(fakeBuildEventLifecycle.FinalizeBuildEventsCallCount()).To(Equal(2))
+			Expect(fakeBuildEventLifecycle.FinalizeBuildEventsArgsForCall(0)).To(Equal(fakeBuild1))
+			Expect(fakeBuildEventLifecycle.FinalizeBuildEventsArgsForCall(1)).To(Equal(fakeBuild2))
+		})
+
+		Context("when finalizing a build fails", func() {
+			BeforeEach(func() {
+				fakeBuildEventLifecycle.FinalizeBuildEventsStub = func(build db.Build) error {
+					if build.ID() == 1 {
+						return errors.New("nope")
+					}
+
+					return nil
+				}
+			})
+
+			It("carries on with the other builds and returns the error", func() {
+				Expect(err).To(MatchError(ContainSubstring("nope")))
+				Expect(fakeBuildEventLifecycle.FinalizeBuildEventsCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when collecting the events of deleted builds fails", func() {
+			BeforeEach(func() {
+				fakeBuildEventLifecycle.CollectDeletedBuildEventsReturns(errors.New("nope"))
+			})
+
+			It("still finalizes builds and returns the error", func() {
+				Expect(err).To(MatchError(ContainSubstring("nope")))
+				Expect(fakeBuildEventLifecycle.FinalizeBuildEventsCallCount()).To(Equal(2))
+			})
+		})
+	})
+})
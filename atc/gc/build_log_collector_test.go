@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -87,9 +88,9 @@ var _ = Describe("BuildLogCollector", func() {
 				})
 				BeforeEach(func() {
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 4, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 4, Limit: 5}) {
 							return []db.Build{sbDrained(10, true), sbDrained(9, false), sbDrained(8, false), sbDrained(7, true), sbDrained(6, false)}, db.Pagination{}, nil
-						} else if page == (db.Page{Until: 10, Limit: 5}) {
+						} else if reflect.DeepEqual(page, db.Page{Until: 10, Limit: 5}) {
 							return []db.Build{sbDrained(11, true)}, db.Pagination{}, nil
 						}
 						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
@@ -137,9 +138,9 @@ var _ = Describe("BuildLogCollector", func() {
 						false,
 					)
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 4, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 4, Limit: 5}) {
 							return []db.Build{sbDrained(9, true), sbDrained(8, false), sbDrained(7, false), sbDrained(6, true), sbDrained(5, false)}, db.Pagination{}, nil
-						} else if page == (db.Page{Until: 9, Limit: 5}) {
+						} else if reflect.DeepEqual(page, db.Page{Until: 9, Limit: 5}) {
 							return []db.Build{sbDrained(10, true)}, db.Pagination{}, nil
 						}
 						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
@@ -167,7 +168,7 @@ var _ = Describe("BuildLogCollector", func() {
 
 				BeforeEach(func() {
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 4, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 4, Limit: 5}) {
 							return []db.Build{sbDrained(8, false), sbDrained(7, true), sbDrained(6, false)}, db.Pagination{}, nil
 						}
 						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
@@ -196,7 +197,7 @@ var _ = Describe("BuildLogCollector", func() {
 
 				BeforeEach(func() {
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 4, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 4, Limit: 5}) {
 							return []db.Build{sbDrained(8, false), sbDrained(7, true), sbDrained(6, false)}, db.Pagination{}, nil
 						}
 						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
@@ -220,7 +221,7 @@ var _ = Describe("BuildLogCollector", func() {
 						BuildLogsToRetain: 3,
 					}, nil)
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 4, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 4, Limit: 5}) {
 							return []db.Build{
 								sb(10),
 								runningBuild(9),
@@ -228,7 +229,7 @@ var _ = Describe("BuildLogCollector", func() {
 								sb(7),
 								sb(6),
 							}, db.Pagination{}, nil
-						} else if page == (db.Page{Until: 10, Limit: 5}) {
+						} else if reflect.DeepEqual(page, db.Page{Until: 10, Limit: 5}) {
 							return []db.Build{sb(11)}, db.Pagination{}, nil
 						} else {
 							Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
@@ -262,7 +263,7 @@ var _ = Describe("BuildLogCollector", func() {
 			Context("when no builds need to be reaped", func() {
 				BeforeEach(func() {
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 4, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 4, Limit: 5}) {
 							return []db.Build{runningBuild(5)}, db.Pagination{}, nil
 						} else {
 							Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
@@ -325,7 +326,7 @@ var _ = Describe("BuildLogCollector", func() {
 			Context("when only count is set", func() {
 				BeforeEach(func() {
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 4, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 4, Limit: 5}) {
 							return []db.Build{sbTime(7, time.Now().Add(-23*time.Hour)), sbTime(6, time.Now().Add(-49*time.Hour))}, db.Pagination{}, nil
 						}
 						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
@@ -356,9 +357,9 @@ var _ = Describe("BuildLogCollector", func() {
 			Context("when only date is set", func() {
 				BeforeEach(func() {
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 4, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 4, Limit: 5}) {
 							return []db.Build{sbTime(7, time.Now().Add(-23*time.Hour)), sbTime(6, time.Now().Add(-49*time.Hour))}, db.Pagination{}, nil
-						} else if page == (db.Page{Limit: 1}) {
+						} else if reflect.DeepEqual(page, db.Page{Limit: 1}) {
 							return []db.Build{sbTime(7, time.Now().Add(-23*time.Hour))}, db.Pagination{}, nil
 						}
 						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
@@ -387,7 +388,7 @@ var _ = Describe("BuildLogCollector", func() {
 			Context("when count and date are set > 0", func() {
 				BeforeEach(func() {
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 4, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 4, Limit: 5}) {
 							return []db.Build{sbTime(7, time.Now().Add(-23*time.Hour)), sbTime(6, time.Now().Add(-49*time.Hour))}, db.Pagination{}, nil
 						}
 						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
@@ -418,7 +419,7 @@ var _ = Describe("BuildLogCollector", func() {
 			Context("when only date is set", func() {
 				BeforeEach(func() {
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 4, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 4, Limit: 5}) {
 							return []db.Build{sbTime(7, time.Now().Add(-23*time.Hour)), sbTime(6, time.Now().Add(-49*time.Hour))}, db.Pagination{}, nil
 						}
 						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
@@ -457,11 +458,11 @@ var _ = Describe("BuildLogCollector", func() {
 					}, nil)
 
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 4, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 4, Limit: 5}) {
 							return []db.Build{sb(9), successBuild(8), sb(7), reapedBuild(6), reapedBuild(5)}, db.Pagination{}, nil
-						} else if page == (db.Page{Until: 9, Limit: 5}) {
+						} else if reflect.DeepEqual(page, db.Page{Until: 9, Limit: 5}) {
 							return []db.Build{sb(14), successBuild(13), sb(12), sb(11), sb(10)}, db.Pagination{}, nil
-						} else if page == (db.Page{Until: 14, Limit: 5}) {
+						} else if reflect.DeepEqual(page, db.Page{Until: 14, Limit: 5}) {
 							return []db.Build{sb(18), sb(17), sb(16), sb(15)}, db.Pagination{}, nil
 						}
 						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
@@ -506,11 +507,11 @@ var _ = Describe("BuildLogCollector", func() {
 					}, nil)
 
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 4, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 4, Limit: 5}) {
 							return []db.Build{sb(9), successBuild(8), sb(7), reapedBuild(6), reapedBuild(5)}, db.Pagination{}, nil
-						} else if page == (db.Page{Until: 9, Limit: 5}) {
+						} else if reflect.DeepEqual(page, db.Page{Until: 9, Limit: 5}) {
 							return []db.Build{sb(14), successBuild(13), successBuild(12), sb(11), successBuild(10)}, db.Pagination{}, nil
-						} else if page == (db.Page{Until: 14, Limit: 5}) {
+						} else if reflect.DeepEqual(page, db.Page{Until: 14, Limit: 5}) {
 							return []db.Build{successBuild(18), sb(17), sb(16), successBuild(15)}, db.Pagination{}, nil
 						}
 						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
@@ -567,7 +568,7 @@ var _ = Describe("BuildLogCollector", func() {
 					buildLogRetainCalc = NewBuildLogRetentionCalculator(3, 3, 0, 0)
 
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
-						if page == (db.Page{Until: 0, Limit: 5}) {
+						if reflect.DeepEqual(page, db.Page{Until: 0, Limit: 5}) {
 							return []db.Build{sb(4), sb(3), sb(2), sb(1)}, db.Pagination{}, nil
 						}
 
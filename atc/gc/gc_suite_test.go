@@ -55,6 +55,8 @@ var (
 	usedResourceType db.ResourceType
 	logger           *lagertest.TestLogger
 	fakeLogFunc      = func(logger lager.Logger, id lock.LockID) {}
+
+	defaultBuildCreatedBy = "some-user"
 )
 
 var _ = BeforeSuite(func() {
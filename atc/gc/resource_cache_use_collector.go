@@ -47,5 +47,11 @@ func (rcuc *resourceCacheUseCollector) Run(ctx context.Context) error {
 		logger.Error("failed-to-clean-finished-build-uses", err)
 	}
 
+	err = rcuc.cacheLifecycle.CleanUsesForInactivePrefetches(logger.Session("clean-for-inactive-prefetches"))
+	if err != nil {
+		errs = multierror.Append(errs, err)
+		logger.Error("failed-to-clean-inactive-prefetch-uses", err)
+	}
+
 	return errs
 }
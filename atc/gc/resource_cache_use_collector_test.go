@@ -2,6 +2,7 @@ package gc_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -17,7 +18,7 @@ var _ = Describe("ResourceCacheUseCollector", func() {
 
 	BeforeEach(func() {
 		collector = gc.NewResourceCacheUseCollector(resourceCacheLifecycle)
-		buildCollector = gc.NewBuildCollector(buildFactory)
+		buildCollector = gc.NewBuildCollector(buildFactory, time.Hour)
 	})
 
 	Describe("Run", func() {
@@ -120,7 +121,7 @@ var _ = Describe("ResourceCacheUseCollector", func() {
 
 				BeforeEach(func() {
 					var err error
-					jobBuild, err = defaultJob.CreateBuild()
+					jobBuild, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
 					Expect(err).ToNot(HaveOccurred())
 
 					_, err = resourceCacheFactory.FindOrCreateResourceCache(
@@ -153,7 +154,7 @@ var _ = Describe("ResourceCacheUseCollector", func() {
 
 					BeforeEach(func() {
 						var err error
-						secondJobBuild, err = defaultJob.CreateBuild()
+						secondJobBuild, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
 						Expect(err).ToNot(HaveOccurred())
 
 						_, err = resourceCacheFactory.FindOrCreateResourceCache(
@@ -187,6 +188,76 @@ var _ = Describe("ResourceCacheUseCollector", func() {
 				})
 			})
 
+			Describe("for prefetched resources", func() {
+				BeforeEach(func() {
+					_, err = resourceCacheFactory.FindOrCreateResourceCache(
+						db.ForResource(usedResource.ID()),
+						"some-type",
+						atc.Version{"some": "version"},
+						atc.Source{
+							"some": "source",
+						},
+						nil,
+						atc.VersionedResourceTypes{
+							versionedResourceType,
+						},
+					)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				Context("while the pipeline is active", func() {
+					It("does not clean up the uses", func() {
+						Expect(countResourceCacheUses()).NotTo(BeZero())
+						Expect(collector.Run(context.TODO())).To(Succeed())
+						Expect(countResourceCacheUses()).NotTo(BeZero())
+					})
+				})
+
+				Context("when the pipeline is paused", func() {
+					It("cleans up the uses", func() {
+						Expect(countResourceCacheUses()).NotTo(BeZero())
+						Expect(defaultPipeline.Pause()).To(Succeed())
+						Expect(collector.Run(context.TODO())).To(Succeed())
+						Expect(countResourceCacheUses()).To(BeZero())
+					})
+				})
+			})
+
+			Describe("for prefetched images", func() {
+				BeforeEach(func() {
+					_, err = resourceCacheFactory.FindOrCreateResourceCache(
+						db.ForJob(defaultJob.ID()),
+						"some-type",
+						atc.Version{"some": "version"},
+						atc.Source{
+							"some": "source",
+						},
+						nil,
+						atc.VersionedResourceTypes{
+							versionedResourceType,
+						},
+					)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				Context("while the pipeline is active", func() {
+					It("does not clean up the uses", func() {
+						Expect(countResourceCacheUses()).NotTo(BeZero())
+						Expect(collector.Run(context.TODO())).To(Succeed())
+						Expect(countResourceCacheUses()).NotTo(BeZero())
+					})
+				})
+
+				Context("when the pipeline is archived", func() {
+					It("cleans up the uses", func() {
+						Expect(countResourceCacheUses()).NotTo(BeZero())
+						Expect(defaultPipeline.Archive()).To(Succeed())
+						Expect(collector.Run(context.TODO())).To(Succeed())
+						Expect(countResourceCacheUses()).To(BeZero())
+					})
+				})
+			})
+
 			Describe("for containers", func() {
 				var container db.CreatingContainer
 
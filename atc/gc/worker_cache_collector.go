@@ -0,0 +1,72 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type workerCacheCollector struct {
+	workerCacheLifecycle db.WorkerCacheLifecycle
+	diskUsageThreshold   int
+	evictionBatchSize    int
+}
+
+// NewWorkerCacheCollector returns a collector which evicts the least recently
+// used caches from the workers using more than the given percentage of the
+// disk holding their volumes, a batch at a time. A threshold of 0 disables
+// it.
+func NewWorkerCacheCollector(workerCacheLifecycle db.WorkerCacheLifecycle, diskUsageThreshold int, evictionBatchSize int) *workerCacheCollector {
+	return &workerCacheCollector{
+		workerCacheLifecycle: workerCacheLifecycle,
+		diskUsageThreshold:   diskUsageThreshold,
+		evictionBatchSize:    evictionBatchSize,
+	}
+}
+
+func (c *workerCacheCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("worker-cache-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	if c.diskUsageThreshold <= 0 {
+		return nil
+	}
+
+	workerNames, err := c.workerCacheLifecycle.WorkersOverDiskUsage(c.diskUsageThreshold)
+	if err != nil {
+		logger.Error("failed-to-find-workers-over-disk-usage", err)
+		return err
+	}
+
+	for _, workerName := range workerNames {
+		// the worker's usage is only reported again once the volumes of the
+		// evicted caches have been destroyed, so evict a bounded batch per run
+		// rather than until the worker is back under the threshold
+		evicted, err := c.workerCacheLifecycle.EvictLeastRecentlyUsedCaches(workerName, c.evictionBatchSize)
+		if err != nil {
+			logger.Error("failed-to-evict-caches", err, lager.Data{"worker": workerName})
+			return err
+		}
+
+		if evicted.ResourceCaches > 0 || evicted.TaskCaches > 0 {
+			logger.Info("evicted-caches", lager.Data{
+				"worker":          workerName,
+				"resource-caches": evicted.ResourceCaches,
+				"task-caches":     evicted.TaskCaches,
+			})
+		}
+
+		metric.WorkerCachesEvicted{
+			WorkerName:     workerName,
+			ResourceCaches: evicted.ResourceCaches,
+			TaskCaches:     evicted.TaskCaches,
+		}.Emit(logger)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerCacheCollector", func() {
+	var collector GcCollector
+	var fakeWorkerCacheLifecycle *dbfakes.FakeWorkerCacheLifecycle
+	var threshold int
+
+	BeforeEach(func() {
+		fakeWorkerCacheLifecycle = new(dbfakes.FakeWorkerCacheLifecycle)
+		fakeWorkerCacheLifecycle.WorkersOverDiskUsageReturns([]string{"some-worker", "other-worker"}, nil)
+		fakeWorkerCacheLifecycle.EvictLeastRecentlyUsedCachesReturns(db.EvictedCaches{ResourceCaches: 1}, nil)
+
+		threshold = 90
+	})
+
+	JustBeforeEach(func() {
+		collector = gc.NewWorkerCacheCollector(fakeWorkerCacheLifecycle, threshold, 5)
+	})
+
+	Describe("Run", func() {
+		It("evicts a batch of caches from each worker over the disk usage threshold", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeWorkerCacheLifecycle.WorkersOverDiskUsageCallCount()).To(Equal(1))
+			Expect(fakeWorkerCacheLifecycle.WorkersOverDiskUsageArgsForCall(0)).To(Equal(90))
+
+			Expect(fakeWorkerCacheLifecycle.EvictLeastRecentlyUsedCachesCallCount()).To(Equal(2))
+
+			workerName, limit := fakeWorkerCacheLifecycle.EvictLeastRecentlyUsedCachesArgsForCall(0)
+			Expect(workerName).To(Equal("some-worker"))
+			Expect(limit).To(Equal(5))
+
+			workerName, limit = fakeWorkerCacheLifecycle.EvictLeastRecentlyUsedCachesArgsForCall(1)
+			Expect(workerName).To(Equal("other-worker"))
+			Expect(limit).To(Equal(5))
+		})
+
+		Context("when the threshold is 0", func() {
+			BeforeEach(func() {
+				threshold = 0
+			})
+
+			It("does nothing", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeWorkerCacheLifecycle.WorkersOverDiskUsageCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when evicting fails", func() {
+			BeforeEach(func() {
+				fakeWorkerCacheLifecycle.EvictLeastRecentlyUsedCachesReturns(db.EvictedCaches{}, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("nope"))
+			})
+		})
+	})
+})
@@ -1,5 +1,7 @@
 package atc
 
+import "time"
+
 type JobConfig struct {
 	Name    string `json:"name"`
 	OldName string `json:"old_name,omitempty"`
@@ -14,6 +16,12 @@ type JobConfig struct {
 
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	KeepFailedContainers string `json:"keep_failed_containers,omitempty"`
+
+	LogLimit    string         `json:"log_limit,omitempty"`
+	OnLogLimit  LogLimitAction `json:"on_log_limit,omitempty"`
+	IdleTimeout string         `json:"idle_timeout,omitempty"`
+
 	Abort   *PlanConfig `json:"on_abort,omitempty"`
 	Error   *PlanConfig `json:"on_error,omitempty"`
 	Failure *PlanConfig `json:"on_failure,omitempty"`
@@ -44,6 +52,30 @@ func (config JobConfig) Plans() []PlanConfig {
 	return collectPlans(config.Plan())
 }
 
+// PrefetchedImages returns the plans of the job's tasks which are configured
+// inline with an image_resource to be prefetched.
+func (config JobConfig) PrefetchedImages() []PlanConfig {
+	var plans []PlanConfig
+	for _, plan := range config.Plans() {
+		if plan.Task == "" || plan.File != "" || plan.ImageArtifactName != "" || plan.TaskConfig == nil {
+			continue
+		}
+
+		if plan.TaskConfig.ImageResource != nil && plan.TaskConfig.ImageResource.Prefetch {
+			plans = append(plans, plan)
+		}
+	}
+
+	return plans
+}
+
+// KeepFailedContainersFor returns how long the containers of the job's failed
+// builds are kept after they finish, or 0 if they are collected as usual.
+func (config JobConfig) KeepFailedContainersFor() time.Duration {
+	duration, _ := time.ParseDuration(config.KeepFailedContainers)
+	return duration
+}
+
 func (config JobConfig) MaxInFlight() int {
 	if config.Serial || len(config.SerialGroups) > 0 {
 		return 1
@@ -56,6 +88,83 @@ func (config JobConfig) MaxInFlight() int {
 	return 0
 }
 
+// BreakAt returns a copy of the config in which the get, put and task steps
+// with the given names pause the build before or after they run, as if they
+// were configured with a breakpoint.
+func (config JobConfig) BreakAt(breakpoints map[string]Breakpoint) JobConfig {
+	if len(breakpoints) == 0 {
+		return config
+	}
+
+	plans := make([]PlanConfig, len(config.PlanSequence))
+	for i, plan := range config.PlanSequence {
+		plans[i] = breakAt(plan, breakpoints)
+	}
+
+	config.PlanSequence = plans
+	config.Abort = breakAtHook(config.Abort, breakpoints)
+	config.Error = breakAtHook(config.Error, breakpoints)
+	config.Failure = breakAtHook(config.Failure, breakpoints)
+	config.Ensure = breakAtHook(config.Ensure, breakpoints)
+	config.Success = breakAtHook(config.Success, breakpoints)
+
+	return config
+}
+
+func breakAt(plan PlanConfig, breakpoints map[string]Breakpoint) PlanConfig {
+	if plan.Get != "" || plan.Put != "" || plan.Task != "" {
+		if breakpoint, found := breakpoints[plan.Name()]; found {
+			plan.Breakpoint = breakpoint
+		}
+	}
+
+	plan.Abort = breakAtHook(plan.Abort, breakpoints)
+	plan.Error = breakAtHook(plan.Error, breakpoints)
+	plan.Failure = breakAtHook(plan.Failure, breakpoints)
+	plan.Ensure = breakAtHook(plan.Ensure, breakpoints)
+	plan.Success = breakAtHook(plan.Success, breakpoints)
+	plan.Try = breakAtHook(plan.Try, breakpoints)
+
+	if plan.Do != nil {
+		steps := make(PlanSequence, len(*plan.Do))
+		for i, step := range *plan.Do {
+			steps[i] = breakAt(step, breakpoints)
+		}
+
+		plan.Do = &steps
+	}
+
+	if plan.Aggregate != nil {
+		steps := make(PlanSequence, len(*plan.Aggregate))
+		for i, step := range *plan.Aggregate {
+			steps[i] = breakAt(step, breakpoints)
+		}
+
+		plan.Aggregate = &steps
+	}
+
+	if plan.InParallel != nil {
+		inParallel := *plan.InParallel
+		inParallel.Steps = make([]PlanConfig, len(plan.InParallel.Steps))
+		for i, step := range plan.InParallel.Steps {
+			inParallel.Steps[i] = breakAt(step, breakpoints)
+		}
+
+		plan.InParallel = &inParallel
+	}
+
+	return plan
+}
+
+func breakAtHook(hook *PlanConfig, breakpoints map[string]Breakpoint) *PlanConfig {
+	if hook == nil {
+		return nil
+	}
+
+	plan := breakAt(*hook, breakpoints)
+	return &plan
+}
+
 func collectPlans(plan PlanConfig) []PlanConfig {
 	var plans []PlanConfig
 
@@ -62,6 +62,101 @@ var _ = Describe("JobConfig", func() {
 		})
 	})
 
+	Describe("BreakAt", func() {
+		var jobConfig atc.JobConfig
+
+		BeforeEach(func() {
+			jobConfig = atc.JobConfig{
+				PlanSequence: []atc.PlanConfig{
+					{Get: "some-resource", Resource: "some-resource"},
+					{
+						InParallel: &atc.InParallelConfig{
+							Steps: []atc.PlanConfig{
+								{Task: "some-task"},
+								{Task: "other-task"},
+							},
+						},
+					},
+					{
+						Put: "some-put",
+						Success: &atc.PlanConfig{
+							Do: &atc.PlanSequence{{Task: "some-hook"}},
+						},
+					},
+				},
+				Failure: &atc.PlanConfig{Task: "some-failure-hook"},
+			}
+		})
+
+		It("sets a breakpoint on the steps with the given names", func() {
+			config := jobConfig.BreakAt(map[string]atc.Breakpoint{
+				"some-task":         atc.BreakpointAfter,
+				"some-hook":         atc.BreakpointBefore,
+				"some-failure-hook": atc.BreakpointAfter,
+			})
+
+			Expect(config.PlanSequence[0].Breakpoint).To(BeEmpty())
+			Expect(config.PlanSequence[1].InParallel.Steps[0].Breakpoint).To(Equal(atc.BreakpointAfter))
+			Expect(config.PlanSequence[1].InParallel.Steps[1].Breakpoint).To(BeEmpty())
+			Expect(config.PlanSequence[2].Breakpoint).To(BeEmpty())
+			Expect((*config.PlanSequence[2].Success.Do)[0].Breakpoint).To(Equal(atc.BreakpointBefore))
+			Expect(config.Failure.Breakpoint).To(Equal(atc.BreakpointAfter))
+		})
+
+		It("does not modify the original config", func() {
+			jobConfig.BreakAt(map[string]atc.Breakpoint{
+				"some-resource":     atc.BreakpointAfter,
+				"some-task":         atc.BreakpointAfter,
+				"some-hook":         atc.BreakpointBefore,
+				"some-failure-hook": atc.BreakpointAfter,
+			})
+
+			Expect(jobConfig.PlanSequence[0].Breakpoint).To(BeEmpty())
+			Expect(jobConfig.PlanSequence[1].InParallel.Steps[0].Breakpoint).To(BeEmpty())
+			Expect((*jobConfig.PlanSequence[2].Success.Do)[0].Breakpoint).To(BeEmpty())
+			Expect(jobConfig.Failure.Breakpoint).To(BeEmpty())
+		})
+	})
+
+	Describe("PrefetchedImages", func() {
+		It("returns the inline tasks with an image_resource to prefetch", func() {
+			prefetched := atc.PlanConfig{
+				Task: "prefetched",
+				TaskConfig: &atc.TaskConfig{
+					ImageResource: &atc.ImageResource{Type: "registry-image", Prefetch: true},
+				},
+			}
+
+			jobConfig := atc.JobConfig{
+				PlanSequence: []atc.PlanConfig{
+					{
+						Task: "not-prefetched",
+						TaskConfig: &atc.TaskConfig{
+							ImageResource: &atc.ImageResource{Type: "registry-image"},
+						},
+					},
+					{
+						Task: "from-file",
+						File: "some/task.yml",
+						TaskConfig: &atc.TaskConfig{
+							ImageResource: &atc.ImageResource{Type: "registry-image", Prefetch: true},
+						},
+					},
+					{
+						Task:              "with-image-artifact",
+						ImageArtifactName: "some-image",
+						TaskConfig: &atc.TaskConfig{
+							ImageResource: &atc.ImageResource{Type: "registry-image", Prefetch: true},
+						},
+					},
+				},
+				Ensure: &prefetched,
+			}
+
+			Expect(jobConfig.PrefetchedImages()).To(Equal([]atc.PlanConfig{prefetched}))
+		})
+	})
+
 	Describe("Inputs", func() {
 		var (
 			jobConfig atc.JobConfig
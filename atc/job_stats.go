@@ -0,0 +1,41 @@
+package atc
+
+import "time"
+
+const JobStatsQueryWindow = "window"
+
+// JobStatsDefaultWindow is how far back job stats look when no window is
+// given.
+const JobStatsDefaultWindow = 7 * 24 * time.Hour
+
+// JobStats summarizes the builds of a job which finished within the window.
+// Durations are in seconds, and rates are fractions of the finished builds.
+type JobStats struct {
+	Window int64 `json:"window"`
+
+	Builds    int `json:"builds"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Errored   int `json:"errored"`
+	Aborted   int `json:"aborted"`
+
+	SuccessRate float64 `json:"success_rate"`
+	FailureRate float64 `json:"failure_rate"`
+	ErrorRate   float64 `json:"error_rate"`
+
+	Durations JobStatsDurations `json:"durations"`
+
+	Recoveries         int   `json:"recoveries"`
+	MeanTimeToRecovery int64 `json:"mean_time_to_recovery,omitempty"`
+
+	// Flakiness is the fraction of failed builds which succeeded when rerun
+	// with the same inputs.
+	FlakyBuilds int     `json:"flaky_builds"`
+	Flakiness   float64 `json:"flakiness"`
+}
+
+type JobStatsDurations struct {
+	P50 int64 `json:"p50"`
+	P90 int64 `json:"p90"`
+	P99 int64 `json:"p99"`
+}
@@ -0,0 +1,142 @@
+package lidar
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	"golang.org/x/time/rate"
+)
+
+// CheckRateLimits configures the maximum number of checks per second for
+// checks of a given resource type, or for checks whose source points at a
+// given host. They are enforced on top of the global rate of checks.
+//
+// Burst is the number of checks that may run at once before a limit takes
+// effect; it defaults to 1.
+type CheckRateLimits struct {
+	ResourceTypes map[string]float64
+	Hosts         map[string]float64
+	Burst         int
+}
+
+// sourceHostKeys are the fields of a resource's source that are looked at to
+// determine the host that a check will talk to.
+var sourceHostKeys = []string{"uri", "url"}
+
+type checkRateLimiter struct {
+	limiters map[string]*rate.Limiter
+}
+
+func newCheckRateLimiter(limits CheckRateLimits) *checkRateLimiter {
+	burst := limits.Burst
+	if burst < 1 {
+		burst = 1
+	}
+
+	limiters := map[string]*rate.Limiter{}
+
+	for resourceType, limit := range limits.ResourceTypes {
+		limiters[typeLimitKey(resourceType)] = rate.NewLimiter(rate.Limit(limit), burst)
+	}
+
+	for host, limit := range limits.Hosts {
+		limiters[hostLimitKey(strings.ToLower(host))] = rate.NewLimiter(rate.Limit(limit), burst)
+	}
+
+	return &checkRateLimiter{
+		limiters: limiters,
+	}
+}
+
+// Reserve takes a token from every limiter applying to the given keys, and
+// returns how long to wait before the check may run along with the key of
+// the limiter that holds it back the longest.
+//
+// If any of the limiters can never allow the check to run, no tokens are
+// taken and false is returned.
+func (l *checkRateLimiter) Reserve(keys []string, now time.Time) (string, time.Duration, bool) {
+	var (
+		reservations []*rate.Reservation
+		limit        string
+		delay        time.Duration
+	)
+
+	for _, key := range keys {
+		limiter, found := l.limiters[key]
+		if !found {
+			continue
+		}
+
+		reservation := limiter.ReserveN(now, 1)
+		if !reservation.OK() {
+			for _, r := range reservations {
+				r.CancelAt(now)
+			}
+
+			return key, 0, false
+		}
+
+		reservations = append(reservations, reservation)
+
+		if d := reservation.DelayFrom(now); d > delay {
+			limit, delay = key, d
+		}
+	}
+
+	return limit, delay, true
+}
+
+// checkQueueKeys returns the keys identifying the resource type and upstream
+// host of a check. The keys are used both for rate limiting and for queueing
+// checks fairly.
+func checkQueueKeys(check db.Check) []string {
+	plan := check.Plan().Check
+	if plan == nil {
+		return nil
+	}
+
+	keys := []string{typeLimitKey(plan.Type)}
+
+	if host, found := sourceHost(plan.Source); found {
+		keys = append(keys, hostLimitKey(host))
+	}
+
+	return keys
+}
+
+func sourceHost(source map[string]interface{}) (string, bool) {
+	for _, field := range sourceHostKeys {
+		value, ok := source[field].(string)
+		if !ok || value == "" {
+			continue
+		}
+
+		if u, err := url.Parse(value); err == nil && u.Host != "" {
+			return strings.ToLower(u.Hostname()), true
+		}
+
+		// scp-like syntax as used by git, e.g. git@github.com:concourse/concourse.git
+		if i := strings.Index(value, ":"); i > 0 && !strings.Contains(value[:i], "/") {
+			host := value[:i]
+			if at := strings.LastIndex(host, "@"); at >= 0 {
+				host = host[at+1:]
+			}
+
+			if host != "" {
+				return strings.ToLower(host), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func typeLimitKey(resourceType string) string {
+	return "type:" + resourceType
+}
+
+func hostLimitKey(host string) string {
+	return "host:" + host
+}
@@ -3,7 +3,9 @@ package lidar
 import (
 	"context"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -24,6 +26,7 @@ func NewChecker(
 	checkFactory db.CheckFactory,
 	engine engine.Engine,
 	checkRateCalculator RateCalculator,
+	checkRateLimits CheckRateLimits,
 ) *checker {
 	return &checker{
 		logger:              logger,
@@ -31,6 +34,7 @@ func NewChecker(
 		engine:              engine,
 		running:             &sync.Map{},
 		checkRateCalculator: checkRateCalculator,
+		checkRateLimiter:    newCheckRateLimiter(checkRateLimits),
 	}
 }
 
@@ -40,6 +44,7 @@ type checker struct {
 	checkFactory        db.CheckFactory
 	engine              engine.Engine
 	checkRateCalculator RateCalculator
+	checkRateLimiter    *checkRateLimiter
 
 	running *sync.Map
 }
@@ -65,42 +70,127 @@ func (c *checker) Run(ctx context.Context) error {
 		return err
 	}
 
+	// Manually triggered checks are run right away. All other checks are
+	// queued by resource type and upstream host, and the queues are taken
+	// from in turn so that a burst of checks for one of them does not hold up
+	// checks for all the others.
+	queues := map[string][]db.Check{}
+	var queueOrder []string
+
 	for _, ck := range checks {
-		if _, exists := c.running.LoadOrStore(ck.ID(), true); !exists {
-			if !ck.ManuallyTriggered() {
+		if _, exists := c.running.Load(ck.ID()); exists {
+			continue
+		}
+
+		if ck.ManuallyTriggered() {
+			c.run(ctx, ck)
+			continue
+		}
+
+		key := strings.Join(checkQueueKeys(ck), ",")
+		if _, exists := queues[key]; !exists {
+			queueOrder = append(queueOrder, key)
+		}
+
+		queues[key] = append(queues[key], ck)
+	}
+
+	for len(queueOrder) > 0 {
+		var remaining []string
+
+		for _, key := range queueOrder {
+			queue := queues[key]
+			ck := queue[0]
+
+			limit, delay, allowed := c.checkRateLimiter.Reserve(checkQueueKeys(ck), time.Now())
+			if !allowed {
+				c.logger.Debug("check-not-allowed-by-limit", lager.Data{"check": ck.ID(), "limit": limit})
+			} else if delay > 0 {
+				// the check has its turn once the limit allows it; it's marked as
+				// running in the meantime so that it's counted and reserved for only
+				// once
+				c.logger.Debug("check-throttled", lager.Data{"check": ck.ID(), "limit": limit, "delay": delay.String()})
+				metric.ChecksThrottled.Inc(limit)
+				c.runAfter(ctx, ck, delay, limiter)
+			} else {
 				err := limiter.Wait(ctx)
 				if err != nil {
 					c.logger.Error("failed-to-wait-for-limiter", err)
-					continue
+				} else {
+					c.run(ctx, ck)
 				}
 			}
 
-			go func(check db.Check) {
-				spanCtx, span := tracing.StartSpanFollowing(
-					ctx,
-					check,
-					"checker.Run",
-					tracing.Attrs{
-						"team":                     check.TeamName(),
-						"pipeline":                 check.PipelineName(),
-						"check_id":                 strconv.Itoa(check.ID()),
-						"resource_config_scope_id": strconv.Itoa(check.ResourceConfigScopeID()),
-					},
-				)
-				defer span.End()
-				defer c.running.Delete(check.ID())
-
-				c.engine.NewCheck(check).Run(
-					lagerctx.NewContext(
-						spanCtx,
-						c.logger.WithData(lager.Data{
-							"check": check.ID(),
-						}),
-					),
-				)
-			}(ck)
+			if len(queue) > 1 {
+				queues[key] = queue[1:]
+				remaining = append(remaining, key)
+			}
 		}
+
+		queueOrder = remaining
 	}
 
 	return nil
 }
+
+// runAfter runs a check once the given delay has passed and the global rate
+// of checks allows it.
+func (c *checker) runAfter(ctx context.Context, ck db.Check, delay time.Duration, limiter Limiter) {
+	if _, exists := c.running.LoadOrStore(ck.ID(), true); exists {
+		return
+	}
+
+	go func(check db.Check) {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			c.running.Delete(check.ID())
+			return
+		}
+
+		err := limiter.Wait(ctx)
+		if err != nil {
+			c.logger.Error("failed-to-wait-for-limiter", err)
+			c.running.Delete(check.ID())
+			return
+		}
+
+		c.check(ctx, check)
+	}(ck)
+}
+
+func (c *checker) run(ctx context.Context, ck db.Check) {
+	if _, exists := c.running.LoadOrStore(ck.ID(), true); exists {
+		return
+	}
+
+	go c.check(ctx, ck)
+}
+
+func (c *checker) check(ctx context.Context, check db.Check) {
+	spanCtx, span := tracing.StartSpanFollowing(
+		ctx,
+		check,
+		"checker.Run",
+		tracing.Attrs{
+			"team":                     check.TeamName(),
+			"pipeline":                 check.PipelineName(),
+			"check_id":                 strconv.Itoa(check.ID()),
+			"resource_config_scope_id": strconv.Itoa(check.ResourceConfigScopeID()),
+		},
+	)
+	defer span.End()
+	defer c.running.Delete(check.ID())
+
+	c.engine.NewCheck(check).Run(
+		lagerctx.NewContext(
+			spanCtx,
+			c.logger.WithData(lager.Data{
+				"check": check.ID(),
+			}),
+		),
+	)
+}
@@ -9,12 +9,14 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/engine/enginefakes"
 	"github.com/concourse/concourse/atc/lidar"
 	"github.com/concourse/concourse/atc/lidar/lidarfakes"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/tracing"
 	"go.opentelemetry.io/otel/api/trace"
 	"go.opentelemetry.io/otel/api/trace/testtrace"
@@ -32,9 +34,13 @@ var _ = Describe("Checker", func() {
 		fakeEngine         *enginefakes.FakeEngine
 		fakeRateCalculator *lidarfakes.FakeRateCalculator
 		fakeLimiter        *lidarfakes.FakeLimiter
+		checkRateLimits    lidar.CheckRateLimits
 
 		checker Checker
 		logger  *lagertest.TestLogger
+
+		ctx    context.Context
+		cancel context.CancelFunc
 	)
 
 	BeforeEach(func() {
@@ -42,8 +48,15 @@ var _ = Describe("Checker", func() {
 		fakeEngine = new(enginefakes.FakeEngine)
 		fakeRateCalculator = new(lidarfakes.FakeRateCalculator)
 		fakeLimiter = new(lidarfakes.FakeLimiter)
+		checkRateLimits = lidar.CheckRateLimits{}
 
 		logger = lagertest.NewTestLogger("test")
+
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
 	})
 
 	JustBeforeEach(func() {
@@ -52,9 +65,10 @@ var _ = Describe("Checker", func() {
 			fakeCheckFactory,
 			fakeEngine,
 			fakeRateCalculator,
+			checkRateLimits,
 		)
 
-		err = checker.Run(context.TODO())
+		err = checker.Run(ctx)
 	})
 
 	Describe("Run", func() {
@@ -161,6 +175,127 @@ var _ = Describe("Checker", func() {
 				})
 			})
 
+			Context("when checks are queued for different resource types", func() {
+				BeforeEach(func() {
+					fakeCheck1.PlanReturns(atc.Plan{Check: &atc.CheckPlan{Type: "git"}})
+					fakeCheck2.PlanReturns(atc.Plan{Check: &atc.CheckPlan{Type: "git"}})
+					fakeCheck3.PlanReturns(atc.Plan{Check: &atc.CheckPlan{Type: "time"}})
+
+					fakeLimiter.WaitReturns(nil)
+					fakeLimiter.WaitReturnsOnCall(1, errors.New("not-allowed"))
+					fakeRateCalculator.RateLimiterReturns(fakeLimiter, nil)
+				})
+
+				It("takes checks from each queue in turn", func() {
+					Eventually(fakeEngine.NewCheckCallCount).Should(Equal(2))
+					Consistently(fakeEngine.NewCheckCallCount).Should(Equal(2))
+
+					ids := []int{
+						fakeEngine.NewCheckArgsForCall(0).ID(),
+						fakeEngine.NewCheckArgsForCall(1).ID(),
+					}
+					Expect(ids).To(ConsistOf(fakeCheck1.ID(), fakeCheck2.ID()))
+				})
+
+				Context("when a resource type has a rate limit", func() {
+					BeforeEach(func() {
+						fakeLimiter.WaitReturnsOnCall(1, nil)
+
+						checkRateLimits.ResourceTypes = map[string]float64{"git": 0.001}
+
+						metric.ChecksThrottled.Deltas()
+					})
+
+					It("runs only as many checks of that type as the limit allows", func() {
+						Eventually(fakeEngine.NewCheckCallCount).Should(Equal(2))
+						Consistently(fakeEngine.NewCheckCallCount).Should(Equal(2))
+
+						ids := []int{
+							fakeEngine.NewCheckArgsForCall(0).ID(),
+							fakeEngine.NewCheckArgsForCall(1).ID(),
+						}
+						Expect(ids).To(ConsistOf(fakeCheck1.ID(), fakeCheck3.ID()))
+					})
+
+					It("counts the throttled checks", func() {
+						Expect(metric.ChecksThrottled.Deltas()).To(HaveKeyWithValue("type:git", float64(1)))
+					})
+
+					Context("when the checker runs again before the throttled check has its turn", func() {
+						JustBeforeEach(func() {
+							Expect(checker.Run(ctx)).To(Succeed())
+						})
+
+						It("neither runs nor counts the throttled check again", func() {
+							Eventually(fakeEngine.NewCheckCallCount).Should(Equal(2))
+							Consistently(fakeEngine.NewCheckCallCount).Should(Equal(2))
+							Expect(metric.ChecksThrottled.Deltas()).To(HaveKeyWithValue("type:git", float64(1)))
+						})
+					})
+
+					Context("when the throttled check has its turn", func() {
+						BeforeEach(func() {
+							checkRateLimits.ResourceTypes = map[string]float64{"git": 10}
+						})
+
+						It("runs it later rather than dropping it", func() {
+							Eventually(fakeEngine.NewCheckCallCount).Should(Equal(3))
+
+							ids := []int{
+								fakeEngine.NewCheckArgsForCall(0).ID(),
+								fakeEngine.NewCheckArgsForCall(1).ID(),
+								fakeEngine.NewCheckArgsForCall(2).ID(),
+							}
+							Expect(ids).To(ConsistOf(fakeCheck1.ID(), fakeCheck2.ID(), fakeCheck3.ID()))
+						})
+					})
+
+					Context("when the limit allows a burst of checks", func() {
+						BeforeEach(func() {
+							checkRateLimits.Burst = 2
+						})
+
+						It("runs them right away", func() {
+							Eventually(fakeEngine.NewCheckCallCount).Should(Equal(3))
+							Expect(metric.ChecksThrottled.Deltas()["type:git"]).To(BeZero())
+						})
+					})
+				})
+			})
+
+			Context("when a host has a rate limit", func() {
+				BeforeEach(func() {
+					fakeCheck1.PlanReturns(atc.Plan{Check: &atc.CheckPlan{
+						Type:   "git",
+						Source: atc.Source{"uri": "https://GitHub.com/concourse/concourse.git"},
+					}})
+					fakeCheck2.PlanReturns(atc.Plan{Check: &atc.CheckPlan{
+						Type:   "git",
+						Source: atc.Source{"uri": "git@github.com:concourse/concourse.git"},
+					}})
+					fakeCheck3.PlanReturns(atc.Plan{Check: &atc.CheckPlan{
+						Type:   "git",
+						Source: atc.Source{"uri": "https://gitlab.com/some/repo.git"},
+					}})
+
+					fakeLimiter.WaitReturns(nil)
+					fakeRateCalculator.RateLimiterReturns(fakeLimiter, nil)
+
+					checkRateLimits.Hosts = map[string]float64{"github.com": 0.001}
+				})
+
+				It("runs only as many checks against that host as the limit allows", func() {
+					Eventually(fakeEngine.NewCheckCallCount).Should(Equal(2))
+					Consistently(fakeEngine.NewCheckCallCount).Should(Equal(2))
+
+					ids := []int{
+						fakeEngine.NewCheckArgsForCall(0).ID(),
+						fakeEngine.NewCheckArgsForCall(1).ID(),
+					}
+					Expect(ids).To(ConsistOf(fakeCheck1.ID(), fakeCheck3.ID()))
+				})
+			})
+
 			Context("when calculating the rate limit fails", func() {
 				BeforeEach(func() {
 					fakeRateCalculator.RateLimiterReturns(nil, errors.New("disaster"))
@@ -8,6 +8,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/metric"
@@ -22,6 +23,8 @@ func NewScanner(
 	defaultCheckTimeout time.Duration,
 	defaultCheckInterval time.Duration,
 	defaultWithWebhookCheckInterval time.Duration,
+	maxCheckBackoff time.Duration,
+	shard Shard,
 ) *scanner {
 	return &scanner{
 		logger:                          logger,
@@ -30,6 +33,8 @@ func NewScanner(
 		defaultCheckTimeout:             defaultCheckTimeout,
 		defaultCheckInterval:            defaultCheckInterval,
 		defaultWithWebhookCheckInterval: defaultWithWebhookCheckInterval,
+		maxCheckBackoff:                 maxCheckBackoff,
+		shard:                           shard,
 	}
 }
 
@@ -41,13 +46,17 @@ type scanner struct {
 	defaultCheckTimeout             time.Duration
 	defaultCheckInterval            time.Duration
 	defaultWithWebhookCheckInterval time.Duration
+	maxCheckBackoff                 time.Duration
+	shard                           Shard
 }
 
 func (s *scanner) Run(ctx context.Context) error {
 	spanCtx, span := tracing.StartSpan(ctx, "scanner.Run", nil)
-	s.logger.Info("start")
+	s.logger.Info("start", lager.Data{"shard": s.shard.Index})
 	defer span.End()
-	defer s.logger.Info("end")
+	defer s.logger.Info("end", lager.Data{"shard": s.shard.Index})
+
+	start := time.Now()
 
 	resources, err := s.checkFactory.Resources()
 	if err != nil {
@@ -64,7 +73,13 @@ func (s *scanner) Run(ctx context.Context) error {
 	waitGroup := new(sync.WaitGroup)
 	resourceTypesChecked := &sync.Map{}
 
+	scanned := 0
 	for _, resource := range resources {
+		if !s.shard.Owns(resource) {
+			continue
+		}
+
+		scanned++
 		waitGroup.Add(1)
 
 		go func(resource db.Resource, resourceTypes db.ResourceTypes) {
@@ -78,6 +93,12 @@ func (s *scanner) Run(ctx context.Context) error {
 
 	waitGroup.Wait()
 
+	metric.ScannerShardScanned{
+		Shard:      s.shard.String(),
+		Checkables: scanned,
+		Duration:   time.Since(start),
+	}.Emit(s.logger)
+
 	return s.checkFactory.NotifyChecker()
 }
 
@@ -108,11 +129,18 @@ func (s *scanner) check(ctx context.Context, checkable db.Checkable, resourceTyp
 		}
 	}
 
+	// versions can only be pushed through the API for resources, so a
+	// resource type is still checked as usual
+	_, isResourceType := checkable.(db.ResourceType)
+	if checkable.CheckEvery() == atc.CheckEveryNever && !isResourceType {
+		return nil
+	}
+
 	interval := s.defaultCheckInterval
 	if checkable.HasWebhook() {
 		interval = s.defaultWithWebhookCheckInterval
 	}
-	if every := checkable.CheckEvery(); every != "" {
+	if every := checkable.CheckEvery(); every != "" && every != atc.CheckEveryNever {
 		interval, err = time.ParseDuration(every)
 		if err != nil {
 			s.logger.Error("failed-to-parse-check-every", err)
@@ -120,6 +148,8 @@ func (s *scanner) check(ctx context.Context, checkable db.Checkable, resourceTyp
 		}
 	}
 
+	interval = s.backoff(interval, checkable.CheckFailures())
+
 	if time.Now().Before(checkable.LastCheckEndTime().Add(interval)) {
 		return nil
 	}
@@ -141,6 +171,25 @@ func (s *scanner) check(ctx context.Context, checkable db.Checkable, resourceTyp
 	return nil
 }
 
+// backoff doubles the check interval for every consecutive failed check, up
+// to the configured maximum. The interval is never made shorter than the one
+// configured for the checkable, and a maximum of 0 disables backing off.
+func (s *scanner) backoff(interval time.Duration, failures int) time.Duration {
+	if failures <= 0 || interval <= 0 || s.maxCheckBackoff <= interval {
+		return interval
+	}
+
+	for i := 0; i < failures; i++ {
+		interval *= 2
+
+		if interval >= s.maxCheckBackoff || interval <= 0 {
+			return s.maxCheckBackoff
+		}
+	}
+
+	return interval
+}
+
 func (s *scanner) setCheckError(logger lager.Logger, checkable db.Checkable, err error) {
 	setErr := checkable.SetCheckSetupError(err)
 	if setErr != nil {
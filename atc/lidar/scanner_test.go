@@ -3,6 +3,7 @@ package lidar_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"code.cloudfoundry.org/lager/lagertest"
@@ -28,6 +29,7 @@ var _ = Describe("Scanner", func() {
 		fakeSecrets      *credsfakes.FakeSecrets
 
 		logger  *lagertest.TestLogger
+		shard   lidar.Shard
 		scanner Scanner
 	)
 
@@ -36,6 +38,10 @@ var _ = Describe("Scanner", func() {
 		fakeSecrets = new(credsfakes.FakeSecrets)
 
 		logger = lagertest.NewTestLogger("test")
+		shard = lidar.Shard{}
+	})
+
+	JustBeforeEach(func() {
 		scanner = lidar.NewScanner(
 			logger,
 			fakeCheckFactory,
@@ -43,10 +49,10 @@ var _ = Describe("Scanner", func() {
 			time.Minute*1,
 			time.Minute*1,
 			time.Minute*10,
+			time.Hour*1,
+			shard,
 		)
-	})
 
-	JustBeforeEach(func() {
 		err = scanner.Run(context.TODO())
 	})
 
@@ -108,6 +114,22 @@ var _ = Describe("Scanner", func() {
 						fakeResource.TypeReturns("base-type")
 					})
 
+					Context("when periodic checking is disabled", func() {
+						BeforeEach(func() {
+							fakeResource.CheckEveryReturns("never")
+							fakeResource.LastCheckEndTimeReturns(time.Now().Add(-time.Hour))
+						})
+
+						It("does not check", func() {
+							Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(0))
+						})
+
+						It("clears the check error", func() {
+							Expect(fakeResource.SetCheckSetupErrorCallCount()).To(Equal(1))
+							Expect(fakeResource.SetCheckSetupErrorArgsForCall(0)).To(BeNil())
+						})
+					})
+
 					Context("when the check interval is parseable", func() {
 						BeforeEach(func() {
 							fakeResource.CheckEveryReturns("10s")
@@ -147,6 +169,58 @@ var _ = Describe("Scanner", func() {
 							})
 						})
 
+						Context("when previous checks have been failing", func() {
+							BeforeEach(func() {
+								fakeResource.CheckFailuresReturns(3)
+							})
+
+							Context("when the last check end time is within the backed off interval", func() {
+								BeforeEach(func() {
+									fakeResource.LastCheckEndTimeReturns(time.Now().Add(-time.Second * 70))
+								})
+
+								It("does not check", func() {
+									Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(0))
+								})
+							})
+
+							Context("when the last check end time is past the backed off interval", func() {
+								BeforeEach(func() {
+									fakeResource.LastCheckEndTimeReturns(time.Now().Add(-time.Second * 90))
+								})
+
+								It("creates a check", func() {
+									Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+								})
+							})
+
+							Context("when the checks have failed many times", func() {
+								BeforeEach(func() {
+									fakeResource.CheckFailuresReturns(100)
+								})
+
+								Context("when the last check end time is within the maximum backoff", func() {
+									BeforeEach(func() {
+										fakeResource.LastCheckEndTimeReturns(time.Now().Add(-time.Minute * 59))
+									})
+
+									It("does not check", func() {
+										Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(0))
+									})
+								})
+
+								Context("when the last check end time is past the maximum backoff", func() {
+									BeforeEach(func() {
+										fakeResource.LastCheckEndTimeReturns(time.Now().Add(-time.Minute * 61))
+									})
+
+									It("creates a check", func() {
+										Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+									})
+								})
+							})
+						})
+
 						Context("when the checkable has a pinned version", func() {
 							BeforeEach(func() {
 								fakeResource.CurrentPinnedVersionReturns(atc.Version{"some": "version"})
@@ -243,6 +317,37 @@ var _ = Describe("Scanner", func() {
 								Expect(fakeCheckFactory.NotifyCheckerCallCount()).To(Equal(1))
 							})
 						})
+
+						Context("when periodic checking is disabled for the parent type", func() {
+							BeforeEach(func() {
+								fakeResourceType.CheckEveryReturns("never")
+							})
+
+							It("still checks the parent type", func() {
+								Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(2))
+
+								_, checkable, _, _, _ := fakeCheckFactory.TryCreateCheckArgsForCall(0)
+								Expect(checkable).To(Equal(fakeResourceType))
+							})
+
+							It("does not set a check error on the parent type", func() {
+								Expect(fakeResourceType.SetCheckSetupErrorCallCount()).To(Equal(1))
+								Expect(fakeResourceType.SetCheckSetupErrorArgsForCall(0)).To(BeNil())
+							})
+
+							Context("when the parent type was checked within the default interval", func() {
+								BeforeEach(func() {
+									fakeResourceType.LastCheckEndTimeReturns(time.Now())
+								})
+
+								It("only checks the resource", func() {
+									Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+
+									_, checkable, _, _, _ := fakeCheckFactory.TryCreateCheckArgsForCall(0)
+									Expect(checkable).To(Equal(fakeResource))
+								})
+							})
+						})
 					})
 				})
 			})
@@ -296,6 +401,59 @@ var _ = Describe("Scanner", func() {
 			})
 		})
 
+		Context("when scanning is sharded", func() {
+			var resources []db.Resource
+
+			BeforeEach(func() {
+				resources = nil
+				for i := 1; i <= 20; i++ {
+					fakeResource := new(dbfakes.FakeResource)
+					fakeResource.NameReturns(fmt.Sprintf("resource-%d", i))
+					fakeResource.TypeReturns("base-type")
+					fakeResource.ResourceConfigScopeIDReturns(i)
+					fakeResource.LastCheckEndTimeReturns(time.Now().Add(-time.Hour))
+					resources = append(resources, fakeResource)
+				}
+
+				fakeCheckFactory.ResourcesReturns(resources, nil)
+
+				shard = lidar.Shard{Index: 0, Count: 2}
+			})
+
+			It("only checks the resources in its shard", func() {
+				Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(BeNumerically(">", 0))
+				Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(BeNumerically("<", len(resources)))
+			})
+
+			It("leaves the other resources to the other shard", func() {
+				otherScanner := lidar.NewScanner(
+					logger,
+					fakeCheckFactory,
+					fakeSecrets,
+					time.Minute*1,
+					time.Minute*1,
+					time.Minute*10,
+					time.Hour*1,
+					lidar.Shard{Index: 1, Count: 2},
+				)
+
+				Expect(otherScanner.Run(context.TODO())).To(Succeed())
+
+				var checked []string
+				for i := 0; i < fakeCheckFactory.TryCreateCheckCallCount(); i++ {
+					_, checkable, _, _, _ := fakeCheckFactory.TryCreateCheckArgsForCall(i)
+					checked = append(checked, checkable.Name())
+				}
+
+				var names []string
+				for _, resource := range resources {
+					names = append(names, resource.Name())
+				}
+
+				Expect(checked).To(ConsistOf(names))
+			})
+		})
+
 		Context("Default with webhook check interval", func() {
 			var fakeResource *dbfakes.FakeResource
 			BeforeEach(func() {
@@ -0,0 +1,40 @@
+package lidar
+
+import (
+	"hash/fnv"
+	"strconv"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+// Shard identifies the portion of all checkables that a scanner is
+// responsible for. Each shard is run as its own component, so shards are
+// locked independently and can be scanned by different ATCs in parallel.
+type Shard struct {
+	Index int
+	Count int
+}
+
+// Owns returns whether the checkable belongs to the shard. Checkables are
+// assigned by their resource config scope so that resources sharing a version
+// history are scanned together. Checkables which have not been given a scope
+// yet are assigned by pipeline.
+func (s Shard) Owns(checkable db.Checkable) bool {
+	if s.Count <= 1 {
+		return true
+	}
+
+	key := "scope:" + strconv.Itoa(checkable.ResourceConfigScopeID())
+	if checkable.ResourceConfigScopeID() == 0 {
+		key = "pipeline:" + strconv.Itoa(checkable.PipelineID())
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+
+	return int(hash.Sum32()%uint32(s.Count)) == s.Index
+}
+
+func (s Shard) String() string {
+	return strconv.Itoa(s.Index)
+}
@@ -1,6 +1,9 @@
 package metric
 
-import "sync/atomic"
+import (
+	"sync"
+	"sync/atomic"
+)
 
 type Counter struct {
 	cur int64
@@ -18,3 +21,40 @@ func (m *Counter) Delta() float64 {
 	cur := atomic.SwapInt64((*int64)(&m.cur), 0)
 	return float64(cur)
 }
+
+// LabeledCounter is a set of counters which are created as they are first
+// incremented, and which can safely be incremented concurrently.
+type LabeledCounter struct {
+	lock     sync.Mutex
+	counters map[string]*Counter
+}
+
+func (m *LabeledCounter) Inc(label string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.counters == nil {
+		m.counters = map[string]*Counter{}
+	}
+
+	counter, found := m.counters[label]
+	if !found {
+		counter = &Counter{}
+		m.counters[label] = counter
+	}
+
+	counter.Inc()
+}
+
+// Deltas returns and resets the value of each counter by its label.
+func (m *LabeledCounter) Deltas() map[string]float64 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	deltas := map[string]float64{}
+	for label, counter := range m.counters {
+		deltas[label] = counter.Delta()
+	}
+
+	return deltas
+}
@@ -50,11 +50,19 @@ type PrometheusEmitter struct {
 	checksQueueSize prometheus.Gauge
 	checksStarted   prometheus.Counter
 	checksEnqueued  prometheus.Counter
+	checksThrottled *prometheus.CounterVec
+
+	scannerShardDuration   *prometheus.HistogramVec
+	scannerShardCheckables *prometheus.GaugeVec
+	scannerShardLastScan   *prometheus.GaugeVec
 
 	workerContainers        *prometheus.GaugeVec
 	workerUnknownContainers *prometheus.GaugeVec
 	workerVolumes           *prometheus.GaugeVec
 	workerUnknownVolumes    *prometheus.GaugeVec
+	workerVolumesDiskUsed   *prometheus.GaugeVec
+	workerVolumesDiskTotal  *prometheus.GaugeVec
+	workerCachesEvicted     *prometheus.CounterVec
 	workerTasks             *prometheus.GaugeVec
 	workersRegistered       *prometheus.GaugeVec
 
@@ -287,6 +295,39 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(workerUnknownVolumes)
 
+	workerVolumesDiskUsed := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "volumes_disk_used_bytes",
+			Help:      "Bytes used on the disk holding the worker's volumes",
+		},
+		[]string{"worker"},
+	)
+	prometheus.MustRegister(workerVolumesDiskUsed)
+
+	workerVolumesDiskTotal := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "volumes_disk_capacity_bytes",
+			Help:      "Capacity in bytes of the disk holding the worker's volumes",
+		},
+		[]string{"worker"},
+	)
+	prometheus.MustRegister(workerVolumesDiskTotal)
+
+	workerCachesEvicted := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "caches_evicted_total",
+			Help:      "Total number of caches evicted from workers running out of disk",
+		},
+		[]string{"worker", "type"},
+	)
+	prometheus.MustRegister(workerCachesEvicted)
+
 	workerTasks := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "concourse",
@@ -381,6 +422,50 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(checksEnqueued)
 
+	checksThrottled := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "lidar",
+			Name:      "checks_throttled_total",
+			Help:      "Total number of checks held back by a resource type or host rate limit",
+		},
+		[]string{"limit"},
+	)
+	prometheus.MustRegister(checksThrottled)
+
+	scannerShardDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "lidar",
+			Name:      "scanner_shard_duration_seconds",
+			Help:      "Time taken to scan a shard of resources",
+		},
+		[]string{"shard"},
+	)
+	prometheus.MustRegister(scannerShardDuration)
+
+	scannerShardCheckables := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "lidar",
+			Name:      "scanner_shard_checkables",
+			Help:      "Number of resources in a shard at its last scan",
+		},
+		[]string{"shard"},
+	)
+	prometheus.MustRegister(scannerShardCheckables)
+
+	scannerShardLastScan := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "lidar",
+			Name:      "scanner_shard_last_scan_timestamp_seconds",
+			Help:      "Time at which this ATC last scanned a shard. The ATC with the most recent timestamp currently owns the shard.",
+		},
+		[]string{"shard"},
+	)
+	prometheus.MustRegister(scannerShardLastScan)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -421,6 +506,11 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		checksQueueSize: checksQueueSize,
 		checksStarted:   checksStarted,
 		checksEnqueued:  checksEnqueued,
+		checksThrottled: checksThrottled,
+
+		scannerShardDuration:   scannerShardDuration,
+		scannerShardCheckables: scannerShardCheckables,
+		scannerShardLastScan:   scannerShardLastScan,
 
 		workerContainers:        workerContainers,
 		workersRegistered:       workersRegistered,
@@ -432,6 +522,9 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		workerTasks:             workerTasks,
 		workerUnknownContainers: workerUnknownContainers,
 		workerUnknownVolumes:    workerUnknownVolumes,
+		workerVolumesDiskUsed:   workerVolumesDiskUsed,
+		workerVolumesDiskTotal:  workerVolumesDiskTotal,
+		workerCachesEvicted:     workerCachesEvicted,
 	}
 	go emitter.periodicMetricGC()
 
@@ -476,6 +569,12 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.workerUnknownContainersMetric(logger, event)
 	case "worker unknown volumes":
 		emitter.workerUnknownVolumesMetric(logger, event)
+	case "worker volumes disk used (bytes)":
+		emitter.workerVolumesDiskUsed.WithLabelValues(event.Attributes["worker"]).Set(event.Value)
+	case "worker volumes disk capacity (bytes)":
+		emitter.workerVolumesDiskTotal.WithLabelValues(event.Attributes["worker"]).Set(event.Value)
+	case "worker caches evicted":
+		emitter.workerCachesEvicted.WithLabelValues(event.Attributes["worker"], event.Attributes["type"]).Add(event.Value)
 	case "worker tasks":
 		emitter.workerTasksMetric(logger, event)
 	case "worker state":
@@ -492,8 +591,15 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.checksStarted.Add(event.Value)
 	case "checks enqueued":
 		emitter.checksEnqueued.Add(event.Value)
+	case "checks throttled":
+		emitter.checksThrottled.WithLabelValues(event.Attributes["limit"]).Add(event.Value)
 	case "checks queue size":
 		emitter.checksQueueSize.Set(event.Value)
+	case "scanner shard duration (ms)":
+		emitter.scannerShardDuration.WithLabelValues(event.Attributes["shard"]).Observe(event.Value / 1000)
+		emitter.scannerShardLastScan.WithLabelValues(event.Attributes["shard"]).SetToCurrentTime()
+	case "scanner shard checkables":
+		emitter.scannerShardCheckables.WithLabelValues(event.Attributes["shard"]).Set(event.Value)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -37,6 +37,7 @@ var ChecksFinishedWithSuccess = &Counter{}
 var ChecksQueueSize = &Gauge{}
 var ChecksStarted = &Counter{}
 var ChecksEnqueued = &Counter{}
+var ChecksThrottled = &LabeledCounter{}
 
 var ConcurrentRequests = map[string]*Gauge{}
 var ConcurrentRequestsLimitHit = map[string]*Counter{}
@@ -189,6 +190,36 @@ func (event SchedulingJobDuration) Emit(logger lager.Logger) {
 	)
 }
 
+type ScannerShardScanned struct {
+	Shard      string
+	Checkables int
+	Duration   time.Duration
+}
+
+func (event ScannerShardScanned) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("scanner-shard-duration"),
+		Event{
+			Name:  "scanner shard duration (ms)",
+			Value: ms(event.Duration),
+			Attributes: map[string]string{
+				"shard": event.Shard,
+			},
+		},
+	)
+
+	emit(
+		logger.Session("scanner-shard-checkables"),
+		Event{
+			Name:  "scanner shard checkables",
+			Value: float64(event.Checkables),
+			Attributes: map[string]string{
+				"shard": event.Shard,
+			},
+		},
+	)
+}
+
 type WorkerContainers struct {
 	WorkerName string
 	Platform   string
@@ -273,6 +304,65 @@ func (event WorkerUnknownVolumes) Emit(logger lager.Logger) {
 	)
 }
 
+type WorkerVolumesDiskUsage struct {
+	WorkerName string
+	Used       uint64
+	Capacity   uint64
+}
+
+func (event WorkerVolumesDiskUsage) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("worker-volumes-disk-used"),
+		Event{
+			Name:  "worker volumes disk used (bytes)",
+			Value: float64(event.Used),
+			Attributes: map[string]string{
+				"worker": event.WorkerName,
+			},
+		},
+	)
+
+	emit(
+		logger.Session("worker-volumes-disk-capacity"),
+		Event{
+			Name:  "worker volumes disk capacity (bytes)",
+			Value: float64(event.Capacity),
+			Attributes: map[string]string{
+				"worker": event.WorkerName,
+			},
+		},
+	)
+}
+
+type WorkerCachesEvicted struct {
+	WorkerName     string
+	ResourceCaches int
+	TaskCaches     int
+}
+
+func (event WorkerCachesEvicted) Emit(logger lager.Logger) {
+	for cacheType, evicted := range map[string]int{
+		"resource": event.ResourceCaches,
+		"task":     event.TaskCaches,
+	} {
+		if evicted == 0 {
+			continue
+		}
+
+		emit(
+			logger.Session("worker-caches-evicted"),
+			Event{
+				Name:  "worker caches evicted",
+				Value: float64(evicted),
+				Attributes: map[string]string{
+					"worker": event.WorkerName,
+					"type":   cacheType,
+				},
+			},
+		)
+	}
+}
+
 type WorkerTasks struct {
 	WorkerName string
 	Platform   string
@@ -213,6 +213,19 @@ func tick(logger lager.Logger) {
 		},
 	)
 
+	for limit, delta := range ChecksThrottled.Deltas() {
+		emit(
+			logger.Session("checks-throttled"),
+			Event{
+				Name:  "checks throttled",
+				Value: delta,
+				Attributes: map[string]string{
+					"limit": limit,
+				},
+			},
+		)
+	}
+
 	emit(
 		logger.Session("checks-queue-size"),
 		Event{
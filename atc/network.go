@@ -0,0 +1,173 @@
+package atc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+)
+
+type NetworkMode string
+
+const (
+	// full network access, subject to the worker's own policy
+	NetworkModeDefault NetworkMode = "default"
+
+	// no network access other than loopback
+	NetworkModeNone NetworkMode = "none"
+
+	// network access only to the hosts and subnets in the allowlist
+	NetworkModeAllowlist NetworkMode = "allowlist"
+)
+
+// NetworkConfig configures the network access of a task's container. It is
+// configured either as 'none' or 'default', or as an allowlist of egress
+// destinations, e.g. {allow: [10.0.0.0/8, github.com]}.
+type NetworkConfig struct {
+	Mode NetworkMode
+
+	// subnets in CIDR notation, IP addresses or host names
+	Allow []string
+}
+
+type networkAllowlist struct {
+	Allow []string `json:"allow"`
+}
+
+func (c *NetworkConfig) UnmarshalJSON(payload []byte) error {
+	var data interface{}
+	err := json.Unmarshal(payload, &data)
+	if err != nil {
+		return err
+	}
+
+	switch actual := data.(type) {
+	case string:
+		c.Mode = NetworkMode(actual)
+		c.Allow = nil
+	case map[string]interface{}:
+		var allowlist networkAllowlist
+		err := json.Unmarshal(payload, &allowlist)
+		if err != nil {
+			return err
+		}
+
+		c.Mode = NetworkModeAllowlist
+		c.Allow = allowlist.Allow
+	default:
+		return errors.New("network must be either 'default', 'none' or an allowlist")
+	}
+
+	return nil
+}
+
+func (c NetworkConfig) MarshalJSON() ([]byte, error) {
+	if c.Mode == NetworkModeAllowlist {
+		return json.Marshal(networkAllowlist{Allow: c.Allow})
+	}
+
+	return json.Marshal(string(c.Mode))
+}
+
+var hostnameRegexp = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`)
+
+func (c NetworkConfig) Validate() error {
+	switch c.Mode {
+	case NetworkModeDefault, NetworkModeNone:
+		return nil
+	case NetworkModeAllowlist:
+	default:
+		return fmt.Errorf("unknown network mode '%s'", c.Mode)
+	}
+
+	if len(c.Allow) == 0 {
+		return errors.New("network allowlist must not be empty")
+	}
+
+	for _, destination := range c.Allow {
+		if _, _, err := net.ParseCIDR(destination); err == nil {
+			continue
+		}
+
+		if net.ParseIP(destination) != nil {
+			continue
+		}
+
+		if !hostnameRegexp.MatchString(destination) {
+			return fmt.Errorf("network allowlist entry '%s' is not a subnet, an IP address or a host name", destination)
+		}
+	}
+
+	return nil
+}
+
+// Restricted returns whether the network access is anything less than the
+// worker's default.
+func (c NetworkConfig) Restricted() bool {
+	return c.Mode == NetworkModeNone || c.Mode == NetworkModeAllowlist
+}
+
+func (c NetworkConfig) Equal(other NetworkConfig) bool {
+	if c.Mode != other.Mode || len(c.Allow) != len(other.Allow) {
+		return false
+	}
+
+	for i := range c.Allow {
+		if c.Allow[i] != other.Allow[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// TeamNetworkConfig is the network access of the team's tasks which do not
+// configure their own.
+type TeamNetworkConfig struct {
+	Default NetworkConfig `json:"default"`
+
+	// when locked, tasks cannot configure their own network access and only
+	// admins can change the team's
+	Locked bool `json:"locked,omitempty"`
+}
+
+// NetworkLockedError is returned when a task configures its own network
+// access in a team which has locked it.
+type NetworkLockedError struct {
+	Team    string
+	Network NetworkConfig
+}
+
+func (err NetworkLockedError) Error() string {
+	return fmt.Sprintf("team '%s' has locked the network of its tasks to '%s', so it cannot be configured by the task", err.Team, err.Network.Mode)
+}
+
+// TaskNetwork returns the network access of a task in the team, given the
+// task's own network config, if any.
+func (team Team) TaskNetwork(task *NetworkConfig) (NetworkConfig, error) {
+	if team.Network == nil {
+		if task != nil {
+			return *task, nil
+		}
+
+		return NetworkConfig{Mode: NetworkModeDefault}, nil
+	}
+
+	if team.Network.Locked {
+		if task != nil && !task.Equal(team.Network.Default) {
+			return NetworkConfig{}, NetworkLockedError{
+				Team:    team.Name,
+				Network: team.Network.Default,
+			}
+		}
+
+		return team.Network.Default, nil
+	}
+
+	if task != nil {
+		return *task, nil
+	}
+
+	return team.Network.Default, nil
+}
@@ -0,0 +1,175 @@
+package atc_test
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+	"sigs.k8s.io/yaml"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NetworkConfig", func() {
+	Describe("unmarshaling", func() {
+		It("parses a mode", func() {
+			var config atc.NetworkConfig
+			err := yaml.Unmarshal([]byte(`none`), &config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).To(Equal(atc.NetworkConfig{Mode: atc.NetworkModeNone}))
+		})
+
+		It("parses an allowlist", func() {
+			var config atc.NetworkConfig
+			err := yaml.Unmarshal([]byte(`allow: [10.0.0.0/8, github.com]`), &config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).To(Equal(atc.NetworkConfig{
+				Mode:  atc.NetworkModeAllowlist,
+				Allow: []string{"10.0.0.0/8", "github.com"},
+			}))
+		})
+
+		It("errors on anything else", func() {
+			var config atc.NetworkConfig
+			err := json.Unmarshal([]byte(`[1]`), &config)
+			Expect(err).To(MatchError("network must be either 'default', 'none' or an allowlist"))
+		})
+
+		It("round-trips through JSON", func() {
+			for _, config := range []atc.NetworkConfig{
+				{Mode: atc.NetworkModeDefault},
+				{Mode: atc.NetworkModeNone},
+				{Mode: atc.NetworkModeAllowlist, Allow: []string{"1.2.3.4"}},
+			} {
+				payload, err := json.Marshal(config)
+				Expect(err).ToNot(HaveOccurred())
+
+				var unmarshaled atc.NetworkConfig
+				Expect(json.Unmarshal(payload, &unmarshaled)).To(Succeed())
+				Expect(unmarshaled).To(Equal(config))
+			}
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts subnets, IP addresses and host names", func() {
+			err := atc.NetworkConfig{
+				Mode:  atc.NetworkModeAllowlist,
+				Allow: []string{"10.0.0.0/8", "1.2.3.4", "::1", "github.com", "localhost"},
+			}.Validate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("rejects unknown modes", func() {
+			err := atc.NetworkConfig{Mode: "bridge"}.Validate()
+			Expect(err).To(MatchError("unknown network mode 'bridge'"))
+		})
+
+		It("rejects empty allowlists", func() {
+			err := atc.NetworkConfig{Mode: atc.NetworkModeAllowlist}.Validate()
+			Expect(err).To(MatchError("network allowlist must not be empty"))
+		})
+
+		It("rejects invalid destinations", func() {
+			err := atc.NetworkConfig{
+				Mode:  atc.NetworkModeAllowlist,
+				Allow: []string{"https://github.com"},
+			}.Validate()
+			Expect(err).To(MatchError("network allowlist entry 'https://github.com' is not a subnet, an IP address or a host name"))
+		})
+	})
+
+	Describe("Team.TaskNetwork", func() {
+		var (
+			team        atc.Team
+			taskNetwork *atc.NetworkConfig
+
+			network atc.NetworkConfig
+			err     error
+		)
+
+		BeforeEach(func() {
+			team = atc.Team{Name: "some-team"}
+			taskNetwork = nil
+		})
+
+		JustBeforeEach(func() {
+			network, err = team.TaskNetwork(taskNetwork)
+		})
+
+		Context("when the team does not configure the network", func() {
+			It("defaults to the default network", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(network).To(Equal(atc.NetworkConfig{Mode: atc.NetworkModeDefault}))
+			})
+
+			Context("when the task configures its network", func() {
+				BeforeEach(func() {
+					taskNetwork = &atc.NetworkConfig{Mode: atc.NetworkModeNone}
+				})
+
+				It("uses the task's", func() {
+					Expect(network).To(Equal(atc.NetworkConfig{Mode: atc.NetworkModeNone}))
+				})
+			})
+		})
+
+		Context("when the team configures a default network", func() {
+			BeforeEach(func() {
+				team.Network = &atc.TeamNetworkConfig{
+					Default: atc.NetworkConfig{Mode: atc.NetworkModeNone},
+				}
+			})
+
+			It("uses the team's", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(network).To(Equal(atc.NetworkConfig{Mode: atc.NetworkModeNone}))
+			})
+
+			Context("when the task configures its network", func() {
+				BeforeEach(func() {
+					taskNetwork = &atc.NetworkConfig{Mode: atc.NetworkModeDefault}
+				})
+
+				It("uses the task's", func() {
+					Expect(network).To(Equal(atc.NetworkConfig{Mode: atc.NetworkModeDefault}))
+				})
+			})
+
+			Context("when the team has locked it", func() {
+				BeforeEach(func() {
+					team.Network.Locked = true
+				})
+
+				It("uses the team's", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(network).To(Equal(atc.NetworkConfig{Mode: atc.NetworkModeNone}))
+				})
+
+				Context("when the task configures the same network", func() {
+					BeforeEach(func() {
+						taskNetwork = &atc.NetworkConfig{Mode: atc.NetworkModeNone}
+					})
+
+					It("uses it", func() {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(network).To(Equal(atc.NetworkConfig{Mode: atc.NetworkModeNone}))
+					})
+				})
+
+				Context("when the task configures another network", func() {
+					BeforeEach(func() {
+						taskNetwork = &atc.NetworkConfig{Mode: atc.NetworkModeDefault}
+					})
+
+					It("errors", func() {
+						Expect(err).To(Equal(atc.NetworkLockedError{
+							Team:    "some-team",
+							Network: atc.NetworkConfig{Mode: atc.NetworkModeNone},
+						}))
+					})
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,99 @@
+package atc
+
+import (
+	"fmt"
+	"time"
+)
+
+type LogLimitAction string
+
+const (
+	LogLimitTruncate LogLimitAction = "truncate"
+	LogLimitFail     LogLimitAction = "fail"
+)
+
+// OutputLimits are enforced on the output of get, put and task steps as it
+// is saved to the build's log, either on each step or, for the limits of a
+// job, on the build as a whole.
+type OutputLimits struct {
+	// number of bytes of output to keep; 0 means unlimited
+	LogLimit   uint64         `json:"log_limit,omitempty"`
+	OnLogLimit LogLimitAction `json:"on_log_limit,omitempty"`
+
+	// duration without any output after which the step is interrupted
+	IdleTimeout string `json:"idle_timeout,omitempty"`
+}
+
+// OutputLimits parses the step's log_limit, on_log_limit and idle_timeout.
+// It returns nil if none of them are configured.
+func (config PlanConfig) OutputLimits() (*OutputLimits, error) {
+	return parseOutputLimits(config.LogLimit, config.OnLogLimit, config.IdleTimeout)
+}
+
+// OutputLimits parses the job's log_limit, on_log_limit and idle_timeout,
+// which apply to its builds as a whole. It returns nil if none of them are
+// configured.
+func (config JobConfig) OutputLimits() (*OutputLimits, error) {
+	return parseOutputLimits(config.LogLimit, config.OnLogLimit, config.IdleTimeout)
+}
+
+func parseOutputLimits(logLimit string, onLogLimit LogLimitAction, idleTimeout string) (*OutputLimits, error) {
+	if logLimit == "" && onLogLimit == "" && idleTimeout == "" {
+		return nil, nil
+	}
+
+	limits := &OutputLimits{
+		OnLogLimit:  onLogLimit,
+		IdleTimeout: idleTimeout,
+	}
+
+	if logLimit != "" {
+		size, err := ParseLogLimit(logLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		limits.LogLimit = size
+	}
+
+	switch onLogLimit {
+	case "", LogLimitTruncate, LogLimitFail:
+	default:
+		return nil, fmt.Errorf("unknown log limit action '%s'", onLogLimit)
+	}
+
+	if idleTimeout != "" {
+		_, err := time.ParseDuration(idleTimeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return limits, nil
+}
+
+// ParseLogLimit parses a size such as 512KB or 10MB into a number of bytes.
+func ParseLogLimit(limit string) (uint64, error) {
+	size, err := parseMemoryLimit(limit)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse log limit '%s'", limit)
+	}
+
+	return size, nil
+}
+
+// Merge fills in the limits which are not configured with those of outer,
+// so that limits set on a step take precedence over those set on its job.
+func (limits *OutputLimits) Merge(outer OutputLimits) {
+	if limits.LogLimit == 0 {
+		limits.LogLimit = outer.LogLimit
+	}
+
+	if limits.OnLogLimit == "" {
+		limits.OnLogLimit = outer.OnLogLimit
+	}
+
+	if limits.IdleTimeout == "" {
+		limits.IdleTimeout = outer.IdleTimeout
+	}
+}
@@ -4,6 +4,14 @@ type Plan struct {
 	ID       PlanID `json:"id"`
 	Attempts []int  `json:"attempts,omitempty"`
 
+	OutputLimits *OutputLimits `json:"output_limits,omitempty"`
+
+	// only set on retry plans
+	RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
+
+	// pause the build before or after the plan runs, until it is resumed
+	Breakpoint Breakpoint `json:"breakpoint,omitempty"`
+
 	Get         *GetPlan         `json:"get,omitempty"`
 	Put         *PutPlan         `json:"put,omitempty"`
 	Check       *CheckPlan       `json:"check,omitempty"`
@@ -205,6 +213,8 @@ type TaskPlan struct {
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 
+	Network *NetworkConfig `json:"network,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
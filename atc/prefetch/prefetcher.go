@@ -0,0 +1,542 @@
+package prefetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/vars"
+)
+
+// versionsToConsider is how many of the latest versions are looked at when
+// skipping over disabled ones.
+const versionsToConsider = 10
+
+// imageCheckTimeout limits how long checking for the latest version of a
+// task's image may take.
+const imageCheckTimeout = time.Hour
+
+// ErrImageUnavailable is returned when the check for the latest version of a
+// task's image finds none.
+var ErrImageUnavailable = errors.New("no versions of image available")
+
+// NewPrefetcher returns a component which fetches the latest version of every
+// resource configured with 'prefetch: true', and of every task image
+// configured with 'prefetch: true', onto the given number of workers, so that
+// the builds which need it find it in a warm resource cache.
+//
+// It is run whenever new versions of resources are saved, and on an interval
+// to pick up new versions of images, which are only checked for here.
+func NewPrefetcher(
+	logger lager.Logger,
+	checkFactory db.CheckFactory,
+	jobFactory db.JobFactory,
+	resourceConfigFactory db.ResourceConfigFactory,
+	resourceCacheFactory db.ResourceCacheFactory,
+	resourceFactory resource.ResourceFactory,
+	secrets creds.Secrets,
+	varSourcePool creds.VarSourcePool,
+	client worker.Client,
+	workers int,
+) *prefetcher {
+	return &prefetcher{
+		logger:                logger,
+		checkFactory:          checkFactory,
+		jobFactory:            jobFactory,
+		resourceConfigFactory: resourceConfigFactory,
+		resourceCacheFactory:  resourceCacheFactory,
+		resourceFactory:       resourceFactory,
+		secrets:               secrets,
+		varSourcePool:         varSourcePool,
+		client:                client,
+		workers:               workers,
+		running:               map[string]bool{},
+	}
+}
+
+type prefetcher struct {
+	logger lager.Logger
+
+	checkFactory          db.CheckFactory
+	jobFactory            db.JobFactory
+	resourceConfigFactory db.ResourceConfigFactory
+	resourceCacheFactory  db.ResourceCacheFactory
+	resourceFactory       resource.ResourceFactory
+	secrets               creds.Secrets
+	varSourcePool         creds.VarSourcePool
+	client                worker.Client
+	workers               int
+
+	// running tracks the prefetches in flight by key, and whether they are
+	// to be run again once they are done because they were asked for in the
+	// meantime
+	running     map[string]bool
+	runningLock sync.Mutex
+}
+
+// Run starts prefetching in the background and returns right away, so that
+// the component can be run again as soon as new versions are saved.
+func (p *prefetcher) Run(ctx context.Context) error {
+	p.logger.Debug("start")
+	defer p.logger.Debug("end")
+
+	if p.workers <= 0 {
+		return nil
+	}
+
+	resources, err := p.checkFactory.Resources()
+	if err != nil {
+		p.logger.Error("failed-to-get-resources", err)
+		return err
+	}
+
+	resourceTypes, err := p.checkFactory.ResourceTypes()
+	if err != nil {
+		p.logger.Error("failed-to-get-resource-types", err)
+		return err
+	}
+
+	jobs, err := p.jobFactory.JobsPrefetchingImages()
+	if err != nil {
+		p.logger.Error("failed-to-get-jobs", err)
+		return err
+	}
+
+	for _, resource := range resources {
+		if !resource.Prefetch() {
+			continue
+		}
+
+		resource := resource
+		logger := p.logger.Session("prefetch", lager.Data{
+			"team":     resource.TeamName(),
+			"pipeline": resource.PipelineName(),
+			"resource": resource.Name(),
+		})
+
+		p.run("resource:"+strconv.Itoa(resource.ID()), func() {
+			err := p.prefetch(ctx, logger, resource, resourceTypes)
+			if err != nil {
+				logger.Error("failed-to-prefetch", err)
+			}
+		})
+	}
+
+	for _, job := range jobs {
+		job := job
+		logger := p.logger.Session("prefetch-images", lager.Data{
+			"team":     job.TeamName(),
+			"pipeline": job.PipelineName(),
+			"job":      job.Name(),
+		})
+
+		p.run("job:"+strconv.Itoa(job.ID()), func() {
+			err := p.prefetchImages(ctx, logger, job, resourceTypes)
+			if err != nil {
+				logger.Error("failed-to-prefetch-images", err)
+			}
+		})
+	}
+
+	return nil
+}
+
+// run runs the prefetch in a goroutine, unless one for the same key is still
+// running, in which case that one is run again once it is done so that the
+// versions saved in the meantime are picked up.
+func (p *prefetcher) run(key string, prefetch func()) {
+	p.runningLock.Lock()
+	defer p.runningLock.Unlock()
+
+	if _, running := p.running[key]; running {
+		p.running[key] = true
+		return
+	}
+
+	p.running[key] = false
+
+	go func() {
+		for {
+			prefetch()
+
+			p.runningLock.Lock()
+			again := p.running[key]
+			if !again {
+				delete(p.running, key)
+			} else {
+				p.running[key] = false
+			}
+			p.runningLock.Unlock()
+
+			if !again {
+				return
+			}
+		}
+	}()
+}
+
+func (p *prefetcher) prefetch(ctx context.Context, logger lager.Logger, dbResource db.Resource, resourceTypes db.ResourceTypes) error {
+	version, found, err := latestVersion(dbResource)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		logger.Debug("no-version-to-prefetch")
+		return nil
+	}
+
+	pipeline, found, err := dbResource.Pipeline()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	variables, err := pipeline.Variables(logger, p.secrets, p.varSourcePool)
+	if err != nil {
+		return err
+	}
+
+	source, err := creds.NewSource(variables, dbResource.Source()).Evaluate()
+	if err != nil {
+		return err
+	}
+
+	versionedResourceTypes, err := creds.NewVersionedResourceTypes(variables, resourceTypes.Filter(dbResource).Deserialize()).Evaluate()
+	if err != nil {
+		return err
+	}
+
+	// the cache is created without params, so it is the one used by the gets
+	// of the resource which do not configure any
+	resourceCache, err := p.resourceCacheFactory.FindOrCreateResourceCache(
+		db.ForResource(dbResource.ID()),
+		dbResource.Type(),
+		version,
+		source,
+		nil,
+		versionedResourceTypes,
+	)
+	if err != nil {
+		return err
+	}
+
+	containerSpec := worker.ContainerSpec{
+		ImageSpec: worker.ImageSpec{
+			ResourceType: dbResource.Type(),
+		},
+		TeamID:        dbResource.TeamID(),
+		ResourceCache: resourceCache,
+	}
+
+	workerSpec := worker.WorkerSpec{
+		ResourceType:  dbResource.Type(),
+		Tags:          dbResource.Tags(),
+		TeamID:        dbResource.TeamID(),
+		ResourceTypes: versionedResourceTypes,
+	}
+
+	containerMetadata := db.ContainerMetadata{
+		Type:         db.ContainerTypeGet,
+		PipelineID:   dbResource.PipelineID(),
+		PipelineName: dbResource.PipelineName(),
+	}
+
+	imageFetcherSpec := worker.ImageFetcherSpec{
+		ResourceTypes: versionedResourceTypes,
+		Delegate:      worker.NoopImageFetchingDelegate{},
+	}
+
+	processSpec := runtime.ProcessSpec{
+		Path:         "/opt/resource/in",
+		Args:         []string{resource.ResourcesDir("get")},
+		StdoutWriter: imageFetcherSpec.Delegate.Stdout(),
+		StderrWriter: imageFetcherSpec.Delegate.Stderr(),
+	}
+
+	fetched, err := p.client.PrefetchResource(
+		ctx,
+		logger,
+		containerSpec,
+		workerSpec,
+		containerMetadata,
+		imageFetcherSpec,
+		processSpec,
+		resourceCache,
+		p.resourceFactory.NewResource(source, nil, version),
+		p.workers,
+	)
+	if fetched > 0 {
+		logger.Info("prefetched", lager.Data{"version": version, "workers": fetched})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// only let go of the previously prefetched versions once the latest one
+	// is warm, so that builds still running against them are not affected
+	return dbResource.ReleasePrefetchedCaches(resourceCache)
+}
+
+func (p *prefetcher) prefetchImages(ctx context.Context, logger lager.Logger, job db.Job, resourceTypes db.ResourceTypes) error {
+	config, err := job.Config()
+	if err != nil {
+		return err
+	}
+
+	pipeline, found, err := job.Pipeline()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	variables, err := pipeline.Variables(logger, p.secrets, p.varSourcePool)
+	if err != nil {
+		return err
+	}
+
+	// the tasks of a job can use any of the pipeline's resource types for
+	// their image
+	var pipelineResourceTypes db.ResourceTypes
+	for _, resourceType := range resourceTypes {
+		if resourceType.PipelineID() == job.PipelineID() {
+			pipelineResourceTypes = append(pipelineResourceTypes, resourceType)
+		}
+	}
+
+	versionedResourceTypes, err := creds.NewVersionedResourceTypes(variables, pipelineResourceTypes.Deserialize()).Evaluate()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	var resourceCaches []db.UsedResourceCache
+	for _, plan := range config.PrefetchedImages() {
+		logger := logger.Session("task", lager.Data{"task": plan.Task})
+
+		resourceCache, err := p.prefetchImage(ctx, logger, job, plan, variables, versionedResourceTypes)
+		if err != nil {
+			logger.Error("failed-to-prefetch-image", err)
+			errs = append(errs, err)
+			continue
+		}
+
+		resourceCaches = append(resourceCaches, resourceCache)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("prefetch %d of %d images: %w", len(errs), len(errs)+len(resourceCaches), errs[0])
+	}
+
+	// as with resources, the previously prefetched images are only let go of
+	// once all of the latest ones are warm
+	return job.ReleasePrefetchedImages(resourceCaches)
+}
+
+func (p *prefetcher) prefetchImage(
+	ctx context.Context,
+	logger lager.Logger,
+	job db.Job,
+	plan atc.PlanConfig,
+	variables vars.Variables,
+	resourceTypes atc.VersionedResourceTypes,
+) (db.UsedResourceCache, error) {
+	imageResource := plan.TaskConfig.ImageResource
+
+	source, err := creds.NewSource(variables, imageResource.Source).Evaluate()
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := creds.NewParams(variables, imageResource.Params).Evaluate()
+	if err != nil {
+		return nil, err
+	}
+
+	workerSpec := worker.WorkerSpec{
+		ResourceType:  imageResource.Type,
+		Tags:          plan.Tags,
+		TeamID:        job.TeamID(),
+		ResourceTypes: resourceTypes,
+	}
+
+	version := imageResource.Version
+	if version == nil {
+		version, err = p.latestImageVersion(ctx, logger, job, imageResource.Type, source, workerSpec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the cache is the same one the task's image fetcher uses, as it is
+	// identified by the same type, version, source and params
+	resourceCache, err := p.resourceCacheFactory.FindOrCreateResourceCache(
+		db.ForJob(job.ID()),
+		imageResource.Type,
+		version,
+		source,
+		params,
+		resourceTypes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	containerSpec := worker.ContainerSpec{
+		ImageSpec: worker.ImageSpec{
+			ResourceType: imageResource.Type,
+		},
+		TeamID:        job.TeamID(),
+		ResourceCache: resourceCache,
+	}
+
+	containerMetadata := db.ContainerMetadata{
+		Type:         db.ContainerTypeGet,
+		PipelineID:   job.PipelineID(),
+		PipelineName: job.PipelineName(),
+		JobID:        job.ID(),
+		JobName:      job.Name(),
+	}
+
+	imageFetcherSpec := worker.ImageFetcherSpec{
+		ResourceTypes: resourceTypes,
+		Delegate:      worker.NoopImageFetchingDelegate{},
+	}
+
+	processSpec := runtime.ProcessSpec{
+		Path:         "/opt/resource/in",
+		Args:         []string{resource.ResourcesDir("get")},
+		StdoutWriter: imageFetcherSpec.Delegate.Stdout(),
+		StderrWriter: imageFetcherSpec.Delegate.Stderr(),
+	}
+
+	fetched, err := p.client.PrefetchResource(
+		ctx,
+		logger,
+		containerSpec,
+		workerSpec,
+		containerMetadata,
+		imageFetcherSpec,
+		processSpec,
+		resourceCache,
+		p.resourceFactory.NewResource(source, params, version),
+		p.workers,
+	)
+	if fetched > 0 {
+		logger.Info("prefetched", lager.Data{"version": version, "workers": fetched})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resourceCache, nil
+}
+
+// latestImageVersion checks for the version of a task's image that builds
+// will fetch, as the versions of images are not saved by checks elsewhere.
+func (p *prefetcher) latestImageVersion(
+	ctx context.Context,
+	logger lager.Logger,
+	job db.Job,
+	imageType string,
+	source atc.Source,
+	workerSpec worker.WorkerSpec,
+) (atc.Version, error) {
+	resourceConfig, err := p.resourceConfigFactory.FindOrCreateResourceConfig(imageType, source, workerSpec.ResourceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := db.NewResourceConfigCheckSessionContainerOwner(
+		resourceConfig.ID(),
+		resourceConfig.OriginBaseResourceType().ID,
+		db.ContainerOwnerExpiries{
+			Min: 5 * time.Minute,
+			Max: 1 * time.Hour,
+		},
+	)
+
+	containerSpec := worker.ContainerSpec{
+		ImageSpec: worker.ImageSpec{
+			ResourceType: imageType,
+		},
+		BindMounts: []worker.BindMountSource{
+			&worker.CertsVolumeMount{Logger: logger},
+		},
+		Tags:   workerSpec.Tags,
+		TeamID: job.TeamID(),
+	}
+
+	containerMetadata := db.ContainerMetadata{
+		Type:         db.ContainerTypeCheck,
+		PipelineID:   job.PipelineID(),
+		PipelineName: job.PipelineName(),
+	}
+
+	result, err := p.client.RunCheckStep(
+		ctx,
+		logger,
+		owner,
+		containerSpec,
+		workerSpec,
+		worker.NewRandomPlacementStrategy(),
+		containerMetadata,
+		workerSpec.ResourceTypes,
+		imageCheckTimeout,
+		p.resourceFactory.NewResource(source, nil, nil),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// like the task's image fetcher, which checks without a version to
+	// start from
+	if len(result.Versions) == 0 {
+		return nil, ErrImageUnavailable
+	}
+
+	return result.Versions[0], nil
+}
+
+// latestVersion returns the version of the resource that builds will get:
+// the pinned version if there is one, and otherwise the latest enabled one.
+func latestVersion(resource db.Resource) (atc.Version, bool, error) {
+	if pinned := resource.CurrentPinnedVersion(); pinned != nil {
+		return pinned, true, nil
+	}
+
+	versions, _, found, err := resource.Versions(db.Page{Limit: versionsToConsider}, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	for _, version := range versions {
+		if version.Enabled {
+			return version.Version, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
@@ -0,0 +1,410 @@
+package prefetch_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/component"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/prefetch"
+	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/resource/resourcefakes"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/concourse/concourse/vars"
+
+	"code.cloudfoundry.org/lager"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Prefetcher", func() {
+	var (
+		fakeCheckFactory          *dbfakes.FakeCheckFactory
+		fakeJobFactory            *dbfakes.FakeJobFactory
+		fakeResourceConfigFactory *dbfakes.FakeResourceConfigFactory
+		fakeResourceCacheFactory  *dbfakes.FakeResourceCacheFactory
+		fakeResourceFactory       *resourcefakes.FakeResourceFactory
+		fakeClient                *workerfakes.FakeClient
+
+		fakePipeline      *dbfakes.FakePipeline
+		fakeResource      *dbfakes.FakeResource
+		fakeResourceType  *dbfakes.FakeResourceType
+		fakeResourceCache *dbfakes.FakeUsedResourceCache
+		fakeGetResource   *resourcefakes.FakeResource
+
+		workers int
+
+		prefetcher component.Runnable
+		err        error
+	)
+
+	BeforeEach(func() {
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+		fakeJobFactory = new(dbfakes.FakeJobFactory)
+		fakeResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
+		fakeResourceCacheFactory = new(dbfakes.FakeResourceCacheFactory)
+		fakeResourceFactory = new(resourcefakes.FakeResourceFactory)
+		fakeClient = new(workerfakes.FakeClient)
+		workers = 2
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.VariablesReturns(vars.StaticVariables{"secret": "some-secret"}, nil)
+
+		fakeResource = new(dbfakes.FakeResource)
+		fakeResource.IDReturns(1)
+		fakeResource.NameReturns("some-resource")
+		fakeResource.TypeReturns("some-type")
+		fakeResource.SourceReturns(atc.Source{"uri": "((secret))"})
+		fakeResource.TagsReturns(atc.Tags{"some-tag"})
+		fakeResource.TeamIDReturns(123)
+		fakeResource.PipelineIDReturns(456)
+		fakeResource.PipelineNameReturns("some-pipeline")
+		fakeResource.PrefetchReturns(true)
+		fakeResource.PipelineReturns(fakePipeline, true, nil)
+		fakeResource.VersionsReturns([]atc.ResourceVersion{
+			{Version: atc.Version{"ref": "disabled"}, Enabled: false},
+			{Version: atc.Version{"ref": "latest"}, Enabled: true},
+		}, db.Pagination{}, true, nil)
+
+		fakeResourceType = new(dbfakes.FakeResourceType)
+		fakeResourceType.NameReturns("some-type")
+		fakeResourceType.TypeReturns("registry-image")
+		fakeResourceType.SourceReturns(atc.Source{"repository": "some-image"})
+		fakeResourceType.PipelineIDReturns(456)
+		fakeResourceType.VersionReturns(atc.Version{"digest": "some-digest"})
+
+		fakeCheckFactory.ResourcesReturns([]db.Resource{fakeResource}, nil)
+		fakeCheckFactory.ResourceTypesReturns([]db.ResourceType{fakeResourceType}, nil)
+
+		fakeResourceCache = new(dbfakes.FakeUsedResourceCache)
+		fakeResourceCacheFactory.FindOrCreateResourceCacheReturns(fakeResourceCache, nil)
+
+		fakeGetResource = new(resourcefakes.FakeResource)
+		fakeResourceFactory.NewResourceReturns(fakeGetResource)
+
+		fakeClient.PrefetchResourceReturns(1, nil)
+	})
+
+	JustBeforeEach(func() {
+		prefetcher = prefetch.NewPrefetcher(
+			lagertest.NewTestLogger("test"),
+			fakeCheckFactory,
+			fakeJobFactory,
+			fakeResourceConfigFactory,
+			fakeResourceCacheFactory,
+			fakeResourceFactory,
+			nil,
+			nil,
+			fakeClient,
+			workers,
+		)
+
+		err = prefetcher.Run(context.TODO())
+	})
+
+	expectedResourceTypes := atc.VersionedResourceTypes{
+		{
+			ResourceType: atc.ResourceType{
+				Name:   "some-type",
+				Type:   "registry-image",
+				Source: atc.Source{"repository": "some-image"},
+			},
+			Version: atc.Version{"digest": "some-digest"},
+		},
+	}
+
+	It("creates a cache of the latest enabled version for the resource", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(fakeResourceCacheFactory.FindOrCreateResourceCacheCallCount).Should(Equal(1))
+		user, resourceType, version, source, params, resourceTypes := fakeResourceCacheFactory.FindOrCreateResourceCacheArgsForCall(0)
+		Expect(user).To(Equal(db.ForResource(1)))
+		Expect(resourceType).To(Equal("some-type"))
+		Expect(version).To(Equal(atc.Version{"ref": "latest"}))
+		Expect(source).To(Equal(atc.Source{"uri": "some-secret"}))
+		Expect(params).To(BeNil())
+		Expect(resourceTypes).To(Equal(expectedResourceTypes))
+	})
+
+	It("prefetches the cache onto the configured number of workers", func() {
+		Eventually(fakeClient.PrefetchResourceCallCount).Should(Equal(1))
+		_, _, containerSpec, workerSpec, metadata, imageSpec, processSpec, cache, getResource, count := fakeClient.PrefetchResourceArgsForCall(0)
+
+		Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{ResourceType: "some-type"}))
+		Expect(containerSpec.TeamID).To(Equal(123))
+		Expect(containerSpec.ResourceCache).To(Equal(fakeResourceCache))
+
+		Expect(workerSpec).To(Equal(worker.WorkerSpec{
+			ResourceType:  "some-type",
+			Tags:          atc.Tags{"some-tag"},
+			TeamID:        123,
+			ResourceTypes: expectedResourceTypes,
+		}))
+
+		Expect(metadata).To(Equal(db.ContainerMetadata{
+			Type:         db.ContainerTypeGet,
+			PipelineID:   456,
+			PipelineName: "some-pipeline",
+		}))
+
+		Expect(imageSpec.ResourceTypes).To(Equal(expectedResourceTypes))
+		Expect(processSpec.Path).To(Equal("/opt/resource/in"))
+		Expect(cache).To(Equal(fakeResourceCache))
+		Expect(getResource).To(Equal(fakeGetResource))
+		Expect(count).To(Equal(2))
+
+		source, params, version := fakeResourceFactory.NewResourceArgsForCall(0)
+		Expect(source).To(Equal(atc.Source{"uri": "some-secret"}))
+		Expect(params).To(BeNil())
+		Expect(version).To(Equal(atc.Version{"ref": "latest"}))
+	})
+
+	It("releases the previously prefetched caches", func() {
+		Eventually(fakeResource.ReleasePrefetchedCachesCallCount).Should(Equal(1))
+		Expect(fakeResource.ReleasePrefetchedCachesArgsForCall(0)).To(Equal(fakeResourceCache))
+	})
+
+	Context("when the resource is pinned", func() {
+		BeforeEach(func() {
+			fakeResource.CurrentPinnedVersionReturns(atc.Version{"ref": "pinned"})
+		})
+
+		It("prefetches the pinned version", func() {
+			Eventually(fakeResourceCacheFactory.FindOrCreateResourceCacheCallCount).Should(Equal(1))
+			_, _, version, _, _, _ := fakeResourceCacheFactory.FindOrCreateResourceCacheArgsForCall(0)
+			Expect(version).To(Equal(atc.Version{"ref": "pinned"}))
+		})
+	})
+
+	Context("when the resource has no enabled versions", func() {
+		BeforeEach(func() {
+			fakeResource.VersionsReturns([]atc.ResourceVersion{
+				{Version: atc.Version{"ref": "disabled"}, Enabled: false},
+			}, db.Pagination{}, true, nil)
+		})
+
+		It("does not prefetch it", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(fakeResource.VersionsCallCount).Should(Equal(1))
+			Consistently(fakeClient.PrefetchResourceCallCount).Should(BeZero())
+		})
+	})
+
+	Context("when the resource is not configured to be prefetched", func() {
+		BeforeEach(func() {
+			fakeResource.PrefetchReturns(false)
+		})
+
+		It("does not prefetch it", func() {
+			Consistently(fakeResourceCacheFactory.FindOrCreateResourceCacheCallCount).Should(BeZero())
+			Expect(fakeClient.PrefetchResourceCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when prefetching fails", func() {
+		BeforeEach(func() {
+			fakeClient.PrefetchResourceReturns(0, errors.New("nope"))
+		})
+
+		It("keeps the previously prefetched caches", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(fakeClient.PrefetchResourceCallCount).Should(Equal(1))
+			Consistently(fakeResource.ReleasePrefetchedCachesCallCount).Should(BeZero())
+		})
+	})
+
+	Context("when it is run again while the resource is being prefetched", func() {
+		var release chan struct{}
+
+		BeforeEach(func() {
+			release = make(chan struct{})
+			fakeClient.PrefetchResourceStub = func(context.Context, lager.Logger, worker.ContainerSpec, worker.WorkerSpec, db.ContainerMetadata, worker.ImageFetcherSpec, runtime.ProcessSpec, db.UsedResourceCache, resource.Resource, int) (int, error) {
+				<-release
+				return 1, nil
+			}
+		})
+
+		It("prefetches it once more after the running prefetch is done", func() {
+			Eventually(fakeClient.PrefetchResourceCallCount).Should(Equal(1))
+
+			Expect(prefetcher.Run(context.TODO())).To(Succeed())
+			Expect(prefetcher.Run(context.TODO())).To(Succeed())
+			Consistently(fakeClient.PrefetchResourceCallCount).Should(Equal(1))
+
+			close(release)
+
+			Eventually(fakeClient.PrefetchResourceCallCount).Should(Equal(2))
+			Consistently(fakeClient.PrefetchResourceCallCount).Should(Equal(2))
+		})
+	})
+
+	Context("when prefetching is disabled", func() {
+		BeforeEach(func() {
+			workers = 0
+		})
+
+		It("does nothing", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeCheckFactory.ResourcesCallCount()).To(BeZero())
+			Expect(fakeJobFactory.JobsPrefetchingImagesCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("prefetching task images", func() {
+		var (
+			fakeJob            *dbfakes.FakeJob
+			fakeResourceConfig *dbfakes.FakeResourceConfig
+			fakeCheckResource  *resourcefakes.FakeResource
+			imageResource      *atc.ImageResource
+		)
+
+		BeforeEach(func() {
+			fakeResource.PrefetchReturns(false)
+
+			imageResource = &atc.ImageResource{
+				Type:     "some-type",
+				Source:   atc.Source{"repository": "((secret))"},
+				Params:   atc.Params{"format": "oci"},
+				Prefetch: true,
+			}
+
+			fakeJob = new(dbfakes.FakeJob)
+			fakeJob.IDReturns(7)
+			fakeJob.NameReturns("some-job")
+			fakeJob.TeamIDReturns(123)
+			fakeJob.PipelineIDReturns(456)
+			fakeJob.PipelineNameReturns("some-pipeline")
+			fakeJob.PipelineReturns(fakePipeline, true, nil)
+			fakeJob.ConfigReturns(atc.JobConfig{
+				Name: "some-job",
+				PlanSequence: atc.PlanSequence{
+					{
+						Task: "some-task",
+						Tags: atc.Tags{"some-tag"},
+						TaskConfig: &atc.TaskConfig{
+							Platform:      "linux",
+							ImageResource: imageResource,
+							Run:           atc.TaskRunConfig{Path: "true"},
+						},
+					},
+				},
+			}, nil)
+
+			fakeJobFactory.JobsPrefetchingImagesReturns([]db.Job{fakeJob}, nil)
+
+			fakeResourceConfig = new(dbfakes.FakeResourceConfig)
+			fakeResourceConfig.IDReturns(11)
+			fakeResourceConfig.OriginBaseResourceTypeReturns(&db.UsedBaseResourceType{ID: 12})
+			fakeResourceConfigFactory.FindOrCreateResourceConfigReturns(fakeResourceConfig, nil)
+
+			fakeCheckResource = new(resourcefakes.FakeResource)
+			fakeResourceFactory.NewResourceStub = func(source atc.Source, params atc.Params, version atc.Version) resource.Resource {
+				if version == nil {
+					return fakeCheckResource
+				}
+
+				return fakeGetResource
+			}
+
+			fakeClient.RunCheckStepReturns(worker.CheckResult{
+				Versions: []atc.Version{{"digest": "latest"}},
+			}, nil)
+		})
+
+		It("checks for the latest version of the image", func() {
+			Eventually(fakeClient.RunCheckStepCallCount).Should(Equal(1))
+			_, _, owner, containerSpec, workerSpec, _, _, resourceTypes, timeout, checkable := fakeClient.RunCheckStepArgsForCall(0)
+
+			Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(11, 12, db.ContainerOwnerExpiries{
+				Min: 5 * time.Minute,
+				Max: 1 * time.Hour,
+			})))
+			Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{ResourceType: "some-type"}))
+			Expect(containerSpec.TeamID).To(Equal(123))
+			Expect(workerSpec.Tags).To(ConsistOf("some-tag"))
+			Expect(resourceTypes).To(Equal(expectedResourceTypes))
+			Expect(timeout).To(Equal(time.Hour))
+			Expect(checkable).To(Equal(fakeCheckResource))
+
+			resourceType, source, _ := fakeResourceConfigFactory.FindOrCreateResourceConfigArgsForCall(0)
+			Expect(resourceType).To(Equal("some-type"))
+			Expect(source).To(Equal(atc.Source{"repository": "some-secret"}))
+		})
+
+		It("creates a cache of the latest version for the job", func() {
+			Eventually(fakeResourceCacheFactory.FindOrCreateResourceCacheCallCount).Should(Equal(1))
+			user, resourceType, version, source, params, resourceTypes := fakeResourceCacheFactory.FindOrCreateResourceCacheArgsForCall(0)
+			Expect(user).To(Equal(db.ForJob(7)))
+			Expect(resourceType).To(Equal("some-type"))
+			Expect(version).To(Equal(atc.Version{"digest": "latest"}))
+			Expect(source).To(Equal(atc.Source{"repository": "some-secret"}))
+			Expect(params).To(Equal(atc.Params{"format": "oci"}))
+			Expect(resourceTypes).To(Equal(expectedResourceTypes))
+		})
+
+		It("prefetches the image onto the configured number of workers", func() {
+			Eventually(fakeClient.PrefetchResourceCallCount).Should(Equal(1))
+			_, _, containerSpec, workerSpec, metadata, _, _, cache, getResource, count := fakeClient.PrefetchResourceArgsForCall(0)
+
+			Expect(containerSpec.ResourceCache).To(Equal(fakeResourceCache))
+			Expect(workerSpec).To(Equal(worker.WorkerSpec{
+				ResourceType:  "some-type",
+				Tags:          atc.Tags{"some-tag"},
+				TeamID:        123,
+				ResourceTypes: expectedResourceTypes,
+			}))
+			Expect(metadata.JobName).To(Equal("some-job"))
+			Expect(cache).To(Equal(fakeResourceCache))
+			Expect(getResource).To(Equal(fakeGetResource))
+			Expect(count).To(Equal(2))
+		})
+
+		It("releases the previously prefetched images", func() {
+			Eventually(fakeJob.ReleasePrefetchedImagesCallCount).Should(Equal(1))
+			Expect(fakeJob.ReleasePrefetchedImagesArgsForCall(0)).To(Equal([]db.UsedResourceCache{fakeResourceCache}))
+		})
+
+		Context("when the image has a version", func() {
+			BeforeEach(func() {
+				imageResource.Version = atc.Version{"digest": "pinned"}
+			})
+
+			It("prefetches that version without checking", func() {
+				Eventually(fakeResourceCacheFactory.FindOrCreateResourceCacheCallCount).Should(Equal(1))
+				_, _, version, _, _, _ := fakeResourceCacheFactory.FindOrCreateResourceCacheArgsForCall(0)
+				Expect(version).To(Equal(atc.Version{"digest": "pinned"}))
+				Expect(fakeClient.RunCheckStepCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the check finds no versions", func() {
+			BeforeEach(func() {
+				fakeClient.RunCheckStepReturns(worker.CheckResult{}, nil)
+			})
+
+			It("does not prefetch it, and keeps the previously prefetched images", func() {
+				Eventually(fakeClient.RunCheckStepCallCount).Should(Equal(1))
+				Consistently(fakeClient.PrefetchResourceCallCount).Should(BeZero())
+				Expect(fakeJob.ReleasePrefetchedImagesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when prefetching fails", func() {
+			BeforeEach(func() {
+				fakeClient.PrefetchResourceReturns(0, errors.New("nope"))
+			})
+
+			It("keeps the previously prefetched images", func() {
+				Eventually(fakeClient.PrefetchResourceCallCount).Should(Equal(1))
+				Consistently(fakeJob.ReleasePrefetchedImagesCallCount).Should(BeZero())
+			})
+		})
+	})
+})
@@ -3,3 +3,18 @@ package atc
 type CheckRequestBody struct {
 	From Version `json:"from"`
 }
+
+// CheckEveryNever can be configured as a resource's check_every to disable
+// periodic checking, e.g. for resources whose versions are pushed through the
+// API instead. Resource types are still checked at the default interval, as
+// their versions cannot be pushed.
+const CheckEveryNever = "never"
+
+type SaveVersionsRequestBody struct {
+	Versions []VersionWithMetadata `json:"versions"`
+}
+
+type VersionWithMetadata struct {
+	Version  Version         `json:"version"`
+	Metadata []MetadataField `json:"metadata,omitempty"`
+}
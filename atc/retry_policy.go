@@ -0,0 +1,113 @@
+package atc
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+type RetryWhen string
+
+const (
+	// retry attempts which failed or errored
+	RetryWhenFailed RetryWhen = "failed"
+
+	// only retry attempts which errored, e.g. because their worker went away
+	RetryWhenErrored RetryWhen = "errored"
+)
+
+// RetryConfig customizes how a step with attempts is retried. Note that the
+// step's timeout already applies to each attempt on its own.
+type RetryConfig struct {
+	// how long to wait before the second attempt; doubled before each attempt
+	// after it
+	Backoff string `json:"backoff,omitempty"`
+
+	// the longest to ever wait between two attempts
+	MaxBackoff string `json:"max_backoff,omitempty"`
+
+	// which attempts to retry: those which failed (default) or only those
+	// which errored
+	When RetryWhen `json:"when,omitempty"`
+}
+
+// RetryPolicy is enforced by a retry plan in between its attempts.
+type RetryPolicy struct {
+	Backoff    string    `json:"backoff,omitempty"`
+	MaxBackoff string    `json:"max_backoff,omitempty"`
+	When       RetryWhen `json:"when,omitempty"`
+}
+
+// RetryPolicy parses the step's retry config. It returns nil if the step does
+// not configure one.
+func (config PlanConfig) RetryPolicy() (*RetryPolicy, error) {
+	if config.Retry == nil {
+		return nil, nil
+	}
+
+	for _, duration := range []string{config.Retry.Backoff, config.Retry.MaxBackoff} {
+		if duration == "" {
+			continue
+		}
+
+		_, err := time.ParseDuration(duration)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch config.Retry.When {
+	case "", RetryWhenFailed, RetryWhenErrored:
+	default:
+		return nil, fmt.Errorf("unknown retry condition '%s'", config.Retry.When)
+	}
+
+	return &RetryPolicy{
+		Backoff:    config.Retry.Backoff,
+		MaxBackoff: config.Retry.MaxBackoff,
+		When:       config.Retry.When,
+	}, nil
+}
+
+// Delay returns how long to wait before the given attempt, counting from 1.
+// The first attempt is never delayed.
+func (policy RetryPolicy) Delay(attempt int) (time.Duration, error) {
+	if policy.Backoff == "" || attempt <= 1 {
+		return 0, nil
+	}
+
+	delay, err := time.ParseDuration(policy.Backoff)
+	if err != nil {
+		return 0, err
+	}
+
+	var maxDelay time.Duration
+	if policy.MaxBackoff != "" {
+		maxDelay, err = time.ParseDuration(policy.MaxBackoff)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	for i := 2; i < attempt; i++ {
+		// clamp before doubling past the max delay, which also keeps a large
+		// max delay from overflowing
+		if maxDelay != 0 && delay > maxDelay/2 {
+			delay = maxDelay
+			break
+		}
+
+		// stop doubling before overflowing
+		if delay > math.MaxInt64/2 {
+			break
+		}
+
+		delay *= 2
+	}
+
+	if maxDelay != 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+
+	return delay, nil
+}
@@ -0,0 +1,107 @@
+package atc_test
+
+import (
+	"math"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RetryPolicy", func() {
+	Describe("PlanConfig.RetryPolicy", func() {
+		It("returns nil if retry is not configured", func() {
+			policy, err := atc.PlanConfig{Attempts: 3}.RetryPolicy()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy).To(BeNil())
+		})
+
+		It("returns the policy enforced in between attempts", func() {
+			policy, err := atc.PlanConfig{
+				Attempts: 3,
+				Retry: &atc.RetryConfig{
+					Backoff:    "10s",
+					MaxBackoff: "1m",
+					When:       atc.RetryWhenErrored,
+				},
+			}.RetryPolicy()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy).To(Equal(&atc.RetryPolicy{
+				Backoff:    "10s",
+				MaxBackoff: "1m",
+				When:       atc.RetryWhenErrored,
+			}))
+		})
+
+		It("errors if a duration cannot be parsed", func() {
+			_, err := atc.PlanConfig{Retry: &atc.RetryConfig{MaxBackoff: "bogus"}}.RetryPolicy()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("errors if the condition to retry on is unknown", func() {
+			_, err := atc.PlanConfig{Retry: &atc.RetryConfig{When: "bogus"}}.RetryPolicy()
+			Expect(err).To(MatchError("unknown retry condition 'bogus'"))
+		})
+	})
+
+	Describe("Delay", func() {
+		delays := func(policy atc.RetryPolicy, attempts int) []time.Duration {
+			var delays []time.Duration
+			for attempt := 1; attempt <= attempts; attempt++ {
+				delay, err := policy.Delay(attempt)
+				Expect(err).ToNot(HaveOccurred())
+				delays = append(delays, delay)
+			}
+			return delays
+		}
+
+		It("does not delay any attempt without a backoff", func() {
+			Expect(delays(atc.RetryPolicy{}, 3)).To(Equal([]time.Duration{0, 0, 0}))
+		})
+
+		It("doubles the backoff before each attempt after the second", func() {
+			Expect(delays(atc.RetryPolicy{Backoff: "1s"}, 5)).To(Equal([]time.Duration{
+				0,
+				time.Second,
+				2 * time.Second,
+				4 * time.Second,
+				8 * time.Second,
+			}))
+		})
+
+		It("never waits longer than the max backoff", func() {
+			Expect(delays(atc.RetryPolicy{Backoff: "1s", MaxBackoff: "3s"}, 5)).To(Equal([]time.Duration{
+				0,
+				time.Second,
+				2 * time.Second,
+				3 * time.Second,
+				3 * time.Second,
+			}))
+		})
+
+		It("does not overflow after many attempts", func() {
+			delay, err := atc.RetryPolicy{Backoff: "1s"}.Delay(1000)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delay).To(BeNumerically(">", 0))
+		})
+
+		It("does not overflow when doubling a backoff of half the longest duration", func() {
+			delay, err := atc.RetryPolicy{Backoff: time.Duration(1 << 62).String()}.Delay(3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delay).To(Equal(time.Duration(1 << 62)))
+		})
+
+		It("does not overflow with a max backoff close to the longest duration", func() {
+			policy := atc.RetryPolicy{
+				Backoff:    "1s",
+				MaxBackoff: time.Duration(math.MaxInt64).String(),
+			}
+
+			delay, err := policy.Delay(1000)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delay).To(BeNumerically(">", 0))
+		})
+	})
+})
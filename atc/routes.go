@@ -11,8 +11,14 @@ const (
 	CreateBuild         = "CreateBuild"
 	ListBuilds          = "ListBuilds"
 	BuildEvents         = "BuildEvents"
+	GetBuildLog         = "GetBuildLog"
+	Firehose            = "Firehose"
+	TeamFirehose        = "TeamFirehose"
 	BuildResources      = "BuildResources"
 	AbortBuild          = "AbortBuild"
+	HoldBuild           = "HoldBuild"
+	ReleaseBuild        = "ReleaseBuild"
+	ResumeBuild         = "ResumeBuild"
 	GetBuildPreparation = "GetBuildPreparation"
 
 	GetCheck = "GetCheck"
@@ -31,6 +37,7 @@ const (
 	GetVersionsDB  = "GetVersionsDB"
 	JobBadge       = "JobBadge"
 	MainJobBadge   = "MainJobBadge"
+	GetJobStats    = "GetJobStats"
 
 	ClearTaskCache = "ClearTaskCache"
 
@@ -42,7 +49,10 @@ const (
 	CheckResourceWebHook = "CheckResourceWebHook"
 	CheckResourceType    = "CheckResourceType"
 
+	ListResourceWebhookDeliveries = "ListResourceWebhookDeliveries"
+
 	ListResourceVersions          = "ListResourceVersions"
+	SaveResourceVersions          = "SaveResourceVersions"
 	GetResourceVersion            = "GetResourceVersion"
 	EnableResourceVersion         = "EnableResourceVersion"
 	DisableResourceVersion        = "DisableResourceVersion"
@@ -88,6 +98,9 @@ const (
 	ListContainers           = "ListContainers"
 	GetContainer             = "GetContainer"
 	HijackContainer          = "HijackContainer"
+	StreamOutContainer       = "StreamOutContainer"
+	StreamInContainer        = "StreamInContainer"
+	PortForwardContainer     = "PortForwardContainer"
 	ListDestroyingContainers = "ListDestroyingContainers"
 	ReportWorkerContainers   = "ReportWorkerContainers"
 
@@ -102,6 +115,10 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
+	SearchBuildLogs = "SearchBuildLogs"
+
+	ClearNamedCache = "ClearNamedCache"
+
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
@@ -129,8 +146,14 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id", Method: "GET", Name: GetBuild},
 	{Path: "/api/v1/builds/:build_id/plan", Method: "GET", Name: GetBuildPlan},
 	{Path: "/api/v1/builds/:build_id/events", Method: "GET", Name: BuildEvents},
+	{Path: "/api/v1/builds/:build_id/log", Method: "GET", Name: GetBuildLog},
+	{Path: "/api/v1/firehose", Method: "GET", Name: Firehose},
+	{Path: "/api/v1/teams/:team_name/firehose", Method: "GET", Name: TeamFirehose},
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
+	{Path: "/api/v1/builds/:build_id/hold", Method: "PUT", Name: HoldBuild},
+	{Path: "/api/v1/builds/:build_id/release", Method: "PUT", Name: ReleaseBuild},
+	{Path: "/api/v1/builds/:build_id/resume", Method: "PUT", Name: ResumeBuild},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
 	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
 
@@ -149,6 +172,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/schedule", Method: "PUT", Name: ScheduleJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/badge", Method: "GET", Name: JobBadge},
 	{Path: "/api/v1/pipelines/:pipeline_name/jobs/:job_name/badge", Method: "GET", Name: MainJobBadge},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/stats", Method: "GET", Name: GetJobStats},
 
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/tasks/:step_name/cache", Method: "DELETE", Name: ClearTaskCache},
 
@@ -174,9 +198,11 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name", Method: "GET", Name: GetResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check", Method: "POST", Name: CheckResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", Method: "POST", Name: CheckResourceWebHook},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/webhook/deliveries", Method: "GET", Name: ListResourceWebhookDeliveries},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/check", Method: "POST", Name: CheckResourceType},
 
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions", Method: "GET", Name: ListResourceVersions},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions", Method: "POST", Name: SaveResourceVersions},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id", Method: "GET", Name: GetResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/enable", Method: "PUT", Name: EnableResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/disable", Method: "PUT", Name: DisableResourceVersion},
@@ -212,6 +238,9 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/containers", Method: "GET", Name: ListContainers},
 	{Path: "/api/v1/teams/:team_name/containers/:id", Method: "GET", Name: GetContainer},
 	{Path: "/api/v1/teams/:team_name/containers/:id/hijack", Method: "GET", Name: HijackContainer},
+	{Path: "/api/v1/teams/:team_name/containers/:id/files", Method: "GET", Name: StreamOutContainer},
+	{Path: "/api/v1/teams/:team_name/containers/:id/files", Method: "PUT", Name: StreamInContainer},
+	{Path: "/api/v1/teams/:team_name/containers/:id/port-forward", Method: "GET", Name: PortForwardContainer},
 
 	{Path: "/api/v1/teams/:team_name/volumes", Method: "GET", Name: ListVolumes},
 	{Path: "/api/v1/volumes/destroying", Method: "GET", Name: ListDestroyingVolumes},
@@ -223,6 +252,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/rename", Method: "PUT", Name: RenameTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
+	{Path: "/api/v1/teams/:team_name/build-logs", Method: "GET", Name: SearchBuildLogs},
+	{Path: "/api/v1/teams/:team_name/caches/:cache_name", Method: "DELETE", Name: ClearNamedCache},
 
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
@@ -44,9 +44,16 @@ type CacheArtifact struct {
 	JobID    int
 	StepName string
 	Path     string
+
+	// Name of the team's shared cache, if the cache is one
+	Name string
 }
 
 func (art CacheArtifact) ID() string {
+	if art.Name != "" {
+		return fmt.Sprintf("%d, %s", art.TeamID, art.Name)
+	}
+
 	return fmt.Sprintf("%d, %d, %s, %s", art.TeamID, art.JobID, art.StepName, art.Path)
 }
 
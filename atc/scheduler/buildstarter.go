@@ -198,7 +198,15 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return startResults{}, fmt.Errorf("config: %w", err)
 	}
 
+	config = config.BreakAt(nextPendingBuild.Breakpoints())
+
 	plan, err := s.planner.Create(config.Plan(), job.Resources, job.ResourceTypes, buildInputs)
+	if err == nil {
+		// the limits of the job apply to the build as a whole, rather than to
+		// each of its steps
+		plan.OutputLimits, err = config.OutputLimits()
+	}
+
 	if err != nil {
 		logger.Error("failed-to-create-build-plan", err)
 
@@ -662,6 +662,27 @@ var _ = Describe("BuildStarter", func() {
 										Expect(actualBuildInputs).To(Equal([]db.BuildInput{{Name: "some-input"}}))
 									})
 
+									Context("when a build has breakpoints", func() {
+										BeforeEach(func() {
+											rerunBuild.BreakpointsReturns(map[string]atc.Breakpoint{"some-input": atc.BreakpointBefore})
+										})
+
+										It("creates its build plan with the breakpoints set", func() {
+											breakpointConfig := atc.PlanSequence{{Get: "some-input", Breakpoint: atc.BreakpointBefore}}
+
+											actualPlanConfig, _, _, _ := fakePlanner.CreateArgsForCall(1)
+											Expect(actualPlanConfig).To(Equal(atc.PlanConfig{Do: &breakpointConfig}))
+										})
+
+										It("does not set the breakpoints for the other builds", func() {
+											actualPlanConfig, _, _, _ := fakePlanner.CreateArgsForCall(0)
+											Expect(actualPlanConfig).To(Equal(atc.PlanConfig{Do: &jobConfig.PlanSequence}))
+
+											actualPlanConfig, _, _, _ = fakePlanner.CreateArgsForCall(2)
+											Expect(actualPlanConfig).To(Equal(atc.PlanConfig{Do: &jobConfig.PlanSequence}))
+										})
+									})
+
 									Context("when starting the build fails", func() {
 										BeforeEach(func() {
 											pendingBuild1.StartReturns(false, disaster)
@@ -757,6 +778,30 @@ var _ = Describe("BuildStarter", func() {
 											Expect(rerunBuild.StartCallCount()).To(Equal(1))
 											Expect(rerunBuild.StartArgsForCall(0)).To(Equal(plannedPlan))
 										})
+
+										Context("when the job has output limits", func() {
+											BeforeEach(func() {
+												limitedConfig := jobConfig
+												limitedConfig.LogLimit = "1KB"
+												limitedConfig.IdleTimeout = "30m"
+												job.ConfigReturns(limitedConfig, nil)
+											})
+
+											It("does not pass them on to each step", func() {
+												actualPlanConfig, _, _, _ := fakePlanner.CreateArgsForCall(0)
+												Expect(actualPlanConfig).To(Equal(atc.PlanConfig{Do: &jobConfig.PlanSequence}))
+											})
+
+											It("sets them on the plan of the build as a whole", func() {
+												limitedPlan := plannedPlan
+												limitedPlan.OutputLimits = &atc.OutputLimits{
+													LogLimit:    1024,
+													IdleTimeout: "30m",
+												}
+
+												Expect(pendingBuild1.StartArgsForCall(0)).To(Equal(limitedPlan))
+											})
+										})
 									})
 								})
 							})
@@ -61,6 +61,11 @@ func (factory *buildFactory) Create(
 		}
 
 		plan = factory.planFactory.NewPlan(retryStep)
+
+		plan.RetryPolicy, err = planConfig.RetryPolicy()
+		if err != nil {
+			return atc.Plan{}, err
+		}
 	}
 
 	if planConfig.Abort != nil {
@@ -148,6 +153,30 @@ func (factory *buildFactory) Create(
 		})
 	}
 
+	// the build pauses before or after the step, its attempts and its hooks
+	// have run, so that the time spent paused does not count towards its
+	// timeout
+	plan.Breakpoint = planConfig.Breakpoint
+
+	limits, err := planConfig.OutputLimits()
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	if limits != nil {
+		plan.Each(func(step *atc.Plan) {
+			if step.Get == nil && step.Put == nil && step.Task == nil {
+				return
+			}
+
+			if step.OutputLimits == nil {
+				step.OutputLimits = &atc.OutputLimits{}
+			}
+
+			step.OutputLimits.Merge(*limits)
+		})
+	}
+
 	return plan, nil
 }
 
@@ -272,6 +301,7 @@ func (factory *buildFactory) basePlan(
 			InputMapping:      planConfig.InputMapping,
 			OutputMapping:     planConfig.OutputMapping,
 			ImageArtifactName: planConfig.ImageArtifactName,
+			Network:           planConfig.Network,
 
 			VersionedResourceTypes: resourceTypes,
 		})
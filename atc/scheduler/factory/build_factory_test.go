@@ -413,6 +413,55 @@ var factoryTests = []FactoryTest{
 			}
 		}`,
 	},
+	{
+		Title: "output limits modifier",
+
+		ConfigYAML: `
+			do:
+			- task: some-task
+			  file: some-task-file
+			  idle_timeout: 5m
+			- load_var: some-var
+			  file: some-file
+			log_limit: 10MB
+			on_log_limit: fail
+			idle_timeout: 1h
+		`,
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"do": [
+				{
+					"id": "(unique)",
+					"output_limits": {
+						"log_limit": 10485760,
+						"on_log_limit": "fail",
+						"idle_timeout": "5m"
+					},
+					"task": {
+						"name": "some-task",
+						"privileged": false,
+						"config_path": "some-task-file",
+						"resource_types": [
+							{
+								"name": "some-resource-type",
+								"type": "some-base-resource-type",
+								"source": {"some": "type-source"},
+								"version": {"some": "type-version"}
+							}
+						]
+					}
+				},
+				{
+					"id": "(unique)",
+					"load_var": {
+						"name": "some-var",
+						"file": "some-file"
+					}
+				}
+			]
+		}`,
+	},
 	{
 		Title: "attempts modifier",
 
@@ -450,6 +499,45 @@ var factoryTests = []FactoryTest{
 			]
 		}`,
 	},
+	{
+		Title: "attempts modifier with retry policy",
+
+		ConfigYAML: `
+			load_var: some-var
+			file: some-file
+			attempts: 2
+			retry:
+			  backoff: 10s
+			  max_backoff: 1m
+			  when: errored
+		`,
+
+		CompareIDs: true,
+		PlanJSON: `{
+			"id": "3",
+			"retry_policy": {
+				"backoff": "10s",
+				"max_backoff": "1m",
+				"when": "errored"
+			},
+			"retry": [
+				{
+					"id": "1",
+					"load_var": {
+						"name": "some-var",
+						"file": "some-file"
+					}
+				},
+				{
+					"id": "2",
+					"load_var": {
+						"name": "some-var",
+						"file": "some-file"
+					}
+				}
+			]
+		}`,
+	},
 	{
 		Title: "timeout and attempts modifier",
 
@@ -505,6 +593,77 @@ var factoryTests = []FactoryTest{
 			]
 		}`,
 	},
+	{
+		Title: "breakpoint modifier",
+
+		ConfigYAML: `
+			load_var: some-var
+			file: some-file
+			timeout: 1h
+			breakpoint: true
+			on_failure:
+			  load_var: some-other-var
+			  file: some-other-file
+		`,
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"breakpoint": "after",
+			"on_failure": {
+				"step": {
+					"id": "(unique)",
+					"timeout": {
+						"step": {
+							"id": "(unique)",
+							"load_var": {
+								"name": "some-var",
+								"file": "some-file"
+							}
+						},
+						"duration": "1h"
+					}
+				},
+				"on_failure": {
+					"id": "(unique)",
+					"load_var": {
+						"name": "some-other-var",
+						"file": "some-other-file"
+					}
+				}
+			}
+		}`,
+	},
+	{
+		Title: "before breakpoint modifier",
+
+		ConfigYAML: `
+			load_var: some-var
+			file: some-file
+			attempts: 2
+			breakpoint: before
+		`,
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"breakpoint": "before",
+			"retry": [
+				{
+					"id": "(unique)",
+					"load_var": {
+						"name": "some-var",
+						"file": "some-file"
+					}
+				},
+				{
+					"id": "(unique)",
+					"load_var": {
+						"name": "some-var",
+						"file": "some-file"
+					}
+				}
+			]
+		}`,
+	},
 }
 
 func init() {
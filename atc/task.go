@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/yaml"
 )
@@ -36,11 +38,24 @@ type TaskConfig struct {
 
 	// Path to cached directory that will be shared between builds for the same task.
 	Caches []TaskCacheConfig `json:"caches,omitempty"`
+
+	// Containers to run alongside the task, e.g. a database, until it finishes.
+	Services []TaskServiceConfig `json:"services,omitempty"`
 }
 
 type ContainerLimits struct {
 	CPU    *uint64 `json:"cpu,omitempty"`
 	Memory *uint64 `json:"memory,omitempty"`
+
+	// Maximum number of processes and threads in the container.
+	Pids *uint64 `json:"pids,omitempty"`
+
+	// Maximum number of files the task's process may have open at once.
+	OpenFiles *uint64 `json:"open_files,omitempty"`
+
+	// Limits which were configured but cannot be enforced, e.g. disk, so
+	// that validation can reject them.
+	Unsupported []string `json:"-"`
 }
 
 type ImageResource struct {
@@ -49,6 +64,11 @@ type ImageResource struct {
 
 	Params  Params  `json:"params,omitempty"`
 	Version Version `json:"version,omitempty"`
+
+	// when set on the image of a task configured inline in a pipeline, the
+	// latest version of the image is fetched onto workers ahead of the
+	// builds which need it
+	Prefetch bool `json:"prefetch,omitempty"`
 }
 
 func NewTaskConfig(configBytes []byte) (TaskConfig, error) {
@@ -79,6 +99,8 @@ func (config TaskConfig) Validate() error {
 
 	messages = append(messages, config.validateInputContainsNames()...)
 	messages = append(messages, config.validateOutputContainsNames()...)
+	messages = append(messages, config.validateServices()...)
+	messages = append(messages, config.validateLimits()...)
 
 	if len(messages) > 0 {
 		return fmt.Errorf("invalid task configuration:\n%s", strings.Join(messages, "\n"))
@@ -87,6 +109,24 @@ func (config TaskConfig) Validate() error {
 	return nil
 }
 
+func (config TaskConfig) validateLimits() []string {
+	if config.Limits == nil {
+		return nil
+	}
+
+	var messages []string
+
+	for _, limit := range config.Limits.Unsupported {
+		if limit == "disk" {
+			messages = append(messages, "  container_limits.disk is not supported")
+		} else {
+			messages = append(messages, fmt.Sprintf("  unknown container limit '%s'", limit))
+		}
+	}
+
+	return messages
+}
+
 func (config TaskConfig) validateOutputContainsNames() []string {
 	var messages []string
 
@@ -111,6 +151,64 @@ func (config TaskConfig) validateInputContainsNames() []string {
 	return messages
 }
 
+var serviceNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+func (config TaskConfig) validateServices() []string {
+	var messages []string
+
+	names := map[string]bool{}
+
+	for i, service := range config.Services {
+		identifier := fmt.Sprintf("service in position %d", i)
+
+		if service.Name == "" {
+			messages = append(messages, fmt.Sprintf("  %s is missing a name", identifier))
+		} else {
+			identifier = fmt.Sprintf("service '%s'", service.Name)
+
+			if !serviceNameRegexp.MatchString(service.Name) {
+				messages = append(messages, fmt.Sprintf("  %s must start with a lowercase letter and only contain lowercase letters, numbers, '-' and '_'", identifier))
+			}
+
+			if names[service.Name] {
+				messages = append(messages, fmt.Sprintf("  %s is configured more than once", identifier))
+			}
+
+			names[service.Name] = true
+		}
+
+		if service.ImageResource == nil {
+			messages = append(messages, fmt.Sprintf("  %s is missing an image_resource", identifier))
+		}
+
+		if service.Run.Path == "" {
+			messages = append(messages, fmt.Sprintf("  %s is missing path to executable to run", identifier))
+		}
+
+		if service.Readiness != nil {
+			if service.Readiness.Run.Path == "" {
+				messages = append(messages, fmt.Sprintf("  %s readiness check is missing path to executable to run", identifier))
+			}
+
+			if service.Readiness.Interval != "" {
+				_, err := time.ParseDuration(service.Readiness.Interval)
+				if err != nil {
+					messages = append(messages, fmt.Sprintf("  %s readiness check has an invalid interval '%s'", identifier, service.Readiness.Interval))
+				}
+			}
+
+			if service.Readiness.Timeout != "" {
+				_, err := time.ParseDuration(service.Readiness.Timeout)
+				if err != nil {
+					messages = append(messages, fmt.Sprintf("  %s readiness check has an invalid timeout '%s'", identifier, service.Readiness.Timeout))
+				}
+			}
+		}
+	}
+
+	return messages
+}
+
 type TaskRunConfig struct {
 	Path string   `json:"path"`
 	Args []string `json:"args,omitempty"`
@@ -133,6 +231,52 @@ type TaskOutputConfig struct {
 
 type TaskCacheConfig struct {
 	Path string `json:"path,omitempty"`
+
+	// Name of a cache shared by all of the team's tasks which mount it, see
+	// NamedCacheConfig. Without a name, the cache is only shared between
+	// builds of the same task.
+	Name string `json:"name,omitempty"`
+}
+
+// TaskServiceConfig describes a container which is started on the same
+// worker as the task before the task runs, and stopped once it finishes.
+type TaskServiceConfig struct {
+	// Name of the service. The service shares the task's network namespace,
+	// so the task reaches it on localhost, also given by the <NAME>_HOST and
+	// <NAME>_PORT environment variables.
+	Name string `json:"name"`
+
+	ImageResource *ImageResource `json:"image_resource,omitempty"`
+
+	// Parameters to pass to the service via environment variables.
+	Params TaskEnv `json:"params,omitempty"`
+
+	// The process which runs the service.
+	Run TaskRunConfig `json:"run"`
+
+	// Ports the service listens on; the first is given to the task as
+	// <NAME>_PORT.
+	Ports []uint16 `json:"ports,omitempty"`
+
+	// Command run in the service's container until it succeeds before the task
+	// is started.
+	Readiness *TaskServiceReadinessConfig `json:"readiness,omitempty"`
+}
+
+type TaskServiceReadinessConfig struct {
+	Run TaskRunConfig `json:"run"`
+
+	// How long to wait in between checks, 1s by default.
+	Interval string `json:"interval,omitempty"`
+
+	// How long to keep checking before giving up, 1m by default.
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// EnvPrefix is the prefix of the environment variables by which the task
+// reaches the service, e.g. MY_DB for a service named my-db.
+func (config TaskServiceConfig) EnvPrefix() string {
+	return strings.ToUpper(strings.Replace(config.Name, "-", "_", -1))
 }
 
 type TaskEnv map[string]string
@@ -308,6 +308,32 @@ run: {path: a/file}
 					Expect(err).To(MatchError(ContainSubstring("cpu limit must be an integer")))
 				})
 			})
+
+			Context("when a disk limit is provided", func() {
+				It("errors, as it cannot be enforced", func() {
+					data := []byte(`
+platform: beos
+container_limits: { disk: 10GB }
+
+run: {path: a/file}
+`)
+					_, err := NewTaskConfig(data)
+					Expect(err).To(MatchError(ContainSubstring("container_limits.disk is not supported")))
+				})
+			})
+
+			Context("when an unknown limit is provided", func() {
+				It("errors", func() {
+					data := []byte(`
+platform: beos
+container_limits: { memroy: 1GB }
+
+run: {path: a/file}
+`)
+					_, err := NewTaskConfig(data)
+					Expect(err).To(MatchError(ContainSubstring("unknown container limit 'memroy'")))
+				})
+			})
 		})
 
 		Context("when the task has inputs", func() {
@@ -386,6 +412,113 @@ run: {path: a/file}
 			})
 		})
 
+		Context("when the task has services", func() {
+			var service TaskServiceConfig
+
+			BeforeEach(func() {
+				service = TaskServiceConfig{
+					Name:          "some-db",
+					ImageResource: &ImageResource{Type: "registry-image", Source: Source{"repository": "postgres"}},
+					Run:           TaskRunConfig{Path: "docker-entrypoint.sh", Args: []string{"postgres"}},
+					Ports:         []uint16{5432},
+					Readiness: &TaskServiceReadinessConfig{
+						Run:      TaskRunConfig{Path: "pg_isready"},
+						Interval: "1s",
+						Timeout:  "1m",
+					},
+				}
+			})
+
+			It("is valid", func() {
+				validConfig.Services = []TaskServiceConfig{service}
+				Expect(validConfig.Validate()).ToNot(HaveOccurred())
+			})
+
+			It("decodes them from yaml", func() {
+				config, err := NewTaskConfig([]byte(`
+platform: linux
+run: {path: reboot}
+services:
+- name: some-db
+  image_resource: {type: registry-image, source: {repository: postgres}}
+  run: {path: docker-entrypoint.sh, args: [postgres]}
+  ports: [5432]
+  readiness:
+    run: {path: pg_isready}
+    interval: 1s
+    timeout: 1m
+`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.Services).To(Equal([]TaskServiceConfig{service}))
+			})
+
+			It("reaches them by environment variables named after them", func() {
+				Expect(service.EnvPrefix()).To(Equal("SOME_DB"))
+			})
+
+			Context("when a service is missing a name", func() {
+				BeforeEach(func() {
+					service.Name = ""
+					invalidConfig.Services = []TaskServiceConfig{service}
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("  service in position 0 is missing a name")))
+				})
+			})
+
+			Context("when a service has an invalid name", func() {
+				BeforeEach(func() {
+					service.Name = "Some DB"
+					invalidConfig.Services = []TaskServiceConfig{service}
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("  service 'Some DB' must start with a lowercase letter")))
+				})
+			})
+
+			Context("when two services have the same name", func() {
+				BeforeEach(func() {
+					invalidConfig.Services = []TaskServiceConfig{service, service}
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("  service 'some-db' is configured more than once")))
+				})
+			})
+
+			Context("when a service is missing an image or a path to run", func() {
+				BeforeEach(func() {
+					service.ImageResource = nil
+					service.Run.Path = ""
+					invalidConfig.Services = []TaskServiceConfig{service}
+				})
+
+				It("returns an error", func() {
+					err := invalidConfig.Validate()
+					Expect(err).To(MatchError(ContainSubstring("  service 'some-db' is missing an image_resource")))
+					Expect(err).To(MatchError(ContainSubstring("  service 'some-db' is missing path to executable to run")))
+				})
+			})
+
+			Context("when a service's readiness check is invalid", func() {
+				BeforeEach(func() {
+					service.Readiness.Run.Path = ""
+					service.Readiness.Interval = "bogus"
+					service.Readiness.Timeout = "also-bogus"
+					invalidConfig.Services = []TaskServiceConfig{service}
+				})
+
+				It("returns an error", func() {
+					err := invalidConfig.Validate()
+					Expect(err).To(MatchError(ContainSubstring("  service 'some-db' readiness check is missing path to executable to run")))
+					Expect(err).To(MatchError(ContainSubstring("  service 'some-db' readiness check has an invalid interval 'bogus'")))
+					Expect(err).To(MatchError(ContainSubstring("  service 'some-db' readiness check has an invalid timeout 'also-bogus'")))
+				})
+			})
+		})
+
 		Context("when run is missing", func() {
 			BeforeEach(func() {
 				invalidConfig.Run.Path = ""
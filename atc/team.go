@@ -13,9 +13,26 @@ type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
 	Auth TeamAuth `json:"auth,omitempty"`
+
+	Network *TeamNetworkConfig `json:"network,omitempty"`
+
+	// caches declared by the team rather than by one of its pipelines
+	Caches NamedCacheConfigs `json:"caches,omitempty"`
 }
 
 func (team Team) Validate() error {
+	err := team.Caches.Validate()
+	if err != nil {
+		return err
+	}
+
+	if team.Network != nil {
+		err = team.Network.Default.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
 	return team.Auth.Validate()
 }
 
@@ -0,0 +1,62 @@
+package atc
+
+const (
+	WebhookTypeGitHub = "github"
+	WebhookTypeGitLab = "gitlab"
+	WebhookTypeHMAC   = "hmac"
+)
+
+// DefaultWebhookSignatureHeader is the header carrying the signature of
+// generic HMAC webhooks when no header is configured.
+const DefaultWebhookSignatureHeader = "X-Signature"
+
+// A WebhookConfig describes how deliveries to a resource's check webhook are
+// verified and which of them result in a check being queued.
+type WebhookConfig struct {
+	// Type is one of github, gitlab or hmac.
+	Type string `json:"type"`
+
+	// Secret is shared with the sender. It may reference credentials using
+	// ((vars)).
+	Secret string `json:"secret"`
+
+	// Header overrides the header carrying the signature for hmac webhooks.
+	Header string `json:"header,omitempty"`
+
+	// Filter maps dot-separated paths in the JSON payload to the value they
+	// must have, e.g. {"ref": "refs/heads/main"}. Deliveries which do not
+	// match every entry are acknowledged without queueing a check.
+	Filter map[string]string `json:"filter,omitempty"`
+}
+
+func (config WebhookConfig) SignatureHeader() string {
+	switch config.Type {
+	case WebhookTypeGitHub:
+		return "X-Hub-Signature-256"
+	case WebhookTypeGitLab:
+		return "X-Gitlab-Token"
+	}
+
+	if config.Header != "" {
+		return config.Header
+	}
+
+	return DefaultWebhookSignatureHeader
+}
+
+type WebhookDeliveryOutcome string
+
+const (
+	WebhookDeliveryAccepted WebhookDeliveryOutcome = "accepted"
+	WebhookDeliveryFiltered WebhookDeliveryOutcome = "filtered"
+	WebhookDeliveryRejected WebhookDeliveryOutcome = "rejected"
+	WebhookDeliveryErrored  WebhookDeliveryOutcome = "errored"
+)
+
+type WebhookDelivery struct {
+	ID          int                    `json:"id"`
+	DeliveredAt int64                  `json:"delivered_at"`
+	Outcome     WebhookDeliveryOutcome `json:"outcome"`
+	Message     string                 `json:"message,omitempty"`
+	CheckID     int                    `json:"check_id,omitempty"`
+}
@@ -30,8 +30,20 @@ type Worker struct {
 	StartTime int64    `json:"start_time"`
 	Ephemeral bool     `json:"ephemeral"`
 	State     string   `json:"state"`
+
+	Capabilities []string `json:"capabilities,omitempty"`
 }
 
+// WorkerCapabilityNetworkIsolation is advertised by workers whose containers
+// are denied network access unless it is allowed to them, so that containers
+// with restricted network access can be placed on them.
+const WorkerCapabilityNetworkIsolation = "network-isolation"
+
+// WorkerCapabilitySharedNetworkNamespace is advertised by workers whose
+// containers can join the network namespace of another container, so that
+// tasks with services can be placed on them.
+const WorkerCapabilitySharedNetworkNamespace = "shared-network-namespace"
+
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
 var ErrMissingWorkerGardenAddress = errors.New("missing garden address")
 var ErrNoWorkers = errors.New("no workers available for checking")
@@ -59,3 +71,19 @@ type WorkerResourceType struct {
 type PruneWorkerResponseBody struct {
 	Stderr string `json:"stderr"`
 }
+
+// VolumesDiskUsage is the usage of the disk holding a worker's volumes, in
+// bytes.
+type VolumesDiskUsage struct {
+	Used     uint64 `json:"used"`
+	Capacity uint64 `json:"capacity"`
+}
+
+// MeasuredVolumeProperty is the baggageclaim property of the volumes whose
+// size workers measure, e.g. the copies of named caches.
+const MeasuredVolumeProperty = "concourse:measured"
+
+// SizeLimitVolumeProperty is the baggageclaim property holding the cap in bytes
+// on the size of a measured volume. Workers remove the least recently used
+// files from volumes which have grown beyond it.
+const SizeLimitVolumeProperty = "concourse:size-limit"
@@ -144,6 +144,10 @@ func NewCacheArtifactSource(artifact runtime.CacheArtifact) ArtifactSource {
 }
 
 func (source *cacheArtifactSource) ExistsOn(logger lager.Logger, worker Worker) (Volume, bool, error) {
+	if source.Name != "" {
+		return worker.FindVolumeForNamedCache(logger, source.TeamID, source.Name)
+	}
+
 	return worker.FindVolumeForTaskCache(logger, source.TeamID, source.JobID, source.StepName, source.Path)
 }
 
@@ -304,5 +304,30 @@ var _ = Describe("CacheArtifactSource", func() {
 			Expect(actualErr).To(Equal(disaster))
 
 		})
+
+		Context("when the cache is named", func() {
+			BeforeEach(func() {
+				cacheArtifact = runtime.CacheArtifact{
+					TeamID: 5,
+					Name:   "some-cache",
+					Path:   "some/path/foo",
+				}
+				cacheArtifactSource = worker.NewCacheArtifactSource(cacheArtifact)
+
+				fakeWorker.FindVolumeForNamedCacheReturns(fakeVolume, true, nil)
+			})
+
+			It("looks up the team's named cache instead", func() {
+				Expect(fakeWorker.FindVolumeForTaskCacheCallCount()).To(Equal(0))
+
+				_, actualTeamID, actualName := fakeWorker.FindVolumeForNamedCacheArgsForCall(0)
+				Expect(actualTeamID).To(Equal(5))
+				Expect(actualName).To(Equal("some-cache"))
+
+				Expect(actualVolume).To(Equal(fakeVolume))
+				Expect(actualFound).To(BeTrue())
+				Expect(actualErr).ToNot(HaveOccurred())
+			})
+		})
 	})
 })
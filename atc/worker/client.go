@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"path"
+	"sort"
 	"strconv"
 	"time"
 
@@ -25,6 +26,9 @@ import (
 const taskProcessID = "task"
 const taskExitStatusPropertyName = "concourse:exit-status"
 
+// prefetchTimeout limits how long prefetching a resource may take.
+const prefetchTimeout = time.Hour
+
 //go:generate counterfeiter . Client
 
 type Client interface {
@@ -93,6 +97,22 @@ type Client interface {
 		db.UsedResourceCache,
 		resource.Resource,
 	) (GetResult, error)
+
+	// PrefetchResource fetches the resource cache onto up to count of the
+	// workers satisfying the spec, counting those which already have it, and
+	// returns the number of workers it was fetched onto.
+	PrefetchResource(
+		context.Context,
+		lager.Logger,
+		ContainerSpec,
+		WorkerSpec,
+		db.ContainerMetadata,
+		ImageFetcherSpec,
+		runtime.ProcessSpec,
+		db.UsedResourceCache,
+		resource.Resource,
+		int,
+	) (int, error)
 }
 
 func NewClient(pool Pool,
@@ -289,6 +309,8 @@ func (client *client) RunTaskStep(
 		defer decreaseActiveTasks(logger.Session("decrease-active-tasks"), chosenWorker)
 	}
 
+	containerSpec.Env = append(containerSpec.Env, servicesEnv(containerSpec.Services)...)
+
 	container, err := chosenWorker.FindOrCreateContainer(
 		ctx,
 		logger,
@@ -317,7 +339,15 @@ func (client *client) RunTaskStep(
 		return TaskResult{
 			ExitStatus:   status,
 			VolumeMounts: container.VolumeMounts(),
-		}, err
+		}, nil
+	}
+
+	// the services join the task container's network namespace, so it must
+	// be created first
+	services, err := client.startServices(ctx, logger, chosenWorker, imageFetcherSpec, containerSpec.Services, container)
+	defer services.Destroy(logger)
+	if err != nil {
+		return TaskResult{}, err
 	}
 
 	processIO := garden.ProcessIO{
@@ -342,6 +372,8 @@ func (client *client) RunTaskStep(
 
 				Dir: path.Join(metadata.WorkingDirectory, processSpec.Dir),
 
+				Limits: containerSpec.Limits.ToGardenResourceLimits(),
+
 				// Guardian sets the default TTY window size to width: 80, height: 24,
 				// which creates ANSI control sequences that do not work with other window sizes
 				TTY: &garden.TTYSpec{
@@ -392,10 +424,11 @@ func (client *client) RunTaskStep(
 				ExitStatus: status.processStatus,
 			}, err
 		}
+
 		return TaskResult{
 			ExitStatus:   status.processStatus,
 			VolumeMounts: container.VolumeMounts(),
-		}, err
+		}, nil
 	}
 }
 
@@ -452,6 +485,101 @@ func (client *client) RunGetStep(
 	return getResult, err
 }
 
+func (client *client) PrefetchResource(
+	ctx context.Context,
+	logger lager.Logger,
+	containerSpec ContainerSpec,
+	workerSpec WorkerSpec,
+	containerMetadata db.ContainerMetadata,
+	imageFetcherSpec ImageFetcherSpec,
+	processSpec runtime.ProcessSpec,
+	resourceCache db.UsedResourceCache,
+	resource resource.Resource,
+	count int,
+) (int, error) {
+	workers, err := client.pool.AllSatisfying(logger, workerSpec)
+	if err != nil {
+		return 0, err
+	}
+
+	names, err := resourceCache.WorkerNames()
+	if err != nil {
+		return 0, err
+	}
+
+	cachedOn := map[string]bool{}
+	for _, name := range names {
+		cachedOn[name] = true
+	}
+
+	var cold []Worker
+	for _, w := range workers {
+		if cachedOn[w.Name()] {
+			count--
+		} else {
+			cold = append(cold, w)
+		}
+	}
+
+	if count <= 0 {
+		return 0, nil
+	}
+
+	sort.SliceStable(cold, func(i, j int) bool {
+		return cold[i].BuildContainers() < cold[j].BuildContainers()
+	})
+
+	if count < len(cold) {
+		cold = cold[:count]
+	}
+
+	sign, err := resource.Signature()
+	if err != nil {
+		return 0, err
+	}
+
+	// the container is only kept for as long as the fetch may take, so that
+	// the containers of failed fetches are not left behind
+	owner := db.NewPrefetchContainerOwner(resourceCache, containerSpec.TeamID, prefetchTimeout)
+
+	ctx, cancel := context.WithTimeout(ctx, prefetchTimeout)
+	defer cancel()
+
+	var errs error
+	fetched := 0
+	for _, chosenWorker := range cold {
+		logger := logger.Session("fetch", lager.Data{"worker": chosenWorker.Name()})
+
+		result, _, err := chosenWorker.Fetch(
+			ctx,
+			logger,
+			containerMetadata,
+			chosenWorker,
+			containerSpec,
+			processSpec,
+			resource,
+			owner,
+			imageFetcherSpec,
+			resourceCache,
+			lockName(sign, chosenWorker.Name()),
+		)
+		if err != nil {
+			logger.Error("failed-to-fetch", err)
+			errs = multierror.Append(errs, err)
+			continue
+		}
+
+		if result.ExitStatus != 0 {
+			errs = multierror.Append(errs, fmt.Errorf("fetching onto worker %s exited with status %d", chosenWorker.Name(), result.ExitStatus))
+			continue
+		}
+
+		fetched++
+	}
+
+	return fetched, errs
+}
+
 func (client *client) RunPutStep(
 	ctx context.Context,
 	logger lager.Logger,
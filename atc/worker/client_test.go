@@ -20,6 +20,7 @@ import (
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
 	"github.com/onsi/gomega/gbytes"
 
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc/db"
@@ -499,6 +500,152 @@ var _ = Describe("Client", func() {
 		})
 	})
 
+	Describe("PrefetchResource", func() {
+		var (
+			ctx                   context.Context
+			containerSpec         worker.ContainerSpec
+			workerSpec            worker.WorkerSpec
+			metadata              db.ContainerMetadata
+			imageSpec             worker.ImageFetcherSpec
+			processSpec           runtime.ProcessSpec
+			fakeResource          *resourcefakes.FakeResource
+			fakeUsedResourceCache *dbfakes.FakeUsedResourceCache
+			count                 int
+
+			warmWorker  *workerfakes.FakeWorker
+			busyWorker  *workerfakes.FakeWorker
+			quietWorker *workerfakes.FakeWorker
+
+			fetched int
+			err     error
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			containerSpec = worker.ContainerSpec{TeamID: 123}
+			workerSpec = worker.WorkerSpec{ResourceType: "some-type"}
+			metadata = db.ContainerMetadata{Type: db.ContainerTypeGet}
+			imageSpec = worker.ImageFetcherSpec{Delegate: worker.NoopImageFetchingDelegate{}}
+			processSpec = runtime.ProcessSpec{Path: "/opt/resource/in"}
+			fakeResource = new(resourcefakes.FakeResource)
+			fakeUsedResourceCache = new(dbfakes.FakeUsedResourceCache)
+			fakeUsedResourceCache.IDReturns(42)
+			fakeUsedResourceCache.WorkerNamesReturns([]string{"warm-worker", "gone-worker"}, nil)
+			count = 2
+
+			warmWorker = new(workerfakes.FakeWorker)
+			warmWorker.NameReturns("warm-worker")
+
+			busyWorker = new(workerfakes.FakeWorker)
+			busyWorker.NameReturns("busy-worker")
+			busyWorker.BuildContainersReturns(10)
+
+			quietWorker = new(workerfakes.FakeWorker)
+			quietWorker.NameReturns("quiet-worker")
+			quietWorker.BuildContainersReturns(1)
+
+			fakePool.AllSatisfyingReturns([]worker.Worker{warmWorker, busyWorker, quietWorker}, nil)
+		})
+
+		JustBeforeEach(func() {
+			fetched, err = client.PrefetchResource(
+				ctx,
+				logger,
+				containerSpec,
+				workerSpec,
+				metadata,
+				imageSpec,
+				processSpec,
+				fakeUsedResourceCache,
+				fakeResource,
+				count,
+			)
+		})
+
+		It("looks for workers satisfying the spec", func() {
+			Expect(fakePool.AllSatisfyingCallCount()).To(Equal(1))
+			_, actualWorkerSpec := fakePool.AllSatisfyingArgsForCall(0)
+			Expect(actualWorkerSpec).To(Equal(workerSpec))
+		})
+
+		It("fetches onto the least busy of the workers which do not have it yet", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetched).To(Equal(1))
+
+			Expect(warmWorker.FetchCallCount()).To(BeZero())
+			Expect(busyWorker.FetchCallCount()).To(BeZero())
+			Expect(quietWorker.FetchCallCount()).To(Equal(1))
+
+			_, _, actualMetadata, actualWorker, actualContainerSpec, actualProcessSpec, actualResource, actualOwner, actualImageSpec, actualCache, _ := quietWorker.FetchArgsForCall(0)
+			Expect(actualMetadata).To(Equal(metadata))
+			Expect(actualWorker).To(Equal(quietWorker))
+			Expect(actualContainerSpec).To(Equal(containerSpec))
+			Expect(actualProcessSpec).To(Equal(processSpec))
+			Expect(actualResource).To(Equal(fakeResource))
+			Expect(actualOwner).To(Equal(db.NewPrefetchContainerOwner(fakeUsedResourceCache, 123, time.Hour)))
+			Expect(actualImageSpec).To(Equal(imageSpec))
+			Expect(actualCache).To(Equal(fakeUsedResourceCache))
+		})
+
+		Context("when enough workers already have it", func() {
+			BeforeEach(func() {
+				count = 1
+			})
+
+			It("does not fetch it", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fetched).To(BeZero())
+				Expect(busyWorker.FetchCallCount()).To(BeZero())
+				Expect(quietWorker.FetchCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when finding the workers which have it fails", func() {
+			BeforeEach(func() {
+				fakeUsedResourceCache.WorkerNamesReturns(nil, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("nope"))
+				Expect(quietWorker.FetchCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when fetching fails on a worker", func() {
+			BeforeEach(func() {
+				count = 3
+				busyWorker.FetchReturns(worker.GetResult{}, nil, errors.New("nope"))
+			})
+
+			It("still fetches onto the others", func() {
+				Expect(fetched).To(Equal(1))
+				Expect(quietWorker.FetchCallCount()).To(Equal(1))
+				Expect(err).To(MatchError(ContainSubstring("nope")))
+			})
+		})
+
+		Context("when the get exits non-zero", func() {
+			BeforeEach(func() {
+				quietWorker.FetchReturns(worker.GetResult{ExitStatus: 1}, nil, nil)
+			})
+
+			It("returns an error", func() {
+				Expect(fetched).To(BeZero())
+				Expect(err).To(MatchError(ContainSubstring("fetching onto worker quiet-worker exited with status 1")))
+			})
+		})
+
+		Context("when no workers satisfy the spec", func() {
+			BeforeEach(func() {
+				fakePool.AllSatisfyingReturns(nil, worker.ErrNoWorkers)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(Equal(worker.ErrNoWorkers))
+			})
+		})
+	})
+
 	Describe("RunTaskStep", func() {
 		var (
 			status       int
@@ -710,6 +857,219 @@ var _ = Describe("Client", func() {
 			}))
 		})
 
+		Context("when the task has services", func() {
+			var (
+				fakeServiceContainer *workerfakes.FakeContainer
+				fakeServiceProcess   *gardenfakes.FakeProcess
+				fakeReadinessProcess *gardenfakes.FakeProcess
+				serviceSpec          worker.ServiceSpec
+			)
+
+			BeforeEach(func() {
+				serviceSpec = worker.ServiceSpec{
+					Name:  "some-db",
+					Owner: db.NewBuildStepContainerOwner(1234, atc.PlanID("42/some-db"), 123),
+					Metadata: db.ContainerMetadata{
+						Type:     db.ContainerTypeTask,
+						StepName: "some-step/some-db",
+					},
+					ContainerSpec: worker.ContainerSpec{
+						TeamID: 123,
+						ImageSpec: worker.ImageSpec{
+							ImageResource: &worker.ImageResource{
+								Type:   "docker",
+								Source: atc.Source{"repository": "postgres"},
+							},
+						},
+						Env: []string{"POSTGRES_PASSWORD=password"},
+					},
+					ProcessSpec: runtime.ProcessSpec{
+						Path:         "docker-entrypoint.sh",
+						Args:         []string{"postgres"},
+						StdoutWriter: new(bytes.Buffer),
+						StderrWriter: new(bytes.Buffer),
+					},
+					EnvPrefix: "SOME_DB",
+					Ports:     []uint16{5432},
+					Readiness: &worker.ServiceReadinessSpec{
+						Path:     "pg_isready",
+						Interval: time.Millisecond,
+						Timeout:  100 * time.Millisecond,
+					},
+				}
+
+				fakeContainerSpec.Services = []worker.ServiceSpec{serviceSpec}
+
+				fakeServiceProcess = new(gardenfakes.FakeProcess)
+				fakeReadinessProcess = new(gardenfakes.FakeProcess)
+				fakeReadinessProcess.WaitReturns(0, nil)
+
+				fakeContainer.HandleReturns("some-task-handle")
+				fakeContainer.PropertiesReturns(garden.Properties{}, nil)
+				fakeContainer.AttachReturns(nil, errors.New("no such process"))
+				fakeContainer.RunReturns(new(gardenfakes.FakeProcess), nil)
+
+				fakeServiceContainer = new(workerfakes.FakeContainer)
+				fakeServiceContainer.AttachReturns(nil, errors.New("no such process"))
+				fakeServiceContainer.RunStub = func(_ context.Context, spec garden.ProcessSpec, _ garden.ProcessIO) (garden.Process, error) {
+					if spec.ID == "service" {
+						return fakeServiceProcess, nil
+					}
+
+					return fakeReadinessProcess, nil
+				}
+
+				fakeWorker.FindOrCreateContainerStub = func(_ context.Context, _ lager.Logger, _ worker.ImageFetchingDelegate, owner db.ContainerOwner, _ db.ContainerMetadata, _ worker.ContainerSpec, _ atc.VersionedResourceTypes) (worker.Container, error) {
+					if owner == serviceSpec.Owner {
+						return fakeServiceContainer, nil
+					}
+
+					return fakeContainer, nil
+				}
+			})
+
+			It("creates the service container after the task container, joining its network namespace", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(2))
+
+				_, _, _, owner, _, _, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
+				Expect(owner).To(Equal(fakeContainerOwner))
+
+				expectedSpec := serviceSpec.ContainerSpec
+				expectedSpec.NetworkNamespaceOf = "some-task-handle"
+
+				_, _, delegate, owner, metadata, containerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(1)
+				Expect(delegate).To(Equal(fakeDelegate))
+				Expect(owner).To(Equal(serviceSpec.Owner))
+				Expect(metadata).To(Equal(serviceSpec.Metadata))
+				Expect(containerSpec).To(Equal(expectedSpec))
+			})
+
+			It("runs the task process once the service is ready", func() {
+				Expect(fakeContainer.RunCallCount()).To(Equal(1))
+			})
+
+			It("runs the service process", func() {
+				Expect(fakeServiceContainer.RunCallCount()).To(Equal(2))
+
+				_, spec, io := fakeServiceContainer.RunArgsForCall(0)
+				Expect(spec).To(Equal(garden.ProcessSpec{
+					ID:   "service",
+					Path: "docker-entrypoint.sh",
+					Args: []string{"postgres"},
+				}))
+				Expect(io.Stdout).To(Equal(serviceSpec.ProcessSpec.StdoutWriter))
+				Expect(io.Stderr).To(Equal(serviceSpec.ProcessSpec.StderrWriter))
+
+				_, spec, _ = fakeServiceContainer.RunArgsForCall(1)
+				Expect(spec.Path).To(Equal("pg_isready"))
+			})
+
+			It("tells the task how to reach the service on localhost", func() {
+				_, _, _, _, _, containerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
+				Expect(containerSpec.Env).To(ContainElement("SOME_DB_HOST=127.0.0.1"))
+				Expect(containerSpec.Env).To(ContainElement("SOME_DB_PORT=5432"))
+			})
+
+			It("leaves the task's network access alone", func() {
+				_, _, _, _, _, containerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
+				Expect(containerSpec.Network).To(BeNil())
+			})
+
+			Context("when the task container has already exited", func() {
+				BeforeEach(func() {
+					fakeContainer.PropertiesReturns(garden.Properties{"concourse:exit-status": "0"}, nil)
+				})
+
+				It("does not start the service", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+				})
+			})
+
+			It("destroys the service once the task has finished", func() {
+				Expect(fakeServiceContainer.DestroyCallCount()).To(Equal(1))
+			})
+
+			Context("when the service is already running", func() {
+				BeforeEach(func() {
+					fakeServiceContainer.AttachReturns(fakeServiceProcess, nil)
+				})
+
+				It("does not run it again", func() {
+					Expect(fakeServiceContainer.RunCallCount()).To(Equal(1))
+					_, spec, _ := fakeServiceContainer.RunArgsForCall(0)
+					Expect(spec.Path).To(Equal("pg_isready"))
+				})
+			})
+
+			Context("when the service does not become ready in time", func() {
+				BeforeEach(func() {
+					fakeReadinessProcess.WaitReturns(1, nil)
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(Equal(worker.ServiceNotReadyError{
+						Name:    "some-db",
+						Timeout: 100 * time.Millisecond,
+					}))
+				})
+
+				It("does not run the task process", func() {
+					Expect(fakeContainer.RunCallCount()).To(BeZero())
+				})
+
+				It("destroys the service", func() {
+					Expect(fakeServiceContainer.DestroyCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the readiness check hangs", func() {
+				BeforeEach(func() {
+					killed := make(chan struct{})
+
+					fakeReadinessProcess.SignalStub = func(signal garden.Signal) error {
+						close(killed)
+						return nil
+					}
+
+					fakeReadinessProcess.WaitStub = func() (int, error) {
+						<-killed
+						return 137, nil
+					}
+				})
+
+				It("returns an error once the timeout is reached", func() {
+					Expect(err).To(Equal(worker.ServiceNotReadyError{
+						Name:    "some-db",
+						Timeout: 100 * time.Millisecond,
+					}))
+				})
+
+				It("kills the readiness check", func() {
+					Expect(fakeReadinessProcess.SignalCallCount()).To(Equal(1))
+					Expect(fakeReadinessProcess.SignalArgsForCall(0)).To(Equal(garden.SignalKill))
+				})
+
+				It("does not run the task process", func() {
+					Expect(fakeContainer.RunCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when creating the service container fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeWorker.FindOrCreateContainerStub = nil
+					fakeWorker.FindOrCreateContainerReturns(nil, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(Equal(disaster))
+				})
+			})
+		})
+
 		Context("found a container that has already exited", func() {
 			BeforeEach(func() {
 				fakeContainer.PropertiesReturns(garden.Properties{"concourse:exit-status": "8"}, nil)
@@ -1008,6 +1368,23 @@ var _ = Describe("Client", func() {
 					Expect(fakeEventDelegate.StartingCallCount()).Should((Equal(1)))
 				})
 
+				It("does not limit the process' open files", func() {
+					_, gardenProcessSpec, _ := fakeContainer.RunArgsForCall(0)
+					Expect(gardenProcessSpec.Limits).To(Equal(garden.ResourceLimits{}))
+				})
+
+				Context("when an open files limit is configured", func() {
+					BeforeEach(func() {
+						openFiles := uint64(4096)
+						fakeContainerSpec.Limits.OpenFiles = &openFiles
+					})
+
+					It("limits the process' open files", func() {
+						_, gardenProcessSpec, _ := fakeContainer.RunArgsForCall(0)
+						Expect(gardenProcessSpec.Limits.Nofile).To(Equal(fakeContainerSpec.Limits.OpenFiles))
+					})
+				})
+
 				Context("when the process is interrupted", func() {
 					var stopped chan struct{}
 					BeforeEach(func() {
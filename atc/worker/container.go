@@ -23,7 +23,8 @@ type Container interface {
 	gclient.Container
 	runtime.Runner
 
-	// TODO: get rid of this, its not used anywhere
+	// Destroy marks the container as destroying and destroys it on the
+	// worker.
 	Destroy() error
 
 	VolumeMounts() []VolumeMount
@@ -93,6 +94,11 @@ func newGardenWorkerContainer(
 }
 
 func (container *gardenWorkerContainer) Destroy() error {
+	_, err := container.dbContainer.Destroying()
+	if err != nil {
+		return err
+	}
+
 	return container.gardenClient.Destroy(container.Handle())
 }
 
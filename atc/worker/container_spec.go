@@ -3,6 +3,7 @@ package worker
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/atc"
@@ -16,6 +17,10 @@ type WorkerSpec struct {
 	Tags          []string
 	TeamID        int
 	ResourceTypes atc.VersionedResourceTypes
+
+	// Capabilities the worker must advertise in order to enforce the
+	// restrictions of the container, e.g. atc.WorkerCapabilityNetworkIsolation.
+	Capabilities []string
 }
 
 type ContainerSpec struct {
@@ -49,6 +54,55 @@ type ContainerSpec struct {
 
 	// Optional user to run processes as. Overwrites the one specified in the docker image.
 	User string
+
+	// Services to run alongside the container on the same worker, until the
+	// process run in it exits.
+	Services []ServiceSpec
+
+	// Restricted network access of the container, if any. Containers without
+	// one get the worker's default network access.
+	Network *atc.NetworkConfig
+
+	// Handle of the container whose network namespace the container joins
+	// instead of getting one of its own, e.g. the task served by a service.
+	// Only workers which advertise atc.WorkerCapabilitySharedNetworkNamespace
+	// can do so.
+	NetworkNamespaceOf string
+
+	// Resource cache to be fetched into the container. Volume locality
+	// placement prefers workers which already have it.
+	ResourceCache db.UsedResourceCache
+}
+
+// ServiceSpec describes a container which is started, and checked to be
+// ready, before the container it serves runs its process.
+//
+// Like a sidecar in a pod, the service joins the network namespace of the
+// container it serves, which therefore reaches it on localhost and shares its
+// network access. This requires a worker which advertises
+// atc.WorkerCapabilitySharedNetworkNamespace.
+type ServiceSpec struct {
+	Name string
+
+	Owner         db.ContainerOwner
+	Metadata      db.ContainerMetadata
+	ContainerSpec ContainerSpec
+	ProcessSpec   runtime.ProcessSpec
+
+	// Name of the environment variables given to the served container, e.g.
+	// MY_DB for MY_DB_HOST and MY_DB_PORT.
+	EnvPrefix string
+	Ports     []uint16
+
+	Readiness *ServiceReadinessSpec
+}
+
+type ServiceReadinessSpec struct {
+	Path string
+	Args []string
+
+	Interval time.Duration
+	Timeout  time.Duration
 }
 
 // The below methods cause ContainerSpec to fulfill the
@@ -109,8 +163,10 @@ type ImageResource struct {
 }
 
 type ContainerLimits struct {
-	CPU    *uint64
-	Memory *uint64
+	CPU       *uint64
+	Memory    *uint64
+	Pids      *uint64
+	OpenFiles *uint64
 }
 
 type inputSource struct {
@@ -140,9 +196,22 @@ func (cl ContainerLimits) ToGardenLimits() garden.Limits {
 	} else {
 		gardenLimits.Memory = garden.MemoryLimits{LimitInBytes: *cl.Memory}
 	}
+	if cl.Pids != nil {
+		gardenLimits.Pid = garden.PidLimits{Max: *cl.Pids}
+	}
 	return gardenLimits
 }
 
+// ToGardenResourceLimits returns the limits which Garden applies per process
+// rather than to the whole container.
+func (cl ContainerLimits) ToGardenResourceLimits() garden.ResourceLimits {
+	resourceLimits := garden.ResourceLimits{}
+	if cl.OpenFiles != nil && *cl.OpenFiles != 0 {
+		resourceLimits.Nofile = cl.OpenFiles
+	}
+	return resourceLimits
+}
+
 func (spec WorkerSpec) Description() string {
 	var attrs []string
 
@@ -158,5 +227,9 @@ func (spec WorkerSpec) Description() string {
 		attrs = append(attrs, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	for _, capability := range spec.Capabilities {
+		attrs = append(attrs, fmt.Sprintf("capability '%s'", capability))
+	}
+
 	return strings.Join(attrs, ", ")
 }
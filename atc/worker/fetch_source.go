@@ -104,6 +104,13 @@ func (s *fetchSource) Find() (GetResult, Volume, bool, error) {
 		return result, nil, false, nil
 	}
 
+	// keep the worker's copy of the cache from being evicted as least
+	// recently used; failing to do so is not worth failing the get over
+	err = s.dbResourceCacheFactory.UpdateResourceCacheLastUsed(s.cache, s.worker.Name())
+	if err != nil {
+		sLog.Error("failed-to-update-resource-cache-last-used", err)
+	}
+
 	metadata, err := s.dbResourceCacheFactory.ResourceCacheMetadata(s.cache)
 	if err != nil {
 		sLog.Error("failed-to-get-resource-cache-metadata", err)
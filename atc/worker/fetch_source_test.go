@@ -72,6 +72,7 @@ var _ = Describe("FetchSource", func() {
 		})
 
 		fakeWorker = new(workerfakes.FakeWorker)
+		fakeWorker.NameReturns("some-worker")
 		fakeWorker.FindOrCreateContainerReturns(fakeContainer, nil)
 
 		fakeResourceCacheFactory = new(dbfakes.FakeResourceCacheFactory)
@@ -159,6 +160,16 @@ var _ = Describe("FetchSource", func() {
 				Expect(volume).To(Equal(fakeVolume))
 				Expect(getResult).To(Equal(expectedGetResult))
 			})
+
+			It("marks the worker's copy of the resource cache as just used", func() {
+				_, _, _, err := fetchSource.Find()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeResourceCacheFactory.UpdateResourceCacheLastUsedCallCount()).To(Equal(1))
+				resourceCache, workerName := fakeResourceCacheFactory.UpdateResourceCacheLastUsedArgsForCall(0)
+				Expect(resourceCache).To(Equal(fakeUsedResourceCache))
+				Expect(workerName).To(Equal("some-worker"))
+			})
 		})
 
 		Context("when there is no volume", func() {
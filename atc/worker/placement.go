@@ -26,6 +26,19 @@ func NewVolumeLocalityPlacementStrategy() ContainerPlacementStrategy {
 }
 
 func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	var cachedOn map[string]bool
+	if spec.ResourceCache != nil {
+		names, err := spec.ResourceCache.WorkerNames()
+		if err != nil {
+			return nil, err
+		}
+
+		cachedOn = map[string]bool{}
+		for _, name := range names {
+			cachedOn[name] = true
+		}
+	}
+
 	workersByCount := map[int][]Worker{}
 	var highestCount int
 	for _, w := range workers {
@@ -42,6 +55,10 @@ func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, wor
 			}
 		}
 
+		if cachedOn[w.Name()] {
+			candidateInputCount++
+		}
+
 		workersByCount[candidateInputCount] = append(workersByCount[candidateInputCount], w)
 
 		if candidateInputCount >= highestCount {
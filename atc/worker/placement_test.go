@@ -1,9 +1,12 @@
 package worker_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 
@@ -232,6 +235,46 @@ var _ = Describe("VolumeLocalityPlacementStrategy", func() {
 			})
 		})
 
+		Context("when a resource cache is being fetched", func() {
+			var fakeResourceCache *dbfakes.FakeUsedResourceCache
+
+			BeforeEach(func() {
+				fakeResourceCache = new(dbfakes.FakeUsedResourceCache)
+				fakeResourceCache.WorkerNamesReturns([]string{"cached-worker"}, nil)
+				spec.ResourceCache = fakeResourceCache
+
+				compatibleWorkerOneCache1.NameReturns("uncached-worker")
+				compatibleWorkerOneCache2.NameReturns("cached-worker")
+				compatibleWorkerNoCaches1.NameReturns("empty-worker")
+
+				workers = []Worker{
+					compatibleWorkerOneCache1,
+					compatibleWorkerOneCache2,
+					compatibleWorkerNoCaches1,
+				}
+			})
+
+			It("counts it as a local cache", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorkerOneCache2))
+			})
+
+			It("looks up the workers which have it once", func() {
+				Expect(fakeResourceCache.WorkerNamesCallCount()).To(Equal(1))
+				Expect(compatibleWorkerOneCache1.FindVolumeForResourceCacheCallCount()).To(BeZero())
+			})
+
+			Context("when looking up the workers fails", func() {
+				BeforeEach(func() {
+					fakeResourceCache.WorkerNamesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns the error", func() {
+					Expect(chooseErr).To(MatchError("nope"))
+				})
+			})
+		})
+
 		Context("with none having any local caches", func() {
 			BeforeEach(func() {
 				workers = []Worker{
@@ -76,6 +76,13 @@ type Pool interface {
 		WorkerSpec,
 		ContainerPlacementStrategy,
 	) (Worker, error)
+
+	// AllSatisfying returns the running workers which satisfy the spec,
+	// preferring the team's own workers over general ones.
+	AllSatisfying(
+		lager.Logger,
+		WorkerSpec,
+	) ([]Worker, error)
 }
 
 type pool struct {
@@ -92,7 +99,7 @@ func NewPool(
 	}
 }
 
-func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker, error) {
+func (pool *pool) AllSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker, error) {
 	workers, err := pool.provider.RunningWorkers(logger)
 	if err != nil {
 		return nil, err
@@ -137,7 +144,7 @@ func (pool *pool) ContainerInWorker(logger lager.Logger, owner db.ContainerOwner
 		return false, err
 	}
 
-	compatibleWorkers, err := pool.allSatisfying(logger, workerSpec)
+	compatibleWorkers, err := pool.AllSatisfying(logger, workerSpec)
 	if err != nil {
 		return false, err
 	}
@@ -169,7 +176,7 @@ func (pool *pool) FindOrChooseWorkerForContainer(
 		return nil, err
 	}
 
-	compatibleWorkers, err := pool.allSatisfying(logger, workerSpec)
+	compatibleWorkers, err := pool.AllSatisfying(logger, workerSpec)
 	if err != nil {
 		return nil, err
 	}
@@ -199,7 +206,7 @@ func (pool *pool) FindOrChooseWorker(
 	logger lager.Logger,
 	workerSpec WorkerSpec,
 ) (Worker, error) {
-	workers, err := pool.allSatisfying(logger, workerSpec)
+	workers, err := pool.AllSatisfying(logger, workerSpec)
 	if err != nil {
 		return nil, err
 	}
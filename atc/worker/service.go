@@ -0,0 +1,229 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+)
+
+const serviceProcessID = "service"
+
+// ServiceNotReadyError is returned when a service's readiness check has not
+// succeeded within its timeout.
+type ServiceNotReadyError struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (err ServiceNotReadyError) Error() string {
+	return fmt.Sprintf("service '%s' did not become ready within %s", err.Name, err.Timeout)
+}
+
+// serviceHost is the address by which the served container reaches its
+// services, as they share its network namespace.
+const serviceHost = "127.0.0.1"
+
+// servicesEnv returns the environment variables by which the served container
+// reaches the given services.
+func servicesEnv(specs []ServiceSpec) []string {
+	var env []string
+
+	for _, spec := range specs {
+		env = append(env, spec.EnvPrefix+"_HOST="+serviceHost)
+
+		if len(spec.Ports) > 0 {
+			env = append(env, spec.EnvPrefix+"_PORT="+strconv.Itoa(int(spec.Ports[0])))
+		}
+	}
+
+	return env
+}
+
+type runningService struct {
+	spec      ServiceSpec
+	container Container
+}
+
+type runningServices []runningService
+
+// Destroy destroys the containers of all of the services, killing their
+// processes, rather than leaving them around until the build is garbage
+// collected.
+func (services runningServices) Destroy(logger lager.Logger) {
+	for _, service := range services {
+		err := service.container.Destroy()
+		if err != nil {
+			logger.Error("failed-to-destroy-service", err, lager.Data{"service": service.spec.Name})
+		}
+	}
+}
+
+// startServices starts the given services on the worker, one after another,
+// and waits for each of them to be ready. The services join the network
+// namespace of the served container, so that they have the same network
+// access and are reached on localhost.
+//
+// The services started so far are returned even if one of them fails to
+// start, so that they can be destroyed.
+func (client *client) startServices(
+	ctx context.Context,
+	logger lager.Logger,
+	chosenWorker Worker,
+	imageFetcherSpec ImageFetcherSpec,
+	specs []ServiceSpec,
+	served Container,
+) (runningServices, error) {
+	var services runningServices
+
+	for _, spec := range specs {
+		serviceLogger := logger.Session("service", lager.Data{"service": spec.Name})
+
+		spec.ContainerSpec.NetworkNamespaceOf = served.Handle()
+
+		container, err := chosenWorker.FindOrCreateContainer(
+			ctx,
+			serviceLogger,
+			imageFetcherSpec.Delegate,
+			spec.Owner,
+			spec.Metadata,
+			spec.ContainerSpec,
+			imageFetcherSpec.ResourceTypes,
+		)
+		if err != nil {
+			return services, err
+		}
+
+		services = append(services, runningService{
+			spec:      spec,
+			container: container,
+		})
+
+		err = runService(ctx, serviceLogger, container, spec)
+		if err != nil {
+			return services, err
+		}
+
+		err = waitForService(ctx, serviceLogger, container, spec)
+		if err != nil {
+			return services, err
+		}
+	}
+
+	return services, nil
+}
+
+func runService(ctx context.Context, logger lager.Logger, container Container, spec ServiceSpec) error {
+	processIO := garden.ProcessIO{
+		Stdout: spec.ProcessSpec.StdoutWriter,
+		Stderr: spec.ProcessSpec.StderrWriter,
+	}
+
+	_, err := container.Attach(ctx, serviceProcessID, processIO)
+	if err == nil {
+		logger.Info("already-running")
+		return nil
+	}
+
+	logger.Info("spawning")
+
+	_, err = container.Run(
+		ctx,
+		garden.ProcessSpec{
+			ID:   serviceProcessID,
+			Path: spec.ProcessSpec.Path,
+			Args: spec.ProcessSpec.Args,
+			Dir:  spec.ProcessSpec.Dir,
+		},
+		processIO,
+	)
+
+	return err
+}
+
+// waitForService runs the service's readiness check until it succeeds. Each
+// run of the check counts towards the readiness timeout, and is killed if it
+// is still running once the timeout is reached.
+func waitForService(ctx context.Context, logger lager.Logger, container Container, spec ServiceSpec) error {
+	if spec.Readiness == nil {
+		return nil
+	}
+
+	readyCtx, cancel := context.WithTimeout(ctx, spec.Readiness.Timeout)
+	defer cancel()
+
+	interval := time.NewTicker(spec.Readiness.Interval)
+	defer interval.Stop()
+
+	for {
+		status, err := probeService(readyCtx, logger, container, spec.Readiness)
+		if err != nil {
+			if ctx.Err() == nil && readyCtx.Err() != nil {
+				return ServiceNotReadyError{
+					Name:    spec.Name,
+					Timeout: spec.Readiness.Timeout,
+				}
+			}
+
+			return err
+		}
+
+		if status == 0 {
+			logger.Info("ready")
+			return nil
+		}
+
+		logger.Debug("not-ready", lager.Data{"status": status})
+
+		select {
+		case <-interval.C:
+		case <-readyCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			return ServiceNotReadyError{
+				Name:    spec.Name,
+				Timeout: spec.Readiness.Timeout,
+			}
+		}
+	}
+}
+
+// probeService runs the readiness check once and returns its exit status. The
+// check is killed if it is still running once the context is done.
+func probeService(ctx context.Context, logger lager.Logger, container Container, readiness *ServiceReadinessSpec) (int, error) {
+	process, err := container.Run(
+		ctx,
+		garden.ProcessSpec{
+			Path: readiness.Path,
+			Args: readiness.Args,
+		},
+		garden.ProcessIO{},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	exited := make(chan processStatus, 1)
+	go func() {
+		status := processStatus{}
+		status.processStatus, status.processErr = process.Wait()
+		exited <- status
+	}()
+
+	select {
+	case status := <-exited:
+		return status.processStatus, status.processErr
+	case <-ctx.Done():
+		err := process.Signal(garden.SignalKill)
+		if err != nil {
+			logger.Error("failed-to-kill-readiness-check", err)
+		}
+
+		return 0, ctx.Err()
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"github.com/concourse/concourse/tracing"
 	"io"
+	"strconv"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -29,6 +31,7 @@ type Volume interface {
 	InitializeResourceCache(db.UsedResourceCache) error
 	GetResourceCacheID() int
 	InitializeTaskCache(logger lager.Logger, jobID int, stepName string, path string, privileged bool) error
+	InitializeNamedCache(logger lager.Logger, name string, privileged bool) error
 	InitializeArtifact(name string, buildID int) (db.WorkerArtifact, error)
 
 	CreateChildForContainer(db.CreatingContainer, string) (db.CreatingVolume, error)
@@ -165,6 +168,49 @@ func (v *volume) InitializeTaskCache(
 	return importVolume.InitializeTaskCache(logger, jobID, stepName, path, privileged)
 }
 
+func (v *volume) InitializeNamedCache(
+	logger lager.Logger,
+	name string,
+	privileged bool,
+) error {
+	if v.dbVolume.ParentHandle() == "" {
+		sizeLimit, err := v.dbVolume.InitializeNamedCache(v.dbVolume.TeamID(), name)
+		if err != nil {
+			return err
+		}
+
+		// the worker measures the size of its copy of the cache and removes
+		// its least recently used files once it exceeds the limit
+		if sizeLimit > 0 {
+			err = v.bcVolume.SetProperty(atc.SizeLimitVolumeProperty, strconv.FormatUint(sizeLimit, 10))
+			if err != nil {
+				return err
+			}
+		}
+
+		return v.bcVolume.SetProperty(atc.MeasuredVolumeProperty, "true")
+	}
+
+	logger.Debug("creating-an-import-volume", lager.Data{"path": v.bcVolume.Path()})
+
+	// as with task caches, the copy-on-write volume the task used is imported
+	// into a new volume which replaces the worker's copy of the cache
+	importVolume, err := v.volumeClient.CreateVolumeForNamedCache(
+		logger,
+		VolumeSpec{
+			Strategy:   baggageclaim.ImportStrategy{Path: v.bcVolume.Path()},
+			Privileged: privileged,
+		},
+		v.dbVolume.TeamID(),
+		name,
+	)
+	if err != nil {
+		return err
+	}
+
+	return importVolume.InitializeNamedCache(logger, name, privileged)
+}
+
 func (v *volume) CreateChildForContainer(creatingContainer db.CreatingContainer, mountPath string) (db.CreatingVolume, error) {
 	return v.dbVolume.CreateChildForContainer(creatingContainer, mountPath)
 }
@@ -65,6 +65,17 @@ type VolumeClient interface {
 		stepName string,
 		path string,
 	) (Volume, error)
+	FindVolumeForNamedCache(
+		logger lager.Logger,
+		teamID int,
+		name string,
+	) (Volume, bool, error)
+	CreateVolumeForNamedCache(
+		logger lager.Logger,
+		volumeSpec VolumeSpec,
+		teamID int,
+		name string,
+	) (Volume, error)
 	FindOrCreateVolumeForResourceCerts(
 		logger lager.Logger,
 	) (volume Volume, found bool, err error)
@@ -261,12 +272,40 @@ func (c *volumeClient) CreateVolumeForTaskCache(
 		return nil, err
 	}
 
+	return c.createVolumeForUsedTaskCache(logger, volumeSpec, teamID, usedTaskCache)
+}
+
+func (c *volumeClient) CreateVolumeForNamedCache(
+	logger lager.Logger,
+	volumeSpec VolumeSpec,
+	teamID int,
+	name string,
+) (Volume, error) {
+	usedTaskCache, err := c.dbTaskCacheFactory.FindOrCreateNamed(teamID, name)
+	if err != nil {
+		logger.Error("failed-to-find-or-create-named-cache-in-db", err)
+		return nil, err
+	}
+
+	return c.createVolumeForUsedTaskCache(logger, volumeSpec, teamID, usedTaskCache)
+}
+
+func (c *volumeClient) createVolumeForUsedTaskCache(
+	logger lager.Logger,
+	volumeSpec VolumeSpec,
+	teamID int,
+	usedTaskCache db.UsedTaskCache,
+) (Volume, error) {
 	workerTaskCache := db.WorkerTaskCache{
 		WorkerName: c.dbWorker.Name(),
 		TaskCache:  usedTaskCache,
 	}
 
 	usedWorkerTaskCache, err := c.dbWorkerTaskCacheFactory.FindOrCreate(workerTaskCache)
+	if err != nil {
+		logger.Error("failed-to-find-or-create-worker-task-cache-in-db", err)
+		return nil, err
+	}
 
 	return c.findOrCreateVolume(
 		logger.Session("find-or-create-volume-for-container"),
@@ -336,6 +375,32 @@ func (c *volumeClient) FindVolumeForTaskCache(
 		return nil, false, nil
 	}
 
+	return c.findVolumeForUsedTaskCache(logger, teamID, usedTaskCache)
+}
+
+func (c *volumeClient) FindVolumeForNamedCache(
+	logger lager.Logger,
+	teamID int,
+	name string,
+) (Volume, bool, error) {
+	usedTaskCache, found, err := c.dbTaskCacheFactory.FindNamed(teamID, name)
+	if err != nil {
+		logger.Error("failed-to-lookup-named-cache-in-db", err)
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	return c.findVolumeForUsedTaskCache(logger, teamID, usedTaskCache)
+}
+
+func (c *volumeClient) findVolumeForUsedTaskCache(
+	logger lager.Logger,
+	teamID int,
+	usedTaskCache db.UsedTaskCache,
+) (Volume, bool, error) {
 	dbVolume, found, err := c.dbVolumeRepository.FindTaskCacheVolume(teamID, c.dbWorker.Name(), usedTaskCache)
 	if err != nil {
 		logger.Error("failed-to-lookup-task-cache-volume-in-db", err)
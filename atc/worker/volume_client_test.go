@@ -644,6 +644,72 @@ var _ = Describe("VolumeClient", func() {
 		})
 	})
 
+	Describe("FindVolumeForNamedCache", func() {
+		Context("when the named cache does not exist", func() {
+			BeforeEach(func() {
+				fakeTaskCacheFactory.FindNamedReturns(nil, false, nil)
+			})
+
+			It("returns false", func() {
+				_, found, err := volumeClient.FindVolumeForNamedCache(testLogger, 123, "some-cache")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the named cache exists", func() {
+			BeforeEach(func() {
+				fakeTaskCacheFactory.FindNamedReturns(nil, true, nil)
+			})
+
+			It("looks it up by team and name", func() {
+				volumeClient.FindVolumeForNamedCache(testLogger, 123, "some-cache")
+
+				teamID, name := fakeTaskCacheFactory.FindNamedArgsForCall(0)
+				Expect(teamID).To(Equal(123))
+				Expect(name).To(Equal("some-cache"))
+			})
+
+			Context("when its volume exists on the worker", func() {
+				var (
+					dbVolume *dbfakes.FakeCreatedVolume
+					bcVolume *baggageclaimfakes.FakeVolume
+				)
+
+				BeforeEach(func() {
+					dbVolume = new(dbfakes.FakeCreatedVolume)
+					fakeDBVolumeRepository.FindTaskCacheVolumeReturns(dbVolume, true, nil)
+
+					bcVolume = new(baggageclaimfakes.FakeVolume)
+					fakeBaggageclaimClient.LookupVolumeReturns(bcVolume, true, nil)
+				})
+
+				It("returns the volume", func() {
+					volume, found, err := volumeClient.FindVolumeForNamedCache(testLogger, 123, "some-cache")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(volume).To(Equal(worker.NewVolume(bcVolume, dbVolume, volumeClient)))
+
+					teamID, workerName, _ := fakeDBVolumeRepository.FindTaskCacheVolumeArgsForCall(0)
+					Expect(teamID).To(Equal(123))
+					Expect(workerName).To(Equal("some-worker"))
+				})
+			})
+
+			Context("when its volume does not exist on the worker", func() {
+				BeforeEach(func() {
+					fakeDBVolumeRepository.FindTaskCacheVolumeReturns(nil, false, nil)
+				})
+
+				It("returns false", func() {
+					_, found, err := volumeClient.FindVolumeForNamedCache(testLogger, 123, "some-cache")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+			})
+		})
+	})
+
 	Describe("CreateVolume", func() {
 		var err error
 		var workerVolume worker.Volume
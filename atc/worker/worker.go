@@ -29,6 +29,15 @@ import (
 
 const userPropertyName = "user"
 
+// properties by which the worker's runtime sets up the network of a
+// container, see worker/runtime
+const (
+	networkPropertyName      = "concourse:network"
+	networkAllowPropertyName = "concourse:network-allow"
+
+	networkNamespaceOfPropertyName = "concourse:network-namespace-of"
+)
+
 var ResourceConfigCheckSessionExpiredError = errors.New("no db container was found for owner")
 
 //go:generate counterfeiter . Worker
@@ -60,6 +69,7 @@ type Worker interface {
 	FindVolumeForResourceCache(logger lager.Logger, resourceCache db.UsedResourceCache) (Volume, bool, error)
 	FindResourceCacheForVolume(volume Volume) (db.UsedResourceCache, bool, error)
 	FindVolumeForTaskCache(lager.Logger, int, int, string, string) (Volume, bool, error)
+	FindVolumeForNamedCache(lager.Logger, int, string) (Volume, bool, error)
 	Fetch(
 		context.Context,
 		lager.Logger,
@@ -191,6 +201,10 @@ func (worker *gardenWorker) FindResourceCacheForVolume(volume Volume) (db.UsedRe
 
 }
 
+func (worker *gardenWorker) FindVolumeForNamedCache(logger lager.Logger, teamID int, name string) (Volume, bool, error) {
+	return worker.volumeClient.FindVolumeForNamedCache(logger, teamID, name)
+}
+
 func (worker *gardenWorker) FindVolumeForTaskCache(logger lager.Logger, teamID int, jobID int, stepName string, path string) (Volume, bool, error) {
 	return worker.volumeClient.FindVolumeForTaskCache(logger, teamID, jobID, stepName, path)
 }
@@ -737,6 +751,10 @@ func (worker *gardenWorker) Satisfies(logger lager.Logger, spec WorkerSpec) bool
 		return false
 	}
 
+	if !worker.hasCapabilities(spec.Capabilities) {
+		return false
+	}
+
 	return true
 }
 
@@ -764,6 +782,10 @@ func (worker *gardenWorker) Description() string {
 		messages = append(messages, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	for _, capability := range worker.dbWorker.Capabilities() {
+		messages = append(messages, fmt.Sprintf("capability '%s'", capability))
+	}
+
 	return strings.Join(messages, ", ")
 }
 
@@ -795,6 +817,23 @@ insert_coin:
 	return true
 }
 
+func (worker *gardenWorker) hasCapabilities(capabilities []string) bool {
+	workerCapabilities := worker.dbWorker.Capabilities()
+
+required:
+	for _, capability := range capabilities {
+		for _, wcapability := range workerCapabilities {
+			if capability == wcapability {
+				continue required
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
 func (worker *gardenWorker) ActiveTasks() (int, error) {
 	return worker.dbWorker.ActiveTasks()
 }
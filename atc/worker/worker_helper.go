@@ -2,10 +2,13 @@ package worker
 
 import (
 	"fmt"
+	"net"
 	"path/filepath"
+	"strings"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/worker/gclient"
 )
@@ -47,6 +50,34 @@ func (w workerHelper) createGardenContainer(
 		env = append(env, fmt.Sprintf("no_proxy=%s", w.dbWorker.NoProxy()))
 	}
 
+	var allowed []*net.IPNet
+	if containerSpec.Network != nil && containerSpec.Network.Restricted() {
+		var err error
+		allowed, err = resolveNetworkAllowlist(containerSpec.Network.Allow)
+		if err != nil {
+			return nil, err
+		}
+
+		var subnets []string
+		for _, subnet := range allowed {
+			subnets = append(subnets, subnet.String())
+		}
+
+		gardenProperties[networkPropertyName] = string(containerSpec.Network.Mode)
+		if len(subnets) > 0 {
+			gardenProperties[networkAllowPropertyName] = strings.Join(subnets, ",")
+		}
+	}
+
+	if containerSpec.NetworkNamespaceOf != "" {
+		gardenProperties[networkNamespaceOfPropertyName] = containerSpec.NetworkNamespaceOf
+	}
+
+	netOutRules, err := w.netOutRules(containerSpec.Network, allowed)
+	if err != nil {
+		return nil, err
+	}
+
 	return w.gardenClient.Create(
 		garden.ContainerSpec{
 			Handle:     handleToCreate,
@@ -56,7 +87,103 @@ func (w workerHelper) createGardenContainer(
 			Limits:     containerSpec.Limits.ToGardenLimits(),
 			Env:        env,
 			Properties: gardenProperties,
+			NetOut:     netOutRules,
+		})
+}
+
+// netOutRules returns the rules by which a container on the worker is
+// allowed to reach the network.
+//
+// Workers which advertise atc.WorkerCapabilityNetworkIsolation deny their
+// containers all network access by default, so unrestricted containers are
+// allowed to reach everything (Guardian workers refuse to isolate containers
+// if the operator denies them any networks, as this would override it), and
+// restricted ones only their allowlist and
+// DNS (the latter only matters to Guardian, as containerd restricts DNS to
+// its own nameservers). Restricted containers are never created on any other
+// worker, as the rules would not restrict anything there.
+func (w workerHelper) netOutRules(network *atc.NetworkConfig, allowed []*net.IPNet) ([]garden.NetOutRule, error) {
+	isolated := false
+	for _, capability := range w.dbWorker.Capabilities() {
+		if capability == atc.WorkerCapabilityNetworkIsolation {
+			isolated = true
+		}
+	}
+
+	if network == nil || !network.Restricted() {
+		if !isolated {
+			return nil, nil
+		}
+
+		return []garden.NetOutRule{{
+			Protocol: garden.ProtocolAll,
+			Networks: []garden.IPRange{{Start: net.IPv4zero, End: net.IPv4bcast}},
+		}}, nil
+	}
+
+	if !isolated {
+		return nil, fmt.Errorf("worker '%s' cannot restrict the network access of containers", w.dbWorker.Name())
+	}
+
+	if network.Mode == atc.NetworkModeNone {
+		return nil, nil
+	}
+
+	var rules []garden.NetOutRule
+	for _, subnet := range allowed {
+		rules = append(rules, garden.NetOutRule{
+			Protocol: garden.ProtocolAll,
+			Networks: []garden.IPRange{garden.IPRangeFromIPNet(subnet)},
 		})
+	}
+
+	for _, protocol := range []garden.Protocol{garden.ProtocolUDP, garden.ProtocolTCP} {
+		rules = append(rules, garden.NetOutRule{
+			Protocol: protocol,
+			Networks: []garden.IPRange{{Start: net.IPv4zero, End: net.IPv4bcast}},
+			Ports:    []garden.PortRange{garden.PortRangeFromPort(53)},
+		})
+	}
+
+	return rules, nil
+}
+
+// resolveNetworkAllowlist converts the destinations of a network allowlist
+// to subnets, looking up the addresses of host names.
+//
+// Host names are looked up by the ATC once, as the container is created, so
+// the container is only allowed to reach the addresses they had at the time;
+// any later changes to their DNS records do not apply to it.
+func resolveNetworkAllowlist(destinations []string) ([]*net.IPNet, error) {
+	var subnets []*net.IPNet
+
+	for _, destination := range destinations {
+		if _, subnet, err := net.ParseCIDR(destination); err == nil {
+			subnets = append(subnets, subnet)
+			continue
+		}
+
+		ips := []net.IP{net.ParseIP(destination)}
+		if ips[0] == nil {
+			var err error
+			ips, err = net.LookupIP(destination)
+			if err != nil {
+				return nil, fmt.Errorf("resolve network allowlist entry '%s': %w", destination, err)
+			}
+		}
+
+		for _, ip := range ips {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+
+			subnets = append(subnets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+		}
+	}
+
+	return subnets, nil
 }
 
 func (w workerHelper) constructGardenWorkerContainer(
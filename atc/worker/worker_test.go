@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
@@ -383,6 +384,9 @@ var _ = Describe("Worker", func() {
 					err := foundContainer.Destroy()
 					Expect(err).NotTo(HaveOccurred())
 
+					By("marking it as destroying")
+					Expect(fakeCreatedContainer.DestroyingCallCount()).To(Equal(1))
+
 					By("destroying via garden")
 					Expect(fakeGardenClient.DestroyCallCount()).To(Equal(1))
 					actualHandle := fakeGardenClient.DestroyArgsForCall(0)
@@ -699,6 +703,32 @@ var _ = Describe("Worker", func() {
 					Expect(satisfies).To(BeFalse())
 				})
 			})
+
+			Context("when capabilities are required", func() {
+				BeforeEach(func() {
+					spec.Capabilities = []string{atc.WorkerCapabilityNetworkIsolation}
+				})
+
+				Context("when the worker has them", func() {
+					BeforeEach(func() {
+						fakeDBWorker.CapabilitiesReturns([]string{"some-capability", atc.WorkerCapabilityNetworkIsolation})
+					})
+
+					It("returns true", func() {
+						Expect(satisfies).To(BeTrue())
+					})
+				})
+
+				Context("when the worker does not have them", func() {
+					BeforeEach(func() {
+						fakeDBWorker.CapabilitiesReturns([]string{"some-capability"})
+					})
+
+					It("returns false", func() {
+						Expect(satisfies).To(BeFalse())
+					})
+				})
+			})
 		})
 
 		Context("when the platform is incompatible", func() {
@@ -1001,6 +1031,119 @@ var _ = Describe("Worker", func() {
 					}))
 				})
 
+				Context("when a pids limit is configured", func() {
+					BeforeEach(func() {
+						pids := uint64(1000)
+						containerSpec.Limits.Pids = &pids
+					})
+
+					It("limits the container's pids in garden", func() {
+						Expect(fakeGardenClient.CreateCallCount()).To(Equal(1))
+
+						actualSpec := fakeGardenClient.CreateArgsForCall(0)
+						Expect(actualSpec.Limits).To(Equal(garden.Limits{
+							CPU:    garden.CPULimits{LimitInShares: 1024},
+							Memory: garden.MemoryLimits{LimitInBytes: 1024},
+							Pid:    garden.PidLimits{Max: 1000},
+						}))
+					})
+				})
+
+				Context("when the container joins the network namespace of another container", func() {
+					BeforeEach(func() {
+						containerSpec.NetworkNamespaceOf = "some-task-handle"
+					})
+
+					It("tells the runtime which container's network namespace to join", func() {
+						Expect(fakeGardenClient.CreateCallCount()).To(Equal(1))
+
+						actualSpec := fakeGardenClient.CreateArgsForCall(0)
+						Expect(actualSpec.Properties).To(HaveKeyWithValue("concourse:network-namespace-of", "some-task-handle"))
+					})
+				})
+
+				Context("when the worker isolates the network of its containers", func() {
+					BeforeEach(func() {
+						fakeDBWorker.CapabilitiesReturns([]string{atc.WorkerCapabilityNetworkIsolation})
+					})
+
+					It("allows unrestricted containers to reach everything", func() {
+						Expect(fakeGardenClient.CreateCallCount()).To(Equal(1))
+
+						actualSpec := fakeGardenClient.CreateArgsForCall(0)
+						Expect(actualSpec.Properties).ToNot(HaveKey("concourse:network"))
+						Expect(actualSpec.NetOut).To(Equal([]garden.NetOutRule{
+							{
+								Protocol: garden.ProtocolAll,
+								Networks: []garden.IPRange{{Start: net.IPv4zero, End: net.IPv4bcast}},
+							},
+						}))
+					})
+
+					Context("when the network is restricted to none", func() {
+						BeforeEach(func() {
+							containerSpec.Network = &atc.NetworkConfig{Mode: atc.NetworkModeNone}
+						})
+
+						It("marks the container as isolated without allowing any egress", func() {
+							Expect(fakeGardenClient.CreateCallCount()).To(Equal(1))
+
+							actualSpec := fakeGardenClient.CreateArgsForCall(0)
+							Expect(actualSpec.Properties).To(HaveKeyWithValue("concourse:network", "none"))
+							Expect(actualSpec.Properties).ToNot(HaveKey("concourse:network-allow"))
+							Expect(actualSpec.NetOut).To(BeEmpty())
+						})
+					})
+
+					Context("when the network is restricted to an allowlist", func() {
+						BeforeEach(func() {
+							containerSpec.Network = &atc.NetworkConfig{
+								Mode:  atc.NetworkModeAllowlist,
+								Allow: []string{"10.0.0.0/8", "1.2.3.4"},
+							}
+						})
+
+						It("allows egress only to the allowlist and DNS", func() {
+							Expect(fakeGardenClient.CreateCallCount()).To(Equal(1))
+
+							actualSpec := fakeGardenClient.CreateArgsForCall(0)
+							Expect(actualSpec.Properties).To(HaveKeyWithValue("concourse:network", "allowlist"))
+							Expect(actualSpec.Properties).To(HaveKeyWithValue("concourse:network-allow", "10.0.0.0/8,1.2.3.4/32"))
+							Expect(actualSpec.NetOut).To(Equal([]garden.NetOutRule{
+								{
+									Protocol: garden.ProtocolAll,
+									Networks: []garden.IPRange{{Start: net.ParseIP("10.0.0.0").To4(), End: net.ParseIP("10.255.255.255").To4()}},
+								},
+								{
+									Protocol: garden.ProtocolAll,
+									Networks: []garden.IPRange{{Start: net.ParseIP("1.2.3.4").To4(), End: net.ParseIP("1.2.3.4").To4()}},
+								},
+								{
+									Protocol: garden.ProtocolUDP,
+									Networks: []garden.IPRange{{Start: net.IPv4zero, End: net.IPv4bcast}},
+									Ports:    []garden.PortRange{{Start: 53, End: 53}},
+								},
+								{
+									Protocol: garden.ProtocolTCP,
+									Networks: []garden.IPRange{{Start: net.IPv4zero, End: net.IPv4bcast}},
+									Ports:    []garden.PortRange{{Start: 53, End: 53}},
+								},
+							}))
+						})
+					})
+				})
+
+				Context("when the network is restricted on a worker which does not isolate it", func() {
+					BeforeEach(func() {
+						containerSpec.Network = &atc.NetworkConfig{Mode: atc.NetworkModeNone}
+					})
+
+					It("refuses to create the container", func() {
+						Expect(findOrCreateErr).To(MatchError(ContainSubstring("cannot restrict the network access of containers")))
+						Expect(fakeGardenClient.CreateCallCount()).To(Equal(0))
+					})
+				})
+
 				Context("when the input and output destination paths overlap", func() {
 					var (
 						fakeRemoteInputUnderInput    *workerfakes.FakeInputSource
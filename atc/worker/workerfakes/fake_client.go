@@ -67,6 +67,28 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	PrefetchResourceStub        func(context.Context, lager.Logger, worker.ContainerSpec, worker.WorkerSpec, db.ContainerMetadata, worker.ImageFetcherSpec, runtime.ProcessSpec, db.UsedResourceCache, resource.Resource, int) (int, error)
+	prefetchResourceMutex       sync.RWMutex
+	prefetchResourceArgsForCall []struct {
+		arg1  context.Context
+		arg2  lager.Logger
+		arg3  worker.ContainerSpec
+		arg4  worker.WorkerSpec
+		arg5  db.ContainerMetadata
+		arg6  worker.ImageFetcherSpec
+		arg7  runtime.ProcessSpec
+		arg8  db.UsedResourceCache
+		arg9  resource.Resource
+		arg10 int
+	}
+	prefetchResourceReturns struct {
+		result1 int
+		result2 error
+	}
+	prefetchResourceReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	RunCheckStepStub        func(context.Context, lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec, worker.ContainerPlacementStrategy, db.ContainerMetadata, atc.VersionedResourceTypes, time.Duration, resource.Resource) (worker.CheckResult, error)
 	runCheckStepMutex       sync.RWMutex
 	runCheckStepArgsForCall []struct {
@@ -381,6 +403,78 @@ func (fake *FakeClient) FindVolumeReturnsOnCall(i int, result1 worker.Volume, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) PrefetchResource(arg1 context.Context, arg2 lager.Logger, arg3 worker.ContainerSpec, arg4 worker.WorkerSpec, arg5 db.ContainerMetadata, arg6 worker.ImageFetcherSpec, arg7 runtime.ProcessSpec, arg8 db.UsedResourceCache, arg9 resource.Resource, arg10 int) (int, error) {
+	fake.prefetchResourceMutex.Lock()
+	ret, specificReturn := fake.prefetchResourceReturnsOnCall[len(fake.prefetchResourceArgsForCall)]
+	fake.prefetchResourceArgsForCall = append(fake.prefetchResourceArgsForCall, struct {
+		arg1  context.Context
+		arg2  lager.Logger
+		arg3  worker.ContainerSpec
+		arg4  worker.WorkerSpec
+		arg5  db.ContainerMetadata
+		arg6  worker.ImageFetcherSpec
+		arg7  runtime.ProcessSpec
+		arg8  db.UsedResourceCache
+		arg9  resource.Resource
+		arg10 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10})
+	fake.recordInvocation("PrefetchResource", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10})
+	fake.prefetchResourceMutex.Unlock()
+	if fake.PrefetchResourceStub != nil {
+		return fake.PrefetchResourceStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.prefetchResourceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) PrefetchResourceCallCount() int {
+	fake.prefetchResourceMutex.RLock()
+	defer fake.prefetchResourceMutex.RUnlock()
+	return len(fake.prefetchResourceArgsForCall)
+}
+
+func (fake *FakeClient) PrefetchResourceCalls(stub func(context.Context, lager.Logger, worker.ContainerSpec, worker.WorkerSpec, db.ContainerMetadata, worker.ImageFetcherSpec, runtime.ProcessSpec, db.UsedResourceCache, resource.Resource, int) (int, error)) {
+	fake.prefetchResourceMutex.Lock()
+	defer fake.prefetchResourceMutex.Unlock()
+	fake.PrefetchResourceStub = stub
+}
+
+func (fake *FakeClient) PrefetchResourceArgsForCall(i int) (context.Context, lager.Logger, worker.ContainerSpec, worker.WorkerSpec, db.ContainerMetadata, worker.ImageFetcherSpec, runtime.ProcessSpec, db.UsedResourceCache, resource.Resource, int) {
+	fake.prefetchResourceMutex.RLock()
+	defer fake.prefetchResourceMutex.RUnlock()
+	argsForCall := fake.prefetchResourceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10
+}
+
+func (fake *FakeClient) PrefetchResourceReturns(result1 int, result2 error) {
+	fake.prefetchResourceMutex.Lock()
+	defer fake.prefetchResourceMutex.Unlock()
+	fake.PrefetchResourceStub = nil
+	fake.prefetchResourceReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PrefetchResourceReturnsOnCall(i int, result1 int, result2 error) {
+	fake.prefetchResourceMutex.Lock()
+	defer fake.prefetchResourceMutex.Unlock()
+	fake.PrefetchResourceStub = nil
+	if fake.prefetchResourceReturnsOnCall == nil {
+		fake.prefetchResourceReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.prefetchResourceReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) RunCheckStep(arg1 context.Context, arg2 lager.Logger, arg3 db.ContainerOwner, arg4 worker.ContainerSpec, arg5 worker.WorkerSpec, arg6 worker.ContainerPlacementStrategy, arg7 db.ContainerMetadata, arg8 atc.VersionedResourceTypes, arg9 time.Duration, arg10 resource.Resource) (worker.CheckResult, error) {
 	fake.runCheckStepMutex.Lock()
 	ret, specificReturn := fake.runCheckStepReturnsOnCall[len(fake.runCheckStepArgsForCall)]
@@ -748,6 +842,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.findContainerMutex.RUnlock()
 	fake.findVolumeMutex.RLock()
 	defer fake.findVolumeMutex.RUnlock()
+	fake.prefetchResourceMutex.RLock()
+	defer fake.prefetchResourceMutex.RUnlock()
 	fake.runCheckStepMutex.RLock()
 	defer fake.runCheckStepMutex.RUnlock()
 	fake.runGetStepMutex.RLock()
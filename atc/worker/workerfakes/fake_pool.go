@@ -11,6 +11,20 @@ import (
 )
 
 type FakePool struct {
+	AllSatisfyingStub        func(lager.Logger, worker.WorkerSpec) ([]worker.Worker, error)
+	allSatisfyingMutex       sync.RWMutex
+	allSatisfyingArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 worker.WorkerSpec
+	}
+	allSatisfyingReturns struct {
+		result1 []worker.Worker
+		result2 error
+	}
+	allSatisfyingReturnsOnCall map[int]struct {
+		result1 []worker.Worker
+		result2 error
+	}
 	ContainerInWorkerStub        func(lager.Logger, db.ContainerOwner, worker.WorkerSpec) (bool, error)
 	containerInWorkerMutex       sync.RWMutex
 	containerInWorkerArgsForCall []struct {
@@ -62,6 +76,70 @@ type FakePool struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePool) AllSatisfying(arg1 lager.Logger, arg2 worker.WorkerSpec) ([]worker.Worker, error) {
+	fake.allSatisfyingMutex.Lock()
+	ret, specificReturn := fake.allSatisfyingReturnsOnCall[len(fake.allSatisfyingArgsForCall)]
+	fake.allSatisfyingArgsForCall = append(fake.allSatisfyingArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 worker.WorkerSpec
+	}{arg1, arg2})
+	fake.recordInvocation("AllSatisfying", []interface{}{arg1, arg2})
+	fake.allSatisfyingMutex.Unlock()
+	if fake.AllSatisfyingStub != nil {
+		return fake.AllSatisfyingStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.allSatisfyingReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePool) AllSatisfyingCallCount() int {
+	fake.allSatisfyingMutex.RLock()
+	defer fake.allSatisfyingMutex.RUnlock()
+	return len(fake.allSatisfyingArgsForCall)
+}
+
+func (fake *FakePool) AllSatisfyingCalls(stub func(lager.Logger, worker.WorkerSpec) ([]worker.Worker, error)) {
+	fake.allSatisfyingMutex.Lock()
+	defer fake.allSatisfyingMutex.Unlock()
+	fake.AllSatisfyingStub = stub
+}
+
+func (fake *FakePool) AllSatisfyingArgsForCall(i int) (lager.Logger, worker.WorkerSpec) {
+	fake.allSatisfyingMutex.RLock()
+	defer fake.allSatisfyingMutex.RUnlock()
+	argsForCall := fake.allSatisfyingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePool) AllSatisfyingReturns(result1 []worker.Worker, result2 error) {
+	fake.allSatisfyingMutex.Lock()
+	defer fake.allSatisfyingMutex.Unlock()
+	fake.AllSatisfyingStub = nil
+	fake.allSatisfyingReturns = struct {
+		result1 []worker.Worker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePool) AllSatisfyingReturnsOnCall(i int, result1 []worker.Worker, result2 error) {
+	fake.allSatisfyingMutex.Lock()
+	defer fake.allSatisfyingMutex.Unlock()
+	fake.AllSatisfyingStub = nil
+	if fake.allSatisfyingReturnsOnCall == nil {
+		fake.allSatisfyingReturnsOnCall = make(map[int]struct {
+			result1 []worker.Worker
+			result2 error
+		})
+	}
+	fake.allSatisfyingReturnsOnCall[i] = struct {
+		result1 []worker.Worker
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePool) ContainerInWorker(arg1 lager.Logger, arg2 db.ContainerOwner, arg3 worker.WorkerSpec) (bool, error) {
 	fake.containerInWorkerMutex.Lock()
 	ret, specificReturn := fake.containerInWorkerReturnsOnCall[len(fake.containerInWorkerArgsForCall)]
@@ -262,6 +340,8 @@ func (fake *FakePool) FindOrChooseWorkerForContainerReturnsOnCall(i int, result1
 func (fake *FakePool) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.allSatisfyingMutex.RLock()
+	defer fake.allSatisfyingMutex.RUnlock()
 	fake.containerInWorkerMutex.RLock()
 	defer fake.containerInWorkerMutex.RUnlock()
 	fake.findOrChooseWorkerMutex.RLock()
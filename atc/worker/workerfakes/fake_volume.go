@@ -47,14 +47,14 @@ type FakeVolume struct {
 	destroyReturnsOnCall map[int]struct {
 		result1 error
 	}
-	GetResourceCacheIdStub        func() int
-	getResourceCacheIdMutex       sync.RWMutex
-	getResourceCacheIdArgsForCall []struct {
+	GetResourceCacheIDStub        func() int
+	getResourceCacheIDMutex       sync.RWMutex
+	getResourceCacheIDArgsForCall []struct {
 	}
-	getResourceCacheIdReturns struct {
+	getResourceCacheIDReturns struct {
 		result1 int
 	}
-	getResourceCacheIdReturnsOnCall map[int]struct {
+	getResourceCacheIDReturnsOnCall map[int]struct {
 		result1 int
 	}
 	HandleStub        func() string
@@ -81,6 +81,19 @@ type FakeVolume struct {
 		result1 db.WorkerArtifact
 		result2 error
 	}
+	InitializeNamedCacheStub        func(lager.Logger, string, bool) error
+	initializeNamedCacheMutex       sync.RWMutex
+	initializeNamedCacheArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 bool
+	}
+	initializeNamedCacheReturns struct {
+		result1 error
+	}
+	initializeNamedCacheReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InitializeResourceCacheStub        func(db.UsedResourceCache) error
 	initializeResourceCacheMutex       sync.RWMutex
 	initializeResourceCacheArgsForCall []struct {
@@ -364,53 +377,53 @@ func (fake *FakeVolume) DestroyReturnsOnCall(i int, result1 error) {
 }
 
 func (fake *FakeVolume) GetResourceCacheID() int {
-	fake.getResourceCacheIdMutex.Lock()
-	ret, specificReturn := fake.getResourceCacheIdReturnsOnCall[len(fake.getResourceCacheIdArgsForCall)]
-	fake.getResourceCacheIdArgsForCall = append(fake.getResourceCacheIdArgsForCall, struct {
+	fake.getResourceCacheIDMutex.Lock()
+	ret, specificReturn := fake.getResourceCacheIDReturnsOnCall[len(fake.getResourceCacheIDArgsForCall)]
+	fake.getResourceCacheIDArgsForCall = append(fake.getResourceCacheIDArgsForCall, struct {
 	}{})
 	fake.recordInvocation("GetResourceCacheID", []interface{}{})
-	fake.getResourceCacheIdMutex.Unlock()
-	if fake.GetResourceCacheIdStub != nil {
-		return fake.GetResourceCacheIdStub()
+	fake.getResourceCacheIDMutex.Unlock()
+	if fake.GetResourceCacheIDStub != nil {
+		return fake.GetResourceCacheIDStub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.getResourceCacheIdReturns
+	fakeReturns := fake.getResourceCacheIDReturns
 	return fakeReturns.result1
 }
 
-func (fake *FakeVolume) GetResourceCacheIdCallCount() int {
-	fake.getResourceCacheIdMutex.RLock()
-	defer fake.getResourceCacheIdMutex.RUnlock()
-	return len(fake.getResourceCacheIdArgsForCall)
+func (fake *FakeVolume) GetResourceCacheIDCallCount() int {
+	fake.getResourceCacheIDMutex.RLock()
+	defer fake.getResourceCacheIDMutex.RUnlock()
+	return len(fake.getResourceCacheIDArgsForCall)
 }
 
-func (fake *FakeVolume) GetResourceCacheIdCalls(stub func() int) {
-	fake.getResourceCacheIdMutex.Lock()
-	defer fake.getResourceCacheIdMutex.Unlock()
-	fake.GetResourceCacheIdStub = stub
+func (fake *FakeVolume) GetResourceCacheIDCalls(stub func() int) {
+	fake.getResourceCacheIDMutex.Lock()
+	defer fake.getResourceCacheIDMutex.Unlock()
+	fake.GetResourceCacheIDStub = stub
 }
 
-func (fake *FakeVolume) GetResourceCacheIdReturns(result1 int) {
-	fake.getResourceCacheIdMutex.Lock()
-	defer fake.getResourceCacheIdMutex.Unlock()
-	fake.GetResourceCacheIdStub = nil
-	fake.getResourceCacheIdReturns = struct {
+func (fake *FakeVolume) GetResourceCacheIDReturns(result1 int) {
+	fake.getResourceCacheIDMutex.Lock()
+	defer fake.getResourceCacheIDMutex.Unlock()
+	fake.GetResourceCacheIDStub = nil
+	fake.getResourceCacheIDReturns = struct {
 		result1 int
 	}{result1}
 }
 
-func (fake *FakeVolume) GetResourceCacheIdReturnsOnCall(i int, result1 int) {
-	fake.getResourceCacheIdMutex.Lock()
-	defer fake.getResourceCacheIdMutex.Unlock()
-	fake.GetResourceCacheIdStub = nil
-	if fake.getResourceCacheIdReturnsOnCall == nil {
-		fake.getResourceCacheIdReturnsOnCall = make(map[int]struct {
+func (fake *FakeVolume) GetResourceCacheIDReturnsOnCall(i int, result1 int) {
+	fake.getResourceCacheIDMutex.Lock()
+	defer fake.getResourceCacheIDMutex.Unlock()
+	fake.GetResourceCacheIDStub = nil
+	if fake.getResourceCacheIDReturnsOnCall == nil {
+		fake.getResourceCacheIDReturnsOnCall = make(map[int]struct {
 			result1 int
 		})
 	}
-	fake.getResourceCacheIdReturnsOnCall[i] = struct {
+	fake.getResourceCacheIDReturnsOnCall[i] = struct {
 		result1 int
 	}{result1}
 }
@@ -531,6 +544,68 @@ func (fake *FakeVolume) InitializeArtifactReturnsOnCall(i int, result1 db.Worker
 	}{result1, result2}
 }
 
+func (fake *FakeVolume) InitializeNamedCache(arg1 lager.Logger, arg2 string, arg3 bool) error {
+	fake.initializeNamedCacheMutex.Lock()
+	ret, specificReturn := fake.initializeNamedCacheReturnsOnCall[len(fake.initializeNamedCacheArgsForCall)]
+	fake.initializeNamedCacheArgsForCall = append(fake.initializeNamedCacheArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("InitializeNamedCache", []interface{}{arg1, arg2, arg3})
+	fake.initializeNamedCacheMutex.Unlock()
+	if fake.InitializeNamedCacheStub != nil {
+		return fake.InitializeNamedCacheStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.initializeNamedCacheReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeVolume) InitializeNamedCacheCallCount() int {
+	fake.initializeNamedCacheMutex.RLock()
+	defer fake.initializeNamedCacheMutex.RUnlock()
+	return len(fake.initializeNamedCacheArgsForCall)
+}
+
+func (fake *FakeVolume) InitializeNamedCacheCalls(stub func(lager.Logger, string, bool) error) {
+	fake.initializeNamedCacheMutex.Lock()
+	defer fake.initializeNamedCacheMutex.Unlock()
+	fake.InitializeNamedCacheStub = stub
+}
+
+func (fake *FakeVolume) InitializeNamedCacheArgsForCall(i int) (lager.Logger, string, bool) {
+	fake.initializeNamedCacheMutex.RLock()
+	defer fake.initializeNamedCacheMutex.RUnlock()
+	argsForCall := fake.initializeNamedCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeVolume) InitializeNamedCacheReturns(result1 error) {
+	fake.initializeNamedCacheMutex.Lock()
+	defer fake.initializeNamedCacheMutex.Unlock()
+	fake.InitializeNamedCacheStub = nil
+	fake.initializeNamedCacheReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeVolume) InitializeNamedCacheReturnsOnCall(i int, result1 error) {
+	fake.initializeNamedCacheMutex.Lock()
+	defer fake.initializeNamedCacheMutex.Unlock()
+	fake.InitializeNamedCacheStub = nil
+	if fake.initializeNamedCacheReturnsOnCall == nil {
+		fake.initializeNamedCacheReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.initializeNamedCacheReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeVolume) InitializeResourceCache(arg1 db.UsedResourceCache) error {
 	fake.initializeResourceCacheMutex.Lock()
 	ret, specificReturn := fake.initializeResourceCacheReturnsOnCall[len(fake.initializeResourceCacheArgsForCall)]
@@ -1072,12 +1147,14 @@ func (fake *FakeVolume) Invocations() map[string][][]interface{} {
 	defer fake.createChildForContainerMutex.RUnlock()
 	fake.destroyMutex.RLock()
 	defer fake.destroyMutex.RUnlock()
-	fake.getResourceCacheIdMutex.RLock()
-	defer fake.getResourceCacheIdMutex.RUnlock()
+	fake.getResourceCacheIDMutex.RLock()
+	defer fake.getResourceCacheIDMutex.RUnlock()
 	fake.handleMutex.RLock()
 	defer fake.handleMutex.RUnlock()
 	fake.initializeArtifactMutex.RLock()
 	defer fake.initializeArtifactMutex.RUnlock()
+	fake.initializeNamedCacheMutex.RLock()
+	defer fake.initializeNamedCacheMutex.RUnlock()
 	fake.initializeResourceCacheMutex.RLock()
 	defer fake.initializeResourceCacheMutex.RUnlock()
 	fake.initializeTaskCacheMutex.RLock()
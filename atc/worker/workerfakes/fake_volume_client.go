@@ -27,6 +27,22 @@ type FakeVolumeClient struct {
 		result1 worker.Volume
 		result2 error
 	}
+	CreateVolumeForNamedCacheStub        func(lager.Logger, worker.VolumeSpec, int, string) (worker.Volume, error)
+	createVolumeForNamedCacheMutex       sync.RWMutex
+	createVolumeForNamedCacheArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 worker.VolumeSpec
+		arg3 int
+		arg4 string
+	}
+	createVolumeForNamedCacheReturns struct {
+		result1 worker.Volume
+		result2 error
+	}
+	createVolumeForNamedCacheReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 error
+	}
 	CreateVolumeForTaskCacheStub        func(lager.Logger, worker.VolumeSpec, int, int, string, string) (worker.Volume, error)
 	createVolumeForTaskCacheMutex       sync.RWMutex
 	createVolumeForTaskCacheArgsForCall []struct {
@@ -111,6 +127,23 @@ type FakeVolumeClient struct {
 		result2 bool
 		result3 error
 	}
+	FindVolumeForNamedCacheStub        func(lager.Logger, int, string) (worker.Volume, bool, error)
+	findVolumeForNamedCacheMutex       sync.RWMutex
+	findVolumeForNamedCacheArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 int
+		arg3 string
+	}
+	findVolumeForNamedCacheReturns struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}
+	findVolumeForNamedCacheReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}
 	FindVolumeForResourceCacheStub        func(lager.Logger, db.UsedResourceCache) (worker.Volume, bool, error)
 	findVolumeForResourceCacheMutex       sync.RWMutex
 	findVolumeForResourceCacheArgsForCall []struct {
@@ -233,6 +266,72 @@ func (fake *FakeVolumeClient) CreateVolumeReturnsOnCall(i int, result1 worker.Vo
 	}{result1, result2}
 }
 
+func (fake *FakeVolumeClient) CreateVolumeForNamedCache(arg1 lager.Logger, arg2 worker.VolumeSpec, arg3 int, arg4 string) (worker.Volume, error) {
+	fake.createVolumeForNamedCacheMutex.Lock()
+	ret, specificReturn := fake.createVolumeForNamedCacheReturnsOnCall[len(fake.createVolumeForNamedCacheArgsForCall)]
+	fake.createVolumeForNamedCacheArgsForCall = append(fake.createVolumeForNamedCacheArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 worker.VolumeSpec
+		arg3 int
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CreateVolumeForNamedCache", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createVolumeForNamedCacheMutex.Unlock()
+	if fake.CreateVolumeForNamedCacheStub != nil {
+		return fake.CreateVolumeForNamedCacheStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createVolumeForNamedCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVolumeClient) CreateVolumeForNamedCacheCallCount() int {
+	fake.createVolumeForNamedCacheMutex.RLock()
+	defer fake.createVolumeForNamedCacheMutex.RUnlock()
+	return len(fake.createVolumeForNamedCacheArgsForCall)
+}
+
+func (fake *FakeVolumeClient) CreateVolumeForNamedCacheCalls(stub func(lager.Logger, worker.VolumeSpec, int, string) (worker.Volume, error)) {
+	fake.createVolumeForNamedCacheMutex.Lock()
+	defer fake.createVolumeForNamedCacheMutex.Unlock()
+	fake.CreateVolumeForNamedCacheStub = stub
+}
+
+func (fake *FakeVolumeClient) CreateVolumeForNamedCacheArgsForCall(i int) (lager.Logger, worker.VolumeSpec, int, string) {
+	fake.createVolumeForNamedCacheMutex.RLock()
+	defer fake.createVolumeForNamedCacheMutex.RUnlock()
+	argsForCall := fake.createVolumeForNamedCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeVolumeClient) CreateVolumeForNamedCacheReturns(result1 worker.Volume, result2 error) {
+	fake.createVolumeForNamedCacheMutex.Lock()
+	defer fake.createVolumeForNamedCacheMutex.Unlock()
+	fake.CreateVolumeForNamedCacheStub = nil
+	fake.createVolumeForNamedCacheReturns = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeClient) CreateVolumeForNamedCacheReturnsOnCall(i int, result1 worker.Volume, result2 error) {
+	fake.createVolumeForNamedCacheMutex.Lock()
+	defer fake.createVolumeForNamedCacheMutex.Unlock()
+	fake.CreateVolumeForNamedCacheStub = nil
+	if fake.createVolumeForNamedCacheReturnsOnCall == nil {
+		fake.createVolumeForNamedCacheReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 error
+		})
+	}
+	fake.createVolumeForNamedCacheReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVolumeClient) CreateVolumeForTaskCache(arg1 lager.Logger, arg2 worker.VolumeSpec, arg3 int, arg4 int, arg5 string, arg6 string) (worker.Volume, error) {
 	fake.createVolumeForTaskCacheMutex.Lock()
 	ret, specificReturn := fake.createVolumeForTaskCacheReturnsOnCall[len(fake.createVolumeForTaskCacheArgsForCall)]
@@ -568,6 +667,74 @@ func (fake *FakeVolumeClient) FindOrCreateVolumeForResourceCertsReturnsOnCall(i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeVolumeClient) FindVolumeForNamedCache(arg1 lager.Logger, arg2 int, arg3 string) (worker.Volume, bool, error) {
+	fake.findVolumeForNamedCacheMutex.Lock()
+	ret, specificReturn := fake.findVolumeForNamedCacheReturnsOnCall[len(fake.findVolumeForNamedCacheArgsForCall)]
+	fake.findVolumeForNamedCacheArgsForCall = append(fake.findVolumeForNamedCacheArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("FindVolumeForNamedCache", []interface{}{arg1, arg2, arg3})
+	fake.findVolumeForNamedCacheMutex.Unlock()
+	if fake.FindVolumeForNamedCacheStub != nil {
+		return fake.FindVolumeForNamedCacheStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findVolumeForNamedCacheReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVolumeClient) FindVolumeForNamedCacheCallCount() int {
+	fake.findVolumeForNamedCacheMutex.RLock()
+	defer fake.findVolumeForNamedCacheMutex.RUnlock()
+	return len(fake.findVolumeForNamedCacheArgsForCall)
+}
+
+func (fake *FakeVolumeClient) FindVolumeForNamedCacheCalls(stub func(lager.Logger, int, string) (worker.Volume, bool, error)) {
+	fake.findVolumeForNamedCacheMutex.Lock()
+	defer fake.findVolumeForNamedCacheMutex.Unlock()
+	fake.FindVolumeForNamedCacheStub = stub
+}
+
+func (fake *FakeVolumeClient) FindVolumeForNamedCacheArgsForCall(i int) (lager.Logger, int, string) {
+	fake.findVolumeForNamedCacheMutex.RLock()
+	defer fake.findVolumeForNamedCacheMutex.RUnlock()
+	argsForCall := fake.findVolumeForNamedCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeVolumeClient) FindVolumeForNamedCacheReturns(result1 worker.Volume, result2 bool, result3 error) {
+	fake.findVolumeForNamedCacheMutex.Lock()
+	defer fake.findVolumeForNamedCacheMutex.Unlock()
+	fake.FindVolumeForNamedCacheStub = nil
+	fake.findVolumeForNamedCacheReturns = struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVolumeClient) FindVolumeForNamedCacheReturnsOnCall(i int, result1 worker.Volume, result2 bool, result3 error) {
+	fake.findVolumeForNamedCacheMutex.Lock()
+	defer fake.findVolumeForNamedCacheMutex.Unlock()
+	fake.FindVolumeForNamedCacheStub = nil
+	if fake.findVolumeForNamedCacheReturnsOnCall == nil {
+		fake.findVolumeForNamedCacheReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findVolumeForNamedCacheReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeVolumeClient) FindVolumeForResourceCache(arg1 lager.Logger, arg2 db.UsedResourceCache) (worker.Volume, bool, error) {
 	fake.findVolumeForResourceCacheMutex.Lock()
 	ret, specificReturn := fake.findVolumeForResourceCacheReturnsOnCall[len(fake.findVolumeForResourceCacheArgsForCall)]
@@ -777,6 +944,8 @@ func (fake *FakeVolumeClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.createVolumeMutex.RLock()
 	defer fake.createVolumeMutex.RUnlock()
+	fake.createVolumeForNamedCacheMutex.RLock()
+	defer fake.createVolumeForNamedCacheMutex.RUnlock()
 	fake.createVolumeForTaskCacheMutex.RLock()
 	defer fake.createVolumeForTaskCacheMutex.RUnlock()
 	fake.findOrCreateCOWVolumeForContainerMutex.RLock()
@@ -787,6 +956,8 @@ func (fake *FakeVolumeClient) Invocations() map[string][][]interface{} {
 	defer fake.findOrCreateVolumeForContainerMutex.RUnlock()
 	fake.findOrCreateVolumeForResourceCertsMutex.RLock()
 	defer fake.findOrCreateVolumeForResourceCertsMutex.RUnlock()
+	fake.findVolumeForNamedCacheMutex.RLock()
+	defer fake.findVolumeForNamedCacheMutex.RUnlock()
 	fake.findVolumeForResourceCacheMutex.RLock()
 	defer fake.findVolumeForResourceCacheMutex.RUnlock()
 	fake.findVolumeForTaskCacheMutex.RLock()
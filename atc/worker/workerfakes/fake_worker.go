@@ -176,6 +176,23 @@ type FakeWorker struct {
 		result2 bool
 		result3 error
 	}
+	FindVolumeForNamedCacheStub        func(lager.Logger, int, string) (worker.Volume, bool, error)
+	findVolumeForNamedCacheMutex       sync.RWMutex
+	findVolumeForNamedCacheArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 int
+		arg3 string
+	}
+	findVolumeForNamedCacheReturns struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}
+	findVolumeForNamedCacheReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}
 	FindVolumeForResourceCacheStub        func(lager.Logger, db.UsedResourceCache) (worker.Volume, bool, error)
 	findVolumeForResourceCacheMutex       sync.RWMutex
 	findVolumeForResourceCacheArgsForCall []struct {
@@ -999,6 +1016,74 @@ func (fake *FakeWorker) FindResourceCacheForVolumeReturnsOnCall(i int, result1 d
 	}{result1, result2, result3}
 }
 
+func (fake *FakeWorker) FindVolumeForNamedCache(arg1 lager.Logger, arg2 int, arg3 string) (worker.Volume, bool, error) {
+	fake.findVolumeForNamedCacheMutex.Lock()
+	ret, specificReturn := fake.findVolumeForNamedCacheReturnsOnCall[len(fake.findVolumeForNamedCacheArgsForCall)]
+	fake.findVolumeForNamedCacheArgsForCall = append(fake.findVolumeForNamedCacheArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("FindVolumeForNamedCache", []interface{}{arg1, arg2, arg3})
+	fake.findVolumeForNamedCacheMutex.Unlock()
+	if fake.FindVolumeForNamedCacheStub != nil {
+		return fake.FindVolumeForNamedCacheStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findVolumeForNamedCacheReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorker) FindVolumeForNamedCacheCallCount() int {
+	fake.findVolumeForNamedCacheMutex.RLock()
+	defer fake.findVolumeForNamedCacheMutex.RUnlock()
+	return len(fake.findVolumeForNamedCacheArgsForCall)
+}
+
+func (fake *FakeWorker) FindVolumeForNamedCacheCalls(stub func(lager.Logger, int, string) (worker.Volume, bool, error)) {
+	fake.findVolumeForNamedCacheMutex.Lock()
+	defer fake.findVolumeForNamedCacheMutex.Unlock()
+	fake.FindVolumeForNamedCacheStub = stub
+}
+
+func (fake *FakeWorker) FindVolumeForNamedCacheArgsForCall(i int) (lager.Logger, int, string) {
+	fake.findVolumeForNamedCacheMutex.RLock()
+	defer fake.findVolumeForNamedCacheMutex.RUnlock()
+	argsForCall := fake.findVolumeForNamedCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeWorker) FindVolumeForNamedCacheReturns(result1 worker.Volume, result2 bool, result3 error) {
+	fake.findVolumeForNamedCacheMutex.Lock()
+	defer fake.findVolumeForNamedCacheMutex.Unlock()
+	fake.FindVolumeForNamedCacheStub = nil
+	fake.findVolumeForNamedCacheReturns = struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorker) FindVolumeForNamedCacheReturnsOnCall(i int, result1 worker.Volume, result2 bool, result3 error) {
+	fake.findVolumeForNamedCacheMutex.Lock()
+	defer fake.findVolumeForNamedCacheMutex.Unlock()
+	fake.FindVolumeForNamedCacheStub = nil
+	if fake.findVolumeForNamedCacheReturnsOnCall == nil {
+		fake.findVolumeForNamedCacheReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findVolumeForNamedCacheReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeWorker) FindVolumeForResourceCache(arg1 lager.Logger, arg2 db.UsedResourceCache) (worker.Volume, bool, error) {
 	fake.findVolumeForResourceCacheMutex.Lock()
 	ret, specificReturn := fake.findVolumeForResourceCacheReturnsOnCall[len(fake.findVolumeForResourceCacheArgsForCall)]
@@ -1714,6 +1799,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.findOrCreateContainerMutex.RUnlock()
 	fake.findResourceCacheForVolumeMutex.RLock()
 	defer fake.findResourceCacheForVolumeMutex.RUnlock()
+	fake.findVolumeForNamedCacheMutex.RLock()
+	defer fake.findVolumeForNamedCacheMutex.RUnlock()
 	fake.findVolumeForResourceCacheMutex.RLock()
 	defer fake.findVolumeForResourceCacheMutex.RUnlock()
 	fake.findVolumeForTaskCacheMutex.RLock()
@@ -44,12 +44,16 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// pipeline and job are public or authorized
 		case atc.GetBuildPreparation,
 			atc.BuildEvents,
+			atc.GetBuildLog,
 			atc.GetBuildPlan,
 			atc.ListBuildArtifacts:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
 			// resource belongs to authorized team
-		case atc.AbortBuild:
+		case atc.AbortBuild,
+			atc.HoldBuild,
+			atc.ReleaseBuild,
+			atc.ResumeBuild:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
@@ -69,6 +73,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.JobBadge,
 			atc.ListJobs,
 			atc.GetJob,
+			atc.GetJobStats,
 			atc.ListJobBuilds,
 			atc.ListPipelineBuilds,
 			atc.GetResource,
@@ -85,6 +90,9 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.CreateBuild,
 			atc.GetContainer,
 			atc.HijackContainer,
+			atc.StreamOutContainer,
+			atc.StreamInContainer,
+			atc.PortForwardContainer,
 			atc.ListContainers,
 			atc.ListWorkers,
 			atc.RegisterWorker,
@@ -115,6 +123,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			newHandler = auth.CheckAuthenticationIfProvidedHandler(handler, rejector)
 
 		case atc.GetLogLevel,
+			atc.Firehose,
 			atc.ListActiveUsersSince,
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
@@ -134,6 +143,10 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.PinResourceVersion,
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
+			atc.SaveResourceVersions,
+			atc.ListResourceWebhookDeliveries,
+			atc.SearchBuildLogs,
+			atc.TeamFirehose,
 			atc.GetConfig,
 			atc.GetCC,
 			atc.GetVersionsDB,
@@ -149,6 +162,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SaveConfig,
 			atc.ArchivePipeline,
 			atc.ClearTaskCache,
+			atc.ClearNamedCache,
 			atc.CreateArtifact,
 			atc.ScheduleJob,
 			atc.GetArtifact:
@@ -122,12 +122,16 @@ var _ = Describe("APIAuthWrappa", func() {
 
 				// authorized or public pipeline and public job
 				atc.BuildEvents:         checksIfPrivateJob(inputHandlers[atc.BuildEvents]),
+				atc.GetBuildLog:         checksIfPrivateJob(inputHandlers[atc.GetBuildLog]),
 				atc.ListBuildArtifacts:  checksIfPrivateJob(inputHandlers[atc.ListBuildArtifacts]),
 				atc.GetBuildPreparation: checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
 				atc.GetBuildPlan:        checksIfPrivateJob(inputHandlers[atc.GetBuildPlan]),
 
 				// resource belongs to authorized team
-				atc.AbortBuild: checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.AbortBuild:   checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.HoldBuild:    checkWritePermissionForBuild(inputHandlers[atc.HoldBuild]),
+				atc.ReleaseBuild: checkWritePermissionForBuild(inputHandlers[atc.ReleaseBuild]),
+				atc.ResumeBuild:  checkWritePermissionForBuild(inputHandlers[atc.ResumeBuild]),
 
 				// resource belongs to authorized team
 				atc.PruneWorker:              checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
@@ -143,6 +147,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetJobBuild:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJobBuild]),
 				atc.PipelineBadge:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.PipelineBadge]),
 				atc.JobBadge:                      openForPublicPipelineOrAuthorized(inputHandlers[atc.JobBadge]),
+				atc.GetJobStats:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJobStats]),
 				atc.ListJobs:                      openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobs]),
 				atc.GetJob:                        openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJob]),
 				atc.ListJobBuilds:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobBuilds]),
@@ -157,21 +162,24 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetResourceVersion:            openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResourceVersion]),
 
 				// authenticated
-				atc.CreateBuild:     authenticated(inputHandlers[atc.CreateBuild]),
-				atc.GetContainer:    authenticated(inputHandlers[atc.GetContainer]),
-				atc.HijackContainer: authenticated(inputHandlers[atc.HijackContainer]),
-				atc.ListContainers:  authenticated(inputHandlers[atc.ListContainers]),
-				atc.ListVolumes:     authenticated(inputHandlers[atc.ListVolumes]),
-				atc.ListTeamBuilds:  authenticated(inputHandlers[atc.ListTeamBuilds]),
-				atc.ListWorkers:     authenticated(inputHandlers[atc.ListWorkers]),
-				atc.RegisterWorker:  authenticated(inputHandlers[atc.RegisterWorker]),
-				atc.HeartbeatWorker: authenticated(inputHandlers[atc.HeartbeatWorker]),
-				atc.DeleteWorker:    authenticated(inputHandlers[atc.DeleteWorker]),
-				atc.GetTeam:         authenticated(inputHandlers[atc.GetTeam]),
-				atc.SetTeam:         authenticated(inputHandlers[atc.SetTeam]),
-				atc.RenameTeam:      authenticated(inputHandlers[atc.RenameTeam]),
-				atc.DestroyTeam:     authenticated(inputHandlers[atc.DestroyTeam]),
-				atc.GetUser:         authenticated(inputHandlers[atc.GetUser]),
+				atc.CreateBuild:          authenticated(inputHandlers[atc.CreateBuild]),
+				atc.GetContainer:         authenticated(inputHandlers[atc.GetContainer]),
+				atc.HijackContainer:      authenticated(inputHandlers[atc.HijackContainer]),
+				atc.StreamOutContainer:   authenticated(inputHandlers[atc.StreamOutContainer]),
+				atc.StreamInContainer:    authenticated(inputHandlers[atc.StreamInContainer]),
+				atc.PortForwardContainer: authenticated(inputHandlers[atc.PortForwardContainer]),
+				atc.ListContainers:       authenticated(inputHandlers[atc.ListContainers]),
+				atc.ListVolumes:          authenticated(inputHandlers[atc.ListVolumes]),
+				atc.ListTeamBuilds:       authenticated(inputHandlers[atc.ListTeamBuilds]),
+				atc.ListWorkers:          authenticated(inputHandlers[atc.ListWorkers]),
+				atc.RegisterWorker:       authenticated(inputHandlers[atc.RegisterWorker]),
+				atc.HeartbeatWorker:      authenticated(inputHandlers[atc.HeartbeatWorker]),
+				atc.DeleteWorker:         authenticated(inputHandlers[atc.DeleteWorker]),
+				atc.GetTeam:              authenticated(inputHandlers[atc.GetTeam]),
+				atc.SetTeam:              authenticated(inputHandlers[atc.SetTeam]),
+				atc.RenameTeam:           authenticated(inputHandlers[atc.RenameTeam]),
+				atc.DestroyTeam:          authenticated(inputHandlers[atc.DestroyTeam]),
+				atc.GetUser:              authenticated(inputHandlers[atc.GetUser]),
 
 				//authenticateIfTokenProvided / delegating to handler
 				atc.GetInfo:              authenticateIfTokenProvided(inputHandlers[atc.GetInfo]),
@@ -189,6 +197,7 @@ var _ = Describe("APIAuthWrappa", func() {
 
 				// authenticated and is admin
 				atc.GetLogLevel:          authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
+				atc.Firehose:             authenticatedAndAdmin(inputHandlers[atc.Firehose]),
 				atc.SetLogLevel:          authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
 				atc.GetInfoCreds:         authenticatedAndAdmin(inputHandlers[atc.GetInfoCreds]),
 				atc.ListActiveUsersSince: authenticatedAndAdmin(inputHandlers[atc.ListActiveUsersSince]),
@@ -196,35 +205,40 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ClearWall:            authenticatedAndAdmin(inputHandlers[atc.ClearWall]),
 
 				// authorized (requested team matches resource team)
-				atc.CheckResource:           authorized(inputHandlers[atc.CheckResource]),
-				atc.CheckResourceType:       authorized(inputHandlers[atc.CheckResourceType]),
-				atc.CreateJobBuild:          authorized(inputHandlers[atc.CreateJobBuild]),
-				atc.RerunJobBuild:           authorized(inputHandlers[atc.RerunJobBuild]),
-				atc.DeletePipeline:          authorized(inputHandlers[atc.DeletePipeline]),
-				atc.DisableResourceVersion:  authorized(inputHandlers[atc.DisableResourceVersion]),
-				atc.EnableResourceVersion:   authorized(inputHandlers[atc.EnableResourceVersion]),
-				atc.PinResourceVersion:      authorized(inputHandlers[atc.PinResourceVersion]),
-				atc.UnpinResource:           authorized(inputHandlers[atc.UnpinResource]),
-				atc.SetPinCommentOnResource: authorized(inputHandlers[atc.SetPinCommentOnResource]),
-				atc.GetConfig:               authorized(inputHandlers[atc.GetConfig]),
-				atc.GetCC:                   authorized(inputHandlers[atc.GetCC]),
-				atc.GetVersionsDB:           authorized(inputHandlers[atc.GetVersionsDB]),
-				atc.ListJobInputs:           authorized(inputHandlers[atc.ListJobInputs]),
-				atc.OrderPipelines:          authorized(inputHandlers[atc.OrderPipelines]),
-				atc.PauseJob:                authorized(inputHandlers[atc.PauseJob]),
-				atc.PausePipeline:           authorized(inputHandlers[atc.PausePipeline]),
-				atc.ArchivePipeline:         authorized(inputHandlers[atc.ArchivePipeline]),
-				atc.RenamePipeline:          authorized(inputHandlers[atc.RenamePipeline]),
-				atc.SaveConfig:              authorized(inputHandlers[atc.SaveConfig]),
-				atc.UnpauseJob:              authorized(inputHandlers[atc.UnpauseJob]),
-				atc.ScheduleJob:             authorized(inputHandlers[atc.ScheduleJob]),
-				atc.UnpausePipeline:         authorized(inputHandlers[atc.UnpausePipeline]),
-				atc.ExposePipeline:          authorized(inputHandlers[atc.ExposePipeline]),
-				atc.HidePipeline:            authorized(inputHandlers[atc.HidePipeline]),
-				atc.CreatePipelineBuild:     authorized(inputHandlers[atc.CreatePipelineBuild]),
-				atc.ClearTaskCache:          authorized(inputHandlers[atc.ClearTaskCache]),
-				atc.CreateArtifact:          authorized(inputHandlers[atc.CreateArtifact]),
-				atc.GetArtifact:             authorized(inputHandlers[atc.GetArtifact]),
+				atc.CheckResource:                 authorized(inputHandlers[atc.CheckResource]),
+				atc.CheckResourceType:             authorized(inputHandlers[atc.CheckResourceType]),
+				atc.CreateJobBuild:                authorized(inputHandlers[atc.CreateJobBuild]),
+				atc.RerunJobBuild:                 authorized(inputHandlers[atc.RerunJobBuild]),
+				atc.DeletePipeline:                authorized(inputHandlers[atc.DeletePipeline]),
+				atc.DisableResourceVersion:        authorized(inputHandlers[atc.DisableResourceVersion]),
+				atc.EnableResourceVersion:         authorized(inputHandlers[atc.EnableResourceVersion]),
+				atc.PinResourceVersion:            authorized(inputHandlers[atc.PinResourceVersion]),
+				atc.UnpinResource:                 authorized(inputHandlers[atc.UnpinResource]),
+				atc.SetPinCommentOnResource:       authorized(inputHandlers[atc.SetPinCommentOnResource]),
+				atc.GetConfig:                     authorized(inputHandlers[atc.GetConfig]),
+				atc.ListResourceWebhookDeliveries: authorized(inputHandlers[atc.ListResourceWebhookDeliveries]),
+				atc.SearchBuildLogs:               authorized(inputHandlers[atc.SearchBuildLogs]),
+				atc.ClearNamedCache:               authorized(inputHandlers[atc.ClearNamedCache]),
+				atc.TeamFirehose:                  authorized(inputHandlers[atc.TeamFirehose]),
+				atc.SaveResourceVersions:          authorized(inputHandlers[atc.SaveResourceVersions]),
+				atc.GetCC:                         authorized(inputHandlers[atc.GetCC]),
+				atc.GetVersionsDB:                 authorized(inputHandlers[atc.GetVersionsDB]),
+				atc.ListJobInputs:                 authorized(inputHandlers[atc.ListJobInputs]),
+				atc.OrderPipelines:                authorized(inputHandlers[atc.OrderPipelines]),
+				atc.PauseJob:                      authorized(inputHandlers[atc.PauseJob]),
+				atc.PausePipeline:                 authorized(inputHandlers[atc.PausePipeline]),
+				atc.ArchivePipeline:               authorized(inputHandlers[atc.ArchivePipeline]),
+				atc.RenamePipeline:                authorized(inputHandlers[atc.RenamePipeline]),
+				atc.SaveConfig:                    authorized(inputHandlers[atc.SaveConfig]),
+				atc.UnpauseJob:                    authorized(inputHandlers[atc.UnpauseJob]),
+				atc.ScheduleJob:                   authorized(inputHandlers[atc.ScheduleJob]),
+				atc.UnpausePipeline:               authorized(inputHandlers[atc.UnpausePipeline]),
+				atc.ExposePipeline:                authorized(inputHandlers[atc.ExposePipeline]),
+				atc.HidePipeline:                  authorized(inputHandlers[atc.HidePipeline]),
+				atc.CreatePipelineBuild:           authorized(inputHandlers[atc.CreatePipelineBuild]),
+				atc.ClearTaskCache:                authorized(inputHandlers[atc.ClearTaskCache]),
+				atc.CreateArtifact:                authorized(inputHandlers[atc.CreateArtifact]),
+				atc.GetArtifact:                   authorized(inputHandlers[atc.GetArtifact]),
 			}
 		})
 
@@ -35,6 +35,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.PinResourceVersion,
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
+			atc.SaveResourceVersions,
 			atc.RerunJobBuild:
 
 			newHandler = rw.handlerFactory.RejectArchived(handler)
@@ -45,10 +46,16 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetBuild,
 			atc.BuildResources,
 			atc.BuildEvents,
+			atc.GetBuildLog,
+			atc.Firehose,
+			atc.TeamFirehose,
 			atc.ListBuildArtifacts,
 			atc.GetBuildPreparation,
 			atc.GetBuildPlan,
 			atc.AbortBuild,
+			atc.HoldBuild,
+			atc.ReleaseBuild,
+			atc.ResumeBuild,
 			atc.PruneWorker,
 			atc.LandWorker,
 			atc.ReportWorkerContainers,
@@ -62,6 +69,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.JobBadge,
 			atc.ListJobs,
 			atc.GetJob,
+			atc.GetJobStats,
 			atc.ListJobBuilds,
 			atc.ListPipelineBuilds,
 			atc.GetResource,
@@ -75,9 +83,14 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.CreateBuild,
 			atc.GetContainer,
 			atc.HijackContainer,
+			atc.StreamOutContainer,
+			atc.StreamInContainer,
+			atc.PortForwardContainer,
 			atc.ListContainers,
 			atc.ListVolumes,
 			atc.ListTeamBuilds,
+			atc.SearchBuildLogs,
+			atc.ClearNamedCache,
 			atc.ListWorkers,
 			atc.RegisterWorker,
 			atc.HeartbeatWorker,
@@ -91,6 +104,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetCheck,
 			atc.DownloadCLI,
 			atc.CheckResourceWebHook,
+			atc.ListResourceWebhookDeliveries,
 			atc.ListAllPipelines,
 			atc.ListBuilds,
 			atc.ListPipelines,
@@ -42,6 +42,7 @@ var _ = Describe("RejectArchivedWrappa", func() {
 			atc.PinResourceVersion,
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
+			atc.SaveResourceVersions,
 			atc.RerunJobBuild,
 		}
 
@@ -29,6 +29,9 @@ type BuildsCommand struct {
 	Teams       []string                 `short:"n"  long:"team" description:"Show builds for these teams"`
 	Since       string                   `long:"since" description:"Start of the range to filter builds"`
 	Until       string                   `long:"until" description:"End of the range to filter builds"`
+	Statuses    []string                 `short:"s" long:"status" choice:"pending" choice:"started" choice:"succeeded" choice:"failed" choice:"errored" choice:"aborted" description:"Only show builds with this status (can be specified multiple times)"`
+	Trigger     string                   `long:"trigger" choice:"manual" choice:"automatic" description:"Only show builds which were triggered manually or automatically"`
+	CreatedBy   string                   `long:"created-by" value-name:"USER" description:"Only show builds triggered by this user"`
 }
 
 func (command *BuildsCommand) Execute([]string) error {
@@ -58,6 +61,7 @@ func (command *BuildsCommand) Execute([]string) error {
 
 	page.Limit = command.Count
 	page.Timestamps = command.Since != "" || command.Until != ""
+	page.Filter = command.filter()
 
 	currentTeam := target.Team()
 	client := target.Client()
@@ -70,6 +74,23 @@ func (command *BuildsCommand) Execute([]string) error {
 	return command.displayBuilds(builds)
 }
 
+func (command *BuildsCommand) filter() concourse.BuildFilter {
+	filter := concourse.BuildFilter{
+		CreatedBy: command.CreatedBy,
+	}
+
+	for _, status := range command.Statuses {
+		filter.Statuses = append(filter.Statuses, atc.BuildStatus(status))
+	}
+
+	if command.Trigger != "" {
+		manuallyTriggered := command.Trigger == "manual"
+		filter.ManuallyTriggered = &manuallyTriggered
+	}
+
+	return filter
+}
+
 func (command *BuildsCommand) getBuilds(builds []atc.Build, currentTeam concourse.Team, page concourse.Page, client concourse.Client, teams []concourse.Team) ([]atc.Build, error) {
 	var err error
 	if command.pipelineFlag() {
@@ -192,6 +213,7 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 			{Contents: "end", Color: color.New(color.Bold)},
 			{Contents: "duration", Color: color.New(color.Bold)},
 			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "created by", Color: color.New(color.Bold)},
 		},
 	}
 
@@ -211,6 +233,13 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 		var statusCell ui.TableCell
 		statusCell.Contents = b.Status
 
+		var createdByCell ui.TableCell
+		if b.CreatedBy == "" {
+			createdByCell.Contents = "n/a"
+		} else {
+			createdByCell.Contents = b.CreatedBy
+		}
+
 		switch b.Status {
 		case "pending":
 			statusCell.Color = ui.PendingColor
@@ -237,6 +266,7 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 			endTimeCell,
 			durationCell,
 			{Contents: b.TeamName},
+			createdByCell,
 		})
 	}
 
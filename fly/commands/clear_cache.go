@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/vito/go-interact/interact"
+)
+
+type ClearCacheCommand struct {
+	Name            string `long:"name" required:"true" description:"Name of the cache to clear"`
+	Team            string `long:"team" description:"Name of the team which owns the cache, if different from the target default"`
+	SkipInteractive bool   `short:"n" long:"non-interactive" description:"Destroy the cache without confirmation"`
+}
+
+func (command *ClearCacheCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("!!! this will remove the cache `%s` from every worker\n\n", command.Name)
+
+	confirm := command.SkipInteractive
+	if !confirm {
+		err := interact.NewInteraction("are you sure?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	numRemoved, err := team.ClearNamedCache(command.Name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%d caches removed\n", numRemoved)
+
+	return nil
+}
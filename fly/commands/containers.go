@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strconv"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
@@ -50,6 +51,7 @@ func (command *ContainersCommand) Execute([]string) error {
 			{Contents: "type", Color: color.New(color.Bold)},
 			{Contents: "name", Color: color.New(color.Bold)},
 			{Contents: "attempt", Color: color.New(color.Bold)},
+			{Contents: "expires in", Color: color.New(color.Bold)},
 		},
 	}
 
@@ -64,6 +66,7 @@ func (command *ContainersCommand) Execute([]string) error {
 			{Contents: c.Type},
 			stringOrDefault(c.StepName + c.ResourceName),
 			stringOrDefault(c.Attempt, "n/a"),
+			expiresIn(c),
 		}
 
 		table.Data = append(table.Data, row)
@@ -74,6 +77,16 @@ func (command *ContainersCommand) Execute([]string) error {
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
 
+// expiresIn shows when a container will be garbage collected, if known, or
+// that it is kept until its build is released.
+func expiresIn(container atc.Container) ui.TableCell {
+	if container.Held {
+		return ui.TableCell{Contents: "held", Color: ui.PendingColor}
+	}
+
+	return stringOrDefault(container.ExpiresIn, "n/a")
+}
+
 func buildIDOrNone(id int) ui.TableCell {
 	var column ui.TableCell
 
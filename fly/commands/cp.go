@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/go-archive/tarfs"
+)
+
+// containerPathPrefix marks an argument to fly cp as a path within the
+// container rather than on the local machine.
+const containerPathPrefix = "container:"
+
+type CpCommand struct {
+	Handle         string `long:"handle" required:"true" description:"Handle id of the container to copy files into or out of"`
+	Team           string `long:"team"                   description:"Name of the team to which the container belongs, if different from the target default"`
+	PositionalArgs struct {
+		Source      string `positional-arg-name:"SOURCE"      required:"true" description:"Path to copy, prefixed with 'container:' if within the container, or '-' to read a tarball from stdin"`
+		Destination string `positional-arg-name:"DESTINATION" required:"true" description:"Directory to copy into, prefixed with 'container:' if within the container, or '-' to write a tarball to stdout"`
+	} `positional-args:"yes"`
+}
+
+func (command *CpCommand) Execute([]string) error {
+	source := command.PositionalArgs.Source
+	destination := command.PositionalArgs.Destination
+
+	sourceInContainer := strings.HasPrefix(source, containerPathPrefix)
+	destinationInContainer := strings.HasPrefix(destination, containerPathPrefix)
+
+	if sourceInContainer == destinationInContainer {
+		return errors.New("exactly one of the source and destination must be prefixed with 'container:'")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	container, err := team.GetContainer(command.Handle)
+	if err != nil {
+		displayhelpers.Failf("no containers matched the given handle id!\n\nthey may have expired if your build hasn't recently finished.")
+	}
+
+	if sourceInContainer {
+		containerPath := resolveContainerPath(container.WorkingDirectory, source)
+
+		tarStream, err := team.StreamOutContainer(command.Handle, containerPath)
+		if err != nil {
+			return err
+		}
+
+		defer tarStream.Close()
+
+		if destination == "-" {
+			_, err = io.Copy(os.Stdout, tarStream)
+			return err
+		}
+
+		err = os.MkdirAll(destination, 0755)
+		if err != nil {
+			return err
+		}
+
+		return tarfs.Extract(tarStream, destination)
+	}
+
+	containerPath := resolveContainerPath(container.WorkingDirectory, destination)
+
+	if source == "-" {
+		return team.StreamInContainer(command.Handle, containerPath, os.Stdin)
+	}
+
+	_, err = os.Stat(source)
+	if err != nil {
+		return err
+	}
+
+	absSource, err := filepath.Abs(source)
+	if err != nil {
+		return err
+	}
+
+	archiveStream, archiveWriter := io.Pipe()
+
+	go func() {
+		archiveWriter.CloseWithError(tarfs.Compress(archiveWriter, filepath.Dir(absSource), filepath.Base(absSource)))
+	}()
+
+	return team.StreamInContainer(command.Handle, containerPath, archiveStream)
+}
+
+// resolveContainerPath strips the 'container:' prefix from the argument and
+// resolves relative paths against the container's working directory.
+func resolveContainerPath(workingDirectory string, arg string) string {
+	containerPath := strings.TrimPrefix(arg, containerPathPrefix)
+	if path.IsAbs(containerPath) {
+		return containerPath
+	}
+
+	return path.Join(workingDirectory, containerPath)
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type DownloadLogCommand struct {
+	Job       flaghelpers.JobFlag `short:"j" long:"job"         value-name:"PIPELINE/JOB"  description:"Download the log of a build of the given job"`
+	Build     string              `short:"b" long:"build"                                  description:"Download the log of a specific build"`
+	Url       string              `short:"u" long:"url"                                    description:"URL for the build or job to download the log of"`
+	Output    string              `short:"o" long:"output"      value-name:"PATH"          description:"Write the log to a file instead of stdout"`
+	Format    string              `long:"format" default:"text" choice:"text" choice:"jsonl" description:"Download the log as plain text or as JSON lines"`
+	Timestamp bool                `short:"t" long:"timestamps"                             description:"Prefix every line with its time (plain text only)"`
+	Steps     bool                `long:"steps"                                            description:"Print a header whenever the step changes (plain text only)"`
+}
+
+func (command *DownloadLogCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	buildId, err := resolveBuildID(target, command.Job, command.Build, command.Url)
+	if err != nil {
+		return err
+	}
+
+	log, err := target.Client().BuildLog(strconv.Itoa(buildId), concourse.BuildLogOptions{
+		Format:     atc.BuildLogFormat(command.Format),
+		Timestamps: command.Timestamp,
+		Steps:      command.Steps,
+	})
+	if err != nil {
+		return err
+	}
+
+	defer log.Close()
+
+	var dst io.Writer = os.Stdout
+	if command.Output != "" {
+		file, err := os.Create(command.Output)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+
+		dst = file
+	}
+
+	_, err = io.Copy(dst, log)
+	return err
+}
@@ -34,16 +34,20 @@ type FlyCommand struct {
 
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
-	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
-	Watch   WatchCommand   `command:"watch"   alias:"w" description:"Stream a build's output"`
+	Execute     ExecuteCommand     `command:"execute"      alias:"e"  description:"Execute a one-off build using local bits"`
+	Watch       WatchCommand       `command:"watch"        alias:"w"  description:"Stream a build's output"`
+	DownloadLog DownloadLogCommand `command:"download-log" alias:"dl" description:"Download a build's log"`
 
-	Containers ContainersCommand `command:"containers" alias:"cs" description:"Print the active containers"`
-	Hijack     HijackCommand     `command:"hijack"     alias:"intercept" alias:"i" description:"Execute a command in a container"`
+	Containers  ContainersCommand  `command:"containers"   alias:"cs"                  description:"Print the active containers"`
+	Hijack      HijackCommand      `command:"hijack"       alias:"intercept" alias:"i" description:"Execute a command in a container"`
+	Cp          CpCommand          `command:"cp"                                       description:"Copy files into or out of a container"`
+	PortForward PortForwardCommand `command:"port-forward" alias:"pf"                  description:"Forward a local port to a port within a container"`
 
 	Jobs        JobsCommand        `command:"jobs"      alias:"js" description:"List the jobs in the pipelines"`
 	PauseJob    PauseJobCommand    `command:"pause-job" alias:"pj" description:"Pause a job"`
 	UnpauseJob  UnpauseJobCommand  `command:"unpause-job" alias:"uj" description:"Unpause a job"`
 	ScheduleJob ScheduleJobCommand `command:"schedule-job" alias:"sj" description:"Request the scheduler to run for a job. Introduced as a recovery command for the v6.0 scheduler."`
+	JobStats    JobStatsCommand    `command:"job-stats" alias:"jst" description:"Print the success rates, durations and flakiness of a job"`
 
 	Pipelines        PipelinesCommand        `command:"pipelines"           alias:"ps"   description:"List the configured pipelines"`
 	DestroyPipeline  DestroyPipelineCommand  `command:"destroy-pipeline"    alias:"dp"   description:"Destroy a pipeline"`
@@ -62,6 +66,8 @@ type FlyCommand struct {
 	Resources              ResourcesCommand              `command:"resources"                  alias:"rs"   description:"List the resources in the pipeline"`
 	ResourceVersions       ResourceVersionsCommand       `command:"resource-versions"          alias:"rvs"  description:"List the versions of a resource"`
 	CheckResource          CheckResourceCommand          `command:"check-resource"             alias:"cr"   description:"Check a resource"`
+	SaveVersions           SaveVersionsCommand           `command:"save-versions"              alias:"sv"   description:"Save versions of a resource without running a check"`
+	WebhookDeliveries      WebhookDeliveriesCommand      `command:"webhook-deliveries"         alias:"wds"  description:"List the recent webhook deliveries of a resource"`
 	PinResource            PinResourceCommand            `command:"pin-resource"               alias:"pr"   description:"Pin a version to a resource"`
 	UnpinResource          UnpinResourceCommand          `command:"unpin-resource"             alias:"ur"   description:"Unpin a resource"`
 	EnableResourceVersion  EnableResourceVersionCommand  `command:"enable-resource-version"    alias:"erv"  description:"Enable a version of a resource"`
@@ -70,10 +76,15 @@ type FlyCommand struct {
 	CheckResourceType CheckResourceTypeCommand `command:"check-resource-type" alias:"crt"  description:"Check a resource-type"`
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
-
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
-	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	ClearCache     ClearCacheCommand     `command:"clear-cache"      alias:"cc"  description:"Clears a named cache shared by the team's tasks"`
+
+	Builds       BuildsCommand       `command:"builds"        alias:"bs"  description:"List builds data"`
+	AbortBuild   AbortBuildCommand   `command:"abort-build"   alias:"ab"  description:"Abort a build"`
+	RerunBuild   RerunBuildCommand   `command:"rerun-build"   alias:"rb"  description:"Rerun a build"`
+	HoldBuild    HoldBuildCommand    `command:"hold-build"    alias:"hb"  description:"Keep the containers of a build after it finishes, until it is released"`
+	ReleaseBuild ReleaseBuildCommand `command:"release-build" alias:"rlb" description:"Release a held build, letting its containers be garbage collected"`
+	ResumeBuild  ResumeBuildCommand  `command:"resume-build"  alias:"rsb" description:"Resume a build paused at a breakpoint"`
+	SearchLogs   SearchLogsCommand   `command:"search-logs"   alias:"sl"  description:"Search the logs of builds"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
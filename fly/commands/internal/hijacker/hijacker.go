@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"time"
 
@@ -44,7 +45,7 @@ func (h *Hijacker) SetHeartbeatInterval(interval time.Duration) {
 }
 
 func (h *Hijacker) Hijack(teamName, handle string, spec atc.HijackProcessSpec, pio ProcessIO) (int, error) {
-	url, header, err := h.hijackRequestParts(teamName, handle)
+	url, header, err := h.requestParts(atc.HijackContainer, teamName, handle, nil)
 	if err != nil {
 		return -1, err
 	}
@@ -82,9 +83,9 @@ func (h *Hijacker) Hijack(teamName, handle string, spec atc.HijackProcessSpec, p
 	return exitStatus, nil
 }
 
-func (h *Hijacker) hijackRequestParts(teamName, handle string) (string, http.Header, error) {
+func (h *Hijacker) requestParts(routeName, teamName, handle string, query url.Values) (string, http.Header, error) {
 	hijackReq, err := h.requestGenerator.CreateRequest(
-		atc.HijackContainer,
+		routeName,
 		rata.Params{"id": handle, "team_name": teamName},
 		nil,
 	)
@@ -98,6 +99,7 @@ func (h *Hijacker) hijackRequestParts(teamName, handle string) (string, http.Hea
 	}
 
 	wsUrl := hijackReq.URL
+	wsUrl.RawQuery = query.Encode()
 
 	var found bool
 	wsUrl.Scheme, found = websocketSchemeMap[wsUrl.Scheme]
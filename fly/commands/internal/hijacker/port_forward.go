@@ -0,0 +1,103 @@
+package hijacker
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/gorilla/websocket"
+)
+
+// PortForward forwards the connection to the port within the container until
+// the port closes it. Once the local side has nothing more to send, whatever
+// the port sends back is still forwarded. The connection is closed once it
+// returns.
+func (h *Hijacker) PortForward(teamName, handle string, port uint16, local io.ReadWriteCloser) error {
+	defer local.Close()
+
+	query := url.Values{atc.ContainerPortForwardQueryPort: {strconv.Itoa(int(port))}}
+
+	wsUrl, header, err := h.requestParts(atc.PortForwardContainer, teamName, handle, query)
+	if err != nil {
+		return err
+	}
+
+	dialer := websocket.Dialer{
+		TLSClientConfig: h.tlsConfig,
+		Proxy:           http.ProxyFromEnvironment,
+	}
+	conn, response, err := dialer.Dial(wsUrl, header)
+	if err != nil {
+		if response != nil {
+			return fmt.Errorf("%s %w", response.Status, err)
+		}
+
+		return err
+	}
+
+	defer conn.Close()
+
+	finished := make(chan struct{})
+	defer close(finished)
+
+	go h.heartbeat(conn, finished)
+
+	go func() {
+		_, _ = io.Copy(&binaryWriter{conn}, local)
+
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(atc.ContainerPortForwardEOF))
+	}()
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return nil
+			}
+
+			var closeErr *websocket.CloseError
+			if errors.As(err, &closeErr) && closeErr.Text != "" {
+				return errors.New(closeErr.Text)
+			}
+
+			return err
+		}
+
+		_, err = local.Write(data)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (h *Hijacker) heartbeat(conn *websocket.Conn, finished chan struct{}) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case t := <-ticker.C:
+			_ = conn.WriteControl(websocket.PingMessage, []byte(t.String()), time.Now().Add(time.Second))
+		case <-finished:
+			return
+		}
+	}
+}
+
+type binaryWriter struct {
+	conn *websocket.Conn
+}
+
+func (w *binaryWriter) Write(d []byte) (int, error) {
+	err := w.conn.WriteMessage(websocket.BinaryMessage, d)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(d), nil
+}
@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type JobStatsCommand struct {
+	Job     flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to get stats for"`
+	Windows []time.Duration     `short:"w" long:"window" default:"168h" value-name:"DURATION" description:"Only look at builds which finished within this long, e.g. 24h. Can be specified multiple times to compare windows"`
+	Json    bool                `long:"json" description:"Print command result as JSON"`
+	Team    string              `long:"team" description:"Name of the team to which the job belongs, if different from the target default"`
+}
+
+func (command *JobStatsCommand) Execute([]string) error {
+	pipelineName, jobName := command.Job.PipelineName, command.Job.JobName
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	var stats []atc.JobStats
+	for _, window := range command.Windows {
+		windowStats, found, err := team.JobStats(pipelineName, jobName, window)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("%s/%s not found on team %s", pipelineName, jobName, team.Name())
+		}
+
+		stats = append(stats, windowStats)
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(stats)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "window", Color: color.New(color.Bold)},
+			{Contents: "builds", Color: color.New(color.Bold)},
+			{Contents: "success rate", Color: color.New(color.Bold)},
+			{Contents: "failure rate", Color: color.New(color.Bold)},
+			{Contents: "error rate", Color: color.New(color.Bold)},
+			{Contents: "p50", Color: color.New(color.Bold)},
+			{Contents: "p90", Color: color.New(color.Bold)},
+			{Contents: "p99", Color: color.New(color.Bold)},
+			{Contents: "mttr", Color: color.New(color.Bold)},
+			{Contents: "flakiness", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, s := range stats {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: formatSeconds(s.Window)},
+			{Contents: strconv.Itoa(s.Builds)},
+			rateCell(s.Builds, s.SuccessRate, ui.SucceededColor),
+			rateCell(s.Builds, s.FailureRate, ui.FailedColor),
+			rateCell(s.Builds, s.ErrorRate, ui.ErroredColor),
+			durationCell(s.Builds, s.Durations.P50),
+			durationCell(s.Builds, s.Durations.P90),
+			durationCell(s.Builds, s.Durations.P99),
+			durationCell(s.Recoveries, s.MeanTimeToRecovery),
+			rateCell(s.Failed, s.Flakiness, nil),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+// rateCell and durationCell print n/a when there was nothing to measure.
+func rateCell(count int, rate float64, c *color.Color) ui.TableCell {
+	if count == 0 {
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: fmt.Sprintf("%.1f%%", rate*100), Color: c}
+}
+
+func durationCell(count int, seconds int64) ui.TableCell {
+	if count == 0 {
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: formatSeconds(seconds)}
+}
+
+func formatSeconds(seconds int64) string {
+	return (time.Duration(seconds) * time.Second).String()
+}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/hijacker"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/tedsuo/rata"
+)
+
+type PortForwardCommand struct {
+	Handle         string `long:"handle" required:"true" description:"Handle id of the container to forward a port to"`
+	Team           string `long:"team"                   description:"Name of the team to which the container belongs, if different from the target default"`
+	PositionalArgs struct {
+		Ports string `positional-arg-name:"LOCAL_PORT:CONTAINER_PORT" required:"true" description:"Local port to listen on, and the port within the container to forward connections to. A local port of 0 picks any free port."`
+	} `positional-args:"yes"`
+}
+
+func (command *PortForwardCommand) Execute([]string) error {
+	localPort, containerPort, err := parsePorts(command.PositionalArgs.Ports)
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	_, err = team.GetContainer(command.Handle)
+	if err != nil {
+		displayhelpers.Failf("no containers matched the given handle id!\n\nthey may have expired if your build hasn't recently finished.")
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(int(localPort))))
+	if err != nil {
+		return err
+	}
+
+	defer listener.Close()
+
+	fmt.Printf("forwarding %s to port %d of container %s\n", listener.Addr(), containerPort, command.Handle)
+
+	reqGenerator := rata.NewRequestGenerator(target.URL(), atc.Routes)
+	h := hijacker.New(target.TLSConfig(), reqGenerator, target.Token())
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			err := h.PortForward(team.Name(), command.Handle, containerPort, conn)
+			if err != nil {
+				fmt.Fprintf(ui.Stderr, "failed to forward connection: %s\n", err)
+			}
+		}()
+	}
+}
+
+func parsePorts(ports string) (uint16, uint16, error) {
+	parts := strings.Split(ports, ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("ports must be given as LOCAL_PORT:CONTAINER_PORT, got '%s'", ports)
+	}
+
+	localPort, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid local port '%s'", parts[0])
+	}
+
+	containerPort, err := strconv.ParseUint(parts[1], 10, 16)
+	if err != nil || containerPort == 0 {
+		return 0, 0, fmt.Errorf("invalid container port '%s'", parts[1])
+	}
+
+	return uint16(localPort), uint16(containerPort), nil
+}
@@ -6,6 +6,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
@@ -16,6 +17,8 @@ type RerunBuildCommand struct {
 	Job   flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of the job that you want to rerun a build for"`
 	Build string              `short:"b" long:"build" required:"true" description:"The number of the build to rerun"`
 	Watch bool                `short:"w" long:"watch" description:"Start watching the rerun build output"`
+
+	FromStep string `long:"from-step" value-name:"STEP" description:"Rerun the build from this get, put or task step, reusing the results of the steps before it where possible"`
 }
 
 func (command *RerunBuildCommand) Execute(args []string) error {
@@ -31,7 +34,13 @@ func (command *RerunBuildCommand) Execute(args []string) error {
 		return err
 	}
 
-	build, err := target.Team().RerunJobBuild(pipelineName, jobName, buildName)
+	var build atc.Build
+	if command.FromStep != "" {
+		build, err = target.Team().RerunJobBuildFromStep(pipelineName, jobName, buildName, command.FromStep)
+	} else {
+		build, err = target.Team().RerunJobBuild(pipelineName, jobName, buildName)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"os"
+	"strconv"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -44,7 +45,7 @@ func (command *ResourcesCommand) Execute([]string) error {
 		return nil
 	}
 
-	headers = []string{"name", "type", "pinned"}
+	headers = []string{"name", "type", "pinned", "check failures"}
 	table := ui.Table{Headers: ui.TableRow{}}
 	for _, h := range headers {
 		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
@@ -64,6 +65,13 @@ func (command *ResourcesCommand) Execute([]string) error {
 
 		row = append(row, pinnedColumn)
 
+		failuresColumn := ui.TableCell{Contents: strconv.Itoa(p.CheckFailures)}
+		if p.CheckFailures > 0 {
+			failuresColumn.Color = color.New(color.FgRed)
+		}
+
+		row = append(row, failuresColumn)
+
 		table.Data = append(table.Data, row)
 	}
 
@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"sigs.k8s.io/yaml"
+)
+
+type SaveVersionsCommand struct {
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource"       required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource to save versions for"`
+	Version  map[string]string        `short:"v" long:"version"                        value-name:"KEY:VALUE"         description:"Field of a single version to save, e.g. ref:abcd. Can be specified multiple times."`
+	Metadata map[string]string        `short:"m" long:"metadata"                       value-name:"NAME:VALUE"        description:"Metadata of the single version to save. Can be specified multiple times."`
+	File     atc.PathFlag             `short:"f" long:"versions-file"                  value-name:"PATH"              description:"YAML or JSON file containing a list of versions with optional metadata to save, oldest first"`
+}
+
+func (command *SaveVersionsCommand) Execute([]string) error {
+	versions, err := command.versions()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Team().SaveResourceVersions(command.Resource.PipelineName, command.Resource.ResourceName, versions)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("pipeline '%s' or resource '%s' not found", command.Resource.PipelineName, command.Resource.ResourceName)
+	}
+
+	fmt.Printf("saved %d version(s) of '%s'\n", len(versions), command.Resource.ResourceName)
+
+	return nil
+}
+
+func (command *SaveVersionsCommand) versions() ([]atc.VersionWithMetadata, error) {
+	if command.File != "" && len(command.Version) != 0 {
+		return nil, errors.New("cannot specify both --version and --versions-file")
+	}
+
+	if command.File != "" {
+		if len(command.Metadata) != 0 {
+			return nil, errors.New("--metadata can only be given along with --version")
+		}
+
+		payload, err := ioutil.ReadFile(string(command.File))
+		if err != nil {
+			return nil, err
+		}
+
+		var versions []atc.VersionWithMetadata
+		err = yaml.Unmarshal(payload, &versions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse versions file: %s", err)
+		}
+
+		if len(versions) == 0 {
+			return nil, errors.New("versions file does not contain any versions")
+		}
+
+		return versions, nil
+	}
+
+	if len(command.Version) == 0 {
+		return nil, errors.New("either --version or --versions-file must be specified")
+	}
+
+	var metadata []atc.MetadataField
+	for name, value := range command.Metadata {
+		metadata = append(metadata, atc.MetadataField{Name: name, Value: value})
+	}
+
+	sort.Slice(metadata, func(i, j int) bool {
+		return metadata[i].Name < metadata[j].Name
+	})
+
+	return []atc.VersionWithMetadata{
+		{
+			Version:  atc.Version(command.Version),
+			Metadata: metadata,
+		},
+	}, nil
+}
@@ -0,0 +1,137 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type SearchLogsCommand struct {
+	Args struct {
+		Query string `positional-arg-name:"QUERY" required:"true" description:"Words to search build logs for"`
+	} `positional-args:"yes"`
+
+	Count    int                 `short:"c" long:"count" default:"100" description:"Maximum number of matching lines to show"`
+	Pipeline string              `short:"p" long:"pipeline" description:"Only search builds of this pipeline"`
+	Job      flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Only search builds of this job"`
+	Since    string              `long:"since" description:"Only search builds started after this time"`
+	Until    string              `long:"until" description:"Only search builds started before this time"`
+	Team     string              `long:"team" description:"Name of the team whose builds to search, if different from the target default"`
+	Json     bool                `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *SearchLogsCommand) Execute([]string) error {
+	search, err := command.search()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	}
+
+	matches, err := team.SearchBuildLogs(search)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(matches)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "pipeline/job", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "line", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, match := range matches {
+		var pipelineJobCell, buildCell ui.TableCell
+		if match.PipelineName == "" {
+			pipelineJobCell.Contents = "one-off"
+			buildCell.Contents = "n/a"
+		} else {
+			pipelineJobCell.Contents = fmt.Sprintf("%s/%s", match.PipelineName, match.JobName)
+			buildCell.Contents = match.BuildName
+		}
+
+		for _, line := range match.Lines {
+			table.Data = append(table.Data, []ui.TableCell{
+				{Contents: strconv.Itoa(match.BuildID)},
+				pipelineJobCell,
+				buildCell,
+				{Contents: line},
+			})
+		}
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *SearchLogsCommand) search() (concourse.BuildLogSearch, error) {
+	search := concourse.BuildLogSearch{
+		Query:        command.Args.Query,
+		PipelineName: command.Pipeline,
+		Limit:        command.Count,
+	}
+
+	if command.Job.JobName != "" {
+		if command.Pipeline != "" {
+			return search, errors.New("Cannot specify both --pipeline and --job")
+		}
+
+		search.PipelineName = command.Job.PipelineName
+		search.JobName = command.Job.JobName
+	}
+
+	var err error
+	if command.Since != "" {
+		search.Since, err = time.ParseInLocation(inputTimeLayout, command.Since, time.Now().Location())
+		if err != nil {
+			return search, errors.New("Since time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Until != "" {
+		search.Until, err = time.ParseInLocation(inputTimeLayout, command.Until, time.Now().Location())
+		if err != nil {
+			return search, errors.New("Until time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Since != "" && command.Until != "" && search.Since.After(search.Until) {
+		return search, errors.New("Cannot have --since after --until")
+	}
+
+	return search, nil
+}
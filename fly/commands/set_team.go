@@ -1,9 +1,11 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -28,6 +30,12 @@ type SetTeamCommand struct {
 	Team            flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
 	SkipInteractive bool                 `long:"non-interactive" description:"Force apply configuration"`
 	AuthFlags       skycmd.AuthTeamFlags `group:"Authentication"`
+
+	Network      string   `long:"network" description:"Network access of the team's tasks which do not configure their own, either 'default' or 'none'"`
+	NetworkAllow []string `long:"network-allow" value-name:"DESTINATION" description:"Only allow the team's tasks which do not configure their own network access to reach this subnet, IP address or host name (can be specified multiple times)"`
+	LockNetwork  bool     `long:"lock-network" description:"Prevent the team's tasks from configuring their own network access (requires admin)"`
+
+	Caches []string `long:"cache" value-name:"NAME[:SIZE]" description:"Declare a cache which any of the team's tasks can mount by name, optionally capping the size of each worker's copy of it, e.g. go-mod:10GB (can be specified multiple times)"`
 }
 
 func (command *SetTeamCommand) Execute([]string) error {
@@ -47,6 +55,16 @@ func (command *SetTeamCommand) Execute([]string) error {
 		os.Exit(1)
 	}
 
+	network, err := command.networkConfig()
+	if err != nil {
+		return err
+	}
+
+	caches, err := command.cacheConfigs()
+	if err != nil {
+		return err
+	}
+
 	roles := []string{}
 	for role := range authRoles {
 		roles = append(roles, role)
@@ -82,6 +100,35 @@ func (command *SetTeamCommand) Execute([]string) error {
 		}
 	}
 
+	if network != nil {
+		fmt.Println()
+		fmt.Printf("network:\n")
+		if network.Default.Mode == atc.NetworkModeAllowlist {
+			fmt.Printf("  allow:\n")
+			for _, destination := range network.Default.Allow {
+				fmt.Printf("  - %s\n", destination)
+			}
+		} else {
+			fmt.Printf("  %s\n", network.Default.Mode)
+		}
+
+		if network.Locked {
+			fmt.Printf("  %s\n", ui.Embolden("locked"))
+		}
+	}
+
+	if len(caches) > 0 {
+		fmt.Println()
+		fmt.Printf("caches:\n")
+		for _, cache := range caches {
+			if cache.Size != "" {
+				fmt.Printf("- %s (%s)\n", cache.Name, cache.Size)
+			} else {
+				fmt.Printf("- %s\n", cache.Name)
+			}
+		}
+	}
+
 	confirm := true
 	if !command.SkipInteractive {
 		confirm = false
@@ -95,7 +142,7 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: authRoles}
+	team := atc.Team{Auth: authRoles, Network: network, Caches: caches}
 
 	_, created, updated, err := target.Client().Team(teamName).CreateOrUpdate(team)
 	if err != nil {
@@ -110,3 +157,58 @@ func (command *SetTeamCommand) Execute([]string) error {
 
 	return nil
 }
+
+func (command *SetTeamCommand) networkConfig() (*atc.TeamNetworkConfig, error) {
+	if command.Network == "" && len(command.NetworkAllow) == 0 && !command.LockNetwork {
+		return nil, nil
+	}
+
+	if command.Network != "" && len(command.NetworkAllow) > 0 {
+		return nil, errors.New("--network and --network-allow cannot be used together")
+	}
+
+	network := atc.NetworkConfig{Mode: atc.NetworkModeDefault}
+	if len(command.NetworkAllow) > 0 {
+		network = atc.NetworkConfig{
+			Mode:  atc.NetworkModeAllowlist,
+			Allow: command.NetworkAllow,
+		}
+	} else if command.Network != "" {
+		network.Mode = atc.NetworkMode(command.Network)
+	}
+
+	if network.Mode == atc.NetworkModeAllowlist && len(command.NetworkAllow) == 0 {
+		return nil, errors.New("use --network-allow to restrict the network to an allowlist")
+	}
+
+	err := network.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &atc.TeamNetworkConfig{
+		Default: network,
+		Locked:  command.LockNetwork,
+	}, nil
+}
+
+func (command *SetTeamCommand) cacheConfigs() (atc.NamedCacheConfigs, error) {
+	caches := atc.NamedCacheConfigs{}
+	for _, value := range command.Caches {
+		segs := strings.SplitN(value, ":", 2)
+
+		cache := atc.NamedCacheConfig{Name: segs[0]}
+		if len(segs) == 2 {
+			cache.Size = segs[1]
+		}
+
+		caches = append(caches, cache)
+	}
+
+	err := caches.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return caches, nil
+}
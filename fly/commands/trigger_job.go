@@ -15,9 +15,11 @@ import (
 )
 
 type TriggerJobCommand struct {
-	Job   flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to trigger"`
-	Watch bool                `short:"w" long:"watch" description:"Start watching the build output"`
-	Team  string              `long:"team" description:"Name of the team to which the job belongs, if different from the target default"`
+	Job         flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to trigger"`
+	Watch       bool                `short:"w" long:"watch" description:"Start watching the build output"`
+	Team        string              `long:"team" description:"Name of the team to which the job belongs, if different from the target default"`
+	BreakAt     []string            `long:"break-at" value-name:"STEP" description:"Pause the build after this get, put or task step, until it is resumed with resume-build (can be specified multiple times)"`
+	BreakBefore []string            `long:"break-before" value-name:"STEP" description:"Pause the build before this get, put or task step, until it is resumed with resume-build (can be specified multiple times)"`
 }
 
 func (command *TriggerJobCommand) Execute(args []string) error {
@@ -46,7 +48,24 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 		team = target.Team()
 	}
 
-	build, err = team.CreateJobBuild(pipelineName, jobName)
+	breakpoints := map[string]atc.Breakpoint{}
+	for _, step := range command.BreakAt {
+		breakpoints[step] = atc.BreakpointAfter
+	}
+
+	for _, step := range command.BreakBefore {
+		if _, found := breakpoints[step]; found {
+			return fmt.Errorf("cannot break both before and after step '%s'", step)
+		}
+
+		breakpoints[step] = atc.BreakpointBefore
+	}
+
+	if len(breakpoints) > 0 {
+		build, err = team.CreateJobBuildWithBreakpoints(pipelineName, jobName, breakpoints)
+	} else {
+		build, err = team.CreateJobBuild(pipelineName, jobName)
+	}
 	if err != nil {
 		return err
 	} else {
@@ -1,14 +1,22 @@
 package commands
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"strconv"
+	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
 )
 
 type WatchCommand struct {
@@ -16,6 +24,12 @@ type WatchCommand struct {
 	Build     string              `short:"b" long:"build"                                  description:"Watches a specific build"`
 	Url       string              `short:"u" long:"url"                                    description:"URL for the build or job to watch"`
 	Timestamp bool                `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
+	Output    string              `short:"o" long:"output"      value-name:"PATH"          description:"Write the build's log to a file instead of the terminal"`
+
+	Team     string `long:"team"      value-name:"TEAM"     description:"Watches the lifecycle of every build in the given team"`
+	AllTeams bool   `long:"all-teams"                       description:"Watches the lifecycle of every build in the cluster (admin only)"`
+	After    string `long:"after"     value-name:"EVENT-ID" description:"Resumes watching the lifecycle of builds after the given event"`
+	Json     bool   `long:"json"                            description:"Print build lifecycle events as JSON"`
 }
 
 func getBuildIDFromURL(target rc.Target, urlParam string) (int, error) {
@@ -76,26 +90,28 @@ func (command *WatchCommand) Execute(args []string) error {
 		return err
 	}
 
-	var buildId int
 	client := target.Client()
-	if command.Job.JobName != "" || command.Build == "" && command.Url == "" {
-		build, err := GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineName)
-		if err != nil {
-			return err
-		}
-		buildId = build.ID
-	} else if command.Build != "" {
-		buildId, err = strconv.Atoi(command.Build)
 
-		if err != nil {
-			return err
-		}
-	} else if command.Url != "" {
-		buildId, err = getBuildIDFromURL(target, command.Url)
+	if command.Team != "" || command.AllTeams {
+		return command.watchFirehose(target)
+	}
+
+	if command.After != "" || command.Json {
+		return errors.New("--after and --json can only be used with --team or --all-teams")
+	}
+
+	buildId, err := resolveBuildID(target, command.Job, command.Build, command.Url)
+	if err != nil {
+		return err
+	}
 
+	if command.Output != "" {
+		exitCode, err := command.writeLog(client, buildId)
 		if err != nil {
 			return err
 		}
+
+		os.Exit(exitCode)
 	}
 
 	eventSource, err := client.BuildEvents(fmt.Sprintf("%d", buildId))
@@ -113,3 +129,153 @@ func (command *WatchCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (command *WatchCommand) watchFirehose(target rc.Target) error {
+	if command.Job.JobName != "" || command.Build != "" || command.Url != "" || command.Output != "" {
+		return errors.New("--team and --all-teams cannot be combined with flags for watching a single build")
+	}
+
+	if command.Team != "" && command.AllTeams {
+		return errors.New("--team and --all-teams are mutually exclusive")
+	}
+
+	var (
+		events concourse.BuildLifecycleEvents
+		err    error
+	)
+	if command.AllTeams {
+		events, err = target.Client().Firehose(command.After)
+	} else {
+		var team concourse.Team
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+
+		events, err = team.Firehose(command.After)
+	}
+	if err != nil {
+		return err
+	}
+
+	defer events.Close()
+
+	for {
+		ev, err := events.NextEvent()
+		if err != nil {
+			return err
+		}
+
+		if command.Json {
+			err = json.NewEncoder(os.Stdout).Encode(ev)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		printBuildLifecycleEvent(ev, command.Timestamp)
+	}
+}
+
+func printBuildLifecycleEvent(ev atc.BuildLifecycleEvent, showTimestamp bool) {
+	build := fmt.Sprintf("%s/one-off #%s", ev.TeamName, ev.BuildName)
+	if ev.PipelineName != "" {
+		build = fmt.Sprintf("%s/%s/%s #%s", ev.TeamName, ev.PipelineName, ev.JobName, ev.BuildName)
+	}
+
+	var statusColor *color.Color
+	switch ev.Status {
+	case atc.StatusPending:
+		statusColor = ui.PendingColor
+	case atc.StatusStarted:
+		statusColor = ui.StartedColor
+	case atc.StatusSucceeded:
+		statusColor = ui.SucceededColor
+	case atc.StatusFailed:
+		statusColor = ui.FailedColor
+	case atc.StatusErrored:
+		statusColor = ui.ErroredColor
+	case atc.StatusAborted:
+		statusColor = ui.AbortedColor
+	default:
+		statusColor = color.New()
+	}
+
+	line := fmt.Sprintf("%-8s  %s (build %d)  %s", ev.Type, build, ev.BuildID, statusColor.Sprint(ev.Status))
+	if showTimestamp {
+		line = time.Unix(ev.Time, 0).Format("15:04:05") + "  " + line
+	}
+
+	fmt.Println(line)
+}
+
+// resolveBuildID finds the build to act on from the --job, --build and --url
+// flags, defaulting to the most recent build.
+func resolveBuildID(target rc.Target, job flaghelpers.JobFlag, buildNameOrID string, buildURL string) (int, error) {
+	if job.JobName != "" || buildNameOrID == "" && buildURL == "" {
+		build, err := GetBuild(target.Client(), target.Team(), job.JobName, buildNameOrID, job.PipelineName)
+		if err != nil {
+			return 0, err
+		}
+
+		return build.ID, nil
+	}
+
+	if buildNameOrID != "" {
+		return strconv.Atoi(buildNameOrID)
+	}
+
+	return getBuildIDFromURL(target, buildURL)
+}
+
+func (command *WatchCommand) writeLog(client concourse.Client, buildId int) (int, error) {
+	file, err := os.Create(command.Output)
+	if err != nil {
+		return 0, err
+	}
+
+	defer file.Close()
+
+	log, err := client.BuildLog(strconv.Itoa(buildId), concourse.BuildLogOptions{
+		Timestamps: command.Timestamp,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	defer log.Close()
+
+	_, err = io.Copy(file, log)
+	if err != nil {
+		return 0, err
+	}
+
+	build, found, err := client.Build(strconv.Itoa(buildId))
+	if err != nil {
+		return 0, err
+	}
+
+	if !found {
+		return 0, errors.New("build not found")
+	}
+
+	return buildExitCode(build.Status), nil
+}
+
+// buildExitCode returns the exit status fly uses for a build's status.
+func buildExitCode(status string) int {
+	switch atc.BuildStatus(status) {
+	case atc.StatusSucceeded:
+		return 0
+	case atc.StatusFailed:
+		return 1
+	case atc.StatusErrored:
+		return 2
+	case atc.StatusAborted:
+		return 3
+	default:
+		return 255
+	}
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type WebhookDeliveriesCommand struct {
+	Count    int                      `short:"c" long:"count" default:"25" description:"Number of deliveries you want to limit the return to"`
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to get webhook deliveries for"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *WebhookDeliveriesCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	deliveries, found, err := target.Team().WebhookDeliveries(command.Resource.PipelineName, command.Resource.ResourceName, command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("resource '%s' not found", command.Resource.ResourceName)
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(deliveries)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "delivered at", Color: color.New(color.Bold)},
+			{Contents: "outcome", Color: color.New(color.Bold)},
+			{Contents: "check", Color: color.New(color.Bold)},
+			{Contents: "message", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, delivery := range deliveries {
+		outcomeCell := ui.TableCell{Contents: string(delivery.Outcome)}
+		switch delivery.Outcome {
+		case atc.WebhookDeliveryAccepted:
+			outcomeCell.Color = ui.SucceededColor
+		case atc.WebhookDeliveryRejected:
+			outcomeCell.Color = ui.FailedColor
+		case atc.WebhookDeliveryErrored:
+			outcomeCell.Color = ui.ErroredColor
+		}
+
+		checkCell := ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		if delivery.CheckID != 0 {
+			checkCell = ui.TableCell{Contents: strconv.Itoa(delivery.CheckID)}
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(delivery.ID)},
+			{Contents: time.Unix(delivery.DeliveredAt, 0).Local().Format(timeDateLayout)},
+			outcomeCell,
+			checkCell,
+			{Contents: delivery.Message},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
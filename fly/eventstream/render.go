@@ -5,6 +5,7 @@ import (
 	"io"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
@@ -51,6 +52,31 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 		case event.FinishTask:
 			exitStatus = e.ExitStatus
 
+		case event.Retry:
+			dstImpl.SetTimestamp(e.Time)
+			if e.Delay == "" || e.Delay == "0s" {
+				fmt.Fprintf(dstImpl, "\x1b[1mattempt %d of %d %s; retrying\x1b[0m\n", e.Attempt-1, e.Attempts, e.PreviousStatus)
+			} else {
+				fmt.Fprintf(dstImpl, "\x1b[1mattempt %d of %d %s; retrying in %s\x1b[0m\n", e.Attempt-1, e.Attempts, e.PreviousStatus, e.Delay)
+			}
+
+		case event.PausedAtBreakpoint:
+			breakpoint := e.Breakpoint
+			if breakpoint == "" {
+				breakpoint = atc.BreakpointAfter
+			}
+
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mpaused at breakpoint %s %s; hijack its containers with fly hijack and continue with fly resume-build\x1b[0m\n", breakpoint, e.Step)
+
+		case event.ResumedFromBreakpoint:
+			dstImpl.SetTimestamp(e.Time)
+			if e.TimedOut {
+				fmt.Fprintf(dstImpl, "\x1b[1mresumed as the breakpoint timed out\x1b[0m\n")
+			} else {
+				fmt.Fprintf(dstImpl, "\x1b[1mresumed\x1b[0m\n")
+			}
+
 		case event.Error:
 			errCol := ui.ErroredColor.SprintFunc()
 			dstImpl.SetTimestamp(0)
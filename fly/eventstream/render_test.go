@@ -186,6 +186,90 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when a Retry event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Retry{
+				Attempt:        3,
+				Attempts:       5,
+				PreviousStatus: atc.StatusErrored,
+				Delay:          "20s",
+				Time:           time.Now().Unix(),
+			}
+		})
+
+		It("prints which attempt did not succeed and how long until the next one", func() {
+			Expect(out).To(gbytes.Say("attempt 2 of 5 errored; retrying in 20s"))
+		})
+	})
+
+	Context("when a Retry event without a delay is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Retry{
+				Attempt:        2,
+				Attempts:       3,
+				PreviousStatus: atc.StatusFailed,
+				Delay:          "0s",
+				Time:           time.Now().Unix(),
+			}
+		})
+
+		It("prints which attempt did not succeed", func() {
+			Expect(out).To(gbytes.Say(`attempt 1 of 3 failed; retrying\x1b`))
+		})
+	})
+
+	Context("when a PausedAtBreakpoint event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.PausedAtBreakpoint{
+				Step: "some-task",
+				Time: time.Now().Unix(),
+			}
+		})
+
+		It("prints which step the build is paused after and how to resume it", func() {
+			Expect(out).To(gbytes.Say("paused at breakpoint after some-task; hijack its containers with fly hijack and continue with fly resume-build"))
+		})
+	})
+
+	Context("when a PausedAtBreakpoint event before a step is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.PausedAtBreakpoint{
+				Step:       "some-task",
+				Breakpoint: atc.BreakpointBefore,
+				Time:       time.Now().Unix(),
+			}
+		})
+
+		It("prints which step the build is paused before", func() {
+			Expect(out).To(gbytes.Say("paused at breakpoint before some-task;"))
+		})
+	})
+
+	Context("when a ResumedFromBreakpoint event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.ResumedFromBreakpoint{
+				Time: time.Now().Unix(),
+			}
+		})
+
+		It("prints that the build was resumed", func() {
+			Expect(out).To(gbytes.Say(`resumed\x1b`))
+		})
+	})
+
+	Context("when a ResumedFromBreakpoint event is received after the breakpoint timed out", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.ResumedFromBreakpoint{
+				TimedOut: true,
+				Time:     time.Now().Unix(),
+			}
+		})
+
+		It("prints that the build was resumed as the breakpoint timed out", func() {
+			Expect(out).To(gbytes.Say(`resumed as the breakpoint timed out`))
+		})
+	})
+
 	Describe("receiving a Status event", func() {
 		Context("with status 'succeeded'", func() {
 			BeforeEach(func() {
@@ -68,6 +68,7 @@ var _ = Describe("Fly CLI", func() {
 				{Contents: "end", Color: color.New(color.Bold)},
 				{Contents: "duration", Color: color.New(color.Bold)},
 				{Contents: "team", Color: color.New(color.Bold)},
+				{Contents: "created by", Color: color.New(color.Bold)},
 			}
 		})
 
@@ -111,6 +112,7 @@ var _ = Describe("Fly CLI", func() {
 						StartTime:    pendingBuildStartTime.Unix(),
 						EndTime:      pendingBuildEndTime.Unix(),
 						TeamName:     "team1",
+						CreatedBy:    "some-user",
 					},
 					{
 						ID:           1000001,
@@ -172,7 +174,8 @@ var _ = Describe("Fly CLI", func() {
                 "api_url": "",
                 "pipeline_name": "some-other-pipeline",
                 "start_time": 1448932815,
-                "end_time": 1448937315
+                "end_time": 1448937315,
+                "created_by": "some-user"
               },
               {
                 "id": 1000001,
@@ -223,6 +226,7 @@ var _ = Describe("Fly CLI", func() {
 								}.String(),
 							},
 							{Contents: "team1"},
+							{Contents: "n/a"},
 						},
 						{
 							{Contents: "3"},
@@ -233,6 +237,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: pendingBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
 							{Contents: "team1"},
+							{Contents: "some-user"},
 						},
 						{
 							{Contents: "1000001"},
@@ -243,6 +248,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: erroredBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "2h45m0s"},
 							{Contents: "team1"},
+							{Contents: "n/a"},
 						},
 						{
 							{Contents: "1002"},
@@ -253,6 +259,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: abortedBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "n/a"},
 							{Contents: "team1"},
+							{Contents: "n/a"},
 						},
 						{
 							{Contents: "39"},
@@ -263,6 +270,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: "n/a"},
 							{Contents: "n/a"},
 							{Contents: "team1"},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -356,6 +364,8 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: "n/a"},
 							{Contents: "n/a"},
 							{Contents: "n/a"},
+							{Contents: ""},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -370,6 +380,8 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: "n/a"},
 							{Contents: "n/a"},
 							{Contents: "n/a"},
+							{Contents: ""},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -411,6 +423,8 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
+							{Contents: ""},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -491,6 +505,8 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
+								{Contents: ""},
+								{Contents: "n/a"},
 							},
 						},
 					}))
@@ -531,6 +547,8 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
+							{Contents: ""},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -580,6 +598,8 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
+								{Contents: ""},
+								{Contents: "n/a"},
 							},
 						},
 					}))
@@ -624,6 +644,7 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
 								{Contents: "team1"},
+								{Contents: "n/a"},
 							},
 						},
 					}))
@@ -692,6 +713,7 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
 								{Contents: "team1"},
+								{Contents: "n/a"},
 							},
 
 							{
@@ -703,6 +725,7 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
 								{Contents: "team2"},
+								{Contents: "n/a"},
 							},
 						},
 					}))
@@ -791,6 +814,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
 							{Contents: "team1"},
+							{Contents: "n/a"},
 						},
 						{
 							{Contents: "4"},
@@ -801,6 +825,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
 							{Contents: "team2"},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -852,6 +877,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
 							{Contents: "team1"},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -892,6 +918,8 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
+							{Contents: ""},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -952,6 +980,8 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
+								{Contents: ""},
+								{Contents: "n/a"},
 							},
 						},
 					}))
@@ -977,5 +1007,34 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 		})
+
+		Context("when passing filters", func() {
+			BeforeEach(func() {
+				cmdArgs = append(cmdArgs, "-p", "some-pipeline")
+				cmdArgs = append(cmdArgs, "--status", "failed", "-s", "errored")
+				cmdArgs = append(cmdArgs, "--trigger", "automatic")
+				cmdArgs = append(cmdArgs, "--created-by", "some-user")
+
+				expectedURL = "/api/v1/teams/main/pipelines/some-pipeline/builds"
+				queryParams = "limit=50&status=failed&status=errored&manually_triggered=false&created_by=some-user"
+				returnedStatusCode = http.StatusOK
+				returnedBuilds = []atc.Build{}
+			})
+
+			It("filters the builds on the server", func() {
+				Eventually(session).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when passing an invalid status", func() {
+			BeforeEach(func() {
+				cmdArgs = append(cmdArgs, "--status", "bogus")
+			})
+
+			It("errors", func() {
+				Eventually(session).Should(gexec.Exit(1))
+				Expect(session.Err).To(gbytes.Say("Invalid value `bogus'"))
+			})
+		})
 	})
 })
@@ -0,0 +1,120 @@
+package integration_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("clear-cache", func() {
+		var (
+			stdin io.Writer
+			args  []string
+			sess  *gexec.Session
+		)
+
+		BeforeEach(func() {
+			stdin = nil
+			args = []string{}
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "clear-cache"}, args...)...)
+			stdin, err = flyCmd.StdinPipe()
+			Expect(err).NotTo(HaveOccurred())
+
+			sess, err = gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when a name is not specified", func() {
+			It("asks the user to specify a name", func() {
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `--name' was not specified"))
+			})
+		})
+
+		Context("when a name is specified", func() {
+			BeforeEach(func() {
+				args = append(args, "--name", "go-mod")
+			})
+
+			yes := func() {
+				Eventually(sess).Should(gbytes.Say(`are you sure\? \[yN\]: `))
+				fmt.Fprintf(stdin, "y\n")
+			}
+
+			no := func() {
+				Eventually(sess).Should(gbytes.Say(`are you sure\? \[yN\]: `))
+				fmt.Fprintf(stdin, "n\n")
+			}
+
+			It("warns that it's about to do bad things", func() {
+				Eventually(sess).Should(gbytes.Say("!!! this will remove the cache `go-mod` from every worker"))
+			})
+
+			It("bails out if the user says no", func() {
+				no()
+				Eventually(sess).Should(gbytes.Say(`bailing out`))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+
+			Context("when the cache exists", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/caches/go-mod"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ClearTaskCacheResponse{CachesRemoved: 3}),
+						),
+					)
+				})
+
+				It("succeeds if the user says yes", func() {
+					yes()
+					Eventually(sess).Should(gbytes.Say("3 caches removed"))
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+
+				Context("when run noninteractively", func() {
+					BeforeEach(func() {
+						args = append(args, "-n")
+					})
+
+					It("clears the cache without confirming", func() {
+						Eventually(sess).Should(gbytes.Say("3 caches removed"))
+						Eventually(sess).Should(gexec.Exit(0))
+					})
+				})
+			})
+
+			Context("and the api returns an unexpected status code", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/caches/go-mod"),
+							ghttp.RespondWith(402, ""),
+						),
+					)
+				})
+
+				It("writes an error message to stderr", func() {
+					yes()
+					Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+		})
+	})
+})
@@ -46,6 +46,7 @@ var _ = Describe("Fly CLI", func() {
 								Type:         "get",
 								StepName:     "git-repo",
 								Attempt:      "1.5",
+								Held:         true,
 							},
 							{
 								ID:           "other-handle",
@@ -56,6 +57,7 @@ var _ = Describe("Fly CLI", func() {
 								BuildID:      122,
 								Type:         "task",
 								StepName:     "unit-tests",
+								ExpiresIn:    "3h59m0s",
 							},
 							{
 								ID:         "post-handle",
@@ -96,7 +98,8 @@ var _ = Describe("Fly CLI", func() {
                 "build_id": 123,
                 "pipeline_name": "pipeline-name",
                 "job_name": "job-name-1",
-                "build_name": "3"
+                "build_name": "3",
+                "held": true
               },
               {
                 "id": "other-handle",
@@ -106,7 +109,8 @@ var _ = Describe("Fly CLI", func() {
                 "build_id": 122,
                 "pipeline_name": "pipeline-name",
                 "job_name": "job-name-2",
-                "build_name": "2"
+                "build_name": "2",
+                "expires_in": "3h59m0s"
               },
               {
                 "id": "post-handle",
@@ -135,12 +139,13 @@ var _ = Describe("Fly CLI", func() {
 						{Contents: "type", Color: color.New(color.Bold)},
 						{Contents: "name", Color: color.New(color.Bold)},
 						{Contents: "attempt", Color: color.New(color.Bold)},
+						{Contents: "expires in", Color: color.New(color.Bold)},
 					},
 					Data: []ui.TableRow{
-						{{Contents: "early-handle"}, {Contents: "worker-name-1"}, {Contents: "pipeline-name"}, {Contents: "job-name-1"}, {Contents: "3"}, {Contents: "123"}, {Contents: "get"}, {Contents: "git-repo"}, {Contents: "1.5"}},
-						{{Contents: "handle-1"}, {Contents: "worker-name-1"}, {Contents: "pipeline-name"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "check"}, {Contents: "git-repo"}, {Contents: "n/a", Color: color.New(color.Faint)}},
-						{{Contents: "other-handle"}, {Contents: "worker-name-2"}, {Contents: "pipeline-name"}, {Contents: "job-name-2"}, {Contents: "2"}, {Contents: "122"}, {Contents: "task"}, {Contents: "unit-tests"}, {Contents: "n/a", Color: color.New(color.Faint)}},
-						{{Contents: "post-handle"}, {Contents: "worker-name-3"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "142"}, {Contents: "task"}, {Contents: "one-off"}, {Contents: "n/a", Color: color.New(color.Faint)}},
+						{{Contents: "early-handle"}, {Contents: "worker-name-1"}, {Contents: "pipeline-name"}, {Contents: "job-name-1"}, {Contents: "3"}, {Contents: "123"}, {Contents: "get"}, {Contents: "git-repo"}, {Contents: "1.5"}, {Contents: "held", Color: ui.PendingColor}},
+						{{Contents: "handle-1"}, {Contents: "worker-name-1"}, {Contents: "pipeline-name"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "check"}, {Contents: "git-repo"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "n/a"}},
+						{{Contents: "other-handle"}, {Contents: "worker-name-2"}, {Contents: "pipeline-name"}, {Contents: "job-name-2"}, {Contents: "2"}, {Contents: "122"}, {Contents: "task"}, {Contents: "unit-tests"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "3h59m0s"}},
+						{{Contents: "post-handle"}, {Contents: "worker-name-3"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "142"}, {Contents: "task"}, {Contents: "one-off"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "n/a"}},
 					},
 				}))
 			})
@@ -0,0 +1,240 @@
+package integration_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("cp", func() {
+		var (
+			tmpDir string
+
+			containerStatusCode int
+			teamName            string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "fly-cp")
+			Expect(err).NotTo(HaveOccurred())
+
+			containerStatusCode = http.StatusOK
+			teamName = "main"
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpDir)
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/"+teamName+"/containers/container-id"),
+					ghttp.RespondWithJSONEncoded(containerStatusCode, atc.Container{
+						ID:               "container-id",
+						WorkingDirectory: "/tmp/build/some-guid",
+					}),
+				),
+			)
+		})
+
+		cp := func(args ...string) *gexec.Session {
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "cp"}, args...)...)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			return sess
+		}
+
+		tarball := func(name string, contents string) []byte {
+			buf := new(bytes.Buffer)
+
+			tarWriter := tar.NewWriter(buf)
+			err := tarWriter.WriteHeader(&tar.Header{
+				Name: name,
+				Mode: 0644,
+				Size: int64(len(contents)),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = tarWriter.Write([]byte(contents))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(tarWriter.Close()).To(Succeed())
+
+			return buf.Bytes()
+		}
+
+		Context("when neither path is within the container", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cp", "--handle", "container-id", "some-file", "some-dir")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("exactly one of the source and destination must be prefixed with 'container:'"))
+			})
+		})
+
+		Context("when the container does not exist", func() {
+			BeforeEach(func() {
+				containerStatusCode = http.StatusNotFound
+			})
+
+			It("errors", func() {
+				sess := cp("--handle", "container-id", "container:some-output", tmpDir)
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("no containers matched the given handle id!"))
+			})
+		})
+
+		Context("when copying out of the container", func() {
+			var statusCode int
+
+			BeforeEach(func() {
+				statusCode = http.StatusOK
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/"+teamName+"/containers/container-id/files", "path=%2Ftmp%2Fbuild%2Fsome-guid%2Fsome-output"),
+						ghttp.RespondWith(statusCode, tarball("some-output/some-file", "some-contents")),
+					),
+				)
+			})
+
+			It("extracts the path relative to the container's working directory into the destination", func() {
+				sess := cp("--handle", "container-id", "container:some-output", filepath.Join(tmpDir, "dest"))
+				Eventually(sess).Should(gexec.Exit(0))
+
+				contents, err := ioutil.ReadFile(filepath.Join(tmpDir, "dest", "some-output", "some-file"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-contents"))
+			})
+
+			It("writes the tarball to stdout when the destination is '-'", func() {
+				sess := cp("--handle", "container-id", "container:/tmp/build/some-guid/some-output", "-")
+				Eventually(sess).Should(gexec.Exit(0))
+
+				tarReader := tar.NewReader(bytes.NewReader(sess.Out.Contents()))
+				header, err := tarReader.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(header.Name).To(Equal("some-output/some-file"))
+			})
+
+			Context("when the team is specified", func() {
+				BeforeEach(func() {
+					teamName = "other"
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/other"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{Name: "other"}),
+						),
+					)
+				})
+
+				It("copies out of the team's container", func() {
+					sess := cp("--handle", "container-id", "--team", "other", "container:some-output", tmpDir)
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(filepath.Join(tmpDir, "some-output", "some-file")).To(BeAnExistingFile())
+				})
+			})
+
+			Context("when the user may not access the container", func() {
+				BeforeEach(func() {
+					statusCode = http.StatusForbidden
+				})
+
+				It("errors", func() {
+					sess := cp("--handle", "container-id", "container:some-output", tmpDir)
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("forbidden"))
+				})
+			})
+		})
+
+		Context("when copying into the container", func() {
+			var streamedIn chan *tar.Header
+
+			BeforeEach(func() {
+				streamedIn = make(chan *tar.Header, 10)
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/containers/container-id/files", "path=%2Ftmp%2Fbuild%2Fsome-guid%2Fsome-input"),
+						ghttp.VerifyHeaderKV("Content-Type", "application/x-tar"),
+						func(w http.ResponseWriter, r *http.Request) {
+							defer close(streamedIn)
+
+							tarReader := tar.NewReader(r.Body)
+							for {
+								header, err := tarReader.Next()
+								if err == io.EOF {
+									break
+								}
+
+								Expect(err).NotTo(HaveOccurred())
+								streamedIn <- header
+							}
+						},
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("streams in the source, named after its base name", func() {
+				source := filepath.Join(tmpDir, "some-source")
+				Expect(os.Mkdir(source, 0755)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(source, "some-file"), []byte("some-contents"), 0644)).To(Succeed())
+
+				sess := cp("--handle", "container-id", source, "container:some-input")
+				Eventually(sess).Should(gexec.Exit(0))
+
+				var names []string
+				for header := range streamedIn {
+					names = append(names, filepath.Clean(header.Name))
+				}
+
+				Expect(names).To(ContainElement("some-source/some-file"))
+			})
+
+			It("streams in a tarball from stdin when the source is '-'", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cp", "--handle", "container-id", "-", "container:/tmp/build/some-guid/some-input")
+				flyCmd.Stdin = bytes.NewReader(tarball("some-file", "some-contents"))
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				header := <-streamedIn
+				Expect(header.Name).To(Equal("some-file"))
+			})
+		})
+	})
+})
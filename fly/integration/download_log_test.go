@@ -0,0 +1,174 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "fly-build-log")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Describe("download-log", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "download-log", "-b", "3")
+		})
+
+		Context("when the log is returned from the API", func() {
+			var expectedQuery string
+
+			BeforeEach(func() {
+				expectedQuery = "format=text"
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/3/log", expectedQuery),
+						ghttp.RespondWith(200, "hello\nsucceeded\n"),
+					),
+				)
+			})
+
+			It("prints the log", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(string(sess.Out.Contents())).To(Equal("hello\nsucceeded\n"))
+			})
+
+			Context("when options are given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--format", "jsonl", "--steps", "--timestamps")
+					expectedQuery = "format=jsonl&steps=true&timestamps=true"
+				})
+
+				It("passes them to the API", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+
+			Context("when --output is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "-o", filepath.Join(tmpDir, "build.log"))
+				})
+
+				It("writes the log to the file", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					contents, err := ioutil.ReadFile(filepath.Join(tmpDir, "build.log"))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(contents)).To(Equal("hello\nsucceeded\n"))
+				})
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/3/log"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("exits 1", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+
+		Context("when the format is unknown", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--format", "xml")
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("format"))
+			})
+		})
+	})
+
+	Describe("watch --output", func() {
+		var (
+			flyCmd *exec.Cmd
+			status atc.BuildStatus
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "watch", "-b", "3", "-o", filepath.Join(tmpDir, "build.log"))
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/3/log", ""),
+					ghttp.RespondWith(200, "hello\n"+string(status)+"\n"),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/3"),
+					ghttp.RespondWithJSONEncoded(200, atc.Build{ID: 3, Status: string(status)}),
+				),
+			)
+		})
+
+		Context("when the build succeeds", func() {
+			BeforeEach(func() {
+				status = atc.StatusSucceeded
+			})
+
+			It("writes the log to the file and exits 0", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				contents, err := ioutil.ReadFile(filepath.Join(tmpDir, "build.log"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("hello\nsucceeded\n"))
+			})
+		})
+
+		Context("when the build fails", func() {
+			BeforeEach(func() {
+				status = atc.StatusFailed
+			})
+
+			It("exits 1", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
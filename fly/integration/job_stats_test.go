@@ -0,0 +1,217 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("job-stats", func() {
+		var (
+			apiPath         string
+			args            []string
+			expectedHeaders ui.TableRow
+		)
+
+		BeforeEach(func() {
+			apiPath = "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/stats"
+			args = []string{"-t", targetName, "job-stats", "-j", "some-pipeline/some-job"}
+
+			expectedHeaders = ui.TableRow{
+				{Contents: "window", Color: color.New(color.Bold)},
+				{Contents: "builds", Color: color.New(color.Bold)},
+				{Contents: "success rate", Color: color.New(color.Bold)},
+				{Contents: "failure rate", Color: color.New(color.Bold)},
+				{Contents: "error rate", Color: color.New(color.Bold)},
+				{Contents: "p50", Color: color.New(color.Bold)},
+				{Contents: "p90", Color: color.New(color.Bold)},
+				{Contents: "p99", Color: color.New(color.Bold)},
+				{Contents: "mttr", Color: color.New(color.Bold)},
+				{Contents: "flakiness", Color: color.New(color.Bold)},
+			}
+		})
+
+		Context("when the job has stats", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", apiPath, "window=168h0m0s"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.JobStats{
+							Window:      604800,
+							Builds:      10,
+							Succeeded:   6,
+							Failed:      4,
+							SuccessRate: 0.6,
+							FailureRate: 0.4,
+							Durations: atc.JobStatsDurations{
+								P50: 60,
+								P90: 120,
+								P99: 300,
+							},
+							Recoveries:         2,
+							MeanTimeToRecovery: 3600,
+							FlakyBuilds:        1,
+							Flakiness:          0.25,
+						}),
+					),
+				)
+			})
+
+			It("prints the stats of the last week", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: expectedHeaders,
+					Data: []ui.TableRow{
+						{
+							{Contents: "168h0m0s"},
+							{Contents: "10"},
+							{Contents: "60.0%", Color: ui.SucceededColor},
+							{Contents: "40.0%", Color: ui.FailedColor},
+							{Contents: "0.0%", Color: ui.ErroredColor},
+							{Contents: "1m0s"},
+							{Contents: "2m0s"},
+							{Contents: "5m0s"},
+							{Contents: "1h0m0s"},
+							{Contents: "25.0%"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when several windows are given", func() {
+			BeforeEach(func() {
+				args = append(args, "-w", "24h", "-w", "1h")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", apiPath, "window=24h0m0s"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.JobStats{
+							Window:      86400,
+							Builds:      1,
+							Succeeded:   1,
+							SuccessRate: 1,
+							Durations: atc.JobStatsDurations{
+								P50: 60,
+								P90: 60,
+								P99: 60,
+							},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", apiPath, "window=1h0m0s"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.JobStats{
+							Window: 3600,
+						}),
+					),
+				)
+			})
+
+			It("prints a row for each window", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: expectedHeaders,
+					Data: []ui.TableRow{
+						{
+							{Contents: "24h0m0s"},
+							{Contents: "1"},
+							{Contents: "100.0%", Color: ui.SucceededColor},
+							{Contents: "0.0%", Color: ui.FailedColor},
+							{Contents: "0.0%", Color: ui.ErroredColor},
+							{Contents: "1m0s"},
+							{Contents: "1m0s"},
+							{Contents: "1m0s"},
+							{Contents: "n/a", Color: ui.OffColor},
+							{Contents: "n/a", Color: ui.OffColor},
+						},
+						{
+							{Contents: "1h0m0s"},
+							{Contents: "0"},
+							{Contents: "n/a", Color: ui.OffColor},
+							{Contents: "n/a", Color: ui.OffColor},
+							{Contents: "n/a", Color: ui.OffColor},
+							{Contents: "n/a", Color: ui.OffColor},
+							{Contents: "n/a", Color: ui.OffColor},
+							{Contents: "n/a", Color: ui.OffColor},
+							{Contents: "n/a", Color: ui.OffColor},
+							{Contents: "n/a", Color: ui.OffColor},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				args = append(args, "--json")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", apiPath, "window=168h0m0s"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.JobStats{
+							Window:    604800,
+							Builds:    2,
+							Succeeded: 2,
+						}),
+					),
+				)
+			})
+
+			It("prints the stats as json", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out.Contents()).To(MatchJSON(`[{
+					"window": 604800,
+					"builds": 2,
+					"succeeded": 2,
+					"failed": 0,
+					"errored": 0,
+					"aborted": 0,
+					"success_rate": 0,
+					"failure_rate": 0,
+					"error_rate": 0,
+					"durations": {"p50": 0, "p90": 0, "p99": 0},
+					"recoveries": 0,
+					"flaky_builds": 0,
+					"flakiness": 0
+				}]`))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", apiPath),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("some-pipeline/some-job not found on team main"))
+			})
+		})
+	})
+})
@@ -0,0 +1,203 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os/exec"
+	"regexp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/gorilla/websocket"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("port-forward", func() {
+		var (
+			containerStatusCode int
+			sess                *gexec.Session
+		)
+
+		BeforeEach(func() {
+			containerStatusCode = http.StatusOK
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/containers/container-id"),
+					ghttp.RespondWithJSONEncoded(containerStatusCode, atc.Container{
+						ID: "container-id",
+					}),
+				),
+			)
+		})
+
+		AfterEach(func() {
+			if sess != nil {
+				sess.Kill().Wait()
+			}
+		})
+
+		portForward := func(args ...string) *gexec.Session {
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "port-forward"}, args...)...)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			return sess
+		}
+
+		localAddr := func(sess *gexec.Session) string {
+			Eventually(sess).Should(gbytes.Say(`forwarding 127\.0\.0\.1:\d+ to port 80 of container container-id`))
+
+			matches := regexp.MustCompile(`forwarding (127\.0\.0\.1:\d+)`).FindSubmatch(sess.Out.Contents())
+			Expect(matches).To(HaveLen(2))
+
+			return string(matches[1])
+		}
+
+		Context("when the ports are invalid", func() {
+			It("errors", func() {
+				sess = portForward("--handle", "container-id", "80")
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("ports must be given as LOCAL_PORT:CONTAINER_PORT, got '80'"))
+			})
+		})
+
+		Context("when the container does not exist", func() {
+			BeforeEach(func() {
+				containerStatusCode = http.StatusNotFound
+			})
+
+			It("errors", func() {
+				sess = portForward("--handle", "container-id", "0:80")
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("no containers matched the given handle id!"))
+			})
+		})
+
+		Context("when the container exists", func() {
+			var (
+				upgrader    websocket.Upgrader
+				closeCode   int
+				closeReason string
+				expectEOF   bool
+			)
+
+			BeforeEach(func() {
+				closeCode = websocket.CloseNormalClosure
+				closeReason = ""
+				expectEOF = false
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/containers/container-id/port-forward", "port=80"),
+						func(w http.ResponseWriter, r *http.Request) {
+							defer GinkgoRecover()
+
+							conn, err := upgrader.Upgrade(w, r, nil)
+							Expect(err).NotTo(HaveOccurred())
+
+							defer conn.Close()
+
+							messageType, data, err := conn.ReadMessage()
+							Expect(err).NotTo(HaveOccurred())
+							Expect(messageType).To(Equal(websocket.BinaryMessage))
+							Expect(string(data)).To(Equal("some-request"))
+
+							if expectEOF {
+								messageType, data, err = conn.ReadMessage()
+								Expect(err).NotTo(HaveOccurred())
+								Expect(messageType).To(Equal(websocket.TextMessage))
+								Expect(string(data)).To(Equal(atc.ContainerPortForwardEOF))
+							}
+
+							err = conn.WriteMessage(websocket.BinaryMessage, []byte("some-response"))
+							Expect(err).NotTo(HaveOccurred())
+
+							err = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(closeCode, closeReason))
+							Expect(err).NotTo(HaveOccurred())
+						},
+					),
+				)
+			})
+
+			It("forwards connections to the local port to the port within the container", func() {
+				sess = portForward("--handle", "container-id", "0:80")
+
+				conn, err := net.Dial("tcp", localAddr(sess))
+				Expect(err).NotTo(HaveOccurred())
+
+				defer conn.Close()
+
+				_, err = conn.Write([]byte("some-request"))
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err := ioutil.ReadAll(conn)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(response)).To(Equal("some-response"))
+
+				Consistently(sess).ShouldNot(gexec.Exit())
+			})
+
+			Context("when the local connection is closed for writing", func() {
+				BeforeEach(func() {
+					expectEOF = true
+				})
+
+				It("says so and still forwards the response", func() {
+					sess = portForward("--handle", "container-id", "0:80")
+
+					conn, err := net.Dial("tcp", localAddr(sess))
+					Expect(err).NotTo(HaveOccurred())
+
+					defer conn.Close()
+
+					_, err = conn.Write([]byte("some-request"))
+					Expect(err).NotTo(HaveOccurred())
+
+					err = conn.(*net.TCPConn).CloseWrite()
+					Expect(err).NotTo(HaveOccurred())
+
+					response, err := ioutil.ReadAll(conn)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(response)).To(Equal("some-response"))
+				})
+			})
+
+			Context("when forwarding fails within the container", func() {
+				BeforeEach(func() {
+					closeCode = websocket.CloseInternalServerErr
+					closeReason = "the image has none of socat, bash or nc to forward the port with"
+				})
+
+				It("prints the error and keeps listening", func() {
+					sess = portForward("--handle", "container-id", "0:80")
+
+					conn, err := net.Dial("tcp", localAddr(sess))
+					Expect(err).NotTo(HaveOccurred())
+
+					defer conn.Close()
+
+					_, err = conn.Write([]byte("some-request"))
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say("failed to forward connection: the image has none of socat, bash or nc to forward the port with"))
+					Consistently(sess).ShouldNot(gexec.Exit())
+				})
+			})
+		})
+	})
+})
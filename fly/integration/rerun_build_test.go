@@ -0,0 +1,93 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("rerun-build", func() {
+		var path string
+
+		BeforeEach(func() {
+			var err error
+			path, err = atc.Routes.CreatePathForRoute(atc.RerunJobBuild, rata.Params{
+				"team_name":     "main",
+				"pipeline_name": "awesome-pipeline",
+				"job_name":      "awesome-job",
+				"build_name":    "42",
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when rerunning the whole build", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", path, ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 57, Name: "42.1"}),
+					),
+				)
+			})
+
+			It("starts the rerun build", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rerun-build", "-j", "awesome-pipeline/awesome-job", "-b", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say(`started awesome-pipeline/awesome-job #42.1`))
+			})
+		})
+
+		Context("when --from-step is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", path, "from_step=unit-tests"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 57, Name: "42.1", RerunFromStep: "unit-tests"}),
+					),
+				)
+			})
+
+			It("starts the rerun build from the step", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rerun-build", "-j", "awesome-pipeline/awesome-job", "-b", "42", "--from-step", "unit-tests")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say(`started awesome-pipeline/awesome-job #42.1`))
+			})
+		})
+
+		Context("when the job has no such step", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", path, "from_step=bogus"),
+						ghttp.RespondWith(http.StatusBadRequest, nil),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rerun-build", "-j", "awesome-pipeline/awesome-job", "-b", "42", "--from-step", "bogus")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
@@ -35,11 +35,12 @@ var _ = Describe("Fly CLI", func() {
 		})
 
 		Context("when resources are returned from the API", func() {
-			createResource := func(num int, pinnedVersion atc.Version, resourceType string) atc.Resource {
+			createResource := func(num int, pinnedVersion atc.Version, resourceType string, checkFailures int) atc.Resource {
 				return atc.Resource{
 					Name:          fmt.Sprintf("resource-%d", num),
 					PinnedVersion: pinnedVersion,
 					Type:          resourceType,
+					CheckFailures: checkFailures,
 				}
 			}
 
@@ -50,8 +51,8 @@ var _ = Describe("Fly CLI", func() {
 					ghttp.CombineHandlers(
 						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources"),
 						ghttp.RespondWithJSONEncoded(200, []atc.Resource{
-							createResource(1, nil, "time"),
-							createResource(2, atc.Version{"some": "version"}, "custom"),
+							createResource(1, nil, "time", 0),
+							createResource(2, atc.Version{"some": "version"}, "custom", 3),
 						}),
 					),
 				)
@@ -79,7 +80,8 @@ var _ = Describe("Fly CLI", func() {
                 "pipeline_name": "",
                 "team_name": "",
                 "type": "custom",
-								"pinned_version": {"some": "version"}
+								"pinned_version": {"some": "version"},
+                "check_failures": 3
               }
             ]`))
 				})
@@ -92,8 +94,8 @@ var _ = Describe("Fly CLI", func() {
 
 				Expect(sess.Out).To(PrintTable(ui.Table{
 					Data: []ui.TableRow{
-						{{Contents: "resource-1"}, {Contents: "time"}, {Contents: "n/a"}},
-						{{Contents: "resource-2"}, {Contents: "custom"}, {Contents: "some:version", Color: color.New(color.FgCyan)}},
+						{{Contents: "resource-1"}, {Contents: "time"}, {Contents: "n/a"}, {Contents: "0"}},
+						{{Contents: "resource-2"}, {Contents: "custom"}, {Contents: "some:version", Color: color.New(color.FgCyan)}, {Contents: "3", Color: color.New(color.FgRed)}},
 					},
 				}))
 			})
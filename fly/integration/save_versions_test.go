@@ -0,0 +1,122 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("SaveVersions", func() {
+	var (
+		flyCmd      *exec.Cmd
+		expectedURL = "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/versions"
+	)
+
+	Context("when a single version is given", func() {
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "save-versions", "-r", "mypipeline/myresource", "-v", "ref:abcd", "-v", "branch:main", "-m", "author:someone")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.VerifyJSON(`{"versions":[{"version":{"ref":"abcd","branch":"main"},"metadata":[{"name":"author","value":"someone"}]}]}`),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+		})
+
+		It("saves the version", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("saved 1 version\\(s\\) of 'myresource'"))
+		})
+	})
+
+	Context("when a versions file is given", func() {
+		var tmpdir string
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-save-versions")
+			Expect(err).NotTo(HaveOccurred())
+
+			versionsFile := filepath.Join(tmpdir, "versions.yml")
+			err = ioutil.WriteFile(versionsFile, []byte(`
+- version: {ref: v1}
+- version: {ref: v2}
+  metadata:
+  - name: author
+    value: someone
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			flyCmd = exec.Command(flyPath, "-t", targetName, "save-versions", "-r", "mypipeline/myresource", "-f", versionsFile)
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.VerifyJSON(`{"versions":[{"version":{"ref":"v1"}},{"version":{"ref":"v2"},"metadata":[{"name":"author","value":"someone"}]}]}`),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		It("saves all of the versions", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("saved 2 version\\(s\\) of 'myresource'"))
+		})
+	})
+
+	Context("when the resource does not exist", func() {
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "save-versions", "-r", "mypipeline/myresource", "-v", "ref:abcd")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("pipeline 'mypipeline' or resource 'myresource' not found"))
+		})
+	})
+
+	Context("when no version is given", func() {
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "save-versions", "-r", "mypipeline/myresource")
+		})
+
+		It("errors", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("either --version or --versions-file must be specified"))
+		})
+	})
+})
@@ -0,0 +1,153 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("search-logs", func() {
+		var (
+			flyCmd  *exec.Cmd
+			matches []atc.BuildLogMatch
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "search-logs", "connection refused")
+
+			matches = []atc.BuildLogMatch{
+				{
+					BuildID:      42,
+					BuildName:    "3",
+					TeamName:     "main",
+					PipelineName: "some-pipeline",
+					JobName:      "some-job",
+					Lines:        []string{"dial tcp: connection refused", "retrying: connection refused"},
+				},
+				{
+					BuildID:  7,
+					TeamName: "main",
+					Lines:    []string{"connection refused"},
+				},
+			}
+		})
+
+		Context("when matches are returned from the API", func() {
+			var expectedQuery string
+
+			BeforeEach(func() {
+				expectedQuery = "limit=100&q=connection+refused"
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/build-logs", expectedQuery),
+						ghttp.RespondWithJSONEncoded(200, matches),
+					),
+				)
+			})
+
+			It("lists every matching line", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "pipeline/job", Color: color.New(color.Bold)},
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "line", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "42"}, {Contents: "some-pipeline/some-job"}, {Contents: "3"}, {Contents: "dial tcp: connection refused"}},
+						{{Contents: "42"}, {Contents: "some-pipeline/some-job"}, {Contents: "3"}, {Contents: "retrying: connection refused"}},
+						{{Contents: "7"}, {Contents: "one-off"}, {Contents: "n/a"}, {Contents: "connection refused"}},
+					},
+				}))
+			})
+
+			Context("when filtering by job and count", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "-j", "some-pipeline/some-job", "-c", "5")
+					expectedQuery = "job_name=some-job&limit=5&pipeline_name=some-pipeline&q=connection+refused"
+				})
+
+				It("passes the filters to the API", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"build_id": 42,
+							"build_name": "3",
+							"team_name": "main",
+							"pipeline_name": "some-pipeline",
+							"job_name": "some-job",
+							"lines": ["dial tcp: connection refused", "retrying: connection refused"]
+						},
+						{
+							"build_id": 7,
+							"build_name": "",
+							"team_name": "main",
+							"lines": ["connection refused"]
+						}
+					]`))
+				})
+			})
+		})
+
+		Context("when both --pipeline and --job are given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "-p", "some-pipeline", "-j", "some-pipeline/some-job")
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("Cannot specify both --pipeline and --job"))
+			})
+		})
+
+		Context("when the API returns an error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/build-logs"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("exits 1", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
@@ -717,6 +717,160 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("network", func() {
+			Context("when restricting the network to an allowlist", func() {
+				BeforeEach(func() {
+					cmdParams = []string{
+						"--local-user", "brock-obama",
+						"--network-allow", "10.0.0.0/8",
+						"--network-allow", "github.com",
+						"--lock-network",
+					}
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+							ghttp.VerifyJSON(`{
+								"auth": {
+									"owner":{
+										"users": ["local:brock-obama"],
+										"groups": []
+									}
+								},
+								"network": {
+									"default": {"allow": ["10.0.0.0/8", "github.com"]},
+									"locked": true
+								}
+							}`),
+							ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Team{
+								Name: "venture",
+								ID:   8,
+							}),
+						),
+					)
+				})
+
+				It("shows and sends the network", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("network:"))
+					Eventually(sess.Out).Should(gbytes.Say("allow:"))
+					Eventually(sess.Out).Should(gbytes.Say("- 10.0.0.0/8"))
+					Eventually(sess.Out).Should(gbytes.Say("- github.com"))
+					Eventually(sess.Out).Should(gbytes.Say("locked"))
+
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+
+			Context("when both a network mode and an allowlist are given", func() {
+				BeforeEach(func() {
+					cmdParams = []string{
+						"--local-user", "brock-obama",
+						"--network", "none",
+						"--network-allow", "10.0.0.0/8",
+					}
+				})
+
+				It("returns an error", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+					Eventually(sess.Err).Should(gbytes.Say("--network and --network-allow cannot be used together"))
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+
+			Context("when the network mode is unknown", func() {
+				BeforeEach(func() {
+					cmdParams = []string{
+						"--local-user", "brock-obama",
+						"--network", "bridge",
+					}
+				})
+
+				It("returns an error", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+					Eventually(sess.Err).Should(gbytes.Say("unknown network mode 'bridge'"))
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+		})
+
+		Describe("caches", func() {
+			Context("when declaring caches", func() {
+				BeforeEach(func() {
+					cmdParams = []string{
+						"--local-user", "brock-obama",
+						"--cache", "go-mod:10GB",
+						"--cache", "npm",
+					}
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+							ghttp.VerifyJSON(`{
+								"auth": {
+									"owner":{
+										"users": ["local:brock-obama"],
+										"groups": []
+									}
+								},
+								"caches": [
+									{"name": "go-mod", "size": "10GB"},
+									{"name": "npm"}
+								]
+							}`),
+							ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Team{
+								Name: "venture",
+								ID:   8,
+							}),
+						),
+					)
+				})
+
+				It("shows and sends the caches", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("caches:"))
+					Eventually(sess.Out).Should(gbytes.Say(`- go-mod \(10GB\)`))
+					Eventually(sess.Out).Should(gbytes.Say("- npm"))
+
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+
+			Context("when the size of a cache cannot be parsed", func() {
+				BeforeEach(func() {
+					cmdParams = []string{
+						"--local-user", "brock-obama",
+						"--cache", "go-mod:lots",
+					}
+				})
+
+				It("returns an error", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+					Eventually(sess.Err).Should(gbytes.Say("could not parse cache size 'lots'"))
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"--local-user", "brock-obama"}
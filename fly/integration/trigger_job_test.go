@@ -98,6 +98,66 @@ var _ = Describe("Fly CLI", func() {
 					})
 				})
 
+				Context("when --break-at is provided", func() {
+					BeforeEach(func() {
+						atcServer.AppendHandlers(
+							ghttp.CombineHandlers(
+								ghttp.VerifyRequest("POST", mainPath, "break_at=some-get&break_at=some-task"),
+								ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 57, Name: "42"}),
+							),
+						)
+					})
+
+					It("starts the build with the breakpoints", func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "trigger-job", "-j", "awesome-pipeline/awesome-job", "--break-at", "some-task", "--break-at", "some-get")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess).Should(gbytes.Say(`started awesome-pipeline/awesome-job #42`))
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+					})
+				})
+
+				Context("when --break-before is provided", func() {
+					BeforeEach(func() {
+						atcServer.AppendHandlers(
+							ghttp.CombineHandlers(
+								ghttp.VerifyRequest("POST", mainPath, "break_at=some-task&break_before=some-get"),
+								ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 57, Name: "42"}),
+							),
+						)
+					})
+
+					It("starts the build with the breakpoints", func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "trigger-job", "-j", "awesome-pipeline/awesome-job", "--break-at", "some-task", "--break-before", "some-get")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess).Should(gbytes.Say(`started awesome-pipeline/awesome-job #42`))
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+					})
+
+					Context("when the same step is given to --break-at", func() {
+						It("errors without starting the build", func() {
+							flyCmd := exec.Command(flyPath, "-t", targetName, "trigger-job", "-j", "awesome-pipeline/awesome-job", "--break-at", "some-get", "--break-before", "some-get")
+
+							sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+							Expect(err).NotTo(HaveOccurred())
+
+							Eventually(sess.Err).Should(gbytes.Say(`cannot break both before and after step 'some-get'`))
+
+							<-sess.Exited
+							Expect(sess.ExitCode()).To(Equal(1))
+						})
+					})
+				})
+
 				Context("when -w option is provided", func() {
 					var streaming chan struct{}
 					var events chan atc.Event
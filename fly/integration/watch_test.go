@@ -239,4 +239,77 @@ var _ = Describe("Watching", func() {
 			})
 		})
 	})
+
+	Context("with --team", func() {
+		var streamed chan struct{}
+
+		BeforeEach(func() {
+			streamed = make(chan struct{})
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/other-team"),
+					ghttp.RespondWithJSONEncoded(200, atc.Team{Name: "other-team"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/other-team/firehose", "last_event_id=41"),
+					func(w http.ResponseWriter, r *http.Request) {
+						flusher := w.(http.Flusher)
+
+						w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+						w.WriteHeader(http.StatusOK)
+
+						for _, e := range []atc.BuildLifecycleEvent{
+							{ID: 42, Type: atc.BuildStarted, Status: atc.StatusStarted, BuildID: 3, BuildName: "7", TeamName: "other-team", PipelineName: "some-pipeline", JobName: "some-job"},
+							{ID: 43, Type: atc.BuildFinished, Status: atc.StatusSucceeded, BuildID: 4, BuildName: "2", TeamName: "other-team"},
+						} {
+							payload, err := json.Marshal(e)
+							Expect(err).NotTo(HaveOccurred())
+
+							err = sse.Event{
+								ID:   fmt.Sprintf("%d", e.ID),
+								Name: "event",
+								Data: payload,
+							}.Write(w)
+							Expect(err).NotTo(HaveOccurred())
+
+							flusher.Flush()
+						}
+
+						<-streamed
+					},
+				),
+			)
+		})
+
+		AfterEach(func() {
+			close(streamed)
+		})
+
+		It("streams the lifecycle of the team's builds", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--team", "other-team", "--after", "41")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Out).Should(gbytes.Say(`started\s+other-team/some-pipeline/some-job #7 \(build 3\)\s+started`))
+			Eventually(sess.Out).Should(gbytes.Say(`finished\s+other-team/one-off #2 \(build 4\)\s+succeeded`))
+
+			sess.Kill()
+			<-sess.Exited
+		})
+	})
+
+	Context("with --team and a build", func() {
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--team", "other-team", "--build", "3")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("--team and --all-teams cannot be combined"))
+		})
+	})
 })
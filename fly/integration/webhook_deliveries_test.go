@@ -0,0 +1,109 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("webhook-deliveries", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "webhook-deliveries", "-r", "pipeline/foo")
+		})
+
+		Context("when deliveries are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/webhook/deliveries", "limit=25"),
+						ghttp.RespondWithJSONEncoded(200, []atc.WebhookDelivery{
+							{ID: 2, DeliveredAt: 1591025749, Outcome: atc.WebhookDeliveryAccepted, CheckID: 42},
+							{ID: 1, DeliveredAt: 1591025700, Outcome: atc.WebhookDeliveryRejected, Message: "invalid webhook signature"},
+						}),
+					),
+				)
+			})
+
+			It("lists the deliveries", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "delivered at", Color: color.New(color.Bold)},
+						{Contents: "outcome", Color: color.New(color.Bold)},
+						{Contents: "check", Color: color.New(color.Bold)},
+						{Contents: "message", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "2"},
+							{Contents: time.Unix(1591025749, 0).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "accepted", Color: color.New(color.FgGreen)},
+							{Contents: "42"},
+							{Contents: ""},
+						},
+						{
+							{Contents: "1"},
+							{Contents: time.Unix(1591025700, 0).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "rejected", Color: color.New(color.FgRed)},
+							{Contents: "n/a", Color: color.New(color.Faint)},
+							{Contents: "invalid webhook signature"},
+						},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{"id": 2, "delivered_at": 1591025749, "outcome": "accepted", "check_id": 42},
+						{"id": 1, "delivered_at": 1591025700, "outcome": "rejected", "message": "invalid webhook signature"}
+					]`))
+				})
+			})
+		})
+
+		Context("when the resource does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/webhook/deliveries"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("resource 'foo' not found"))
+			})
+		})
+	})
+})
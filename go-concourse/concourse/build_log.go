@@ -0,0 +1,55 @@
+package concourse
+
+import (
+	"io"
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+type BuildLogOptions struct {
+	Format     atc.BuildLogFormat
+	Timestamps bool
+	Steps      bool
+}
+
+func (options BuildLogOptions) QueryParams() url.Values {
+	queryParams := url.Values{}
+
+	if options.Format != "" {
+		queryParams.Add(atc.BuildLogQueryFormat, string(options.Format))
+	}
+
+	if options.Timestamps {
+		queryParams.Add(atc.BuildLogQueryTimestamps, "true")
+	}
+
+	if options.Steps {
+		queryParams.Add(atc.BuildLogQuerySteps, "true")
+	}
+
+	return queryParams
+}
+
+// BuildLog returns the rendered log of a build. For a running build the log
+// keeps streaming until the build finishes.
+func (client *client) BuildLog(buildID string, options BuildLogOptions) (io.ReadCloser, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	response := internal.Response{}
+	err := client.connection.Send(internal.Request{
+		RequestName:        atc.GetBuildLog,
+		Params:             params,
+		Query:              options.QueryParams(),
+		ReturnResponseBody: true,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Result.(io.ReadCloser), nil
+}
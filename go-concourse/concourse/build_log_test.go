@@ -0,0 +1,60 @@
+package concourse_test
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("BuildLog", func() {
+	var expectedURL = "/api/v1/builds/123/log"
+
+	Context("when ATC request succeeds", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, "format=jsonl&steps=true&timestamps=true"),
+					ghttp.RespondWith(http.StatusOK, `{"type":"status","message":"succeeded"}`),
+				),
+			)
+		})
+
+		It("returns the log", func() {
+			log, err := client.BuildLog("123", concourse.BuildLogOptions{
+				Format:     atc.BuildLogFormatJSONLines,
+				Timestamps: true,
+				Steps:      true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			defer log.Close()
+
+			contents, err := ioutil.ReadAll(log)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal(`{"type":"status","message":"succeeded"}`))
+		})
+	})
+
+	Context("when the build does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, ""),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("returns an error", func() {
+			_, err := client.BuildLog("123", concourse.BuildLogOptions{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -53,6 +55,38 @@ func (team *team) CreateJobBuild(pipelineName string, jobName string) (atc.Build
 	return build, err
 }
 
+func (team *team) CreateJobBuildWithBreakpoints(pipelineName string, jobName string, breakpoints map[string]atc.Breakpoint) (atc.Build, error) {
+	query := url.Values{}
+	for step, breakpoint := range breakpoints {
+		if breakpoint == atc.BreakpointBefore {
+			query.Add(atc.CreateBuildQueryBreakBefore, step)
+		} else {
+			query.Add(atc.CreateBuildQueryBreakAt, step)
+		}
+	}
+
+	for _, steps := range query {
+		sort.Strings(steps)
+	}
+
+	params := rata.Params{
+		"job_name":      jobName,
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	var build atc.Build
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.CreateJobBuild,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &build,
+	})
+
+	return build, err
+}
+
 func (team *team) RerunJobBuild(pipelineName string, jobName string, buildName string) (atc.Build, error) {
 	params := rata.Params{
 		"build_name":    buildName,
@@ -72,6 +106,26 @@ func (team *team) RerunJobBuild(pipelineName string, jobName string, buildName s
 	return build, err
 }
 
+func (team *team) RerunJobBuildFromStep(pipelineName string, jobName string, buildName string, stepName string) (atc.Build, error) {
+	params := rata.Params{
+		"build_name":    buildName,
+		"job_name":      jobName,
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	var build atc.Build
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RerunJobBuild,
+		Params:      params,
+		Query:       url.Values{atc.RerunBuildQueryFromStep: {stepName}},
+	}, &internal.Response{
+		Result: &build,
+	})
+
+	return build, err
+}
+
 func (team *team) JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error) {
 	params := rata.Params{
 		"job_name":      jobName,
@@ -157,6 +211,39 @@ func (client *client) AbortBuild(buildID string) error {
 	}, nil)
 }
 
+func (client *client) HoldBuild(buildID string) error {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	return client.connection.Send(internal.Request{
+		RequestName: atc.HoldBuild,
+		Params:      params,
+	}, nil)
+}
+
+func (client *client) ReleaseBuild(buildID string) error {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	return client.connection.Send(internal.Request{
+		RequestName: atc.ReleaseBuild,
+		Params:      params,
+	}, nil)
+}
+
+func (client *client) ResumeBuild(buildID string) error {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	return client.connection.Send(internal.Request{
+		RequestName: atc.ResumeBuild,
+		Params:      params,
+	}, nil)
+}
+
 func (team *team) Builds(page Page) ([]atc.Build, Pagination, error) {
 	var builds []atc.Build
 
@@ -133,6 +133,67 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("CreateJobBuildWithBreakpoints", func() {
+		var expectedBuild atc.Build
+
+		BeforeEach(func() {
+			expectedBuild = atc.Build{
+				ID:      123,
+				Name:    "mybuild",
+				Status:  "pending",
+				JobName: "myjob",
+				APIURL:  "api/v1/builds/123",
+			}
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL, "break_at=myput&break_at=mytask&break_before=myget"),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedBuild),
+				),
+			)
+		})
+
+		It("creates a build which pauses before or after the steps", func() {
+			build, err := team.CreateJobBuildWithBreakpoints("mypipeline", "myjob", map[string]atc.Breakpoint{
+				"myput":  atc.BreakpointAfter,
+				"mytask": atc.BreakpointAfter,
+				"myget":  atc.BreakpointBefore,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build).To(Equal(expectedBuild))
+		})
+	})
+
+	Describe("RerunJobBuildFromStep", func() {
+		var expectedBuild atc.Build
+
+		BeforeEach(func() {
+			expectedBuild = atc.Build{
+				ID:            123,
+				Name:          "myrerunbuild",
+				Status:        "pending",
+				JobName:       "myjob",
+				APIURL:        "api/v1/builds/123",
+				RerunFromStep: "mytask",
+			}
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/mybuild"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL, "from_step=mytask"),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedBuild),
+				),
+			)
+		})
+
+		It("creates a rerun of the build from the step", func() {
+			build, err := team.RerunJobBuildFromStep("mypipeline", "myjob", "mybuild", "mytask")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build).To(Equal(expectedBuild))
+		})
+	})
+
 	Describe("JobBuild", func() {
 		var (
 			expectedBuild atc.Build
@@ -377,6 +438,50 @@ var _ = Describe("ATC Handler Builds", func() {
 			})
 		})
 
+		Context("when a filter is specified", func() {
+			BeforeEach(func() {
+				manuallyTriggered := false
+
+				page = concourse.Page{
+					Limit: 5,
+					Filter: concourse.BuildFilter{
+						Statuses:          []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored},
+						PipelineName:      "some-pipeline",
+						JobName:           "some-job",
+						ManuallyTriggered: &manuallyTriggered,
+						CreatedBy:         "some-user",
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "limit=5&status=failed&status=errored&pipeline_name=some-pipeline&job_name=some-job&manually_triggered=false&created_by=some-user"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuilds, http.Header{
+							"Link": []string{
+								`<http://some-url.com/api/v1/builds?until=254&limit=5&pipeline_name=some-pipeline&status=failed>; rel="next"`,
+							},
+						}),
+					),
+				)
+			})
+
+			It("sends the filter", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(builds).To(Equal(expectedBuilds))
+			})
+
+			It("keeps filtering on the next page", func() {
+				Expect(pagination.Next).To(Equal(&concourse.Page{
+					Until: 254,
+					Limit: 5,
+					Filter: concourse.BuildFilter{
+						Statuses:     []atc.BuildStatus{atc.StatusFailed},
+						PipelineName: "some-pipeline",
+					},
+				}))
+			})
+		})
+
 		Context("when the server returns an error", func() {
 			BeforeEach(func() {
 				atcServer.AppendHandlers(
@@ -460,6 +565,57 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("HoldBuild", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/123/hold"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("sends a hold request to ATC", func() {
+			err := client.HoldBuild("123")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("ReleaseBuild", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/123/release"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("sends a release request to ATC", func() {
+			err := client.ReleaseBuild("123")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("ResumeBuild", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/123/resume"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("sends a resume request to ATC", func() {
+			err := client.ResumeBuild("123")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
 	Describe("team.Builds", func() {
 		expectedURL := "/api/v1/teams/some-team/builds"
 
@@ -17,9 +17,14 @@ type Client interface {
 	Builds(Page) ([]atc.Build, Pagination, error)
 	Build(buildID string) (atc.Build, bool, error)
 	BuildEvents(buildID string) (Events, error)
+	Firehose(lastEventID string) (BuildLifecycleEvents, error)
+	BuildLog(buildID string, options BuildLogOptions) (io.ReadCloser, error)
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
 	AbortBuild(buildID string) error
+	HoldBuild(buildID string) error
+	ReleaseBuild(buildID string) error
+	ResumeBuild(buildID string) error
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
@@ -51,6 +51,20 @@ type FakeClient struct {
 		result1 concourse.Events
 		result2 error
 	}
+	BuildLogStub        func(string, concourse.BuildLogOptions) (io.ReadCloser, error)
+	buildLogMutex       sync.RWMutex
+	buildLogArgsForCall []struct {
+		arg1 string
+		arg2 concourse.BuildLogOptions
+	}
+	buildLogReturns struct {
+		result1 io.ReadCloser
+		result2 error
+	}
+	buildLogReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 error
+	}
 	BuildPlanStub        func(int) (atc.PublicBuildPlan, bool, error)
 	buildPlanMutex       sync.RWMutex
 	buildPlanArgsForCall []struct {
@@ -124,6 +138,19 @@ type FakeClient struct {
 		result1 concourse.Team
 		result2 error
 	}
+	FirehoseStub        func(string) (concourse.BuildLifecycleEvents, error)
+	firehoseMutex       sync.RWMutex
+	firehoseArgsForCall []struct {
+		arg1 string
+	}
+	firehoseReturns struct {
+		result1 concourse.BuildLifecycleEvents
+		result2 error
+	}
+	firehoseReturnsOnCall map[int]struct {
+		result1 concourse.BuildLifecycleEvents
+		result2 error
+	}
 	GetCLIReaderStub        func(string, string) (io.ReadCloser, http.Header, error)
 	getCLIReaderMutex       sync.RWMutex
 	getCLIReaderArgsForCall []struct {
@@ -162,6 +189,17 @@ type FakeClient struct {
 	hTTPClientReturnsOnCall map[int]struct {
 		result1 *http.Client
 	}
+	HoldBuildStub        func(string) error
+	holdBuildMutex       sync.RWMutex
+	holdBuildArgsForCall []struct {
+		arg1 string
+	}
+	holdBuildReturns struct {
+		result1 error
+	}
+	holdBuildReturnsOnCall map[int]struct {
+		result1 error
+	}
 	LandWorkerStub        func(string) error
 	landWorkerMutex       sync.RWMutex
 	landWorkerArgsForCall []struct {
@@ -246,6 +284,28 @@ type FakeClient struct {
 	pruneWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReleaseBuildStub        func(string) error
+	releaseBuildMutex       sync.RWMutex
+	releaseBuildArgsForCall []struct {
+		arg1 string
+	}
+	releaseBuildReturns struct {
+		result1 error
+	}
+	releaseBuildReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ResumeBuildStub        func(string) error
+	resumeBuildMutex       sync.RWMutex
+	resumeBuildArgsForCall []struct {
+		arg1 string
+	}
+	resumeBuildReturns struct {
+		result1 error
+	}
+	resumeBuildReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SaveWorkerStub        func(atc.Worker, *time.Duration) (*atc.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -486,6 +546,70 @@ func (fake *FakeClient) BuildEventsReturnsOnCall(i int, result1 concourse.Events
 	}{result1, result2}
 }
 
+func (fake *FakeClient) BuildLog(arg1 string, arg2 concourse.BuildLogOptions) (io.ReadCloser, error) {
+	fake.buildLogMutex.Lock()
+	ret, specificReturn := fake.buildLogReturnsOnCall[len(fake.buildLogArgsForCall)]
+	fake.buildLogArgsForCall = append(fake.buildLogArgsForCall, struct {
+		arg1 string
+		arg2 concourse.BuildLogOptions
+	}{arg1, arg2})
+	fake.recordInvocation("BuildLog", []interface{}{arg1, arg2})
+	fake.buildLogMutex.Unlock()
+	if fake.BuildLogStub != nil {
+		return fake.BuildLogStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.buildLogReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) BuildLogCallCount() int {
+	fake.buildLogMutex.RLock()
+	defer fake.buildLogMutex.RUnlock()
+	return len(fake.buildLogArgsForCall)
+}
+
+func (fake *FakeClient) BuildLogCalls(stub func(string, concourse.BuildLogOptions) (io.ReadCloser, error)) {
+	fake.buildLogMutex.Lock()
+	defer fake.buildLogMutex.Unlock()
+	fake.BuildLogStub = stub
+}
+
+func (fake *FakeClient) BuildLogArgsForCall(i int) (string, concourse.BuildLogOptions) {
+	fake.buildLogMutex.RLock()
+	defer fake.buildLogMutex.RUnlock()
+	argsForCall := fake.buildLogArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) BuildLogReturns(result1 io.ReadCloser, result2 error) {
+	fake.buildLogMutex.Lock()
+	defer fake.buildLogMutex.Unlock()
+	fake.BuildLogStub = nil
+	fake.buildLogReturns = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) BuildLogReturnsOnCall(i int, result1 io.ReadCloser, result2 error) {
+	fake.buildLogMutex.Lock()
+	defer fake.buildLogMutex.Unlock()
+	fake.BuildLogStub = nil
+	if fake.buildLogReturnsOnCall == nil {
+		fake.buildLogReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 error
+		})
+	}
+	fake.buildLogReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) BuildPlan(arg1 int) (atc.PublicBuildPlan, bool, error) {
 	fake.buildPlanMutex.Lock()
 	ret, specificReturn := fake.buildPlanReturnsOnCall[len(fake.buildPlanArgsForCall)]
@@ -813,6 +937,69 @@ func (fake *FakeClient) FindTeamReturnsOnCall(i int, result1 concourse.Team, res
 	}{result1, result2}
 }
 
+func (fake *FakeClient) Firehose(arg1 string) (concourse.BuildLifecycleEvents, error) {
+	fake.firehoseMutex.Lock()
+	ret, specificReturn := fake.firehoseReturnsOnCall[len(fake.firehoseArgsForCall)]
+	fake.firehoseArgsForCall = append(fake.firehoseArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Firehose", []interface{}{arg1})
+	fake.firehoseMutex.Unlock()
+	if fake.FirehoseStub != nil {
+		return fake.FirehoseStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.firehoseReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) FirehoseCallCount() int {
+	fake.firehoseMutex.RLock()
+	defer fake.firehoseMutex.RUnlock()
+	return len(fake.firehoseArgsForCall)
+}
+
+func (fake *FakeClient) FirehoseCalls(stub func(string) (concourse.BuildLifecycleEvents, error)) {
+	fake.firehoseMutex.Lock()
+	defer fake.firehoseMutex.Unlock()
+	fake.FirehoseStub = stub
+}
+
+func (fake *FakeClient) FirehoseArgsForCall(i int) string {
+	fake.firehoseMutex.RLock()
+	defer fake.firehoseMutex.RUnlock()
+	argsForCall := fake.firehoseArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) FirehoseReturns(result1 concourse.BuildLifecycleEvents, result2 error) {
+	fake.firehoseMutex.Lock()
+	defer fake.firehoseMutex.Unlock()
+	fake.FirehoseStub = nil
+	fake.firehoseReturns = struct {
+		result1 concourse.BuildLifecycleEvents
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) FirehoseReturnsOnCall(i int, result1 concourse.BuildLifecycleEvents, result2 error) {
+	fake.firehoseMutex.Lock()
+	defer fake.firehoseMutex.Unlock()
+	fake.FirehoseStub = nil
+	if fake.firehoseReturnsOnCall == nil {
+		fake.firehoseReturnsOnCall = make(map[int]struct {
+			result1 concourse.BuildLifecycleEvents
+			result2 error
+		})
+	}
+	fake.firehoseReturnsOnCall[i] = struct {
+		result1 concourse.BuildLifecycleEvents
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) GetCLIReader(arg1 string, arg2 string) (io.ReadCloser, http.Header, error) {
 	fake.getCLIReaderMutex.Lock()
 	ret, specificReturn := fake.getCLIReaderReturnsOnCall[len(fake.getCLIReaderArgsForCall)]
@@ -987,6 +1174,66 @@ func (fake *FakeClient) HTTPClientReturnsOnCall(i int, result1 *http.Client) {
 	}{result1}
 }
 
+func (fake *FakeClient) HoldBuild(arg1 string) error {
+	fake.holdBuildMutex.Lock()
+	ret, specificReturn := fake.holdBuildReturnsOnCall[len(fake.holdBuildArgsForCall)]
+	fake.holdBuildArgsForCall = append(fake.holdBuildArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("HoldBuild", []interface{}{arg1})
+	fake.holdBuildMutex.Unlock()
+	if fake.HoldBuildStub != nil {
+		return fake.HoldBuildStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.holdBuildReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) HoldBuildCallCount() int {
+	fake.holdBuildMutex.RLock()
+	defer fake.holdBuildMutex.RUnlock()
+	return len(fake.holdBuildArgsForCall)
+}
+
+func (fake *FakeClient) HoldBuildCalls(stub func(string) error) {
+	fake.holdBuildMutex.Lock()
+	defer fake.holdBuildMutex.Unlock()
+	fake.HoldBuildStub = stub
+}
+
+func (fake *FakeClient) HoldBuildArgsForCall(i int) string {
+	fake.holdBuildMutex.RLock()
+	defer fake.holdBuildMutex.RUnlock()
+	argsForCall := fake.holdBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) HoldBuildReturns(result1 error) {
+	fake.holdBuildMutex.Lock()
+	defer fake.holdBuildMutex.Unlock()
+	fake.HoldBuildStub = nil
+	fake.holdBuildReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) HoldBuildReturnsOnCall(i int, result1 error) {
+	fake.holdBuildMutex.Lock()
+	defer fake.holdBuildMutex.Unlock()
+	fake.HoldBuildStub = nil
+	if fake.holdBuildReturnsOnCall == nil {
+		fake.holdBuildReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.holdBuildReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) LandWorker(arg1 string) error {
 	fake.landWorkerMutex.Lock()
 	ret, specificReturn := fake.landWorkerReturnsOnCall[len(fake.landWorkerArgsForCall)]
@@ -1398,6 +1645,126 @@ func (fake *FakeClient) PruneWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) ReleaseBuild(arg1 string) error {
+	fake.releaseBuildMutex.Lock()
+	ret, specificReturn := fake.releaseBuildReturnsOnCall[len(fake.releaseBuildArgsForCall)]
+	fake.releaseBuildArgsForCall = append(fake.releaseBuildArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ReleaseBuild", []interface{}{arg1})
+	fake.releaseBuildMutex.Unlock()
+	if fake.ReleaseBuildStub != nil {
+		return fake.ReleaseBuildStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.releaseBuildReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ReleaseBuildCallCount() int {
+	fake.releaseBuildMutex.RLock()
+	defer fake.releaseBuildMutex.RUnlock()
+	return len(fake.releaseBuildArgsForCall)
+}
+
+func (fake *FakeClient) ReleaseBuildCalls(stub func(string) error) {
+	fake.releaseBuildMutex.Lock()
+	defer fake.releaseBuildMutex.Unlock()
+	fake.ReleaseBuildStub = stub
+}
+
+func (fake *FakeClient) ReleaseBuildArgsForCall(i int) string {
+	fake.releaseBuildMutex.RLock()
+	defer fake.releaseBuildMutex.RUnlock()
+	argsForCall := fake.releaseBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ReleaseBuildReturns(result1 error) {
+	fake.releaseBuildMutex.Lock()
+	defer fake.releaseBuildMutex.Unlock()
+	fake.ReleaseBuildStub = nil
+	fake.releaseBuildReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ReleaseBuildReturnsOnCall(i int, result1 error) {
+	fake.releaseBuildMutex.Lock()
+	defer fake.releaseBuildMutex.Unlock()
+	fake.ReleaseBuildStub = nil
+	if fake.releaseBuildReturnsOnCall == nil {
+		fake.releaseBuildReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseBuildReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ResumeBuild(arg1 string) error {
+	fake.resumeBuildMutex.Lock()
+	ret, specificReturn := fake.resumeBuildReturnsOnCall[len(fake.resumeBuildArgsForCall)]
+	fake.resumeBuildArgsForCall = append(fake.resumeBuildArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ResumeBuild", []interface{}{arg1})
+	fake.resumeBuildMutex.Unlock()
+	if fake.ResumeBuildStub != nil {
+		return fake.ResumeBuildStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resumeBuildReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ResumeBuildCallCount() int {
+	fake.resumeBuildMutex.RLock()
+	defer fake.resumeBuildMutex.RUnlock()
+	return len(fake.resumeBuildArgsForCall)
+}
+
+func (fake *FakeClient) ResumeBuildCalls(stub func(string) error) {
+	fake.resumeBuildMutex.Lock()
+	defer fake.resumeBuildMutex.Unlock()
+	fake.ResumeBuildStub = stub
+}
+
+func (fake *FakeClient) ResumeBuildArgsForCall(i int) string {
+	fake.resumeBuildMutex.RLock()
+	defer fake.resumeBuildMutex.RUnlock()
+	argsForCall := fake.resumeBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ResumeBuildReturns(result1 error) {
+	fake.resumeBuildMutex.Lock()
+	defer fake.resumeBuildMutex.Unlock()
+	fake.ResumeBuildStub = nil
+	fake.resumeBuildReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ResumeBuildReturnsOnCall(i int, result1 error) {
+	fake.resumeBuildMutex.Lock()
+	defer fake.resumeBuildMutex.Unlock()
+	fake.ResumeBuildStub = nil
+	if fake.resumeBuildReturnsOnCall == nil {
+		fake.resumeBuildReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resumeBuildReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) SaveWorker(arg1 atc.Worker, arg2 *time.Duration) (*atc.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -1638,6 +2005,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildMutex.RUnlock()
 	fake.buildEventsMutex.RLock()
 	defer fake.buildEventsMutex.RUnlock()
+	fake.buildLogMutex.RLock()
+	defer fake.buildLogMutex.RUnlock()
 	fake.buildPlanMutex.RLock()
 	defer fake.buildPlanMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
@@ -1648,12 +2017,16 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.checkMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
+	fake.firehoseMutex.RLock()
+	defer fake.firehoseMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
 	defer fake.getCLIReaderMutex.RUnlock()
 	fake.getInfoMutex.RLock()
 	defer fake.getInfoMutex.RUnlock()
 	fake.hTTPClientMutex.RLock()
 	defer fake.hTTPClientMutex.RUnlock()
+	fake.holdBuildMutex.RLock()
+	defer fake.holdBuildMutex.RUnlock()
 	fake.landWorkerMutex.RLock()
 	defer fake.landWorkerMutex.RUnlock()
 	fake.listActiveUsersSinceMutex.RLock()
@@ -1668,6 +2041,10 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listWorkersMutex.RUnlock()
 	fake.pruneWorkerMutex.RLock()
 	defer fake.pruneWorkerMutex.RUnlock()
+	fake.releaseBuildMutex.RLock()
+	defer fake.releaseBuildMutex.RUnlock()
+	fake.resumeBuildMutex.RLock()
+	defer fake.resumeBuildMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
 	fake.teamMutex.RLock()
@@ -4,6 +4,7 @@ package concoursefakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -132,6 +133,19 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	ClearNamedCacheStub        func(string) (int64, error)
+	clearNamedCacheMutex       sync.RWMutex
+	clearNamedCacheArgsForCall []struct {
+		arg1 string
+	}
+	clearNamedCacheReturns struct {
+		result1 int64
+		result2 error
+	}
+	clearNamedCacheReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
 	ClearTaskCacheStub        func(string, string, string, string) (int64, error)
 	clearTaskCacheMutex       sync.RWMutex
 	clearTaskCacheArgsForCall []struct {
@@ -189,6 +203,21 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
+	CreateJobBuildWithBreakpointsStub        func(string, string, map[string]atc.Breakpoint) (atc.Build, error)
+	createJobBuildWithBreakpointsMutex       sync.RWMutex
+	createJobBuildWithBreakpointsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]atc.Breakpoint
+	}
+	createJobBuildWithBreakpointsReturns struct {
+		result1 atc.Build
+		result2 error
+	}
+	createJobBuildWithBreakpointsReturnsOnCall map[int]struct {
+		result1 atc.Build
+		result2 error
+	}
 	CreateOrUpdateStub        func(atc.Team) (atc.Team, bool, bool, error)
 	createOrUpdateMutex       sync.RWMutex
 	createOrUpdateArgsForCall []struct {
@@ -307,6 +336,19 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	FirehoseStub        func(string) (concourse.BuildLifecycleEvents, error)
+	firehoseMutex       sync.RWMutex
+	firehoseArgsForCall []struct {
+		arg1 string
+	}
+	firehoseReturns struct {
+		result1 concourse.BuildLifecycleEvents
+		result2 error
+	}
+	firehoseReturnsOnCall map[int]struct {
+		result1 concourse.BuildLifecycleEvents
+		result2 error
+	}
 	GetArtifactStub        func(int) (io.ReadCloser, error)
 	getArtifactMutex       sync.RWMutex
 	getArtifactArgsForCall []struct {
@@ -398,6 +440,23 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	JobStatsStub        func(string, string, time.Duration) (atc.JobStats, bool, error)
+	jobStatsMutex       sync.RWMutex
+	jobStatsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 time.Duration
+	}
+	jobStatsReturns struct {
+		result1 atc.JobStats
+		result2 bool
+		result3 error
+	}
+	jobStatsReturnsOnCall map[int]struct {
+		result1 atc.JobStats
+		result2 bool
+		result3 error
+	}
 	ListContainersStub        func(map[string]string) ([]atc.Container, error)
 	listContainersMutex       sync.RWMutex
 	listContainersArgsForCall []struct {
@@ -617,6 +676,22 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
+	RerunJobBuildFromStepStub        func(string, string, string, string) (atc.Build, error)
+	rerunJobBuildFromStepMutex       sync.RWMutex
+	rerunJobBuildFromStepArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	rerunJobBuildFromStepReturns struct {
+		result1 atc.Build
+		result2 error
+	}
+	rerunJobBuildFromStepReturnsOnCall map[int]struct {
+		result1 atc.Build
+		result2 error
+	}
 	ResourceStub        func(string, string) (atc.Resource, bool, error)
 	resourceMutex       sync.RWMutex
 	resourceArgsForCall []struct {
@@ -653,6 +728,21 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	SaveResourceVersionsStub        func(string, string, []atc.VersionWithMetadata) (bool, error)
+	saveResourceVersionsMutex       sync.RWMutex
+	saveResourceVersionsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []atc.VersionWithMetadata
+	}
+	saveResourceVersionsReturns struct {
+		result1 bool
+		result2 error
+	}
+	saveResourceVersionsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ScheduleJobStub        func(string, string) (bool, error)
 	scheduleJobMutex       sync.RWMutex
 	scheduleJobArgsForCall []struct {
@@ -667,6 +757,19 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SearchBuildLogsStub        func(concourse.BuildLogSearch) ([]atc.BuildLogMatch, error)
+	searchBuildLogsMutex       sync.RWMutex
+	searchBuildLogsArgsForCall []struct {
+		arg1 concourse.BuildLogSearch
+	}
+	searchBuildLogsReturns struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}
+	searchBuildLogsReturnsOnCall map[int]struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}
 	SetPinCommentStub        func(string, string, string) (bool, error)
 	setPinCommentMutex       sync.RWMutex
 	setPinCommentArgsForCall []struct {
@@ -682,6 +785,33 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	StreamInContainerStub        func(string, string, io.Reader) error
+	streamInContainerMutex       sync.RWMutex
+	streamInContainerArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 io.Reader
+	}
+	streamInContainerReturns struct {
+		result1 error
+	}
+	streamInContainerReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StreamOutContainerStub        func(string, string) (io.ReadCloser, error)
+	streamOutContainerMutex       sync.RWMutex
+	streamOutContainerArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	streamOutContainerReturns struct {
+		result1 io.ReadCloser
+		result2 error
+	}
+	streamOutContainerReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 error
+	}
 	UnpauseJobStub        func(string, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
@@ -738,6 +868,23 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	WebhookDeliveriesStub        func(string, string, int) ([]atc.WebhookDelivery, bool, error)
+	webhookDeliveriesMutex       sync.RWMutex
+	webhookDeliveriesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	webhookDeliveriesReturns struct {
+		result1 []atc.WebhookDelivery
+		result2 bool
+		result3 error
+	}
+	webhookDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.WebhookDelivery
+		result2 bool
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1262,6 +1409,69 @@ func (fake *FakeTeam) CheckResourceTypeReturnsOnCall(i int, result1 atc.Check, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) ClearNamedCache(arg1 string) (int64, error) {
+	fake.clearNamedCacheMutex.Lock()
+	ret, specificReturn := fake.clearNamedCacheReturnsOnCall[len(fake.clearNamedCacheArgsForCall)]
+	fake.clearNamedCacheArgsForCall = append(fake.clearNamedCacheArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ClearNamedCache", []interface{}{arg1})
+	fake.clearNamedCacheMutex.Unlock()
+	if fake.ClearNamedCacheStub != nil {
+		return fake.ClearNamedCacheStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.clearNamedCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ClearNamedCacheCallCount() int {
+	fake.clearNamedCacheMutex.RLock()
+	defer fake.clearNamedCacheMutex.RUnlock()
+	return len(fake.clearNamedCacheArgsForCall)
+}
+
+func (fake *FakeTeam) ClearNamedCacheCalls(stub func(string) (int64, error)) {
+	fake.clearNamedCacheMutex.Lock()
+	defer fake.clearNamedCacheMutex.Unlock()
+	fake.ClearNamedCacheStub = stub
+}
+
+func (fake *FakeTeam) ClearNamedCacheArgsForCall(i int) string {
+	fake.clearNamedCacheMutex.RLock()
+	defer fake.clearNamedCacheMutex.RUnlock()
+	argsForCall := fake.clearNamedCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) ClearNamedCacheReturns(result1 int64, result2 error) {
+	fake.clearNamedCacheMutex.Lock()
+	defer fake.clearNamedCacheMutex.Unlock()
+	fake.ClearNamedCacheStub = nil
+	fake.clearNamedCacheReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ClearNamedCacheReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.clearNamedCacheMutex.Lock()
+	defer fake.clearNamedCacheMutex.Unlock()
+	fake.ClearNamedCacheStub = nil
+	if fake.clearNamedCacheReturnsOnCall == nil {
+		fake.clearNamedCacheReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.clearNamedCacheReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ClearTaskCache(arg1 string, arg2 string, arg3 string, arg4 string) (int64, error) {
 	fake.clearTaskCacheMutex.Lock()
 	ret, specificReturn := fake.clearTaskCacheReturnsOnCall[len(fake.clearTaskCacheArgsForCall)]
@@ -1519,6 +1729,71 @@ func (fake *FakeTeam) CreateJobBuildReturnsOnCall(i int, result1 atc.Build, resu
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateJobBuildWithBreakpoints(arg1 string, arg2 string, arg3 map[string]atc.Breakpoint) (atc.Build, error) {
+	fake.createJobBuildWithBreakpointsMutex.Lock()
+	ret, specificReturn := fake.createJobBuildWithBreakpointsReturnsOnCall[len(fake.createJobBuildWithBreakpointsArgsForCall)]
+	fake.createJobBuildWithBreakpointsArgsForCall = append(fake.createJobBuildWithBreakpointsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]atc.Breakpoint
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateJobBuildWithBreakpoints", []interface{}{arg1, arg2, arg3})
+	fake.createJobBuildWithBreakpointsMutex.Unlock()
+	if fake.CreateJobBuildWithBreakpointsStub != nil {
+		return fake.CreateJobBuildWithBreakpointsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createJobBuildWithBreakpointsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateJobBuildWithBreakpointsCallCount() int {
+	fake.createJobBuildWithBreakpointsMutex.RLock()
+	defer fake.createJobBuildWithBreakpointsMutex.RUnlock()
+	return len(fake.createJobBuildWithBreakpointsArgsForCall)
+}
+
+func (fake *FakeTeam) CreateJobBuildWithBreakpointsCalls(stub func(string, string, map[string]atc.Breakpoint) (atc.Build, error)) {
+	fake.createJobBuildWithBreakpointsMutex.Lock()
+	defer fake.createJobBuildWithBreakpointsMutex.Unlock()
+	fake.CreateJobBuildWithBreakpointsStub = stub
+}
+
+func (fake *FakeTeam) CreateJobBuildWithBreakpointsArgsForCall(i int) (string, string, map[string]atc.Breakpoint) {
+	fake.createJobBuildWithBreakpointsMutex.RLock()
+	defer fake.createJobBuildWithBreakpointsMutex.RUnlock()
+	argsForCall := fake.createJobBuildWithBreakpointsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) CreateJobBuildWithBreakpointsReturns(result1 atc.Build, result2 error) {
+	fake.createJobBuildWithBreakpointsMutex.Lock()
+	defer fake.createJobBuildWithBreakpointsMutex.Unlock()
+	fake.CreateJobBuildWithBreakpointsStub = nil
+	fake.createJobBuildWithBreakpointsReturns = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateJobBuildWithBreakpointsReturnsOnCall(i int, result1 atc.Build, result2 error) {
+	fake.createJobBuildWithBreakpointsMutex.Lock()
+	defer fake.createJobBuildWithBreakpointsMutex.Unlock()
+	fake.CreateJobBuildWithBreakpointsStub = nil
+	if fake.createJobBuildWithBreakpointsReturnsOnCall == nil {
+		fake.createJobBuildWithBreakpointsReturnsOnCall = make(map[int]struct {
+			result1 atc.Build
+			result2 error
+		})
+	}
+	fake.createJobBuildWithBreakpointsReturnsOnCall[i] = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CreateOrUpdate(arg1 atc.Team) (atc.Team, bool, bool, error) {
 	fake.createOrUpdateMutex.Lock()
 	ret, specificReturn := fake.createOrUpdateReturnsOnCall[len(fake.createOrUpdateArgsForCall)]
@@ -2045,6 +2320,69 @@ func (fake *FakeTeam) ExposePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) Firehose(arg1 string) (concourse.BuildLifecycleEvents, error) {
+	fake.firehoseMutex.Lock()
+	ret, specificReturn := fake.firehoseReturnsOnCall[len(fake.firehoseArgsForCall)]
+	fake.firehoseArgsForCall = append(fake.firehoseArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Firehose", []interface{}{arg1})
+	fake.firehoseMutex.Unlock()
+	if fake.FirehoseStub != nil {
+		return fake.FirehoseStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.firehoseReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) FirehoseCallCount() int {
+	fake.firehoseMutex.RLock()
+	defer fake.firehoseMutex.RUnlock()
+	return len(fake.firehoseArgsForCall)
+}
+
+func (fake *FakeTeam) FirehoseCalls(stub func(string) (concourse.BuildLifecycleEvents, error)) {
+	fake.firehoseMutex.Lock()
+	defer fake.firehoseMutex.Unlock()
+	fake.FirehoseStub = stub
+}
+
+func (fake *FakeTeam) FirehoseArgsForCall(i int) string {
+	fake.firehoseMutex.RLock()
+	defer fake.firehoseMutex.RUnlock()
+	argsForCall := fake.firehoseArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) FirehoseReturns(result1 concourse.BuildLifecycleEvents, result2 error) {
+	fake.firehoseMutex.Lock()
+	defer fake.firehoseMutex.Unlock()
+	fake.FirehoseStub = nil
+	fake.firehoseReturns = struct {
+		result1 concourse.BuildLifecycleEvents
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) FirehoseReturnsOnCall(i int, result1 concourse.BuildLifecycleEvents, result2 error) {
+	fake.firehoseMutex.Lock()
+	defer fake.firehoseMutex.Unlock()
+	fake.FirehoseStub = nil
+	if fake.firehoseReturnsOnCall == nil {
+		fake.firehoseReturnsOnCall = make(map[int]struct {
+			result1 concourse.BuildLifecycleEvents
+			result2 error
+		})
+	}
+	fake.firehoseReturnsOnCall[i] = struct {
+		result1 concourse.BuildLifecycleEvents
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) GetArtifact(arg1 int) (io.ReadCloser, error) {
 	fake.getArtifactMutex.Lock()
 	ret, specificReturn := fake.getArtifactReturnsOnCall[len(fake.getArtifactArgsForCall)]
@@ -2440,6 +2778,74 @@ func (fake *FakeTeam) JobBuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) JobStats(arg1 string, arg2 string, arg3 time.Duration) (atc.JobStats, bool, error) {
+	fake.jobStatsMutex.Lock()
+	ret, specificReturn := fake.jobStatsReturnsOnCall[len(fake.jobStatsArgsForCall)]
+	fake.jobStatsArgsForCall = append(fake.jobStatsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("JobStats", []interface{}{arg1, arg2, arg3})
+	fake.jobStatsMutex.Unlock()
+	if fake.JobStatsStub != nil {
+		return fake.JobStatsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.jobStatsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) JobStatsCallCount() int {
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
+	return len(fake.jobStatsArgsForCall)
+}
+
+func (fake *FakeTeam) JobStatsCalls(stub func(string, string, time.Duration) (atc.JobStats, bool, error)) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = stub
+}
+
+func (fake *FakeTeam) JobStatsArgsForCall(i int) (string, string, time.Duration) {
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
+	argsForCall := fake.jobStatsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) JobStatsReturns(result1 atc.JobStats, result2 bool, result3 error) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = nil
+	fake.jobStatsReturns = struct {
+		result1 atc.JobStats
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) JobStatsReturnsOnCall(i int, result1 atc.JobStats, result2 bool, result3 error) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = nil
+	if fake.jobStatsReturnsOnCall == nil {
+		fake.jobStatsReturnsOnCall = make(map[int]struct {
+			result1 atc.JobStats
+			result2 bool
+			result3 error
+		})
+	}
+	fake.jobStatsReturnsOnCall[i] = struct {
+		result1 atc.JobStats
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) ListContainers(arg1 map[string]string) ([]atc.Container, error) {
 	fake.listContainersMutex.Lock()
 	ret, specificReturn := fake.listContainersReturnsOnCall[len(fake.listContainersArgsForCall)]
@@ -3446,6 +3852,72 @@ func (fake *FakeTeam) RerunJobBuildReturnsOnCall(i int, result1 atc.Build, resul
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) RerunJobBuildFromStep(arg1 string, arg2 string, arg3 string, arg4 string) (atc.Build, error) {
+	fake.rerunJobBuildFromStepMutex.Lock()
+	ret, specificReturn := fake.rerunJobBuildFromStepReturnsOnCall[len(fake.rerunJobBuildFromStepArgsForCall)]
+	fake.rerunJobBuildFromStepArgsForCall = append(fake.rerunJobBuildFromStepArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RerunJobBuildFromStep", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rerunJobBuildFromStepMutex.Unlock()
+	if fake.RerunJobBuildFromStepStub != nil {
+		return fake.RerunJobBuildFromStepStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.rerunJobBuildFromStepReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RerunJobBuildFromStepCallCount() int {
+	fake.rerunJobBuildFromStepMutex.RLock()
+	defer fake.rerunJobBuildFromStepMutex.RUnlock()
+	return len(fake.rerunJobBuildFromStepArgsForCall)
+}
+
+func (fake *FakeTeam) RerunJobBuildFromStepCalls(stub func(string, string, string, string) (atc.Build, error)) {
+	fake.rerunJobBuildFromStepMutex.Lock()
+	defer fake.rerunJobBuildFromStepMutex.Unlock()
+	fake.RerunJobBuildFromStepStub = stub
+}
+
+func (fake *FakeTeam) RerunJobBuildFromStepArgsForCall(i int) (string, string, string, string) {
+	fake.rerunJobBuildFromStepMutex.RLock()
+	defer fake.rerunJobBuildFromStepMutex.RUnlock()
+	argsForCall := fake.rerunJobBuildFromStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) RerunJobBuildFromStepReturns(result1 atc.Build, result2 error) {
+	fake.rerunJobBuildFromStepMutex.Lock()
+	defer fake.rerunJobBuildFromStepMutex.Unlock()
+	fake.RerunJobBuildFromStepStub = nil
+	fake.rerunJobBuildFromStepReturns = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RerunJobBuildFromStepReturnsOnCall(i int, result1 atc.Build, result2 error) {
+	fake.rerunJobBuildFromStepMutex.Lock()
+	defer fake.rerunJobBuildFromStepMutex.Unlock()
+	fake.RerunJobBuildFromStepStub = nil
+	if fake.rerunJobBuildFromStepReturnsOnCall == nil {
+		fake.rerunJobBuildFromStepReturnsOnCall = make(map[int]struct {
+			result1 atc.Build
+			result2 error
+		})
+	}
+	fake.rerunJobBuildFromStepReturnsOnCall[i] = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Resource(arg1 string, arg2 string) (atc.Resource, bool, error) {
 	fake.resourceMutex.Lock()
 	ret, specificReturn := fake.resourceReturnsOnCall[len(fake.resourceArgsForCall)]
@@ -3585,6 +4057,76 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) SaveResourceVersions(arg1 string, arg2 string, arg3 []atc.VersionWithMetadata) (bool, error) {
+	var arg3Copy []atc.VersionWithMetadata
+	if arg3 != nil {
+		arg3Copy = make([]atc.VersionWithMetadata, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.saveResourceVersionsMutex.Lock()
+	ret, specificReturn := fake.saveResourceVersionsReturnsOnCall[len(fake.saveResourceVersionsArgsForCall)]
+	fake.saveResourceVersionsArgsForCall = append(fake.saveResourceVersionsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []atc.VersionWithMetadata
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("SaveResourceVersions", []interface{}{arg1, arg2, arg3Copy})
+	fake.saveResourceVersionsMutex.Unlock()
+	if fake.SaveResourceVersionsStub != nil {
+		return fake.SaveResourceVersionsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.saveResourceVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) SaveResourceVersionsCallCount() int {
+	fake.saveResourceVersionsMutex.RLock()
+	defer fake.saveResourceVersionsMutex.RUnlock()
+	return len(fake.saveResourceVersionsArgsForCall)
+}
+
+func (fake *FakeTeam) SaveResourceVersionsCalls(stub func(string, string, []atc.VersionWithMetadata) (bool, error)) {
+	fake.saveResourceVersionsMutex.Lock()
+	defer fake.saveResourceVersionsMutex.Unlock()
+	fake.SaveResourceVersionsStub = stub
+}
+
+func (fake *FakeTeam) SaveResourceVersionsArgsForCall(i int) (string, string, []atc.VersionWithMetadata) {
+	fake.saveResourceVersionsMutex.RLock()
+	defer fake.saveResourceVersionsMutex.RUnlock()
+	argsForCall := fake.saveResourceVersionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) SaveResourceVersionsReturns(result1 bool, result2 error) {
+	fake.saveResourceVersionsMutex.Lock()
+	defer fake.saveResourceVersionsMutex.Unlock()
+	fake.SaveResourceVersionsStub = nil
+	fake.saveResourceVersionsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SaveResourceVersionsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.saveResourceVersionsMutex.Lock()
+	defer fake.saveResourceVersionsMutex.Unlock()
+	fake.SaveResourceVersionsStub = nil
+	if fake.saveResourceVersionsReturnsOnCall == nil {
+		fake.saveResourceVersionsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.saveResourceVersionsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ScheduleJob(arg1 string, arg2 string) (bool, error) {
 	fake.scheduleJobMutex.Lock()
 	ret, specificReturn := fake.scheduleJobReturnsOnCall[len(fake.scheduleJobArgsForCall)]
@@ -3649,6 +4191,69 @@ func (fake *FakeTeam) ScheduleJobReturnsOnCall(i int, result1 bool, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SearchBuildLogs(arg1 concourse.BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	fake.searchBuildLogsMutex.Lock()
+	ret, specificReturn := fake.searchBuildLogsReturnsOnCall[len(fake.searchBuildLogsArgsForCall)]
+	fake.searchBuildLogsArgsForCall = append(fake.searchBuildLogsArgsForCall, struct {
+		arg1 concourse.BuildLogSearch
+	}{arg1})
+	fake.recordInvocation("SearchBuildLogs", []interface{}{arg1})
+	fake.searchBuildLogsMutex.Unlock()
+	if fake.SearchBuildLogsStub != nil {
+		return fake.SearchBuildLogsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.searchBuildLogsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) SearchBuildLogsCallCount() int {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	return len(fake.searchBuildLogsArgsForCall)
+}
+
+func (fake *FakeTeam) SearchBuildLogsCalls(stub func(concourse.BuildLogSearch) ([]atc.BuildLogMatch, error)) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = stub
+}
+
+func (fake *FakeTeam) SearchBuildLogsArgsForCall(i int) concourse.BuildLogSearch {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	argsForCall := fake.searchBuildLogsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturns(result1 []atc.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	fake.searchBuildLogsReturns = struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturnsOnCall(i int, result1 []atc.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	if fake.searchBuildLogsReturnsOnCall == nil {
+		fake.searchBuildLogsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildLogMatch
+			result2 error
+		})
+	}
+	fake.searchBuildLogsReturnsOnCall[i] = struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) SetPinComment(arg1 string, arg2 string, arg3 string) (bool, error) {
 	fake.setPinCommentMutex.Lock()
 	ret, specificReturn := fake.setPinCommentReturnsOnCall[len(fake.setPinCommentArgsForCall)]
@@ -3714,6 +4319,132 @@ func (fake *FakeTeam) SetPinCommentReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) StreamInContainer(arg1 string, arg2 string, arg3 io.Reader) error {
+	fake.streamInContainerMutex.Lock()
+	ret, specificReturn := fake.streamInContainerReturnsOnCall[len(fake.streamInContainerArgsForCall)]
+	fake.streamInContainerArgsForCall = append(fake.streamInContainerArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 io.Reader
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("StreamInContainer", []interface{}{arg1, arg2, arg3})
+	fake.streamInContainerMutex.Unlock()
+	if fake.StreamInContainerStub != nil {
+		return fake.StreamInContainerStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.streamInContainerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) StreamInContainerCallCount() int {
+	fake.streamInContainerMutex.RLock()
+	defer fake.streamInContainerMutex.RUnlock()
+	return len(fake.streamInContainerArgsForCall)
+}
+
+func (fake *FakeTeam) StreamInContainerCalls(stub func(string, string, io.Reader) error) {
+	fake.streamInContainerMutex.Lock()
+	defer fake.streamInContainerMutex.Unlock()
+	fake.StreamInContainerStub = stub
+}
+
+func (fake *FakeTeam) StreamInContainerArgsForCall(i int) (string, string, io.Reader) {
+	fake.streamInContainerMutex.RLock()
+	defer fake.streamInContainerMutex.RUnlock()
+	argsForCall := fake.streamInContainerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) StreamInContainerReturns(result1 error) {
+	fake.streamInContainerMutex.Lock()
+	defer fake.streamInContainerMutex.Unlock()
+	fake.StreamInContainerStub = nil
+	fake.streamInContainerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) StreamInContainerReturnsOnCall(i int, result1 error) {
+	fake.streamInContainerMutex.Lock()
+	defer fake.streamInContainerMutex.Unlock()
+	fake.StreamInContainerStub = nil
+	if fake.streamInContainerReturnsOnCall == nil {
+		fake.streamInContainerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.streamInContainerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) StreamOutContainer(arg1 string, arg2 string) (io.ReadCloser, error) {
+	fake.streamOutContainerMutex.Lock()
+	ret, specificReturn := fake.streamOutContainerReturnsOnCall[len(fake.streamOutContainerArgsForCall)]
+	fake.streamOutContainerArgsForCall = append(fake.streamOutContainerArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("StreamOutContainer", []interface{}{arg1, arg2})
+	fake.streamOutContainerMutex.Unlock()
+	if fake.StreamOutContainerStub != nil {
+		return fake.StreamOutContainerStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.streamOutContainerReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) StreamOutContainerCallCount() int {
+	fake.streamOutContainerMutex.RLock()
+	defer fake.streamOutContainerMutex.RUnlock()
+	return len(fake.streamOutContainerArgsForCall)
+}
+
+func (fake *FakeTeam) StreamOutContainerCalls(stub func(string, string) (io.ReadCloser, error)) {
+	fake.streamOutContainerMutex.Lock()
+	defer fake.streamOutContainerMutex.Unlock()
+	fake.StreamOutContainerStub = stub
+}
+
+func (fake *FakeTeam) StreamOutContainerArgsForCall(i int) (string, string) {
+	fake.streamOutContainerMutex.RLock()
+	defer fake.streamOutContainerMutex.RUnlock()
+	argsForCall := fake.streamOutContainerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) StreamOutContainerReturns(result1 io.ReadCloser, result2 error) {
+	fake.streamOutContainerMutex.Lock()
+	defer fake.streamOutContainerMutex.Unlock()
+	fake.StreamOutContainerStub = nil
+	fake.streamOutContainerReturns = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) StreamOutContainerReturnsOnCall(i int, result1 io.ReadCloser, result2 error) {
+	fake.streamOutContainerMutex.Lock()
+	defer fake.streamOutContainerMutex.Unlock()
+	fake.StreamOutContainerStub = nil
+	if fake.streamOutContainerReturnsOnCall == nil {
+		fake.streamOutContainerReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 error
+		})
+	}
+	fake.streamOutContainerReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) UnpauseJob(arg1 string, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
@@ -3971,6 +4702,74 @@ func (fake *FakeTeam) VersionedResourceTypesReturnsOnCall(i int, result1 atc.Ver
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) WebhookDeliveries(arg1 string, arg2 string, arg3 int) ([]atc.WebhookDelivery, bool, error) {
+	fake.webhookDeliveriesMutex.Lock()
+	ret, specificReturn := fake.webhookDeliveriesReturnsOnCall[len(fake.webhookDeliveriesArgsForCall)]
+	fake.webhookDeliveriesArgsForCall = append(fake.webhookDeliveriesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("WebhookDeliveries", []interface{}{arg1, arg2, arg3})
+	fake.webhookDeliveriesMutex.Unlock()
+	if fake.WebhookDeliveriesStub != nil {
+		return fake.WebhookDeliveriesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.webhookDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) WebhookDeliveriesCallCount() int {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	return len(fake.webhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeTeam) WebhookDeliveriesCalls(stub func(string, string, int) ([]atc.WebhookDelivery, bool, error)) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = stub
+}
+
+func (fake *FakeTeam) WebhookDeliveriesArgsForCall(i int) (string, string, int) {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	argsForCall := fake.webhookDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) WebhookDeliveriesReturns(result1 []atc.WebhookDelivery, result2 bool, result3 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	fake.webhookDeliveriesReturns = struct {
+		result1 []atc.WebhookDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) WebhookDeliveriesReturnsOnCall(i int, result1 []atc.WebhookDelivery, result2 bool, result3 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	if fake.webhookDeliveriesReturnsOnCall == nil {
+		fake.webhookDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.WebhookDelivery
+			result2 bool
+			result3 error
+		})
+	}
+	fake.webhookDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.WebhookDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -3990,6 +4789,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.checkResourceMutex.RUnlock()
 	fake.checkResourceTypeMutex.RLock()
 	defer fake.checkResourceTypeMutex.RUnlock()
+	fake.clearNamedCacheMutex.RLock()
+	defer fake.clearNamedCacheMutex.RUnlock()
 	fake.clearTaskCacheMutex.RLock()
 	defer fake.clearTaskCacheMutex.RUnlock()
 	fake.createArtifactMutex.RLock()
@@ -3998,6 +4799,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createBuildMutex.RUnlock()
 	fake.createJobBuildMutex.RLock()
 	defer fake.createJobBuildMutex.RUnlock()
+	fake.createJobBuildWithBreakpointsMutex.RLock()
+	defer fake.createJobBuildWithBreakpointsMutex.RUnlock()
 	fake.createOrUpdateMutex.RLock()
 	defer fake.createOrUpdateMutex.RUnlock()
 	fake.createOrUpdatePipelineConfigMutex.RLock()
@@ -4014,6 +4817,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.enableResourceVersionMutex.RUnlock()
 	fake.exposePipelineMutex.RLock()
 	defer fake.exposePipelineMutex.RUnlock()
+	fake.firehoseMutex.RLock()
+	defer fake.firehoseMutex.RUnlock()
 	fake.getArtifactMutex.RLock()
 	defer fake.getArtifactMutex.RUnlock()
 	fake.getContainerMutex.RLock()
@@ -4026,6 +4831,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.jobBuildMutex.RUnlock()
 	fake.jobBuildsMutex.RLock()
 	defer fake.jobBuildsMutex.RUnlock()
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
 	fake.listContainersMutex.RLock()
 	defer fake.listContainersMutex.RUnlock()
 	fake.listJobsMutex.RLock()
@@ -4058,14 +4865,24 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.renameTeamMutex.RUnlock()
 	fake.rerunJobBuildMutex.RLock()
 	defer fake.rerunJobBuildMutex.RUnlock()
+	fake.rerunJobBuildFromStepMutex.RLock()
+	defer fake.rerunJobBuildFromStepMutex.RUnlock()
 	fake.resourceMutex.RLock()
 	defer fake.resourceMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.saveResourceVersionsMutex.RLock()
+	defer fake.saveResourceVersionsMutex.RUnlock()
 	fake.scheduleJobMutex.RLock()
 	defer fake.scheduleJobMutex.RUnlock()
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
+	fake.streamInContainerMutex.RLock()
+	defer fake.streamInContainerMutex.RUnlock()
+	fake.streamOutContainerMutex.RLock()
+	defer fake.streamOutContainerMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	fake.unpausePipelineMutex.RLock()
@@ -4074,6 +4891,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.unpinResourceMutex.RUnlock()
 	fake.versionedResourceTypesMutex.RLock()
 	defer fake.versionedResourceTypesMutex.RUnlock()
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -1,6 +1,8 @@
 package concourse
 
 import (
+	"io"
+	"net/http"
 	"net/url"
 
 	"github.com/concourse/concourse/atc"
@@ -45,3 +47,38 @@ func (team *team) GetContainer(handle string) (atc.Container, error) {
 
 	return container, err
 }
+
+func (team *team) StreamOutContainer(handle string, path string) (io.ReadCloser, error) {
+	params := rata.Params{
+		"id":        handle,
+		"team_name": team.name,
+	}
+
+	response := internal.Response{}
+	err := team.connection.Send(internal.Request{
+		RequestName:        atc.StreamOutContainer,
+		Params:             params,
+		Query:              url.Values{atc.ContainerFilesQueryPath: {path}},
+		ReturnResponseBody: true,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Result.(io.ReadCloser), nil
+}
+
+func (team *team) StreamInContainer(handle string, path string, tarStream io.Reader) error {
+	params := rata.Params{
+		"id":        handle,
+		"team_name": team.name,
+	}
+
+	return team.connection.Send(internal.Request{
+		Header:      http.Header{"Content-Type": {"application/x-tar"}},
+		RequestName: atc.StreamInContainer,
+		Params:      params,
+		Query:       url.Values{atc.ContainerFilesQueryPath: {path}},
+		Body:        tarStream,
+	}, nil)
+}
@@ -1,9 +1,12 @@
 package concourse_test
 
 import (
+	"bytes"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -108,4 +111,85 @@ var _ = Describe("ATC Handler Containers", func() {
 			})
 		})
 	})
+
+	Describe("StreamOutContainer", func() {
+		expectedURL := "/api/v1/teams/some-team/containers/myid-1/files"
+
+		Context("when the container exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "path=%2Ftmp%2Fbuild%2Fsome-guid"),
+						ghttp.RespondWith(http.StatusOK, "some-tar-contents"),
+					),
+				)
+			})
+
+			It("returns the tarball of the path", func() {
+				tarStream, err := team.StreamOutContainer("myid-1", "/tmp/build/some-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				defer tarStream.Close()
+
+				contents, err := ioutil.ReadAll(tarStream)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-tar-contents"))
+			})
+		})
+
+		Context("when the user may not access the container", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusForbidden, nil),
+					),
+				)
+			})
+
+			It("returns ErrForbidden", func() {
+				_, err := team.StreamOutContainer("myid-1", "/tmp/build/some-guid")
+				Expect(err).To(Equal(concourse.ErrForbidden))
+			})
+		})
+	})
+
+	Describe("StreamInContainer", func() {
+		expectedURL := "/api/v1/teams/some-team/containers/myid-1/files"
+
+		Context("when the container exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL, "path=%2Ftmp%2Fbuild%2Fsome-guid"),
+						ghttp.VerifyHeaderKV("Content-Type", "application/x-tar"),
+						ghttp.VerifyBody([]byte("some-tar-contents")),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("streams the tarball into the path", func() {
+				err := team.StreamInContainer("myid-1", "/tmp/build/some-guid", bytes.NewBufferString("some-tar-contents"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the container does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				err := team.StreamInContainer("myid-1", "/tmp/build/some-guid", bytes.NewBufferString("some-tar-contents"))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
@@ -0,0 +1,83 @@
+package concourse
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+	"github.com/vito/go-sse/sse"
+)
+
+type BuildLifecycleEvents interface {
+	NextEvent() (atc.BuildLifecycleEvent, error)
+	Close() error
+}
+
+// Firehose streams the lifecycle events of every build in the cluster. If
+// lastEventID is non-empty the stream resumes after that event, otherwise it
+// starts from now.
+func (client *client) Firehose(lastEventID string) (BuildLifecycleEvents, error) {
+	return connectToFirehose(client.connection, internal.Request{
+		RequestName: atc.Firehose,
+		Query:       firehoseQuery(lastEventID),
+	})
+}
+
+// Firehose streams the lifecycle events of every build in the team.
+func (team *team) Firehose(lastEventID string) (BuildLifecycleEvents, error) {
+	return connectToFirehose(team.connection, internal.Request{
+		RequestName: atc.TeamFirehose,
+		Params: rata.Params{
+			"team_name": team.name,
+		},
+		Query: firehoseQuery(lastEventID),
+	})
+}
+
+func firehoseQuery(lastEventID string) url.Values {
+	query := url.Values{}
+	if lastEventID != "" {
+		query.Add(atc.FirehoseQueryLastEventID, lastEventID)
+	}
+
+	return query
+}
+
+func connectToFirehose(connection internal.Connection, request internal.Request) (BuildLifecycleEvents, error) {
+	source, err := connection.ConnectToEventStream(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &buildLifecycleEvents{source: source}, nil
+}
+
+type buildLifecycleEvents struct {
+	source *sse.EventSource
+}
+
+func (events *buildLifecycleEvents) NextEvent() (atc.BuildLifecycleEvent, error) {
+	se, err := events.source.Next()
+	if err != nil {
+		return atc.BuildLifecycleEvent{}, err
+	}
+
+	if se.Name != "event" {
+		return atc.BuildLifecycleEvent{}, fmt.Errorf("unknown event name: %s", se.Name)
+	}
+
+	var ev atc.BuildLifecycleEvent
+	err = json.Unmarshal(se.Data, &ev)
+	if err != nil {
+		return atc.BuildLifecycleEvent{}, err
+	}
+
+	return ev, nil
+}
+
+func (events *buildLifecycleEvents) Close() error {
+	return events.source.Close()
+}
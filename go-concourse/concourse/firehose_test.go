@@ -0,0 +1,109 @@
+package concourse_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("Firehose", func() {
+	var events []atc.BuildLifecycleEvent
+
+	BeforeEach(func() {
+		events = []atc.BuildLifecycleEvent{
+			{ID: 1, Type: atc.BuildCreated, Status: atc.StatusPending, BuildID: 3, BuildName: "1", TeamName: "some-team"},
+			{ID: 2, Type: atc.BuildStarted, Status: atc.StatusStarted, BuildID: 3, BuildName: "1", TeamName: "some-team"},
+		}
+	})
+
+	firehoseHandler := func(w http.ResponseWriter, r *http.Request) {
+		flusher := w.(http.Flusher)
+
+		w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+
+		for _, e := range events {
+			payload, err := json.Marshal(e)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = sse.Event{
+				ID:   strconv.FormatInt(e.ID, 10),
+				Name: "event",
+				Data: payload,
+			}.Write(w)
+			Expect(err).NotTo(HaveOccurred())
+
+			flusher.Flush()
+		}
+	}
+
+	Describe("Client.Firehose", func() {
+		Context("when the server streams events", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/firehose", ""),
+						firehoseHandler,
+					),
+				)
+			})
+
+			It("streams the build lifecycle events", func() {
+				stream, err := client.Firehose("")
+				Expect(err).NotTo(HaveOccurred())
+
+				next, err := stream.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next).To(Equal(events[0]))
+
+				next, err = stream.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next).To(Equal(events[1]))
+
+				err = stream.Close()
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the server returns 403", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(ghttp.RespondWith(http.StatusForbidden, ""))
+			})
+
+			It("returns ErrForbidden", func() {
+				_, err := client.Firehose("")
+				Expect(err).To(Equal(concourse.ErrForbidden))
+			})
+		})
+	})
+
+	Describe("Team.Firehose", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/firehose", "last_event_id=41"),
+					firehoseHandler,
+				),
+			)
+		})
+
+		It("resumes the team's stream after the given event", func() {
+			stream, err := team.Firehose("41")
+			Expect(err).NotTo(HaveOccurred())
+
+			next, err := stream.NextEvent()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(next).To(Equal(events[0]))
+
+			err = stream.Close()
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -3,6 +3,7 @@ package concourse
 import (
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -50,6 +51,31 @@ func (team *team) Job(pipelineName, jobName string) (atc.Job, bool, error) {
 	}
 }
 
+func (team *team) JobStats(pipelineName, jobName string, window time.Duration) (atc.JobStats, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"job_name":      jobName,
+		"team_name":     team.name,
+	}
+
+	var stats atc.JobStats
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetJobStats,
+		Params:      params,
+		Query:       url.Values{atc.JobStatsQueryWindow: {window.String()}},
+	}, &internal.Response{
+		Result: &stats,
+	})
+	switch err.(type) {
+	case nil:
+		return stats, true, nil
+	case internal.ResourceNotFoundError:
+		return stats, false, nil
+	default:
+		return stats, false, err
+	}
+}
+
 func (team *team) JobBuilds(pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, bool, error) {
 	params := rata.Params{
 		"pipeline_name": pipelineName,
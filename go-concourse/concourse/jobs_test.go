@@ -3,6 +3,7 @@ package concourse_test
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -128,6 +129,61 @@ var _ = Describe("ATC Handler Jobs", func() {
 		})
 	})
 
+	Describe("JobStats", func() {
+		var expectedURL = "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/stats"
+
+		Context("when the job exists", func() {
+			var expectedStats atc.JobStats
+
+			BeforeEach(func() {
+				expectedStats = atc.JobStats{
+					Window:      86400,
+					Builds:      4,
+					Succeeded:   3,
+					Failed:      1,
+					SuccessRate: 0.75,
+					FailureRate: 0.25,
+					Durations: atc.JobStatsDurations{
+						P50: 60,
+						P90: 120,
+						P99: 180,
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "window=24h0m0s"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedStats),
+					),
+				)
+			})
+
+			It("returns the stats of the job within the window", func() {
+				stats, found, err := team.JobStats("mypipeline", "myjob", 24*time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(stats).To(Equal(expectedStats))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.JobStats("mypipeline", "myjob", 24*time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("JobBuilds", func() {
 		var (
 			expectedBuilds []atc.Build
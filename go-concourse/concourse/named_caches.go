@@ -0,0 +1,27 @@
+package concourse
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ClearNamedCache(name string) (int64, error) {
+	params := rata.Params{
+		"team_name":  team.name,
+		"cache_name": name,
+	}
+
+	var ctcResponse atc.ClearTaskCacheResponse
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ClearNamedCache,
+		Params:      params,
+	}, &internal.Response{
+		Result: &ctcResponse,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return ctcResponse.CachesRemoved, nil
+}
@@ -0,0 +1,49 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ClearNamedCache", func() {
+	var expectedURL = "/api/v1/teams/some-team/caches/some-cache"
+
+	Context("when ATC request succeeds", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ClearTaskCacheResponse{CachesRemoved: 3}),
+				),
+			)
+		})
+
+		It("returns the number of caches removed", func() {
+			removed, err := team.ClearNamedCache("some-cache")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(Equal(int64(3)))
+		})
+	})
+
+	Context("when ATC responds with an error", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", expectedURL),
+					ghttp.RespondWith(http.StatusInternalServerError, ""),
+				),
+			)
+		})
+
+		It("returns an error", func() {
+			_, err := team.ClearNamedCache("some-cache")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
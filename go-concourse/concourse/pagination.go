@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/peterhellberg/link"
 )
 
@@ -47,6 +48,22 @@ type Page struct {
 	Until      int
 	Limit      int
 	Timestamps bool
+
+	// Filter narrows down the builds being listed. It is ignored when listing
+	// anything else.
+	Filter BuildFilter
+}
+
+type BuildFilter struct {
+	Statuses     []atc.BuildStatus
+	PipelineName string
+	JobName      string
+
+	// ManuallyTriggered only lists builds which were (or were not) triggered
+	// by a user when set.
+	ManuallyTriggered *bool
+
+	CreatedBy string
 }
 
 func pageFromURI(uri string) (Page, error) {
@@ -61,6 +78,18 @@ func pageFromURI(uri string) (Page, error) {
 	page.Until, _ = strconv.Atoi(params.Get("until"))
 	page.Limit, _ = strconv.Atoi(params.Get("limit"))
 
+	for _, status := range params[atc.BuildsQueryStatus] {
+		page.Filter.Statuses = append(page.Filter.Statuses, atc.BuildStatus(status))
+	}
+
+	page.Filter.PipelineName = params.Get(atc.BuildsQueryPipeline)
+	page.Filter.JobName = params.Get(atc.BuildsQueryJob)
+	page.Filter.CreatedBy = params.Get(atc.BuildsQueryCreatedBy)
+
+	if manual, err := strconv.ParseBool(params.Get(atc.BuildsQueryManuallyTriggered)); err == nil {
+		page.Filter.ManuallyTriggered = &manual
+	}
+
 	return page, nil
 }
 
@@ -82,5 +111,25 @@ func (p Page) QueryParams() url.Values {
 		queryParams.Add("timestamps", "true")
 	}
 
+	for _, status := range p.Filter.Statuses {
+		queryParams.Add(atc.BuildsQueryStatus, string(status))
+	}
+
+	if p.Filter.PipelineName != "" {
+		queryParams.Add(atc.BuildsQueryPipeline, p.Filter.PipelineName)
+	}
+
+	if p.Filter.JobName != "" {
+		queryParams.Add(atc.BuildsQueryJob, p.Filter.JobName)
+	}
+
+	if p.Filter.ManuallyTriggered != nil {
+		queryParams.Add(atc.BuildsQueryManuallyTriggered, strconv.FormatBool(*p.Filter.ManuallyTriggered))
+	}
+
+	if p.Filter.CreatedBy != "" {
+		queryParams.Add(atc.BuildsQueryCreatedBy, p.Filter.CreatedBy)
+	}
+
 	return queryParams
 }
@@ -1,6 +1,9 @@
 package concourse
 
 import (
+	"net/url"
+	"strconv"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 	"github.com/tedsuo/rata"
@@ -46,3 +49,33 @@ func (team *team) ListResources(pipelineName string) ([]atc.Resource, error) {
 
 	return resources, err
 }
+
+func (team *team) WebhookDeliveries(pipelineName string, resourceName string, limit int) ([]atc.WebhookDelivery, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"resource_name": resourceName,
+		"team_name":     team.name,
+	}
+
+	query := url.Values{}
+	if limit > 0 {
+		query.Add("limit", strconv.Itoa(limit))
+	}
+
+	var deliveries []atc.WebhookDelivery
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListResourceWebhookDeliveries,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &deliveries,
+	})
+	switch err.(type) {
+	case nil:
+		return deliveries, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
@@ -108,4 +108,56 @@ var _ = Describe("ATC Handler Resource", func() {
 			})
 		})
 	})
+
+	Describe("WebhookDeliveries", func() {
+		var expectedDeliveries []atc.WebhookDelivery
+
+		var deliveries []atc.WebhookDelivery
+		var found bool
+		var clientErr error
+
+		BeforeEach(func() {
+			expectedDeliveries = []atc.WebhookDelivery{
+				{ID: 2, DeliveredAt: 1591025749, Outcome: atc.WebhookDeliveryAccepted, CheckID: 42},
+				{ID: 1, DeliveredAt: 1591025700, Outcome: atc.WebhookDeliveryFiltered},
+			}
+		})
+
+		JustBeforeEach(func() {
+			deliveries, found, clientErr = team.WebhookDeliveries("some-pipeline", "myresource", 10)
+		})
+
+		Context("when the server returns the deliveries", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/some-pipeline/resources/myresource/webhook/deliveries", "limit=10"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDeliveries),
+					),
+				)
+			})
+
+			It("returns the deliveries", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(deliveries).To(Equal(expectedDeliveries))
+			})
+		})
+
+		Context("when the server returns a 404", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/some-pipeline/resources/myresource/webhook/deliveries"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false for found and a nil error", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
 })
@@ -0,0 +1,46 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) SaveResourceVersions(pipelineName string, resourceName string, versions []atc.VersionWithMetadata) (bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"resource_name": resourceName,
+		"team_name":     team.name,
+	}
+
+	jsonBytes, err := json.Marshal(atc.SaveVersionsRequestBody{Versions: versions})
+	if err != nil {
+		return false, err
+	}
+
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.SaveResourceVersions,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+
+	switch e := err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusInternalServerError {
+			return false, GenericError{e.Body}
+		} else {
+			return false, err
+		}
+	default:
+		return false, err
+	}
+}
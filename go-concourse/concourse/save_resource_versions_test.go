@@ -0,0 +1,86 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("SaveResourceVersions", func() {
+	var (
+		expectedURL = "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/versions"
+		versions    []atc.VersionWithMetadata
+	)
+
+	BeforeEach(func() {
+		versions = []atc.VersionWithMetadata{
+			{
+				Version:  atc.Version{"ref": "fake-ref"},
+				Metadata: []atc.MetadataField{{Name: "author", Value: "someone"}},
+			},
+		}
+	})
+
+	Context("when ATC request succeeds", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.VerifyJSON(`{"versions":[{"version":{"ref":"fake-ref"},"metadata":[{"name":"author","value":"someone"}]}]}`),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+		})
+
+		It("sends the versions to ATC", func() {
+			found, err := team.SaveResourceVersions("mypipeline", "myresource", versions)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Context("when pipeline or resource does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("returns false", func() {
+			found, err := team.SaveResourceVersions("mypipeline", "myresource", versions)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when ATC responds with an internal server error", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.RespondWith(http.StatusInternalServerError, "unknown server error"),
+				),
+			)
+		})
+
+		It("returns an error with body", func() {
+			_, err := team.SaveResourceVersions("mypipeline", "myresource", versions)
+			Expect(err).To(HaveOccurred())
+
+			cre, ok := err.(concourse.GenericError)
+			Expect(ok).To(BeTrue())
+			Expect(cre.Error()).To(Equal("unknown server error"))
+		})
+	})
+})
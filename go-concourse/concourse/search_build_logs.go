@@ -0,0 +1,67 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+type BuildLogSearch struct {
+	Query string
+
+	PipelineName string
+	JobName      string
+
+	Since time.Time
+	Until time.Time
+
+	Limit int
+}
+
+func (search BuildLogSearch) QueryParams() url.Values {
+	queryParams := url.Values{}
+	queryParams.Add(atc.SearchBuildLogsQueryText, search.Query)
+
+	if search.PipelineName != "" {
+		queryParams.Add(atc.SearchBuildLogsQueryPipeline, search.PipelineName)
+	}
+
+	if search.JobName != "" {
+		queryParams.Add(atc.SearchBuildLogsQueryJob, search.JobName)
+	}
+
+	if !search.Since.IsZero() {
+		queryParams.Add(atc.SearchBuildLogsQuerySince, strconv.FormatInt(search.Since.Unix(), 10))
+	}
+
+	if !search.Until.IsZero() {
+		queryParams.Add(atc.SearchBuildLogsQueryUntil, strconv.FormatInt(search.Until.Unix(), 10))
+	}
+
+	if search.Limit > 0 {
+		queryParams.Add(atc.SearchBuildLogsQueryLimit, strconv.Itoa(search.Limit))
+	}
+
+	return queryParams
+}
+
+func (team *team) SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	params := rata.Params{
+		"team_name": team.name,
+	}
+
+	var matches []atc.BuildLogMatch
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.SearchBuildLogs,
+		Params:      params,
+		Query:       search.QueryParams(),
+	}, &internal.Response{
+		Result: &matches,
+	})
+
+	return matches, err
+}
@@ -0,0 +1,71 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("SearchBuildLogs", func() {
+	var expectedURL = "/api/v1/teams/some-team/build-logs"
+
+	Context("when ATC request succeeds", func() {
+		var expectedMatches []atc.BuildLogMatch
+
+		BeforeEach(func() {
+			expectedMatches = []atc.BuildLogMatch{
+				{
+					BuildID:      42,
+					BuildName:    "3",
+					TeamName:     "some-team",
+					PipelineName: "some-pipeline",
+					JobName:      "some-job",
+					Lines:        []string{"connection refused"},
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, "job_name=some-job&limit=10&pipeline_name=some-pipeline&q=connection+refused&since=100&until=200"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedMatches),
+				),
+			)
+		})
+
+		It("returns the matches", func() {
+			matches, err := team.SearchBuildLogs(concourse.BuildLogSearch{
+				Query:        "connection refused",
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+				Since:        time.Unix(100, 0),
+				Until:        time.Unix(200, 0),
+				Limit:        10,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(Equal(expectedMatches))
+		})
+	})
+
+	Context("when ATC responds with an error", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, "q=refused"),
+					ghttp.RespondWith(http.StatusInternalServerError, ""),
+				),
+			)
+		})
+
+		It("returns an error", func() {
+			_, err := team.SearchBuildLogs(concourse.BuildLogSearch{Query: "refused"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
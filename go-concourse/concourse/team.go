@@ -2,6 +2,7 @@ package concourse
 
 import (
 	"io"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -36,10 +37,13 @@ type Team interface {
 	BuildInputsForJob(pipelineName string, jobName string) ([]atc.BuildInput, bool, error)
 
 	Job(pipelineName, jobName string) (atc.Job, bool, error)
+	JobStats(pipelineName, jobName string, window time.Duration) (atc.JobStats, bool, error)
 	JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error)
 	JobBuilds(pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, bool, error)
 	CreateJobBuild(pipelineName string, jobName string) (atc.Build, error)
+	CreateJobBuildWithBreakpoints(pipelineName string, jobName string, breakpoints map[string]atc.Breakpoint) (atc.Build, error)
 	RerunJobBuild(pipelineName string, jobName string, buildName string) (atc.Build, error)
+	RerunJobBuildFromStep(pipelineName string, jobName string, buildName string, stepName string) (atc.Build, error)
 	ListJobs(pipelineName string) ([]atc.Job, error)
 	ScheduleJob(pipelineName string, jobName string) (bool, error)
 
@@ -47,12 +51,15 @@ type Team interface {
 	UnpauseJob(pipelineName string, jobName string) (bool, error)
 
 	ClearTaskCache(pipelineName string, jobName string, stepName string, cachePath string) (int64, error)
+	ClearNamedCache(name string) (int64, error)
 
 	Resource(pipelineName string, resourceName string) (atc.Resource, bool, error)
 	ListResources(pipelineName string) ([]atc.Resource, error)
+	WebhookDeliveries(pipelineName string, resourceName string, limit int) ([]atc.WebhookDelivery, bool, error)
 	VersionedResourceTypes(pipelineName string) (atc.VersionedResourceTypes, bool, error)
 	ResourceVersions(pipelineName string, resourceName string, page Page, filter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error)
 	CheckResource(pipelineName string, resourceName string, version atc.Version) (atc.Check, bool, error)
+	SaveResourceVersions(pipelineName string, resourceName string, versions []atc.VersionWithMetadata) (bool, error)
 	CheckResourceType(pipelineName string, resourceTypeName string, version atc.Version) (atc.Check, bool, error)
 	DisableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
 	EnableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
@@ -66,9 +73,13 @@ type Team interface {
 
 	ListContainers(queryList map[string]string) ([]atc.Container, error)
 	GetContainer(id string) (atc.Container, error)
+	StreamOutContainer(handle string, path string) (io.ReadCloser, error)
+	StreamInContainer(handle string, path string, tarStream io.Reader) error
 	ListVolumes() ([]atc.Volume, error)
 	CreateBuild(plan atc.Plan) (atc.Build, error)
 	Builds(page Page) ([]atc.Build, Pagination, error)
+	SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, error)
+	Firehose(lastEventID string) (BuildLifecycleEvents, error)
 	OrderingPipelines(pipelineNames []string) error
 
 	CreateArtifact(io.Reader, string) (atc.WorkerArtifact, error)
@@ -12,6 +12,7 @@ import (
 	"net"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -306,8 +307,9 @@ func (client *Client) VolumesToDestroy(ctx context.Context) ([]string, error) {
 }
 
 // ReportVolumes invokes the 'report-volumes' command, sending a list of the
-// worker's container handles to Concourse.
-func (client *Client) ReportVolumes(ctx context.Context, handles []string) error {
+// worker's volume handles to Concourse, along with the usage of the disk
+// holding them if it is known.
+func (client *Client) ReportVolumes(ctx context.Context, handles []string, usage *atc.VolumesDiskUsage) error {
 	logger := lagerctx.FromContext(ctx)
 
 	sshClient, _, err := client.dial(ctx, 0)
@@ -318,7 +320,15 @@ func (client *Client) ReportVolumes(ctx context.Context, handles []string) error
 
 	defer sshClient.Close()
 
-	command := append([]string{"report-volumes"}, handles...)
+	command := []string{"report-volumes"}
+	if usage != nil {
+		command = append(command,
+			"--disk-used", strconv.FormatUint(usage.Used, 10),
+			"--disk-capacity", strconv.FormatUint(usage.Capacity, 10),
+		)
+	}
+
+	command = append(command, handles...)
 
 	return client.run(ctx, sshClient, strings.Join(command, " "), os.Stdout)
 }
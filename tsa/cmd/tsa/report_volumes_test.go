@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/concourse/concourse/atc"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
@@ -11,10 +12,17 @@ import (
 )
 
 var _ = Describe("ReportVolumes", func() {
-	var reportErr error
+	var (
+		diskUsage *atc.VolumesDiskUsage
+		reportErr error
+	)
+
+	BeforeEach(func() {
+		diskUsage = nil
+	})
 
 	JustBeforeEach(func() {
-		reportErr = tsaClient.ReportVolumes(context.TODO(), []string{"a", "b"})
+		reportErr = tsaClient.ReportVolumes(context.TODO(), []string{"a", "b"}, diskUsage)
 	})
 
 	Context("when the worker is registered globally", func() {
@@ -42,6 +50,23 @@ var _ = Describe("ReportVolumes", func() {
 				})
 			})
 
+			Context("when the usage of the disk is reported", func() {
+				BeforeEach(func() {
+					diskUsage = &atc.VolumesDiskUsage{Used: 42, Capacity: 100}
+
+					atcServer.AppendHandlers(ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/volumes/report", "disk_capacity=100&disk_used=42&worker_name=some-worker"),
+						ghttp.VerifyJSONRepresenting([]string{"a", "b"}),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					))
+				})
+
+				It("sends it to the ATC along with the handles", func() {
+					Expect(reportErr).ToNot(HaveOccurred())
+					Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+				})
+			})
+
 			Context("when the ATC responds with an error", func() {
 				BeforeEach(func() {
 					atcServer.AppendHandlers(ghttp.CombineHandlers(
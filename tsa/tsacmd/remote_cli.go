@@ -310,6 +310,7 @@ func (req sweepVolumesRequest) Handle(ctx context.Context, state ConnState, chan
 type reportVolumesRequest struct {
 	server        *server
 	volumeHandles []string
+	diskUsage     *atc.VolumesDiskUsage
 }
 
 func (req reportVolumesRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
@@ -327,6 +328,7 @@ func (req reportVolumesRequest) Handle(ctx context.Context, state ConnState, cha
 		ATCEndpoint:   req.server.atcEndpointPicker.Pick(),
 		HTTPClient:    req.server.httpClient,
 		VolumeHandles: req.volumeHandles,
+		DiskUsage:     req.diskUsage,
 	}).WorkerStatus(ctx, worker, tsa.ReportVolumes)
 }
 
@@ -13,6 +13,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 	"golang.org/x/crypto/ssh"
 )
@@ -532,9 +533,29 @@ func (server *server) parseRequest(cli string) (request, string, error) {
 			server: server,
 		}
 	case tsa.ReportVolumes:
+		var fs = flag.NewFlagSet(command, flag.ContinueOnError)
+
+		// older workers only send handles, so the usage is optional
+		var diskUsed = fs.Uint64("disk-used", 0, "bytes used on the disk holding the volumes")
+		var diskCapacity = fs.Uint64("disk-capacity", 0, "capacity of the disk holding the volumes")
+
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, "", err
+		}
+
+		var usage *atc.VolumesDiskUsage
+		if *diskCapacity > 0 {
+			usage = &atc.VolumesDiskUsage{
+				Used:     *diskUsed,
+				Capacity: *diskCapacity,
+			}
+		}
+
 		req = reportVolumesRequest{
 			server:        server,
-			volumeHandles: args,
+			volumeHandles: fs.Args(),
+			diskUsage:     usage,
 		}
 	default:
 		return nil, "", fmt.Errorf("unknown command: %s", command)
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"net/http/httputil"
 
@@ -23,6 +24,9 @@ type WorkerStatus struct {
 	HTTPClient       *http.Client
 	ContainerHandles []string
 	VolumeHandles    []string
+
+	// usage of the disk holding the volumes, reported along with them
+	DiskUsage *atc.VolumesDiskUsage
 }
 
 func (l *WorkerStatus) WorkerStatus(ctx context.Context, worker atc.Worker, resourceAction string) error {
@@ -74,9 +78,16 @@ func (l *WorkerStatus) WorkerStatus(ctx context.Context, worker atc.Worker, reso
 	}
 	request.Header.Add("Content-Type", "application/json")
 
-	request.URL.RawQuery = url.Values{
+	query := url.Values{
 		"worker_name": []string{worker.Name},
-	}.Encode()
+	}
+
+	if resourceAction == ReportVolumes && l.DiskUsage != nil {
+		query.Set("disk_used", strconv.FormatUint(l.DiskUsage.Used, 10))
+		query.Set("disk_capacity", strconv.FormatUint(l.DiskUsage.Capacity, 10))
+	}
+
+	request.URL.RawQuery = query.Encode()
 
 	response, err := l.HTTPClient.Do(request)
 	if err != nil {
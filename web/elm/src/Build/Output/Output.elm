@@ -210,6 +210,20 @@ handleEvent event ( model, effects ) =
             , effects
             )
 
+        Retrying _ _ ->
+            -- attempts are already shown as tabs of the retry step
+            ( model, effects )
+
+        PausedAtBreakpoint origin time ->
+            ( updateStep origin.id (appendLastRunLog "\u{001B}[1mpaused at breakpoint; hijack its containers with fly hijack and continue with fly resume-build\u{001B}[0m\n" time) model
+            , effects
+            )
+
+        ResumedFromBreakpoint origin time ->
+            ( updateStep origin.id (appendLastRunLog "\u{001B}[1mresumed\u{001B}[0m\n" time) model
+            , effects
+            )
+
         BuildStatus status _ ->
             let
                 newSt =
@@ -244,32 +258,40 @@ setRunning =
     setStepState StepStateRunning
 
 
+appendLastRunLog : String -> Time.Posix -> StepTree -> StepTree
+appendLastRunLog output time tree =
+    StepTree.mapLastRun (appendLog output (Just time)) tree
+
+
 appendStepLog : String -> Maybe Time.Posix -> StepTree -> StepTree
 appendStepLog output mtime tree =
-    (\a -> StepTree.map a tree) <|
-        \step ->
-            let
-                outputLineCount =
-                    Ansi.Log.update output (Ansi.Log.init Ansi.Log.Cooked)
-                        |> .lines
-                        |> Array.length
+    StepTree.map (appendLog output mtime) tree
+
 
-                lastLineNo =
-                    max (Array.length step.log.lines) 1
+appendLog : String -> Maybe Time.Posix -> StepTree.Step -> StepTree.Step
+appendLog output mtime step =
+    let
+        outputLineCount =
+            Ansi.Log.update output (Ansi.Log.init Ansi.Log.Cooked)
+                |> .lines
+                |> Array.length
 
-                setLineTimestamp lineNo timestamps =
-                    Dict.update lineNo (always mtime) timestamps
+        lastLineNo =
+            max (Array.length step.log.lines) 1
 
-                newTimestamps =
-                    List.foldl
-                        setLineTimestamp
-                        step.timestamps
-                        (List.range lastLineNo (lastLineNo + outputLineCount - 1))
+        setLineTimestamp lineNo timestamps =
+            Dict.update lineNo (always mtime) timestamps
 
-                newLog =
-                    Ansi.Log.update output step.log
-            in
-            { step | log = newLog, timestamps = newTimestamps }
+        newTimestamps =
+            List.foldl
+                setLineTimestamp
+                step.timestamps
+                (List.range lastLineNo (lastLineNo + outputLineCount - 1))
+
+        newLog =
+            Ansi.Log.update output step.log
+    in
+    { step | log = newLog, timestamps = newTimestamps }
 
 
 setStepError : String -> Time.Posix -> StepTree -> StepTree
@@ -15,6 +15,7 @@ module Build.StepTree.Models exposing
     , finishTree
     , focusRetry
     , map
+    , mapLastRun
     , updateAt
     , wrapHook
     , wrapMultiStep
@@ -136,6 +137,9 @@ type BuildEvent
     | FinishPut Origin Int Concourse.Version Concourse.Metadata (Maybe Time.Posix)
     | Log Origin String (Maybe Time.Posix)
     | Error Origin String Time.Posix
+    | Retrying Origin Time.Posix
+    | PausedAtBreakpoint Origin Time.Posix
+    | ResumedFromBreakpoint Origin Time.Posix
     | End
     | Opened
     | NetworkError
@@ -195,6 +199,108 @@ map f tree =
             tree
 
 
+{-| Like map, but for a retry applies the function to its latest attempt which
+has run, looking through any hooks, try or timeout wrapped around it.
+-}
+mapLastRun : (Step -> Step) -> StepTree -> StepTree
+mapLastRun f tree =
+    case tree of
+        Retry id tab focus attempts ->
+            let
+                lastRun =
+                    attempts
+                        |> Array.toIndexedList
+                        |> List.filter (Tuple.second >> hasRun)
+                        |> List.reverse
+                        |> List.head
+                        |> Maybe.map Tuple.first
+                        |> Maybe.withDefault 0
+            in
+            Retry id tab focus (Array.set lastRun (mapLastRun f (getMultiStepIndex lastRun tree)) attempts)
+
+        OnSuccess hookedStep ->
+            OnSuccess { hookedStep | step = mapLastRun f hookedStep.step }
+
+        OnFailure hookedStep ->
+            OnFailure { hookedStep | step = mapLastRun f hookedStep.step }
+
+        OnAbort hookedStep ->
+            OnAbort { hookedStep | step = mapLastRun f hookedStep.step }
+
+        OnError hookedStep ->
+            OnError { hookedStep | step = mapLastRun f hookedStep.step }
+
+        Ensure hookedStep ->
+            Ensure { hookedStep | step = mapLastRun f hookedStep.step }
+
+        Try step ->
+            Try (mapLastRun f step)
+
+        Timeout step ->
+            Timeout (mapLastRun f step)
+
+        _ ->
+            map f tree
+
+
+hasRun : StepTree -> Bool
+hasRun tree =
+    case tree of
+        Task step ->
+            step.state /= StepStatePending
+
+        Get step ->
+            step.state /= StepStatePending
+
+        Put step ->
+            step.state /= StepStatePending
+
+        SetPipeline step ->
+            step.state /= StepStatePending
+
+        LoadVar step ->
+            step.state /= StepStatePending
+
+        ArtifactInput step ->
+            step.state /= StepStatePending
+
+        ArtifactOutput step ->
+            step.state /= StepStatePending
+
+        Aggregate trees ->
+            List.any hasRun (Array.toList trees)
+
+        InParallel trees ->
+            List.any hasRun (Array.toList trees)
+
+        Do trees ->
+            List.any hasRun (Array.toList trees)
+
+        Retry _ _ _ trees ->
+            List.any hasRun (Array.toList trees)
+
+        OnSuccess hookedStep ->
+            hasRun hookedStep.step
+
+        OnFailure hookedStep ->
+            hasRun hookedStep.step
+
+        OnAbort hookedStep ->
+            hasRun hookedStep.step
+
+        OnError hookedStep ->
+            hasRun hookedStep.step
+
+        Ensure hookedStep ->
+            hasRun hookedStep.step
+
+        Try step ->
+            hasRun step
+
+        Timeout step ->
+            hasRun step
+
+
 wrapMultiStep : Int -> Dict StepID StepFocus -> Dict StepID StepFocus
 wrapMultiStep i =
     Dict.map (\_ subFocus -> subFocus >> setMultiStepIndex i)
@@ -177,6 +177,30 @@ decodeBuildEvent =
                     "finish-put" ->
                         Json.Decode.field "data" (decodeFinishResource FinishPut)
 
+                    "retry" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map2 Retrying
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "paused-at-breakpoint" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map2 PausedAtBreakpoint
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "resumed-from-breakpoint" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map2 ResumedFromBreakpoint
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
                     unknown ->
                         Json.Decode.fail ("unknown event type: " ++ unknown)
             )
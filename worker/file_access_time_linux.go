@@ -0,0 +1,18 @@
+package worker
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// fileAccessTime returns when the file was last read, falling back to when it
+// was last modified if it is not known.
+func fileAccessTime(info os.FileInfo) time.Time {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return info.ModTime()
+	}
+
+	return time.Unix(stat.Atim.Sec, stat.Atim.Nsec)
+}
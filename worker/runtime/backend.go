@@ -8,6 +8,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/garden"
@@ -157,6 +158,15 @@ func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container,
 
 	oci.Mounts = append(oci.Mounts, netMounts...)
 
+	netns, err := b.networkNamespaceOf(ctx, gdnSpec.Properties)
+	if err != nil {
+		return nil, fmt.Errorf("network namespace lookup: %w", err)
+	}
+
+	if netns != "" {
+		bespec.JoinNetworkNamespace(oci, netns)
+	}
+
 	cont, err := b.client.NewContainer(ctx, gdnSpec.Handle, gdnSpec.Properties, oci)
 	if err != nil {
 		return nil, fmt.Errorf("new container: %w", err)
@@ -167,7 +177,7 @@ func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container,
 		return nil, fmt.Errorf("new task: %w", err)
 	}
 
-	err = b.network.Add(ctx, task)
+	err = b.addToNetwork(ctx, task, gdnSpec.Properties)
 	if err != nil {
 		return nil, fmt.Errorf("network add: %w", err)
 	}
@@ -184,6 +194,52 @@ func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container,
 	), nil
 }
 
+// networkNamespaceOf returns the path of the network namespace of the
+// container whose handle is set in the properties of the container, if any.
+//
+func (b *GardenBackend) networkNamespaceOf(ctx context.Context, properties garden.Properties) (string, error) {
+	handle := properties[networkNamespaceOfPropertyName]
+	if handle == "" {
+		return "", nil
+	}
+
+	container, err := b.client.GetContainer(ctx, handle)
+	if err != nil {
+		return "", fmt.Errorf("get container: %w", err)
+	}
+
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("task lookup: %w", err)
+	}
+
+	return netNsPath(task), nil
+}
+
+// addToNetwork adds the task to the network, restricting its access as
+// requested by the properties of the container.
+//
+// Containers with no network access at all are left with only the loopback
+// interface of their network namespace. Containers which joined the network
+// namespace of another container are already on its network, with the same
+// access.
+//
+func (b *GardenBackend) addToNetwork(ctx context.Context, task containerd.Task, properties garden.Properties) error {
+	if properties[networkNamespaceOfPropertyName] != "" {
+		return nil
+	}
+
+	switch properties[networkPropertyName] {
+	case networkModeNone:
+		return nil
+	case networkModeAllowlist:
+		allowed := strings.Split(properties[networkAllowPropertyName], ",")
+		return b.network.AddRestricted(ctx, task, allowed)
+	default:
+		return b.network.Add(ctx, task)
+	}
+}
+
 // Destroy gracefully destroys a container.
 //
 func (b *GardenBackend) Destroy(handle string) error {
@@ -217,9 +273,18 @@ func (b *GardenBackend) Destroy(handle string) error {
 		return fmt.Errorf("gracefully killing task: %w", err)
 	}
 
-	err = b.network.Remove(ctx, task)
+	labels, err := container.Labels(ctx)
 	if err != nil {
-		return fmt.Errorf("network remove: %w", err)
+		return fmt.Errorf("labels lookup: %w", err)
+	}
+
+	// removing a container which joined the network namespace of another one
+	// would remove the other one's interface instead
+	if labels[networkNamespaceOfPropertyName] == "" {
+		err = b.network.Remove(ctx, task)
+		if err != nil {
+			return fmt.Errorf("network remove: %w", err)
+		}
 	}
 
 	_, err = task.Delete(ctx, containerd.WithProcessKill)
@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -144,6 +145,127 @@ func (s *BackendSuite) TestCreateContainerSetsHandle() {
 
 }
 
+func (s *BackendSuite) TestCreateContainerAddsToNetwork() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	_, err := s.backend.Create(minimumValidGdnSpec)
+	s.NoError(err)
+
+	s.Equal(1, s.network.AddCallCount())
+	_, task := s.network.AddArgsForCall(0)
+	s.Equal(fakeTask, task)
+	s.Equal(0, s.network.AddRestrictedCallCount())
+}
+
+func (s *BackendSuite) TestCreateContainerWithoutNetwork() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	spec := minimumValidGdnSpec
+	spec.Properties = garden.Properties{"concourse:network": "none"}
+
+	_, err := s.backend.Create(spec)
+	s.NoError(err)
+
+	s.Equal(0, s.network.AddCallCount())
+	s.Equal(0, s.network.AddRestrictedCallCount())
+}
+
+func (s *BackendSuite) TestCreateContainerWithNetworkAllowlist() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	spec := minimumValidGdnSpec
+	spec.Properties = garden.Properties{
+		"concourse:network":       "allowlist",
+		"concourse:network-allow": "10.0.0.0/8,1.2.3.4/32",
+	}
+
+	_, err := s.backend.Create(spec)
+	s.NoError(err)
+
+	s.Equal(0, s.network.AddCallCount())
+	s.Equal(1, s.network.AddRestrictedCallCount())
+	_, task, allowed := s.network.AddRestrictedArgsForCall(0)
+	s.Equal(fakeTask, task)
+	s.Equal([]string{"10.0.0.0/8", "1.2.3.4/32"}, allowed)
+}
+
+func (s *BackendSuite) TestCreateContainerNetworkAddFailure() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+	s.network.AddRestrictedReturns(errors.New("add-err"))
+
+	spec := minimumValidGdnSpec
+	spec.Properties = garden.Properties{
+		"concourse:network":       "allowlist",
+		"concourse:network-allow": "10.0.0.0/8",
+	}
+
+	_, err := s.backend.Create(spec)
+	s.EqualError(errors.Unwrap(err), "add-err")
+	s.Equal(0, fakeTask.StartCallCount())
+}
+
+func (s *BackendSuite) TestCreateContainerJoiningNetworkNamespace() {
+	servedTask := new(libcontainerdfakes.FakeTask)
+	servedTask.PidReturns(123)
+	servedContainer := new(libcontainerdfakes.FakeContainer)
+	servedContainer.TaskReturns(servedTask, nil)
+	s.client.GetContainerReturns(servedContainer, nil)
+
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	spec := minimumValidGdnSpec
+	spec.Properties = garden.Properties{"concourse:network-namespace-of": "served-handle"}
+
+	_, err := s.backend.Create(spec)
+	s.NoError(err)
+
+	_, handle := s.client.GetContainerArgsForCall(0)
+	s.Equal("served-handle", handle)
+
+	_, _, _, oci := s.client.NewContainerArgsForCall(0)
+	joined := false
+	for _, namespace := range oci.Linux.Namespaces {
+		if namespace.Type == specs.NetworkNamespace {
+			s.Equal("/proc/123/ns/net", namespace.Path)
+			joined = true
+		}
+	}
+	s.True(joined)
+
+	s.Equal(0, s.network.AddCallCount())
+	s.Equal(0, s.network.AddRestrictedCallCount())
+}
+
+func (s *BackendSuite) TestCreateContainerJoiningMissingNetworkNamespace() {
+	s.client.GetContainerReturns(nil, errors.New("not-found"))
+
+	spec := minimumValidGdnSpec
+	spec.Properties = garden.Properties{"concourse:network-namespace-of": "served-handle"}
+
+	_, err := s.backend.Create(spec)
+	s.Error(err)
+	s.Equal(0, s.client.NewContainerCallCount())
+}
+
 func (s *BackendSuite) TestContainersWithContainerdFailure() {
 	s.client.ContainersReturns(nil, errors.New("err"))
 
@@ -333,6 +455,19 @@ func (s *BackendSuite) TestDestroyRemoveNetworkFails() {
 	s.True(errors.Is(err, expectedError))
 }
 
+func (s *BackendSuite) TestDestroyJoinedNetworkNamespace() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeTask := new(libcontainerdfakes.FakeTask)
+
+	s.client.GetContainerReturns(fakeContainer, nil)
+	fakeContainer.TaskReturns(fakeTask, nil)
+	fakeContainer.LabelsReturns(map[string]string{"concourse:network-namespace-of": "served-handle"}, nil)
+
+	err := s.backend.Destroy("some handle")
+	s.NoError(err)
+	s.Equal(0, s.network.RemoveCallCount())
+}
+
 func (s *BackendSuite) TestDestroyDeleteTaskFails() {
 	fakeContainer := new(libcontainerdfakes.FakeContainer)
 	fakeTask := new(libcontainerdfakes.FakeTask)
@@ -2,9 +2,12 @@ package runtime
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"net"
 	"path/filepath"
 
+	"github.com/concourse/concourse/worker/runtime/iptables"
 	"github.com/containerd/containerd"
 	"github.com/containerd/go-cni"
 	"github.com/opencontainers/runtime-spec/specs-go"
@@ -41,6 +44,11 @@ const (
 	// binaries in.
 	//
 	binariesDir = "/usr/local/concourse/bin"
+
+	// filterTable is the iptables table in which the firewall rules of
+	// containers with restricted network access are set up.
+	//
+	filterTable = "filter"
 )
 
 var (
@@ -121,6 +129,15 @@ func WithCNIClient(c cni.CNI) CNINetworkOpt {
 	}
 }
 
+// WithIptables changes the default Iptables used to set up the firewall rules
+// of containers with restricted network access.
+//
+func WithIptables(ipt iptables.Iptables) CNINetworkOpt {
+	return func(n *cniNetwork) {
+		n.iptables = ipt
+	}
+}
+
 // WithCNINetworkConfig provides a custom CNINetworkConfig to be used by the CNI
 // client at startup time.
 //
@@ -142,6 +159,7 @@ func WithCNIFileStore(f FileStore) CNINetworkOpt {
 type cniNetwork struct {
 	client      cni.CNI
 	store       FileStore
+	iptables    iptables.Iptables
 	config      CNINetworkConfig
 	nameServers []string
 	binariesDir string
@@ -166,6 +184,10 @@ func NewCNINetwork(opts ...CNINetworkOpt) (*cniNetwork, error) {
 		n.store = NewFileStore(fileStoreWorkDir)
 	}
 
+	if n.iptables == nil {
+		n.iptables = iptables.New()
+	}
+
 	if n.client == nil {
 		n.client, err = cni.New(cni.WithPluginDir([]string{n.binariesDir}))
 		if err != nil {
@@ -244,6 +266,90 @@ func (n cniNetwork) Add(ctx context.Context, task containerd.Task) error {
 	return nil
 }
 
+// AddRestricted adds a task to the network, and then sets up a chain of
+// firewall rules on the host which only accepts traffic from the task's
+// address to the given subnets and DNS to the nameservers, rejecting
+// everything else.
+//
+// As the rules live outside of the container's network namespace, they hold
+// for privileged containers too. Traffic to other containers on the bridge
+// only goes through them once bridged traffic is passed to iptables (see
+// net.bridge.bridge-nf-call-iptables).
+//
+func (n cniNetwork) AddRestricted(ctx context.Context, task containerd.Task, allowed []string) error {
+	if task == nil {
+		return ErrInvalidInput("nil task")
+	}
+
+	id, netns := netId(task), netNsPath(task)
+
+	result, err := n.client.Setup(ctx, id, netns)
+	if err != nil {
+		return fmt.Errorf("cni net setup: %w", err)
+	}
+
+	ip, err := resultIP(result)
+	if err == nil {
+		err = n.restrict(id, ip, allowed)
+	}
+
+	if err != nil {
+		// never leave the task on the network without its restrictions
+		_ = n.unrestrict(id)
+		_ = n.client.Remove(ctx, id, netns)
+
+		return fmt.Errorf("restrict: %w", err)
+	}
+
+	return nil
+}
+
+func (n cniNetwork) restrict(id string, ip net.IP, allowed []string) error {
+	chain := restrictedChain(id)
+
+	err := n.iptables.CreateChainOrFlushIfExists(filterTable, chain)
+	if err != nil {
+		return err
+	}
+
+	rules := [][]string{
+		// the chain is jumped to for all traffic, see below
+		{"!", "-s", ip.String() + "/32", "-j", "RETURN"},
+		{"-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT"},
+	}
+
+	for _, nameServer := range n.nameServers {
+		for _, protocol := range []string{"udp", "tcp"} {
+			rules = append(rules, []string{"-d", nameServer, "-p", protocol, "--dport", "53", "-j", "ACCEPT"})
+		}
+	}
+
+	for _, subnet := range allowed {
+		rules = append(rules, []string{"-d", subnet, "-j", "ACCEPT"})
+	}
+
+	rules = append(rules, []string{"-j", "REJECT"})
+
+	for _, rule := range rules {
+		err = n.iptables.AppendRule(filterTable, chain, rule...)
+		if err != nil {
+			return err
+		}
+	}
+
+	// both traffic routed through the host (including to other containers,
+	// as long as it's bridged through iptables) and traffic to the host
+	// itself is checked, before the rules the CNI plugins set up
+	for _, hook := range []string{"FORWARD", "INPUT"} {
+		err = n.iptables.InsertRule(filterTable, hook, 1, "-j", chain)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 	if task == nil {
 		return ErrInvalidInput("nil task")
@@ -251,7 +357,12 @@ func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 
 	id, netns := netId(task), netNsPath(task)
 
-	err := n.client.Remove(ctx, id, netns)
+	err := n.unrestrict(id)
+	if err != nil {
+		return fmt.Errorf("unrestrict: %w", err)
+	}
+
+	err = n.client.Remove(ctx, id, netns)
 	if err != nil {
 		return fmt.Errorf("cni net teardown: %w", err)
 	}
@@ -259,6 +370,51 @@ func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 	return nil
 }
 
+func (n cniNetwork) unrestrict(id string) error {
+	chain := restrictedChain(id)
+
+	exists, err := n.iptables.ChainExists(filterTable, chain)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	for _, hook := range []string{"FORWARD", "INPUT"} {
+		err = n.iptables.DeleteRule(filterTable, hook, "-j", chain)
+		if err != nil {
+			return err
+		}
+	}
+
+	return n.iptables.DeleteChain(filterTable, chain)
+}
+
+// restrictedChain names the chain of firewall rules of a container with
+// restricted network access, within the 28 characters iptables allows.
+//
+func restrictedChain(id string) string {
+	return fmt.Sprintf("CONCOURSE-%x", sha256.Sum256([]byte(id)))[:26]
+}
+
+func resultIP(result *cni.CNIResult) (net.IP, error) {
+	for _, iface := range result.Interfaces {
+		if iface.Sandbox == "" {
+			continue
+		}
+
+		for _, ipConfig := range iface.IPConfigs {
+			if ipConfig.IP.To4() != nil && !ipConfig.IP.IsLoopback() {
+				return ipConfig.IP, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no address in cni result")
+}
+
 func netId(task containerd.Task) string {
 	return task.ID()
 }
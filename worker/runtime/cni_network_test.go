@@ -3,10 +3,13 @@ package runtime_test
 import (
 	"context"
 	"errors"
+	"net"
 
 	"github.com/concourse/concourse/worker/runtime"
+	"github.com/concourse/concourse/worker/runtime/iptables/iptablesfakes"
 	"github.com/concourse/concourse/worker/runtime/runtimefakes"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
+	"github.com/containerd/go-cni"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -16,9 +19,10 @@ type CNINetworkSuite struct {
 	suite.Suite
 	*require.Assertions
 
-	network runtime.Network
-	cni     *runtimefakes.FakeCNI
-	store   *runtimefakes.FakeFileStore
+	network  runtime.Network
+	cni      *runtimefakes.FakeCNI
+	store    *runtimefakes.FakeFileStore
+	iptables *iptablesfakes.FakeIptables
 }
 
 func (s *CNINetworkSuite) SetupTest() {
@@ -26,9 +30,11 @@ func (s *CNINetworkSuite) SetupTest() {
 
 	s.store = new(runtimefakes.FakeFileStore)
 	s.cni = new(runtimefakes.FakeCNI)
+	s.iptables = new(iptablesfakes.FakeIptables)
 	s.network, err = runtime.NewCNINetwork(
 		runtime.WithCNIFileStore(s.store),
 		runtime.WithCNIClient(s.cni),
+		runtime.WithIptables(s.iptables),
 	)
 	s.NoError(err)
 }
@@ -152,6 +158,111 @@ func (s *CNINetworkSuite) TestAdd() {
 	s.Equal("/proc/123/ns/net", netns)
 }
 
+func (s *CNINetworkSuite) TestAddRestrictedNilTask() {
+	err := s.network.AddRestricted(context.Background(), nil, []string{"10.0.0.0/8"})
+	s.EqualError(err, "nil task")
+}
+
+func (s *CNINetworkSuite) TestAddRestrictedSetupErrors() {
+	s.cni.SetupReturns(nil, errors.New("setup-err"))
+	task := new(libcontainerdfakes.FakeTask)
+
+	err := s.network.AddRestricted(context.Background(), task, []string{"10.0.0.0/8"})
+	s.EqualError(errors.Unwrap(err), "setup-err")
+
+	s.Equal(0, s.iptables.CreateChainOrFlushIfExistsCallCount())
+}
+
+func (s *CNINetworkSuite) TestAddRestricted() {
+	network, err := runtime.NewCNINetwork(
+		runtime.WithCNIFileStore(s.store),
+		runtime.WithCNIClient(s.cni),
+		runtime.WithIptables(s.iptables),
+		runtime.WithNameServers([]string{"1.1.1.1"}),
+	)
+	s.NoError(err)
+
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"lo": {
+				Sandbox:   "/proc/123/ns/net",
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("127.0.0.1")}},
+			},
+			"eth0": {
+				Sandbox:   "/proc/123/ns/net",
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("10.80.0.2")}},
+			},
+		},
+	}, nil)
+
+	task := new(libcontainerdfakes.FakeTask)
+	task.PidReturns(123)
+	task.IDReturns("id")
+
+	err = network.AddRestricted(context.Background(), task, []string{"10.0.0.0/8", "1.2.3.4/32"})
+	s.NoError(err)
+
+	s.Equal(1, s.cni.SetupCallCount())
+	_, id, netns, _ := s.cni.SetupArgsForCall(0)
+	s.Equal("id", id)
+	s.Equal("/proc/123/ns/net", netns)
+
+	s.Equal(1, s.iptables.CreateChainOrFlushIfExistsCallCount())
+	table, chain := s.iptables.CreateChainOrFlushIfExistsArgsForCall(0)
+	s.Equal("filter", table)
+	s.Regexp("^CONCOURSE-[0-9a-f]{16}$", chain)
+
+	var rules [][]string
+	for i := 0; i < s.iptables.AppendRuleCallCount(); i++ {
+		table, ruleChain, rule := s.iptables.AppendRuleArgsForCall(i)
+		s.Equal("filter", table)
+		s.Equal(chain, ruleChain)
+		rules = append(rules, rule)
+	}
+
+	s.Equal([][]string{
+		{"!", "-s", "10.80.0.2/32", "-j", "RETURN"},
+		{"-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT"},
+		{"-d", "1.1.1.1", "-p", "udp", "--dport", "53", "-j", "ACCEPT"},
+		{"-d", "1.1.1.1", "-p", "tcp", "--dport", "53", "-j", "ACCEPT"},
+		{"-d", "10.0.0.0/8", "-j", "ACCEPT"},
+		{"-d", "1.2.3.4/32", "-j", "ACCEPT"},
+		{"-j", "REJECT"},
+	}, rules)
+
+	s.Equal(2, s.iptables.InsertRuleCallCount())
+	for i, hook := range []string{"FORWARD", "INPUT"} {
+		table, hookChain, pos, rule := s.iptables.InsertRuleArgsForCall(i)
+		s.Equal("filter", table)
+		s.Equal(hook, hookChain)
+		s.Equal(1, pos)
+		s.Equal([]string{"-j", chain}, rule)
+	}
+}
+
+func (s *CNINetworkSuite) TestAddRestrictedFirewallErrors() {
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"eth0": {
+				Sandbox:   "/proc/123/ns/net",
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("10.80.0.2")}},
+			},
+		},
+	}, nil)
+	s.iptables.AppendRuleReturns(errors.New("append-err"))
+	s.iptables.ChainExistsReturns(true, nil)
+
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.AddRestricted(context.Background(), task, []string{"10.0.0.0/8"})
+	s.EqualError(errors.Unwrap(err), "append-err")
+
+	// the task is taken off the network rather than left unrestricted
+	s.Equal(1, s.iptables.DeleteChainCallCount())
+	s.Equal(1, s.cni.RemoveCallCount())
+}
+
 func (s *CNINetworkSuite) TestRemoveNilTask() {
 	err := s.network.Remove(context.Background(), nil)
 	s.EqualError(err, "nil task")
@@ -177,4 +288,33 @@ func (s *CNINetworkSuite) TestRemove() {
 	_, id, netns, _ := s.cni.RemoveArgsForCall(0)
 	s.Equal("id", id)
 	s.Equal("/proc/123/ns/net", netns)
+
+	s.Equal(1, s.iptables.ChainExistsCallCount())
+	s.Equal(0, s.iptables.DeleteChainCallCount())
+}
+
+func (s *CNINetworkSuite) TestRemoveRestricted() {
+	s.iptables.ChainExistsReturns(true, nil)
+
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.Remove(context.Background(), task)
+	s.NoError(err)
+
+	_, chain := s.iptables.ChainExistsArgsForCall(0)
+
+	s.Equal(2, s.iptables.DeleteRuleCallCount())
+	for i, hook := range []string{"FORWARD", "INPUT"} {
+		table, hookChain, rule := s.iptables.DeleteRuleArgsForCall(i)
+		s.Equal("filter", table)
+		s.Equal(hook, hookChain)
+		s.Equal([]string{"-j", chain}, rule)
+	}
+
+	s.Equal(1, s.iptables.DeleteChainCallCount())
+	_, deleted := s.iptables.DeleteChainArgsForCall(0)
+	s.Equal(chain, deleted)
+
+	s.Equal(1, s.cni.RemoveCallCount())
 }
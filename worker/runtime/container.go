@@ -360,6 +360,14 @@ func (c *Container) setupContainerdProcSpec(gdnProcSpec garden.ProcessSpec, cont
 	}
 
 
+	if gdnProcSpec.Limits.Nofile != nil {
+		procSpec.Rlimits = withRlimit(procSpec.Rlimits, specs.POSIXRlimit{
+			Type: "RLIMIT_NOFILE",
+			Hard: *gdnProcSpec.Limits.Nofile,
+			Soft: *gdnProcSpec.Limits.Nofile,
+		})
+	}
+
 	if gdnProcSpec.User != "" {
 		var ok bool
 		var err error
@@ -392,3 +400,16 @@ func containerdCIO(gdnProcIO garden.ProcessIO, tty bool) []cio.Opt {
 
 	return cioOpts
 }
+
+// withRlimit returns a copy of rlimits in which the rlimit of the same type
+// is replaced by the given one.
+func withRlimit(rlimits []specs.POSIXRlimit, rlimit specs.POSIXRlimit) []specs.POSIXRlimit {
+	replaced := []specs.POSIXRlimit{}
+	for _, r := range rlimits {
+		if r.Type != rlimit.Type {
+			replaced = append(replaced, r)
+		}
+	}
+
+	return append(replaced, rlimit)
+}
@@ -252,6 +252,33 @@ func (s *ContainerSuite) TestRunWithUserLookupNotFound() {
 	s.True(errors.Is(err, runtime.UserNotFoundError{User: "some_invalid_user"}))
 }
 
+func (s *ContainerSuite) TestRunWithOpenFilesLimit() {
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Process: &specs.Process{
+			Rlimits: []specs.POSIXRlimit{
+				{Type: "RLIMIT_NOFILE", Hard: 1024, Soft: 1024},
+				{Type: "RLIMIT_NPROC", Hard: 512, Soft: 512},
+			},
+		},
+		Root: &specs.Root{},
+	}, nil)
+
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.ExecReturns(s.containerdProcess, nil)
+
+	openFiles := uint64(4096)
+	_, err := s.container.Run(garden.ProcessSpec{
+		Limits: garden.ResourceLimits{Nofile: &openFiles},
+	}, garden.ProcessIO{})
+	s.NoError(err)
+
+	_, _, procSpec, _ := s.containerdTask.ExecArgsForCall(0)
+	s.ElementsMatch([]specs.POSIXRlimit{
+		{Type: "RLIMIT_NPROC", Hard: 512, Soft: 512},
+		{Type: "RLIMIT_NOFILE", Hard: 4096, Soft: 4096},
+	}, procSpec.Rlimits)
+}
+
 func (s *ContainerSuite) TestSetGraceTimeSetLabelsFails() {
 	expectedErr := errors.New("set-label-error")
 	s.containerdContainer.SetLabelsReturns(nil, expectedErr)
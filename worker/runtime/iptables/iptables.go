@@ -0,0 +1,137 @@
+package iptables
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Iptables
+
+// Iptables manages the rules of the host's firewall.
+//
+type Iptables interface {
+	// CreateChainOrFlushIfExists creates a chain, or removes all of its
+	// rules if it already exists.
+	//
+	CreateChainOrFlushIfExists(table string, chain string) error
+
+	// ChainExists tells whether a chain exists.
+	//
+	ChainExists(table string, chain string) (bool, error)
+
+	// DeleteChain removes all of the rules of a chain and then the chain
+	// itself, which must not be referred to by any other rule.
+	//
+	DeleteChain(table string, chain string) error
+
+	// InsertRule inserts a rule at the given (1-based) position of a chain.
+	//
+	InsertRule(table string, chain string, pos int, rulespec ...string) error
+
+	// AppendRule appends a rule to the end of a chain.
+	//
+	AppendRule(table string, chain string, rulespec ...string) error
+
+	// DeleteRule removes a rule from a chain.
+	//
+	DeleteRule(table string, chain string, rulespec ...string) error
+}
+
+type iptables struct {
+	bin string
+}
+
+var _ Iptables = (*iptables)(nil)
+
+// New returns an Iptables which runs the `iptables` binary found in $PATH,
+// waiting for the xtables lock so that concurrent changes do not fail.
+//
+func New() *iptables {
+	return &iptables{
+		bin: "iptables",
+	}
+}
+
+func (ipt iptables) CreateChainOrFlushIfExists(table string, chain string) error {
+	exists, err := ipt.ChainExists(table, chain)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return ipt.run(table, "-F", chain)
+	}
+
+	return ipt.run(table, "-N", chain)
+}
+
+func (ipt iptables) ChainExists(table string, chain string) (bool, error) {
+	err := ipt.run(table, "-S", chain)
+	if err == nil {
+		return true, nil
+	}
+
+	if exitErr, ok := err.(*Error); ok && exitErr.ExitStatus == 1 {
+		return false, nil
+	}
+
+	return false, err
+}
+
+func (ipt iptables) DeleteChain(table string, chain string) error {
+	err := ipt.run(table, "-F", chain)
+	if err != nil {
+		return err
+	}
+
+	return ipt.run(table, "-X", chain)
+}
+
+func (ipt iptables) InsertRule(table string, chain string, pos int, rulespec ...string) error {
+	return ipt.run(table, append([]string{"-I", chain, strconv.Itoa(pos)}, rulespec...)...)
+}
+
+func (ipt iptables) AppendRule(table string, chain string, rulespec ...string) error {
+	return ipt.run(table, append([]string{"-A", chain}, rulespec...)...)
+}
+
+func (ipt iptables) DeleteRule(table string, chain string, rulespec ...string) error {
+	return ipt.run(table, append([]string{"-D", chain}, rulespec...)...)
+}
+
+func (ipt iptables) run(table string, args ...string) error {
+	stderr := new(bytes.Buffer)
+
+	cmd := exec.Command(ipt.bin, append([]string{"-w", "-t", table}, args...)...)
+	cmd.Stderr = stderr
+
+	err := cmd.Run()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return &Error{
+				Args:       args,
+				ExitStatus: exitErr.ExitCode(),
+				Stderr:     strings.TrimSpace(stderr.String()),
+			}
+		}
+
+		return fmt.Errorf("run iptables: %w", err)
+	}
+
+	return nil
+}
+
+// Error is returned when iptables exits unsuccessfully.
+//
+type Error struct {
+	Args       []string
+	ExitStatus int
+	Stderr     string
+}
+
+func (err *Error) Error() string {
+	return fmt.Sprintf("iptables %s: exit status %d: %s", strings.Join(err.Args, " "), err.ExitStatus, err.Stderr)
+}
@@ -0,0 +1,500 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package iptablesfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/worker/runtime/iptables"
+)
+
+type FakeIptables struct {
+	AppendRuleStub        func(string, string, ...string) error
+	appendRuleMutex       sync.RWMutex
+	appendRuleArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}
+	appendRuleReturns struct {
+		result1 error
+	}
+	appendRuleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ChainExistsStub        func(string, string) (bool, error)
+	chainExistsMutex       sync.RWMutex
+	chainExistsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	chainExistsReturns struct {
+		result1 bool
+		result2 error
+	}
+	chainExistsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CreateChainOrFlushIfExistsStub        func(string, string) error
+	createChainOrFlushIfExistsMutex       sync.RWMutex
+	createChainOrFlushIfExistsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	createChainOrFlushIfExistsReturns struct {
+		result1 error
+	}
+	createChainOrFlushIfExistsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteChainStub        func(string, string) error
+	deleteChainMutex       sync.RWMutex
+	deleteChainArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteChainReturns struct {
+		result1 error
+	}
+	deleteChainReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRuleStub        func(string, string, ...string) error
+	deleteRuleMutex       sync.RWMutex
+	deleteRuleArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}
+	deleteRuleReturns struct {
+		result1 error
+	}
+	deleteRuleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	InsertRuleStub        func(string, string, int, ...string) error
+	insertRuleMutex       sync.RWMutex
+	insertRuleArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 []string
+	}
+	insertRuleReturns struct {
+		result1 error
+	}
+	insertRuleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeIptables) AppendRule(arg1 string, arg2 string, arg3 ...string) error {
+	fake.appendRuleMutex.Lock()
+	ret, specificReturn := fake.appendRuleReturnsOnCall[len(fake.appendRuleArgsForCall)]
+	fake.appendRuleArgsForCall = append(fake.appendRuleArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AppendRule", []interface{}{arg1, arg2, arg3})
+	fake.appendRuleMutex.Unlock()
+	if fake.AppendRuleStub != nil {
+		return fake.AppendRuleStub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.appendRuleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) AppendRuleCallCount() int {
+	fake.appendRuleMutex.RLock()
+	defer fake.appendRuleMutex.RUnlock()
+	return len(fake.appendRuleArgsForCall)
+}
+
+func (fake *FakeIptables) AppendRuleCalls(stub func(string, string, ...string) error) {
+	fake.appendRuleMutex.Lock()
+	defer fake.appendRuleMutex.Unlock()
+	fake.AppendRuleStub = stub
+}
+
+func (fake *FakeIptables) AppendRuleArgsForCall(i int) (string, string, []string) {
+	fake.appendRuleMutex.RLock()
+	defer fake.appendRuleMutex.RUnlock()
+	argsForCall := fake.appendRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIptables) AppendRuleReturns(result1 error) {
+	fake.appendRuleMutex.Lock()
+	defer fake.appendRuleMutex.Unlock()
+	fake.AppendRuleStub = nil
+	fake.appendRuleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) AppendRuleReturnsOnCall(i int, result1 error) {
+	fake.appendRuleMutex.Lock()
+	defer fake.appendRuleMutex.Unlock()
+	fake.AppendRuleStub = nil
+	if fake.appendRuleReturnsOnCall == nil {
+		fake.appendRuleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.appendRuleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) ChainExists(arg1 string, arg2 string) (bool, error) {
+	fake.chainExistsMutex.Lock()
+	ret, specificReturn := fake.chainExistsReturnsOnCall[len(fake.chainExistsArgsForCall)]
+	fake.chainExistsArgsForCall = append(fake.chainExistsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ChainExists", []interface{}{arg1, arg2})
+	fake.chainExistsMutex.Unlock()
+	if fake.ChainExistsStub != nil {
+		return fake.ChainExistsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.chainExistsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIptables) ChainExistsCallCount() int {
+	fake.chainExistsMutex.RLock()
+	defer fake.chainExistsMutex.RUnlock()
+	return len(fake.chainExistsArgsForCall)
+}
+
+func (fake *FakeIptables) ChainExistsCalls(stub func(string, string) (bool, error)) {
+	fake.chainExistsMutex.Lock()
+	defer fake.chainExistsMutex.Unlock()
+	fake.ChainExistsStub = stub
+}
+
+func (fake *FakeIptables) ChainExistsArgsForCall(i int) (string, string) {
+	fake.chainExistsMutex.RLock()
+	defer fake.chainExistsMutex.RUnlock()
+	argsForCall := fake.chainExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) ChainExistsReturns(result1 bool, result2 error) {
+	fake.chainExistsMutex.Lock()
+	defer fake.chainExistsMutex.Unlock()
+	fake.ChainExistsStub = nil
+	fake.chainExistsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIptables) ChainExistsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.chainExistsMutex.Lock()
+	defer fake.chainExistsMutex.Unlock()
+	fake.ChainExistsStub = nil
+	if fake.chainExistsReturnsOnCall == nil {
+		fake.chainExistsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.chainExistsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIptables) CreateChainOrFlushIfExists(arg1 string, arg2 string) error {
+	fake.createChainOrFlushIfExistsMutex.Lock()
+	ret, specificReturn := fake.createChainOrFlushIfExistsReturnsOnCall[len(fake.createChainOrFlushIfExistsArgsForCall)]
+	fake.createChainOrFlushIfExistsArgsForCall = append(fake.createChainOrFlushIfExistsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CreateChainOrFlushIfExists", []interface{}{arg1, arg2})
+	fake.createChainOrFlushIfExistsMutex.Unlock()
+	if fake.CreateChainOrFlushIfExistsStub != nil {
+		return fake.CreateChainOrFlushIfExistsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createChainOrFlushIfExistsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) CreateChainOrFlushIfExistsCallCount() int {
+	fake.createChainOrFlushIfExistsMutex.RLock()
+	defer fake.createChainOrFlushIfExistsMutex.RUnlock()
+	return len(fake.createChainOrFlushIfExistsArgsForCall)
+}
+
+func (fake *FakeIptables) CreateChainOrFlushIfExistsCalls(stub func(string, string) error) {
+	fake.createChainOrFlushIfExistsMutex.Lock()
+	defer fake.createChainOrFlushIfExistsMutex.Unlock()
+	fake.CreateChainOrFlushIfExistsStub = stub
+}
+
+func (fake *FakeIptables) CreateChainOrFlushIfExistsArgsForCall(i int) (string, string) {
+	fake.createChainOrFlushIfExistsMutex.RLock()
+	defer fake.createChainOrFlushIfExistsMutex.RUnlock()
+	argsForCall := fake.createChainOrFlushIfExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) CreateChainOrFlushIfExistsReturns(result1 error) {
+	fake.createChainOrFlushIfExistsMutex.Lock()
+	defer fake.createChainOrFlushIfExistsMutex.Unlock()
+	fake.CreateChainOrFlushIfExistsStub = nil
+	fake.createChainOrFlushIfExistsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) CreateChainOrFlushIfExistsReturnsOnCall(i int, result1 error) {
+	fake.createChainOrFlushIfExistsMutex.Lock()
+	defer fake.createChainOrFlushIfExistsMutex.Unlock()
+	fake.CreateChainOrFlushIfExistsStub = nil
+	if fake.createChainOrFlushIfExistsReturnsOnCall == nil {
+		fake.createChainOrFlushIfExistsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createChainOrFlushIfExistsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteChain(arg1 string, arg2 string) error {
+	fake.deleteChainMutex.Lock()
+	ret, specificReturn := fake.deleteChainReturnsOnCall[len(fake.deleteChainArgsForCall)]
+	fake.deleteChainArgsForCall = append(fake.deleteChainArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteChain", []interface{}{arg1, arg2})
+	fake.deleteChainMutex.Unlock()
+	if fake.DeleteChainStub != nil {
+		return fake.DeleteChainStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteChainReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) DeleteChainCallCount() int {
+	fake.deleteChainMutex.RLock()
+	defer fake.deleteChainMutex.RUnlock()
+	return len(fake.deleteChainArgsForCall)
+}
+
+func (fake *FakeIptables) DeleteChainCalls(stub func(string, string) error) {
+	fake.deleteChainMutex.Lock()
+	defer fake.deleteChainMutex.Unlock()
+	fake.DeleteChainStub = stub
+}
+
+func (fake *FakeIptables) DeleteChainArgsForCall(i int) (string, string) {
+	fake.deleteChainMutex.RLock()
+	defer fake.deleteChainMutex.RUnlock()
+	argsForCall := fake.deleteChainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) DeleteChainReturns(result1 error) {
+	fake.deleteChainMutex.Lock()
+	defer fake.deleteChainMutex.Unlock()
+	fake.DeleteChainStub = nil
+	fake.deleteChainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteChainReturnsOnCall(i int, result1 error) {
+	fake.deleteChainMutex.Lock()
+	defer fake.deleteChainMutex.Unlock()
+	fake.DeleteChainStub = nil
+	if fake.deleteChainReturnsOnCall == nil {
+		fake.deleteChainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteChainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteRule(arg1 string, arg2 string, arg3 ...string) error {
+	fake.deleteRuleMutex.Lock()
+	ret, specificReturn := fake.deleteRuleReturnsOnCall[len(fake.deleteRuleArgsForCall)]
+	fake.deleteRuleArgsForCall = append(fake.deleteRuleArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteRule", []interface{}{arg1, arg2, arg3})
+	fake.deleteRuleMutex.Unlock()
+	if fake.DeleteRuleStub != nil {
+		return fake.DeleteRuleStub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteRuleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) DeleteRuleCallCount() int {
+	fake.deleteRuleMutex.RLock()
+	defer fake.deleteRuleMutex.RUnlock()
+	return len(fake.deleteRuleArgsForCall)
+}
+
+func (fake *FakeIptables) DeleteRuleCalls(stub func(string, string, ...string) error) {
+	fake.deleteRuleMutex.Lock()
+	defer fake.deleteRuleMutex.Unlock()
+	fake.DeleteRuleStub = stub
+}
+
+func (fake *FakeIptables) DeleteRuleArgsForCall(i int) (string, string, []string) {
+	fake.deleteRuleMutex.RLock()
+	defer fake.deleteRuleMutex.RUnlock()
+	argsForCall := fake.deleteRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIptables) DeleteRuleReturns(result1 error) {
+	fake.deleteRuleMutex.Lock()
+	defer fake.deleteRuleMutex.Unlock()
+	fake.DeleteRuleStub = nil
+	fake.deleteRuleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteRuleReturnsOnCall(i int, result1 error) {
+	fake.deleteRuleMutex.Lock()
+	defer fake.deleteRuleMutex.Unlock()
+	fake.DeleteRuleStub = nil
+	if fake.deleteRuleReturnsOnCall == nil {
+		fake.deleteRuleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRuleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) InsertRule(arg1 string, arg2 string, arg3 int, arg4 ...string) error {
+	fake.insertRuleMutex.Lock()
+	ret, specificReturn := fake.insertRuleReturnsOnCall[len(fake.insertRuleArgsForCall)]
+	fake.insertRuleArgsForCall = append(fake.insertRuleArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 []string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("InsertRule", []interface{}{arg1, arg2, arg3, arg4})
+	fake.insertRuleMutex.Unlock()
+	if fake.InsertRuleStub != nil {
+		return fake.InsertRuleStub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.insertRuleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) InsertRuleCallCount() int {
+	fake.insertRuleMutex.RLock()
+	defer fake.insertRuleMutex.RUnlock()
+	return len(fake.insertRuleArgsForCall)
+}
+
+func (fake *FakeIptables) InsertRuleCalls(stub func(string, string, int, ...string) error) {
+	fake.insertRuleMutex.Lock()
+	defer fake.insertRuleMutex.Unlock()
+	fake.InsertRuleStub = stub
+}
+
+func (fake *FakeIptables) InsertRuleArgsForCall(i int) (string, string, int, []string) {
+	fake.insertRuleMutex.RLock()
+	defer fake.insertRuleMutex.RUnlock()
+	argsForCall := fake.insertRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeIptables) InsertRuleReturns(result1 error) {
+	fake.insertRuleMutex.Lock()
+	defer fake.insertRuleMutex.Unlock()
+	fake.InsertRuleStub = nil
+	fake.insertRuleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) InsertRuleReturnsOnCall(i int, result1 error) {
+	fake.insertRuleMutex.Lock()
+	defer fake.insertRuleMutex.Unlock()
+	fake.InsertRuleStub = nil
+	if fake.insertRuleReturnsOnCall == nil {
+		fake.insertRuleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.insertRuleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.appendRuleMutex.RLock()
+	defer fake.appendRuleMutex.RUnlock()
+	fake.chainExistsMutex.RLock()
+	defer fake.chainExistsMutex.RUnlock()
+	fake.createChainOrFlushIfExistsMutex.RLock()
+	defer fake.createChainOrFlushIfExistsMutex.RUnlock()
+	fake.deleteChainMutex.RLock()
+	defer fake.deleteChainMutex.RUnlock()
+	fake.deleteRuleMutex.RLock()
+	defer fake.deleteRuleMutex.RUnlock()
+	fake.insertRuleMutex.RLock()
+	defer fake.insertRuleMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeIptables) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ iptables.Iptables = new(FakeIptables)
@@ -7,6 +7,21 @@ import (
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
+// properties by which the ATC restricts the network access of a container,
+// see atc/worker
+//
+const (
+	networkPropertyName      = "concourse:network"
+	networkAllowPropertyName = "concourse:network-allow"
+
+	networkModeNone      = "none"
+	networkModeAllowlist = "allowlist"
+
+	// handle of the container whose network namespace the container
+	// joins, e.g. a service joining the task it serves
+	networkNamespaceOfPropertyName = "concourse:network-namespace-of"
+)
+
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Network
 
 type Network interface {
@@ -19,6 +34,11 @@ type Network interface {
 	//
 	Add(ctx context.Context, task containerd.Task) (err error)
 
+	// AddRestricted adds a task to the network, only allowing it to reach
+	// the given subnets (in CIDR notation).
+	//
+	AddRestricted(ctx context.Context, task containerd.Task, allowed []string) (err error)
+
 	// Removes a task from the network.
 	//
 	Remove(ctx context.Context, task containerd.Task) (err error)
@@ -23,6 +23,19 @@ type FakeNetwork struct {
 	addReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AddRestrictedStub        func(context.Context, containerd.Task, []string) error
+	addRestrictedMutex       sync.RWMutex
+	addRestrictedArgsForCall []struct {
+		arg1 context.Context
+		arg2 containerd.Task
+		arg3 []string
+	}
+	addRestrictedReturns struct {
+		result1 error
+	}
+	addRestrictedReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemoveStub        func(context.Context, containerd.Task) error
 	removeMutex       sync.RWMutex
 	removeArgsForCall []struct {
@@ -113,6 +126,73 @@ func (fake *FakeNetwork) AddReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeNetwork) AddRestricted(arg1 context.Context, arg2 containerd.Task, arg3 []string) error {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.addRestrictedMutex.Lock()
+	ret, specificReturn := fake.addRestrictedReturnsOnCall[len(fake.addRestrictedArgsForCall)]
+	fake.addRestrictedArgsForCall = append(fake.addRestrictedArgsForCall, struct {
+		arg1 context.Context
+		arg2 containerd.Task
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("AddRestricted", []interface{}{arg1, arg2, arg3Copy})
+	fake.addRestrictedMutex.Unlock()
+	if fake.AddRestrictedStub != nil {
+		return fake.AddRestrictedStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.addRestrictedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNetwork) AddRestrictedCallCount() int {
+	fake.addRestrictedMutex.RLock()
+	defer fake.addRestrictedMutex.RUnlock()
+	return len(fake.addRestrictedArgsForCall)
+}
+
+func (fake *FakeNetwork) AddRestrictedCalls(stub func(context.Context, containerd.Task, []string) error) {
+	fake.addRestrictedMutex.Lock()
+	defer fake.addRestrictedMutex.Unlock()
+	fake.AddRestrictedStub = stub
+}
+
+func (fake *FakeNetwork) AddRestrictedArgsForCall(i int) (context.Context, containerd.Task, []string) {
+	fake.addRestrictedMutex.RLock()
+	defer fake.addRestrictedMutex.RUnlock()
+	argsForCall := fake.addRestrictedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNetwork) AddRestrictedReturns(result1 error) {
+	fake.addRestrictedMutex.Lock()
+	defer fake.addRestrictedMutex.Unlock()
+	fake.AddRestrictedStub = nil
+	fake.addRestrictedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetwork) AddRestrictedReturnsOnCall(i int, result1 error) {
+	fake.addRestrictedMutex.Lock()
+	defer fake.addRestrictedMutex.Unlock()
+	fake.AddRestrictedStub = nil
+	if fake.addRestrictedReturnsOnCall == nil {
+		fake.addRestrictedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addRestrictedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeNetwork) Remove(arg1 context.Context, arg2 containerd.Task) error {
 	fake.removeMutex.Lock()
 	ret, specificReturn := fake.removeReturnsOnCall[len(fake.removeArgsForCall)]
@@ -242,6 +322,8 @@ func (fake *FakeNetwork) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addMutex.RLock()
 	defer fake.addMutex.RUnlock()
+	fake.addRestrictedMutex.RLock()
+	defer fake.addRestrictedMutex.RUnlock()
 	fake.removeMutex.RLock()
 	defer fake.removeMutex.RUnlock()
 	fake.setupMountsMutex.RLock()
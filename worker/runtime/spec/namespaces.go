@@ -23,3 +23,20 @@ func OciNamespaces(privileged bool) []specs.LinuxNamespace {
 
 	return PrivilegedContainerNamespaces
 }
+
+// JoinNetworkNamespace makes the container join the network namespace at the
+// given path, e.g. /proc/<pid>/ns/net, instead of getting one of its own.
+//
+func JoinNetworkNamespace(oci *specs.Spec, path string) {
+	// copied, as the namespaces may be shared with other specs
+	namespaces := make([]specs.LinuxNamespace, len(oci.Linux.Namespaces))
+	copy(namespaces, oci.Linux.Namespaces)
+
+	for i := range namespaces {
+		if namespaces[i].Type == specs.NetworkNamespace {
+			namespaces[i].Path = path
+		}
+	}
+
+	oci.Linux.Namespaces = namespaces
+}
@@ -207,6 +207,28 @@ func (s *SpecSuite) TestOciNamespaces() {
 	}
 }
 
+func (s *SpecSuite) TestJoinNetworkNamespace() {
+	oci := &specs.Spec{
+		Linux: &specs.Linux{
+			Namespaces: spec.OciNamespaces(false),
+		},
+	}
+
+	spec.JoinNetworkNamespace(oci, "/proc/123/ns/net")
+
+	for _, namespace := range oci.Linux.Namespaces {
+		if namespace.Type == specs.NetworkNamespace {
+			s.Equal("/proc/123/ns/net", namespace.Path)
+		} else {
+			s.Empty(namespace.Path)
+		}
+	}
+
+	for _, namespace := range spec.OciNamespaces(false) {
+		s.Empty(namespace.Path)
+	}
+}
+
 func (s *SpecSuite) TestOciCapabilities() {
 	for _, tc := range []struct {
 		desc       string
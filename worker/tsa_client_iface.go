@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 )
 
@@ -18,6 +19,6 @@ type TSAClient interface {
 	ReportContainers(context.Context, []string) error
 	ContainersToDestroy(context.Context) ([]string, error)
 
-	ReportVolumes(context.Context, []string) error
+	ReportVolumes(context.Context, []string, *atc.VolumesDiskUsage) error
 	VolumesToDestroy(context.Context) ([]string, error)
 }
@@ -0,0 +1,92 @@
+package worker
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+type volumeFile struct {
+	path     string
+	size     uint64
+	lastUsed time.Time
+}
+
+// volumeSize measures the total size of the files in a volume's directory.
+// Files which disappear while it is being measured are skipped.
+func volumeSize(path string) (uint64, error) {
+	_, size, err := volumeFiles(path)
+	return size, err
+}
+
+// trimVolume removes the least recently used files from a volume's directory
+// until their total size is within the limit, and returns the size of the
+// files which remain. A file was last used when it was last read or written,
+// whichever came later.
+func trimVolume(path string, limit uint64) (uint64, error) {
+	files, size, err := volumeFiles(path)
+	if err != nil {
+		return 0, err
+	}
+
+	if size <= limit {
+		return size, nil
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].lastUsed.Before(files[j].lastUsed)
+	})
+
+	for _, file := range files {
+		if size <= limit {
+			break
+		}
+
+		err := os.Remove(file.path)
+		if err != nil && !os.IsNotExist(err) {
+			return size, err
+		}
+
+		size -= file.size
+	}
+
+	return size, nil
+}
+
+func volumeFiles(path string) ([]volumeFile, uint64, error) {
+	var files []volumeFile
+	var size uint64
+
+	err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if info.Mode().IsRegular() {
+			lastUsed := info.ModTime()
+			if accessed := fileAccessTime(info); accessed.After(lastUsed) {
+				lastUsed = accessed
+			}
+
+			files = append(files, volumeFile{
+				path:     path,
+				size:     uint64(info.Size()),
+				lastUsed: lastUsed,
+			})
+
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return files, size, nil
+}
@@ -3,12 +3,14 @@ package worker
 import (
 	"context"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
 )
 
 // volumeSweeper is an ifrit.Runner that periodically reports and
@@ -18,6 +20,7 @@ type volumeSweeper struct {
 	interval           time.Duration
 	tsaClient          TSAClient
 	baggageclaimClient baggageclaim.Client
+	volumesDir         string
 	maxInFlight        uint16
 }
 
@@ -26,6 +29,7 @@ func NewVolumeSweeper(
 	sweepInterval time.Duration,
 	tsaClient TSAClient,
 	bcClient baggageclaim.Client,
+	volumesDir string,
 	maxInFlight uint16,
 ) *volumeSweeper {
 	return &volumeSweeper{
@@ -33,6 +37,7 @@ func NewVolumeSweeper(
 		interval:           sweepInterval,
 		tsaClient:          tsaClient,
 		baggageclaimClient: bcClient,
+		volumesDir:         volumesDir,
 		maxInFlight:        maxInFlight,
 	}
 }
@@ -66,12 +71,19 @@ func (sweeper *volumeSweeper) sweep(logger lager.Logger) {
 			handles = append(handles, volume.Handle())
 		}
 
-		err := sweeper.tsaClient.ReportVolumes(ctx, handles)
+		usage, err := volumesDiskUsage(sweeper.volumesDir)
+		if err != nil {
+			logger.Error("failed-to-measure-volumes-disk-usage", err)
+		}
+
+		err = sweeper.tsaClient.ReportVolumes(ctx, handles, usage)
 		if err != nil {
 			logger.Error("failed-to-report-volumes", err)
 		}
 	}
 
+	sweeper.trimVolumes(logger)
+
 	volumeHandles, err := sweeper.tsaClient.VolumesToDestroy(ctx)
 	if err != nil {
 		logger.Error("failed-to-get-volumes-to-destroy", err)
@@ -96,3 +108,47 @@ func (sweeper *volumeSweeper) sweep(logger lager.Logger) {
 		wg.Wait()
 	}
 }
+
+// trimVolumes removes the least recently used files from the volumes which
+// have the atc.MeasuredVolumeProperty, e.g. the copies of named caches, until
+// they are within their atc.SizeLimitVolumeProperty. Volumes without a limit
+// are left as they are.
+func (sweeper *volumeSweeper) trimVolumes(logger lager.Logger) {
+	volumes, err := sweeper.baggageclaimClient.ListVolumes(
+		logger.Session("list-measured-volumes"),
+		baggageclaim.VolumeProperties{atc.MeasuredVolumeProperty: "true"},
+	)
+	if err != nil {
+		logger.Error("failed-to-list-measured-volumes", err)
+		return
+	}
+
+	for _, volume := range volumes {
+		vLog := logger.WithData(lager.Data{"handle": volume.Handle()})
+
+		properties, err := volume.Properties()
+		if err != nil {
+			vLog.Error("failed-to-get-volume-properties", err)
+			continue
+		}
+
+		value, found := properties[atc.SizeLimitVolumeProperty]
+		if !found {
+			continue
+		}
+
+		limit, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			vLog.Error("failed-to-parse-size-limit", err)
+			continue
+		}
+
+		size, err := trimVolume(volume.Path(), limit)
+		if err != nil {
+			vLog.Error("failed-to-trim-volume", err)
+			continue
+		}
+
+		vLog.Debug("trimmed-volume", lager.Data{"size": size, "limit": limit})
+	}
+}
@@ -0,0 +1,135 @@
+package worker_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/baggageclaim/baggageclaimfakes"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/worker"
+	"github.com/concourse/concourse/worker/workerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Volume Sweeper", func() {
+	const sweepInterval = 50 * time.Millisecond
+
+	var (
+		testLogger = lagertest.NewTestLogger("volume-sweeper")
+
+		fakeTSAClient    *workerfakes.FakeTSAClient
+		fakeBaggageclaim *baggageclaimfakes.FakeClient
+		fakeVolume       *baggageclaimfakes.FakeVolume
+		fakeCacheVolume  *baggageclaimfakes.FakeVolume
+		volumesDir       string
+		osSignal         chan os.Signal
+		exited           chan struct{}
+	)
+
+	BeforeEach(func() {
+		var err error
+		volumesDir, err = ioutil.TempDir("", "volumes")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeTSAClient = new(workerfakes.FakeTSAClient)
+		fakeBaggageclaim = new(baggageclaimfakes.FakeClient)
+
+		fakeVolume = new(baggageclaimfakes.FakeVolume)
+		fakeVolume.HandleReturns("some-handle")
+
+		cacheDir := filepath.Join(volumesDir, "some-cache-handle")
+		Expect(os.MkdirAll(filepath.Join(cacheDir, "some-dir"), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(cacheDir, "some-file"), make([]byte, 100), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(cacheDir, "some-dir", "some-other-file"), make([]byte, 20), 0644)).To(Succeed())
+
+		fakeCacheVolume = new(baggageclaimfakes.FakeVolume)
+		fakeCacheVolume.HandleReturns("some-cache-handle")
+		fakeCacheVolume.PathReturns(cacheDir)
+		fakeCacheVolume.PropertiesReturns(baggageclaim.VolumeProperties{atc.MeasuredVolumeProperty: "true"}, nil)
+
+		fakeBaggageclaim.ListVolumesStub = func(_ lager.Logger, properties baggageclaim.VolumeProperties) (baggageclaim.Volumes, error) {
+			if properties[atc.MeasuredVolumeProperty] == "true" {
+				return baggageclaim.Volumes{fakeCacheVolume}, nil
+			}
+
+			return baggageclaim.Volumes{fakeVolume, fakeCacheVolume}, nil
+		}
+
+		osSignal = make(chan os.Signal)
+		exited = make(chan struct{})
+	})
+
+	JustBeforeEach(func() {
+		sweeper := worker.NewVolumeSweeper(testLogger, sweepInterval, fakeTSAClient, fakeBaggageclaim, volumesDir, 1)
+
+		go func() {
+			_ = sweeper.Run(osSignal, make(chan struct{}))
+			close(exited)
+		}()
+	})
+
+	AfterEach(func() {
+		close(osSignal)
+		<-exited
+		Expect(os.RemoveAll(volumesDir)).To(Succeed())
+	})
+
+	It("reports the volumes along with the usage of their disk", func() {
+		Eventually(fakeTSAClient.ReportVolumesCallCount).Should(BeNumerically(">=", 1))
+
+		_, handles, usage := fakeTSAClient.ReportVolumesArgsForCall(0)
+		Expect(handles).To(Equal([]string{"some-handle", "some-cache-handle"}))
+		Expect(usage).ToNot(BeNil())
+		Expect(usage.Capacity).ToNot(BeZero())
+		Expect(usage.Used).To(BeNumerically("<=", usage.Capacity))
+	})
+
+	It("leaves measured volumes without a size limit as they are", func() {
+		Eventually(fakeTSAClient.ReportVolumesCallCount).Should(BeNumerically(">=", 2))
+
+		Expect(filepath.Join(volumesDir, "some-cache-handle", "some-file")).To(BeAnExistingFile())
+		Expect(filepath.Join(volumesDir, "some-cache-handle", "some-dir", "some-other-file")).To(BeAnExistingFile())
+	})
+
+	Context("when a measured volume has grown beyond its size limit", func() {
+		BeforeEach(func() {
+			cacheDir := filepath.Join(volumesDir, "some-cache-handle")
+
+			longAgo := time.Now().Add(-time.Hour)
+			Expect(os.Chtimes(filepath.Join(cacheDir, "some-file"), longAgo, longAgo)).To(Succeed())
+
+			fakeCacheVolume.PropertiesReturns(baggageclaim.VolumeProperties{
+				atc.MeasuredVolumeProperty:  "true",
+				atc.SizeLimitVolumeProperty: "50",
+			}, nil)
+		})
+
+		It("removes its least recently used files until it is within the limit", func() {
+			cacheDir := filepath.Join(volumesDir, "some-cache-handle")
+
+			Eventually(filepath.Join(cacheDir, "some-file")).ShouldNot(BeAnExistingFile())
+			Expect(filepath.Join(cacheDir, "some-dir", "some-other-file")).To(BeAnExistingFile())
+		})
+	})
+
+	Context("when the usage of the disk cannot be measured", func() {
+		BeforeEach(func() {
+			Expect(os.RemoveAll(volumesDir)).To(Succeed())
+		})
+
+		It("reports the volumes without it", func() {
+			Eventually(fakeTSAClient.ReportVolumesCallCount).Should(BeNumerically(">=", 1))
+
+			_, handles, usage := fakeTSAClient.ReportVolumesArgsForCall(0)
+			Expect(handles).To(Equal([]string{"some-handle", "some-cache-handle"}))
+			Expect(usage).To(BeNil())
+		})
+	})
+})
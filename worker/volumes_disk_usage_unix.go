@@ -0,0 +1,26 @@
+// +build !windows
+
+package worker
+
+import (
+	"syscall"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// volumesDiskUsage measures the usage of the filesystem holding baggageclaim's
+// volumes directory.
+func volumesDiskUsage(dir string) (*atc.VolumesDiskUsage, error) {
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(dir, &stat)
+	if err != nil {
+		return nil, err
+	}
+
+	blockSize := uint64(stat.Bsize)
+
+	return &atc.VolumesDiskUsage{
+		Used:     (uint64(stat.Blocks) - uint64(stat.Bfree)) * blockSize,
+		Capacity: uint64(stat.Blocks) * blockSize,
+	}, nil
+}
@@ -82,6 +82,29 @@ func containerdGardenServerRunner(
 	}, nil
 }
 
+// bridgeNetfilterPath is the sysctl which has traffic between containers on
+// the same bridge go through iptables.
+//
+const bridgeNetfilterPath = "/proc/sys/net/bridge/bridge-nf-call-iptables"
+
+// enableBridgeNetfilter has traffic between containers go through iptables,
+// so that the firewall rules of containers with restricted network access
+// keep them from reaching other containers too. Without it, containers are
+// not isolated from one another, and so the worker cannot restrict them.
+//
+func enableBridgeNetfilter(logger lager.Logger) bool {
+	// the sysctl only exists once the module is loaded
+	_ = exec.Command("modprobe", "br_netfilter").Run()
+
+	err := ioutil.WriteFile(bridgeNetfilterPath, []byte("1"), 0644)
+	if err != nil {
+		logger.Info("network-isolation-unavailable", lager.Data{"error": err.Error()})
+		return false
+	}
+
+	return true
+}
+
 // writeDefaultContainerdConfig writes a default containerd configuration file
 // to a destination.
 //
@@ -3,7 +3,9 @@
 package workercmd
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -58,7 +60,26 @@ func (cmd *WorkerCommand) guardianRunner(logger lager.Logger) (ifrit.Runner, err
 		gdnServerFlags = append(gdnServerFlags, "--network-pool", cmd.ContainerNetworkPool)
 	}
 
-	gdnServerFlags = append(gdnServerFlags, detectGardenFlags(logger)...)
+	gardenFlags := detectGardenFlags(logger)
+
+	if cmd.Garden.NetworkIsolation {
+		// unrestricted containers are allowed to reach everything, which would
+		// override any networks the operator denies them
+		denied, err := deniesNetworks(cmd.Garden.Config.Path(), gardenFlags)
+		if err != nil {
+			return nil, err
+		}
+
+		if denied {
+			return nil, errors.New("--garden-network-isolation cannot be combined with Guardian's deny-network")
+		}
+
+		// containers are allowed to reach the network by the rules the ATC
+		// creates them with, see atc/worker
+		gdnServerFlags = append(gdnServerFlags, "--deny-network", "0.0.0.0/0")
+	}
+
+	gdnServerFlags = append(gdnServerFlags, gardenFlags...)
 
 	if cmd.Garden.DNS.Enable {
 		dnsProxyRunner, err := cmd.dnsProxyRunner(logger.Session("dns-proxy"))
@@ -152,6 +173,34 @@ func detectGardenFlags(logger lager.Logger) []string {
 	return flags
 }
 
+// deniesNetworks determines whether Guardian is configured to deny networks
+// to containers, either in its config file or by the flags forwarded to it.
+func deniesNetworks(configPath string, gardenFlags []string) (bool, error) {
+	for _, flag := range gardenFlags {
+		if flag == "--deny-network" {
+			return true, nil
+		}
+	}
+
+	if configPath == "" {
+		return false, nil
+	}
+
+	config, err := ioutil.ReadFile(configPath)
+	if err != nil {
+		return false, err
+	}
+
+	for _, line := range strings.Split(string(config), "\n") {
+		key := strings.SplitN(line, "=", 2)[0]
+		if strings.TrimSpace(key) == "deny-network" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func flagify(env string) string {
 	return strings.Replace(strings.ToLower(env), "_", "-", -1)
 }
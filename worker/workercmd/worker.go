@@ -146,6 +146,7 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		cmd.SweepInterval,
 		tsaClient,
 		baggageclaimClient,
+		cmd.Baggageclaim.VolumesDir.Path(),
 		cmd.VolumeSweeperMaxInFlight,
 	)
 
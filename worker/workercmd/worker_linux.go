@@ -32,6 +32,8 @@ type GardenBackend struct {
 	DNS            DNSConfig     `group:"DNS Proxy Configuration" namespace:"dns-proxy"`
 
 	RequestTimeout time.Duration `long:"request-timeout" default:"5m" description:"How long to wait for requests to Garden to complete. 0 means no timeout."`
+
+	NetworkIsolation bool `long:"network-isolation" description:"Deny Guardian containers all network access unless it is allowed to them, so that tasks with restricted network access may run on the worker. Containers which are not restricted are allowed everything, so it cannot be combined with Guardian's --deny-network. Ignored by containerd, which always isolates restricted containers."`
 }
 
 func (cmd WorkerCommand) LessenRequirements(prefix string, command *flags.Command) {
@@ -71,8 +73,15 @@ func (cmd *WorkerCommand) gardenRunner(logger lager.Logger) (atc.Worker, ifrit.R
 		runner, err = cmd.houdiniRunner(logger)
 	case cmd.Garden.UseContainerd:
 		runner, err = cmd.containerdRunner(logger)
+		worker.Capabilities = append(worker.Capabilities, atc.WorkerCapabilitySharedNetworkNamespace)
+		if enableBridgeNetfilter(logger) {
+			worker.Capabilities = append(worker.Capabilities, atc.WorkerCapabilityNetworkIsolation)
+		}
 	default:
 		runner, err = cmd.guardianRunner(logger)
+		if cmd.Garden.NetworkIsolation {
+			worker.Capabilities = append(worker.Capabilities, atc.WorkerCapabilityNetworkIsolation)
+		}
 	}
 
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 	"github.com/concourse/concourse/worker"
 )
@@ -69,11 +70,12 @@ type FakeTSAClient struct {
 	reportContainersReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ReportVolumesStub        func(context.Context, []string) error
+	ReportVolumesStub        func(context.Context, []string, *atc.VolumesDiskUsage) error
 	reportVolumesMutex       sync.RWMutex
 	reportVolumesArgsForCall []struct {
 		arg1 context.Context
 		arg2 []string
+		arg3 *atc.VolumesDiskUsage
 	}
 	reportVolumesReturns struct {
 		result1 error
@@ -419,7 +421,7 @@ func (fake *FakeTSAClient) ReportContainersReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTSAClient) ReportVolumes(arg1 context.Context, arg2 []string) error {
+func (fake *FakeTSAClient) ReportVolumes(arg1 context.Context, arg2 []string, arg3 *atc.VolumesDiskUsage) error {
 	var arg2Copy []string
 	if arg2 != nil {
 		arg2Copy = make([]string, len(arg2))
@@ -430,11 +432,12 @@ func (fake *FakeTSAClient) ReportVolumes(arg1 context.Context, arg2 []string) er
 	fake.reportVolumesArgsForCall = append(fake.reportVolumesArgsForCall, struct {
 		arg1 context.Context
 		arg2 []string
-	}{arg1, arg2Copy})
-	fake.recordInvocation("ReportVolumes", []interface{}{arg1, arg2Copy})
+		arg3 *atc.VolumesDiskUsage
+	}{arg1, arg2Copy, arg3})
+	fake.recordInvocation("ReportVolumes", []interface{}{arg1, arg2Copy, arg3})
 	fake.reportVolumesMutex.Unlock()
 	if fake.ReportVolumesStub != nil {
-		return fake.ReportVolumesStub(arg1, arg2)
+		return fake.ReportVolumesStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -449,17 +452,17 @@ func (fake *FakeTSAClient) ReportVolumesCallCount() int {
 	return len(fake.reportVolumesArgsForCall)
 }
 
-func (fake *FakeTSAClient) ReportVolumesCalls(stub func(context.Context, []string) error) {
+func (fake *FakeTSAClient) ReportVolumesCalls(stub func(context.Context, []string, *atc.VolumesDiskUsage) error) {
 	fake.reportVolumesMutex.Lock()
 	defer fake.reportVolumesMutex.Unlock()
 	fake.ReportVolumesStub = stub
 }
 
-func (fake *FakeTSAClient) ReportVolumesArgsForCall(i int) (context.Context, []string) {
+func (fake *FakeTSAClient) ReportVolumesArgsForCall(i int) (context.Context, []string, *atc.VolumesDiskUsage) {
 	fake.reportVolumesMutex.RLock()
 	defer fake.reportVolumesMutex.RUnlock()
 	argsForCall := fake.reportVolumesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeTSAClient) ReportVolumesReturns(result1 error) {